@@ -2,7 +2,10 @@ package cmd
 
 import (
 	// Internal Server
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/auth"
 	"github.com/reidlai/ta-workspace/apps/ta-server/internal/server"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/telemetry"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/watchlist"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -19,10 +22,34 @@ func init() {
 	// Server flags
 	apiServerCmd.Flags().String("host", "localhost", "Server host")
 	apiServerCmd.Flags().Int("port", 8080, "HTTP port")
+	apiServerCmd.Flags().String("admin-host", "127.0.0.1", "Admin server host, loopback only by default")
+	apiServerCmd.Flags().Int("admin-port", 9090, "Admin HTTP port serving /metrics and the exchange import (0 disables the admin server)")
+	apiServerCmd.Flags().String("admin-token-file", "", "File holding the bearer token required by the exchange import (disabled when unset)")
+	apiServerCmd.Flags().Duration("shutdown-drain-delay", server.DefaultDrainDelay, "How long the server keeps serving with a failing readiness probe before it shuts down")
 	apiServerCmd.Flags().Bool("debug", false, "Enable debug logging (DEPRECATED: use --log-level=DEBUG)")
 	apiServerCmd.Flags().String("log-level", "INFO", "Log level: DEBUG, INFO, WARN, ERROR")
 	apiServerCmd.Flags().String("log-format", "json", "Log format: json, text")
-	apiServerCmd.Flags().Bool("secure", false, "Use HTTPS scheme")
+	apiServerCmd.Flags().Bool("secure", false, "Serve HTTPS (self-signed development certificate unless --tls-cert/--tls-key are set)")
+	apiServerCmd.Flags().String("tls-cert", "", "TLS certificate file (PEM), reloaded on change")
+	apiServerCmd.Flags().String("tls-key", "", "TLS private key file (PEM), reloaded on change")
+	apiServerCmd.Flags().String("tls-client-ca", "", "CA bundle (PEM) used to require and verify client certificates (mTLS)")
+	apiServerCmd.Flags().String("storage", "memory", "Watchlist and instrument storage backend: memory, sqlite")
+	apiServerCmd.Flags().String("storage-path", "ta-server.db", "Database file used by the sqlite storage backend")
+	apiServerCmd.Flags().String("symbol-pattern", watchlist.DefaultSymbolPattern, "Regular expression watchlist symbols must match, upper-cased and without their MIC suffix")
+	apiServerCmd.Flags().Bool("require-instrument", false, "Reject watchlist symbols missing from the instrument master")
+	apiServerCmd.Flags().Duration("watchlist-retention", watchlist.DefaultRetention, "How long removed watchlist items can be restored before they are purged")
+	apiServerCmd.Flags().String("exchanges-file", "", "ISO 10383 MIC file (CSV or XLSX) served instead of the embedded list when present, and saved by exchange imports")
+	apiServerCmd.Flags().String("auth-mode", "", "Authentication mode (required): local, jwks, issuer, or none with --insecure-dev-auth")
+	apiServerCmd.Flags().Bool("insecure-dev-auth", false, "Allow --auth-mode=none, which trusts the user ID sent by clients. Development only")
+	apiServerCmd.Flags().String("auth-issuer", "", "Expected token issuer (OpenID Connect issuer URL in issuer mode)")
+	apiServerCmd.Flags().String("auth-audience", "", "Expected token audience")
+	apiServerCmd.Flags().String("auth-jwks-file", "", "JWKS file used to validate tokens in jwks mode")
+	apiServerCmd.Flags().String("auth-signing-key", "", "HS256 secret key file used in local mode")
+	apiServerCmd.Flags().String("otel-exporter", "none", "OpenTelemetry exporter: otlp, stdout, none")
+	apiServerCmd.Flags().String("otel-endpoint", "", "OTLP/HTTP collector base URL (defaults to OTEL_EXPORTER_OTLP_ENDPOINT)")
+	apiServerCmd.Flags().Float64("otel-sample-ratio", 1.0, "Fraction of new traces to sample (0.0 - 1.0)")
+	apiServerCmd.Flags().Float64("access-log-sample-ratio", 1.0, "Fraction of successful requests written to the access log (0.0 - 1.0); failures are always logged")
+	apiServerCmd.Flags().StringSlice("access-log-exclude", []string{"/healthz", "/readyz"}, "Request path patterns excluded from the access log")
 
 	// Bind flags to Viper
 	if err := viper.BindPFlag("api-server.host", apiServerCmd.Flags().Lookup("host")); err != nil {
@@ -31,6 +58,18 @@ func init() {
 	if err := viper.BindPFlag("api-server.port", apiServerCmd.Flags().Lookup("port")); err != nil {
 		panic(err)
 	}
+	if err := viper.BindPFlag("api-server.admin-host", apiServerCmd.Flags().Lookup("admin-host")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.admin-port", apiServerCmd.Flags().Lookup("admin-port")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.admin-token-file", apiServerCmd.Flags().Lookup("admin-token-file")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.shutdown-drain-delay", apiServerCmd.Flags().Lookup("shutdown-drain-delay")); err != nil {
+		panic(err)
+	}
 	if err := viper.BindPFlag("api-server.debug", apiServerCmd.Flags().Lookup("debug")); err != nil {
 		panic(err)
 	}
@@ -43,6 +82,66 @@ func init() {
 	if err := viper.BindPFlag("api-server.secure", apiServerCmd.Flags().Lookup("secure")); err != nil {
 		panic(err)
 	}
+	if err := viper.BindPFlag("api-server.tls.cert", apiServerCmd.Flags().Lookup("tls-cert")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.tls.key", apiServerCmd.Flags().Lookup("tls-key")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.tls.client-ca", apiServerCmd.Flags().Lookup("tls-client-ca")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.storage", apiServerCmd.Flags().Lookup("storage")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.storage-path", apiServerCmd.Flags().Lookup("storage-path")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.watchlist.symbol-pattern", apiServerCmd.Flags().Lookup("symbol-pattern")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.watchlist.require-instrument", apiServerCmd.Flags().Lookup("require-instrument")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.watchlist.retention", apiServerCmd.Flags().Lookup("watchlist-retention")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.exchanges-file", apiServerCmd.Flags().Lookup("exchanges-file")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.auth.mode", apiServerCmd.Flags().Lookup("auth-mode")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.auth.insecure-dev", apiServerCmd.Flags().Lookup("insecure-dev-auth")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.auth.issuer", apiServerCmd.Flags().Lookup("auth-issuer")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.auth.audience", apiServerCmd.Flags().Lookup("auth-audience")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.auth.jwks-file", apiServerCmd.Flags().Lookup("auth-jwks-file")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.auth.signing-key", apiServerCmd.Flags().Lookup("auth-signing-key")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.otel.exporter", apiServerCmd.Flags().Lookup("otel-exporter")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.otel.endpoint", apiServerCmd.Flags().Lookup("otel-endpoint")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.otel.sample-ratio", apiServerCmd.Flags().Lookup("otel-sample-ratio")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.access-log.sample-ratio", apiServerCmd.Flags().Lookup("access-log-sample-ratio")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api-server.access-log.exclude", apiServerCmd.Flags().Lookup("access-log-exclude")); err != nil {
+		panic(err)
+	}
 
 	// Environment variable binding
 	viper.SetEnvPrefix("TA_SERVER")
@@ -51,12 +150,46 @@ func init() {
 
 func runAPIServer(cmd *cobra.Command, args []string) error {
 	cfg := server.Config{
-		Host:      viper.GetString("api-server.host"),
-		Port:      viper.GetInt("api-server.port"),
-		Debug:     viper.GetBool("api-server.debug"),
-		LogLevel:  viper.GetString("api-server.log-level"),
-		LogFormat: viper.GetString("api-server.log-format"),
-		Secure:    viper.GetBool("api-server.secure"),
+		Host:           viper.GetString("api-server.host"),
+		Port:           viper.GetInt("api-server.port"),
+		Debug:          viper.GetBool("api-server.debug"),
+		LogLevel:       viper.GetString("api-server.log-level"),
+		LogFormat:      viper.GetString("api-server.log-format"),
+		Secure:         viper.GetBool("api-server.secure"),
+		TLSCert:        viper.GetString("api-server.tls.cert"),
+		TLSKey:         viper.GetString("api-server.tls.key"),
+		TLSClientCA:    viper.GetString("api-server.tls.client-ca"),
+		AdminHost:      viper.GetString("api-server.admin-host"),
+		AdminPort:      viper.GetInt("api-server.admin-port"),
+		AdminTokenFile: viper.GetString("api-server.admin-token-file"),
+		DrainDelay:     viper.GetDuration("api-server.shutdown-drain-delay"),
+		Storage:        viper.GetString("api-server.storage"),
+		StoragePath:    viper.GetString("api-server.storage-path"),
+		ExchangesFile:  viper.GetString("api-server.exchanges-file"),
+		Auth: auth.Config{
+			Mode:           viper.GetString("api-server.auth.mode"),
+			InsecureDev:    viper.GetBool("api-server.auth.insecure-dev"),
+			Issuer:         viper.GetString("api-server.auth.issuer"),
+			Audience:       viper.GetString("api-server.auth.audience"),
+			JWKSFile:       viper.GetString("api-server.auth.jwks-file"),
+			SigningKeyFile: viper.GetString("api-server.auth.signing-key"),
+		},
+		AccessLog: server.AccessLogConfig{
+			SampleRatio:  viper.GetFloat64("api-server.access-log.sample-ratio"),
+			ExcludePaths: viper.GetStringSlice("api-server.access-log.exclude"),
+		},
+		Telemetry: telemetry.Config{
+			Exporter:       viper.GetString("api-server.otel.exporter"),
+			Endpoint:       viper.GetString("api-server.otel.endpoint"),
+			SampleRatio:    viper.GetFloat64("api-server.otel.sample-ratio"),
+			ServiceName:    "ta-server",
+			ServiceVersion: Version,
+		},
+		Watchlist: watchlist.Config{
+			SymbolPattern:     viper.GetString("api-server.watchlist.symbol-pattern"),
+			RequireInstrument: viper.GetBool("api-server.watchlist.require-instrument"),
+			Retention:         viper.GetDuration("api-server.watchlist.retention"),
+		},
 	}
 
 	return server.Run(cmd.Context(), cfg)
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/exchange"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/problem"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var exchangesCmd = &cobra.Command{
+	Use:   "exchanges",
+	Short: "Manage the ISO 10383 exchange list",
+}
+
+var exchangesImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import an ISO 10383 MIC file into a running server",
+	Long: `Validate an ISO 10383 MIC file (the CSV or XLSX published by ISO 20022) and
+upload it to the admin server of a running api-server, which swaps its
+exchange list atomically and reports the MICs added, modified and
+deactivated. The upload is authenticated with the admin token of the server
+(api-server.admin-token-file). Use --dry-run to only report the differences.`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runExchangesImport,
+}
+
+func init() {
+	exchangesImportCmd.Flags().String("admin-url", "", "Admin server base URL (defaults to http://127.0.0.1:<api-server.admin-port>)")
+	exchangesImportCmd.Flags().String("admin-token-file", "", "File holding the admin token of the server (defaults to api-server.admin-token-file)")
+	exchangesImportCmd.Flags().Bool("dry-run", false, "Report the differences without replacing the list")
+	exchangesImportCmd.Flags().Bool("json", false, "Print the report as JSON")
+
+	exchangesCmd.AddCommand(exchangesImportCmd)
+	RootCmd.AddCommand(exchangesCmd)
+}
+
+func runExchangesImport(cmd *cobra.Command, args []string) error {
+	adminURL, _ := cmd.Flags().GetString("admin-url")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	asJSON, _ := cmd.Flags().GetBool("json")
+	tokenFile, _ := cmd.Flags().GetString("admin-token-file")
+	if adminURL == "" {
+		port := viper.GetInt("api-server.admin-port")
+		if port == 0 {
+			port = 9090
+		}
+		adminURL = fmt.Sprintf("http://127.0.0.1:%d", port)
+	}
+
+	// Validate locally first so that a bad file is reported without a
+	// running server.
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	if _, err := exchange.Decode(data); err != nil {
+		return fmt.Errorf("invalid MIC file %s: %w", args[0], err)
+	}
+
+	if tokenFile == "" {
+		tokenFile = viper.GetString("api-server.admin-token-file")
+	}
+	if tokenFile == "" {
+		return errors.New("no admin token file, set --admin-token-file or api-server.admin-token-file")
+	}
+	token, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return fmt.Errorf("read admin token: %w", err)
+	}
+
+	u, err := url.Parse(strings.TrimSuffix(adminURL, "/") + "/exchanges/import")
+	if err != nil {
+		return fmt.Errorf("invalid admin URL: %w", err)
+	}
+	if dryRun {
+		u.RawQuery = "dry_run=true"
+	}
+	req, err := http.NewRequestWithContext(cmd.Context(), http.MethodPost, u.String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Authorization", "Bearer "+string(bytes.TrimSpace(token)))
+	client := &http.Client{Timeout: time.Minute}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var p problem.Problem
+		if json.Unmarshal(body, &p) == nil && p.Detail != "" {
+			return fmt.Errorf("import failed (%s): %s", resp.Status, p.Detail)
+		}
+		return fmt.Errorf("import failed (%s)", resp.Status)
+	}
+
+	if asJSON {
+		_, err := os.Stdout.Write(body)
+		return err
+	}
+	var report exchange.ImportReport
+	if err := json.Unmarshal(body, &report); err != nil {
+		return fmt.Errorf("decode import report: %w", err)
+	}
+	printImportReport(cmd.OutOrStdout(), &report)
+	return nil
+}
+
+func printImportReport(w io.Writer, r *exchange.ImportReport) {
+	verb := "Imported"
+	if r.DryRun {
+		verb = "Validated (dry run)"
+	}
+	fmt.Fprintf(w, "%s %d active exchanges\n", verb, r.Exchanges)
+	fmt.Fprintf(w, "Added (%d): %s\n", len(r.Added), strings.Join(r.Added, ", "))
+	fmt.Fprintf(w, "Modified (%d):\n", len(r.Modified))
+	for _, c := range r.Modified {
+		fmt.Fprintf(w, "  %s: %s\n", c.MIC, strings.Join(c.Fields, ", "))
+	}
+	fmt.Fprintf(w, "Deactivated (%d): %s\n", len(r.Deactivated), strings.Join(r.Deactivated, ", "))
+}
@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/problem"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/watchlist"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var watchlistCmd = &cobra.Command{
+	Use:   "watchlist",
+	Short: "Import and export watchlists",
+}
+
+var watchlistImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import a CSV or JSON file into a watchlist of a running server",
+	Long: `Upload a CSV or JSON file to the API server of a running api-server, which
+adds or updates its items in a watchlist (the default one unless --watchlist
+is set). Nothing is stored unless every row is valid; invalid rows are
+reported with their line numbers. Use --dry-run to only validate the file.
+
+The format is taken from --format, else from the file extension, else from
+the content.`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runWatchlistImport,
+}
+
+var watchlistExportCmd = &cobra.Command{
+	Use:          "export",
+	Short:        "Export a watchlist of a running server as CSV or JSON",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runWatchlistExport,
+}
+
+func init() {
+	for _, c := range []*cobra.Command{watchlistImportCmd, watchlistExportCmd} {
+		c.Flags().String("url", "", "API server base URL (defaults to http://localhost:<api-server.port>)")
+		c.Flags().String("token", "", "Bearer access token, or the user ID when the server runs with --auth-mode=none")
+		c.Flags().String("watchlist", "", "Watchlist ID (defaults to the default watchlist)")
+	}
+	watchlistImportCmd.Flags().String("format", "", "File format, csv or json")
+	watchlistImportCmd.Flags().Bool("dry-run", false, "Validate the file without storing the items")
+	watchlistImportCmd.Flags().Bool("json", false, "Print the report as JSON")
+	watchlistExportCmd.Flags().String("format", watchlist.FormatCSV, "File format, csv or json")
+	watchlistExportCmd.Flags().StringP("output", "o", "", "Output file (defaults to standard output)")
+
+	watchlistCmd.AddCommand(watchlistImportCmd, watchlistExportCmd)
+	RootCmd.AddCommand(watchlistCmd)
+}
+
+// watchlistImportReport is the response of POST /watchlist/import.
+type watchlistImportReport struct {
+	DryRun    bool `json:"dry_run"`
+	Applied   bool `json:"applied"`
+	Rows      int  `json:"rows"`
+	Added     int  `json:"added"`
+	Updated   int  `json:"updated"`
+	Unchanged int  `json:"unchanged"`
+	Errors    []struct {
+		Line  int    `json:"line"`
+		Error string `json:"error"`
+	} `json:"errors"`
+}
+
+func runWatchlistImport(cmd *cobra.Command, args []string) error {
+	format, _ := cmd.Flags().GetString("format")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	asJSON, _ := cmd.Flags().GetBool("json")
+	if format == "" {
+		switch ext := strings.ToLower(filepath.Ext(args[0])); ext {
+		case ".csv", ".json":
+			format = ext[1:]
+		}
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	if len(data) > watchlist.MaxImportSize {
+		return fmt.Errorf("%s exceeds %d bytes", args[0], watchlist.MaxImportSize)
+	}
+
+	query := url.Values{}
+	if format != "" {
+		query.Set("format", format)
+	}
+	if dryRun {
+		query.Set("dry_run", "true")
+	}
+	contentType := "text/csv"
+	if format == watchlist.FormatJSON {
+		contentType = "application/json"
+	}
+	body, err := watchlistRequest(cmd, http.MethodPost, "/watchlist/import", query, contentType, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+
+	if asJSON {
+		_, err := os.Stdout.Write(body)
+		return err
+	}
+	var report watchlistImportReport
+	if err := json.Unmarshal(body, &report); err != nil {
+		return fmt.Errorf("decode import report: %w", err)
+	}
+	printWatchlistImportReport(cmd.OutOrStdout(), &report)
+	if len(report.Errors) > 0 {
+		return fmt.Errorf("%s has %d invalid rows", args[0], len(report.Errors))
+	}
+	return nil
+}
+
+func printWatchlistImportReport(w io.Writer, r *watchlistImportReport) {
+	verb := "Imported"
+	switch {
+	case len(r.Errors) > 0:
+		verb = "Rejected"
+	case r.DryRun:
+		verb = "Validated (dry run)"
+	}
+	fmt.Fprintf(w, "%s %d rows\n", verb, r.Rows)
+	fmt.Fprintf(w, "Added: %d, updated: %d, unchanged: %d\n", r.Added, r.Updated, r.Unchanged)
+	if len(r.Errors) > 0 {
+		fmt.Fprintf(w, "Errors (%d):\n", len(r.Errors))
+		for _, e := range r.Errors {
+			fmt.Fprintf(w, "  line %d: %s\n", e.Line, e.Error)
+		}
+	}
+}
+
+func runWatchlistExport(cmd *cobra.Command, args []string) error {
+	format, _ := cmd.Flags().GetString("format")
+	output, _ := cmd.Flags().GetString("output")
+
+	body, err := watchlistRequest(cmd, http.MethodGet, "/watchlist/export", url.Values{"format": {format}}, "", nil)
+	if err != nil {
+		return fmt.Errorf("export failed: %w", err)
+	}
+	if output == "" {
+		_, err := cmd.OutOrStdout().Write(body)
+		return err
+	}
+	return os.WriteFile(output, body, 0o644)
+}
+
+// watchlistRequest sends a request to the API server with the credentials
+// and watchlist of the command flags and returns the body of a 200 response.
+func watchlistRequest(cmd *cobra.Command, method, path string, query url.Values, contentType string, body io.Reader) ([]byte, error) {
+	baseURL, _ := cmd.Flags().GetString("url")
+	token, _ := cmd.Flags().GetString("token")
+	list, _ := cmd.Flags().GetString("watchlist")
+	if baseURL == "" {
+		port := viper.GetInt("api-server.port")
+		if port == 0 {
+			port = 8080
+		}
+		baseURL = fmt.Sprintf("http://localhost:%d", port)
+	}
+
+	u, err := url.Parse(strings.TrimSuffix(baseURL, "/") + path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+	if list != "" {
+		query.Set("watchlist", list)
+	}
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(cmd.Context(), method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	client := &http.Client{Timeout: time.Minute}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var p problem.Problem
+		if json.Unmarshal(data, &p) == nil && p.Detail != "" {
+			return nil, fmt.Errorf("%s: %s", resp.Status, p.Detail)
+		}
+		return nil, errors.New(resp.Status)
+	}
+	return data, nil
+}
@@ -0,0 +1,20 @@
+// Package design contains the Goa design of the services implemented by
+// ta-server itself. Run `moon run ta-server:gen` after changing it.
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = API("ta-server", func() {
+	Title("TA Server API")
+	Description("Services implemented by the ta-server application")
+	Version("0.0.1")
+})
+
+// JWTAuth authenticates the caller with a JWT bearer token; the token
+// subject is the ID of the user the request acts for. The server validates
+// tokens as configured by --auth-mode.
+var JWTAuth = JWTSecurity("jwt", func() {
+	Description("JWT bearer token sent in the Authorization header")
+})
@@ -0,0 +1,109 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("exchange", func() {
+	Description("Manage financial exchanges")
+
+	Error("not_found", ErrorResult, "Exchange not found")
+	HTTP(func() {
+		Response("not_found", StatusNotFound)
+	})
+
+	Method("list", func() {
+		Description("List exchanges, optionally filtered, one page at a time")
+		Payload(func() {
+			Attribute("query", String, "Optional search query, matched against the MIC, acronym, name, city and country with typo tolerance")
+			Attribute("country", String, "ISO 3166 country code the exchange must be in")
+			Attribute("city", String, "City the exchange must be in")
+			Attribute("acronym", String, "Acronym the exchange must have")
+			Attribute("sort", String, "Sort order; relevance with a query and country otherwise by default", func() {
+				Enum("relevance", "name", "country", "mic")
+			})
+			PageParams()
+		})
+		Result(func() {
+			Attribute("exchanges", ArrayOf(Exchange))
+			PageResult()
+			Required("exchanges")
+		})
+		Error("invalid_cursor", ErrorResult, "Malformed cursor, or cursor issued for another sort order")
+		HTTP(func() {
+			GET("/exchanges")
+			Param("query")
+			Param("country")
+			Param("city")
+			Param("acronym")
+			Param("sort")
+			PageHTTPParams()
+			Response(StatusOK, func() {
+				PageHTTPHeaders()
+				Body("exchanges")
+			})
+			Response("invalid_cursor", StatusBadRequest)
+		})
+	})
+
+	Method("get", func() {
+		Payload(func() {
+			Attribute("operating_mic", String, "Operating MIC of the exchange")
+			Required("operating_mic")
+		})
+		Result(Exchange)
+		HTTP(func() {
+			GET("/exchanges/{operating_mic}")
+			Response(StatusOK)
+		})
+	})
+
+	Method("segments", func() {
+		Description("Segment MICs operated by an exchange")
+		Payload(func() {
+			Attribute("operating_mic", String, "Operating MIC of the exchange")
+			Required("operating_mic")
+		})
+		Result(SegmentTree)
+		HTTP(func() {
+			GET("/exchanges/{operating_mic}/segments")
+			Response(StatusOK)
+		})
+	})
+
+	Method("calendar", func() {
+		Description("Trading calendar of an exchange; the range defaults to the next 30 days, is limited to 366 days and must fall within the years the calendar covers")
+		Payload(func() {
+			Attribute("operating_mic", String, "Operating MIC of the exchange")
+			Attribute("from", String, "First date, in the exchange time zone", func() {
+				Format(FormatDate)
+			})
+			Attribute("to", String, "Last date (inclusive), in the exchange time zone", func() {
+				Format(FormatDate)
+			})
+			Required("operating_mic")
+		})
+		Result(TradingCalendar)
+		Error("invalid_range", ErrorResult, "Invalid date range")
+		HTTP(func() {
+			GET("/exchanges/{operating_mic}/calendar")
+			Param("from")
+			Param("to")
+			Response(StatusOK)
+			Response("invalid_range", StatusBadRequest)
+		})
+	})
+
+	Method("status", func() {
+		Description("Whether an exchange is currently open and when it next opens and closes")
+		Payload(func() {
+			Attribute("operating_mic", String, "Operating MIC of the exchange")
+			Required("operating_mic")
+		})
+		Result(MarketStatus)
+		HTTP(func() {
+			GET("/exchanges/{operating_mic}/status")
+			Response(StatusOK)
+		})
+	})
+})
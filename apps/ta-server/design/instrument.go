@@ -0,0 +1,150 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("instrument", func() {
+	Description("Instrument master data referenced by watchlists and portfolios")
+
+	Error("not_found", ErrorResult, "Instrument not found")
+	Error("invalid_instrument", ErrorResult, "Instrument fails validation, e.g. an unknown exchange or a bad ISIN check digit")
+	HTTP(func() {
+		Response("not_found", StatusNotFound)
+		Response("invalid_instrument", StatusBadRequest)
+	})
+
+	Method("list", func() {
+		Description("List instruments, optionally filtered, one page at a time")
+		Payload(func() {
+			Attribute("exchange_mic", String, "Operating MIC of the listing exchange")
+			Attribute("asset_class", String, "Asset class", func() {
+				Enum(AssetClasses...)
+			})
+			Attribute("currency", String, "ISO 4217 trading currency")
+			Attribute("isin", String, "ISIN")
+			Attribute("sort", String, "Sort order", func() {
+				Enum("symbol", "name")
+				Default("symbol")
+			})
+			PageParams()
+		})
+		Result(func() {
+			Attribute("instruments", ArrayOf(Instrument))
+			PageResult()
+			Required("instruments")
+		})
+		Error("invalid_cursor", ErrorResult, "Malformed cursor, or cursor issued for another sort order")
+		HTTP(func() {
+			GET("/instruments")
+			Param("exchange_mic")
+			Param("asset_class")
+			Param("currency")
+			Param("isin")
+			Param("sort")
+			PageHTTPParams()
+			Response(StatusOK, func() {
+				PageHTTPHeaders()
+				Body("instruments")
+			})
+			Response("invalid_cursor", StatusBadRequest)
+		})
+	})
+
+	Method("search", func() {
+		Description("Find instruments by symbol, name, ISIN or FIGI, best matches first")
+		Payload(func() {
+			Attribute("q", String, "Symbol, ISIN or FIGI, or words of the name", func() {
+				MinLength(1)
+			})
+			Attribute("limit", Int, "Maximum number of results", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(20)
+			})
+			Required("q")
+		})
+		Result(ArrayOf(Instrument))
+		HTTP(func() {
+			GET("/instruments/search")
+			Param("q")
+			Param("limit")
+			Response(StatusOK)
+		})
+	})
+
+	Method("get", func() {
+		Payload(func() {
+			Attribute("id", String, "Instrument ID")
+			Required("id")
+		})
+		Result(Instrument)
+		HTTP(func() {
+			GET("/instruments/{id}")
+			Response(StatusOK)
+		})
+	})
+
+	Method("create", func() {
+		Payload(func() {
+			Attribute("symbol", String, "Ticker symbol on the listing exchange", func() {
+				Pattern(`^[A-Za-z0-9][A-Za-z0-9.\-]{0,19}$`)
+			})
+			Attribute("exchange_mic", String, "Operating or segment MIC of the listing exchange", func() {
+				Pattern(`^[A-Za-z0-9]{4}$`)
+			})
+			InstrumentAttributes()
+			Required("symbol", "exchange_mic", "name", "asset_class", "currency")
+		})
+		Result(Instrument)
+		Error("conflict", ErrorResult, "Instrument or FIGI already exists")
+		HTTP(func() {
+			POST("/instruments")
+			Response(StatusCreated)
+			Response("conflict", StatusConflict)
+		})
+	})
+
+	Method("update", func() {
+		Description("Replace the attributes of an instrument; the symbol and exchange are its identity and cannot change")
+		Payload(func() {
+			Attribute("id", String, "Instrument ID")
+			InstrumentAttributes()
+			Required("id", "name", "asset_class", "currency")
+		})
+		Result(Instrument)
+		Error("conflict", ErrorResult, "FIGI already used by another instrument")
+		HTTP(func() {
+			PUT("/instruments/{id}")
+			Response(StatusOK)
+			Response("conflict", StatusConflict)
+		})
+	})
+
+	Method("delete", func() {
+		Payload(func() {
+			Attribute("id", String, "Instrument ID")
+			Required("id")
+		})
+		HTTP(func() {
+			DELETE("/instruments/{id}")
+			Response(StatusNoContent)
+		})
+	})
+
+	Method("import", func() {
+		Description("Create or update instruments from a CSV file with the columns symbol, exchange_mic, name, asset_class, currency, isin and figi. Nothing is stored unless every row is valid.")
+		Payload(func() {
+			Attribute("dry_run", Boolean, "Validate the file and report the changes without storing them", func() {
+				Default(false)
+			})
+		})
+		Result(InstrumentImportReport)
+		HTTP(func() {
+			POST("/instruments/import")
+			Param("dry_run")
+			SkipRequestBodyEncodeDecode()
+			Response(StatusOK)
+		})
+	})
+})
@@ -0,0 +1,39 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+// The cursor pagination contract shared by list methods, implemented by the
+// internal/paging package: the payload carries an opaque cursor and a page
+// size, the result the total number of matching items and the cursor of the
+// next page. Over HTTP the result attributes are returned as the
+// X-Total-Count and X-Next-Cursor headers so that the body stays an array.
+
+// PageParams declares the cursor and limit payload attributes.
+func PageParams() {
+	Attribute("cursor", String, "Opaque cursor from the X-Next-Cursor header of the previous page")
+	Attribute("limit", Int, "Maximum number of items to return; every remaining item when omitted", func() {
+		Minimum(1)
+		Maximum(1000)
+	})
+}
+
+// PageResult declares the total and next_cursor result attributes.
+func PageResult() {
+	Attribute("total", Int, "Number of items matching the filters, across all pages")
+	Attribute("next_cursor", String, "Cursor of the next page, absent on the last page")
+	Required("total")
+}
+
+// PageHTTPParams maps the PageParams attributes to query parameters.
+func PageHTTPParams() {
+	Param("cursor")
+	Param("limit")
+}
+
+// PageHTTPHeaders maps the PageResult attributes to response headers.
+func PageHTTPHeaders() {
+	Header("total:X-Total-Count")
+	Header("next_cursor:X-Next-Cursor")
+}
@@ -0,0 +1,369 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+var Exchange = Type("Exchange", func() {
+	Attribute("operating_mic", String, "4-character ISO 10383 code")
+	Attribute("exchange_name", String, "Full descriptive name")
+	Attribute("display_name", String, "Formatted name for UI")
+	Attribute("country", String, "ISO 3166 alpha-2 country code")
+	Attribute("city", String, "City location")
+	Attribute("acronym", String, "Short identifier")
+	Attribute("market_category", String, "ISO 10383 market category code", func() {
+		Example("RMKT")
+	})
+	Attribute("status", String, "ISO 10383 status", func() {
+		Example("ACTIVE")
+	})
+	Attribute("website", String, "Website of the market")
+	Attribute("creation_date", String, "Date the MIC was created", func() {
+		Format(FormatDate)
+	})
+	Attribute("last_modified_date", String, "Date the MIC was last modified", func() {
+		Format(FormatDate)
+	})
+	Attribute("segments", ArrayOf(Segment), "Active segment MICs operated by the exchange")
+	Required("operating_mic", "exchange_name", "display_name", "country", "city", "market_category", "status", "segments")
+})
+
+var Segment = Type("Segment", func() {
+	Description("Segment MIC: a section of an operating market")
+	Attribute("mic", String, "4-character ISO 10383 segment code")
+	Attribute("operating_mic", String, "Operating MIC the segment belongs to")
+	Attribute("name", String, "Full descriptive name")
+	Attribute("city", String, "City location")
+	Attribute("acronym", String, "Short identifier")
+	Attribute("market_category", String, "ISO 10383 market category code", func() {
+		Example("MLTF")
+	})
+	Attribute("status", String, "ISO 10383 status", func() {
+		Example("ACTIVE")
+	})
+	Attribute("website", String, "Website of the market")
+	Attribute("creation_date", String, "Date the MIC was created", func() {
+		Format(FormatDate)
+	})
+	Attribute("last_modified_date", String, "Date the MIC was last modified", func() {
+		Format(FormatDate)
+	})
+	Required("mic", "operating_mic", "name", "city", "market_category", "status")
+})
+
+var SegmentTree = Type("SegmentTree", func() {
+	Description("Operating MIC and the segment MICs below it")
+	Attribute("operating_mic", String, "4-character ISO 10383 code")
+	Attribute("exchange_name", String, "Full descriptive name")
+	Attribute("segments", ArrayOf(Segment), "Active segment MICs")
+	Required("operating_mic", "exchange_name", "segments")
+})
+
+var TradingSession = Type("TradingSession", func() {
+	Attribute("kind", String, "Session kind", func() {
+		Enum("pre", "regular", "post")
+	})
+	Attribute("open", String, "Session start", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("close", String, "Session end", func() {
+		Format(FormatDateTime)
+	})
+	Required("kind", "open", "close")
+})
+
+var TradingDay = Type("TradingDay", func() {
+	Attribute("date", String, "Calendar date in the exchange time zone", func() {
+		Format(FormatDate)
+	})
+	Attribute("status", String, "Whether the market trades that day", func() {
+		Enum("open", "half_day", "closed")
+	})
+	Attribute("holiday", String, "Holiday name when the market is closed for a holiday")
+	Attribute("sessions", ArrayOf(TradingSession), "Trading sessions in chronological order")
+	Required("date", "status", "sessions")
+})
+
+var TradingCalendar = Type("TradingCalendar", func() {
+	Description("Trading days of an exchange over a date range")
+	Attribute("operating_mic", String, "4-character ISO 10383 code")
+	Attribute("timezone", String, "IANA time zone of the exchange", func() {
+		Example("America/New_York")
+	})
+	Attribute("days", ArrayOf(TradingDay))
+	Required("operating_mic", "timezone", "days")
+})
+
+var MarketStatus = Type("MarketStatus", func() {
+	Description("Current state of an exchange")
+	Attribute("operating_mic", String, "4-character ISO 10383 code")
+	Attribute("timezone", String, "IANA time zone of the exchange", func() {
+		Example("America/New_York")
+	})
+	Attribute("status", String, "Market state; a lunch break is reported as closed, and the state is unknown outside the years the calendar covers", func() {
+		Enum("open", "closed", "pre", "post", "unknown")
+	})
+	Attribute("as_of", String, "Time the status was computed", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("next_open", String, "Next start of the regular session", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("next_close", String, "Next end of the regular session", func() {
+		Format(FormatDateTime)
+	})
+	Required("operating_mic", "timezone", "status", "as_of")
+})
+
+var TickerItem = Type("TickerItem", func() {
+	Attribute("symbol", String, "Stock Symbol")
+	Attribute("on_hand", Boolean, "Whether user holds the stock")
+	Attribute("created_at", String, "Creation timestamp")
+	Attribute("updated_at", String, "Last update timestamp")
+	Attribute("exchange_mic", String, "Operating MIC of the listing exchange, when known")
+	Attribute("instrument_id", String, "ID of the instrument in the instrument master, when listed there")
+	Attribute("note", String, "Free-text note")
+	Attribute("tags", ArrayOf(String), "Free-form tags")
+	Attribute("buy_target", Float64, "Target buy price, when set")
+	Attribute("sell_target", Float64, "Target sell price, when set")
+	Attribute("position", Int, "Zero-based position in the watchlist")
+	Required("symbol", "on_hand", "position")
+})
+
+var RemovedTickerItem = Type("RemovedTickerItem", func() {
+	Description("Ticker removed from a watchlist, which can be restored until it is purged")
+	Extend(TickerItem)
+	Attribute("removed_at", String, "Removal timestamp")
+	Attribute("purge_at", String, "Time after which the ticker is purged and can no longer be restored")
+	Required("removed_at", "purge_at")
+})
+
+var TickerItemsResult = Type("TickerItemsResult", func() {
+	Description("Items of a watchlist and the ETag of its version")
+	Attribute("items", ArrayOf(TickerItem), "Items, omitted when not modified")
+	Attribute("etag", String, "ETag of the watchlist version", func() {
+		Example(`"3f9a0c2d41b7e865-12"`)
+	})
+	Attribute("outcome", String, "not_modified when If-None-Match names the current version")
+	Required("etag")
+})
+
+// TickerItemAttributes declares the optional watchlist item attributes
+// shared by the add and update payloads.
+func TickerItemAttributes() {
+	Attribute("note", String, "Free-text note; empty clears it", func() {
+		MaxLength(1000)
+	})
+	Attribute("tags", ArrayOf(String, func() {
+		Pattern(`\S`)
+		MaxLength(50)
+	}), "Free-form tags, replacing the current ones; empty clears them", func() {
+		MaxLength(20)
+	})
+	Attribute("buy_target", Float64, "Target buy price; 0 clears it", func() {
+		Minimum(0)
+	})
+	Attribute("sell_target", Float64, "Target sell price; 0 clears it", func() {
+		Minimum(0)
+	})
+}
+
+var Watchlist = Type("Watchlist", func() {
+	Description("Named watchlist of a user")
+	Attribute("id", String, "Watchlist ID", func() {
+		Example("3f9a0c2d41b7e865")
+	})
+	Attribute("name", String, "Watchlist name", func() {
+		Example("Tech")
+	})
+	Attribute("position", Int, "Zero-based position among the user's watchlists")
+	Attribute("default", Boolean, "Whether this is the default watchlist, served by the /watchlist routes")
+	Attribute("item_count", Int, "Number of items")
+	Attribute("created_at", String, "Creation time", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("updated_at", String, "Last rename or move", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("owner", String, "User ID of the owner")
+	Attribute("role", String, "Access of the user: owner of the watchlist, or the role it is shared with them with", func() {
+		Enum(WatchlistRoles...)
+	})
+	Attribute("public_token", String, "Token of the public link, /public/watchlists/{public_token}, when published; shown to the owner only")
+	Required("id", "name", "position", "default", "item_count", "created_at", "updated_at", "owner", "role")
+})
+
+// WatchlistRoles lists the access a user may have to a watchlist, from the
+// most to the least.
+var WatchlistRoles = []any{"owner", "editor", "viewer"}
+
+var WatchlistShare = Type("WatchlistShare", func() {
+	Description("Access to a watchlist granted to a user other than its owner")
+	Attribute("user_id", String, "User ID the watchlist is shared with")
+	Attribute("role", String, "editor changes the items of the watchlist; viewer only reads them", func() {
+		Enum("editor", "viewer")
+	})
+	Attribute("created_at", String, "When the watchlist was first shared with the user", func() {
+		Format(FormatDateTime)
+	})
+	Required("user_id", "role", "created_at")
+})
+
+var PublicWatchlist = Type("PublicWatchlist", func() {
+	Description("Watchlist read through its public link")
+	Attribute("name", String, "Watchlist name")
+	Attribute("items", ArrayOf(PublicTickerItem))
+	Required("name", "items")
+})
+
+var PublicTickerItem = Type("PublicTickerItem", func() {
+	Description("Ticker of a public watchlist; whether the owner holds it is not disclosed")
+	Attribute("symbol", String, "Stock Symbol")
+	Attribute("exchange_mic", String, "Operating MIC of the listing exchange, when known")
+	Attribute("instrument_id", String, "ID of the instrument in the instrument master, when listed there")
+	Attribute("note", String, "Free-text note")
+	Attribute("tags", ArrayOf(String), "Free-form tags")
+	Attribute("buy_target", Float64, "Target buy price, when set")
+	Attribute("sell_target", Float64, "Target sell price, when set")
+	Attribute("position", Int, "Zero-based position in the watchlist")
+	Required("symbol", "position")
+})
+
+var Instrument = Type("Instrument", func() {
+	Description("Canonical instrument listed on an exchange")
+	Attribute("id", String, "Instrument ID, the listing exchange operating MIC and the symbol", func() {
+		Example("XNAS:AAPL")
+	})
+	Attribute("symbol", String, "Ticker symbol on the listing exchange", func() {
+		Example("AAPL")
+	})
+	Attribute("name", String, "Instrument name", func() {
+		Example("Apple Inc.")
+	})
+	Attribute("asset_class", String, "Asset class", func() {
+		Enum(AssetClasses...)
+	})
+	Attribute("currency", String, "ISO 4217 trading currency", func() {
+		Example("USD")
+	})
+	Attribute("isin", String, "ISO 6166 International Securities Identification Number", func() {
+		Example("US0378331005")
+	})
+	Attribute("figi", String, "Financial Instrument Global Identifier of the listing", func() {
+		Example("BBG000B9XRY4")
+	})
+	Attribute("exchange_mic", String, "Operating MIC of the listing exchange", func() {
+		Example("XNAS")
+	})
+	Attribute("created_at", String, "Creation time", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("updated_at", String, "Last update time", func() {
+		Format(FormatDateTime)
+	})
+	Required("id", "symbol", "name", "asset_class", "currency", "exchange_mic", "created_at", "updated_at")
+})
+
+// AssetClasses lists the instrument asset classes.
+var AssetClasses = []any{"equity", "etf", "fund", "bond", "index", "fx", "crypto", "derivative", "other"}
+
+// InstrumentAttributes declares the writable instrument attributes shared by
+// the create and update payloads.
+func InstrumentAttributes() {
+	Attribute("name", String, "Instrument name", func() {
+		MinLength(1)
+		MaxLength(200)
+	})
+	Attribute("asset_class", String, "Asset class", func() {
+		Enum(AssetClasses...)
+	})
+	Attribute("currency", String, "ISO 4217 trading currency", func() {
+		Pattern(`^[A-Z]{3}$`)
+	})
+	Attribute("isin", String, "ISO 6166 International Securities Identification Number", func() {
+		Pattern(`^[A-Z]{2}[A-Z0-9]{9}[0-9]$`)
+	})
+	Attribute("figi", String, "Financial Instrument Global Identifier of the listing", func() {
+		Pattern(`^[B-DF-HJ-NP-TV-Z0-9]{2}G[B-DF-HJ-NP-TV-Z0-9]{8}[0-9]$`)
+	})
+}
+
+var InstrumentImportReport = Type("InstrumentImportReport", func() {
+	Description("Outcome of a bulk instrument import")
+	Attribute("dry_run", Boolean, "Whether the file was only validated")
+	Attribute("applied", Boolean, "Whether the instruments were stored; false when any row is invalid")
+	Attribute("rows", Int, "Number of data rows in the file")
+	Attribute("created", Int, "Instruments created, or that would be")
+	Attribute("updated", Int, "Instruments updated, or that would be")
+	Attribute("unchanged", Int, "Instruments already up to date")
+	Attribute("errors", ArrayOf(ImportRowError), "Invalid rows")
+	Required("dry_run", "applied", "rows", "created", "updated", "unchanged", "errors")
+})
+
+var WatchlistImportReport = Type("WatchlistImportReport", func() {
+	Description("Outcome of a watchlist import")
+	Attribute("dry_run", Boolean, "Whether the file was only validated")
+	Attribute("applied", Boolean, "Whether the items were stored; false when any row is invalid")
+	Attribute("rows", Int, "Number of data rows in the file")
+	Attribute("added", Int, "Items added, or that would be")
+	Attribute("updated", Int, "Items updated, or that would be")
+	Attribute("unchanged", Int, "Items already up to date")
+	Attribute("errors", ArrayOf(ImportRowError), "Invalid rows")
+	Required("dry_run", "applied", "rows", "added", "updated", "unchanged", "errors")
+})
+
+var ImportRowError = Type("ImportRowError", func() {
+	Attribute("line", Int, "Line of the row in the file")
+	Attribute("error", String, "Why the row is invalid")
+	Required("line", "error")
+})
+
+var BulkAddItem = Type("BulkAddItem", func() {
+	Description("Ticker of a bulk add")
+	Attribute("symbol", String, "Ticker symbol, optionally suffixed with the MIC of its listing exchange")
+	Attribute("on_hand", Boolean, "Whether user holds the stock; unchanged when omitted, false for new items")
+	TickerItemAttributes()
+	Required("symbol")
+})
+
+var WatchlistBulkResult = Type("WatchlistBulkResult", func() {
+	Description("Outcome of a bulk add or remove")
+	Attribute("mode", String, "Mode of the request", func() {
+		Enum(BulkModes...)
+	})
+	Attribute("succeeded", Int, "Entries stored")
+	Attribute("failed", Int, "Entries rejected")
+	Attribute("results", ArrayOf(BulkItemResult), "Result of each entry, in request order")
+	Required("mode", "succeeded", "failed", "results")
+})
+
+var BulkItemResult = Type("BulkItemResult", func() {
+	Attribute("symbol", String, "Symbol as given in the request")
+	Attribute("status", String, "added, updated or removed when stored; invalid or not_found when rejected; skipped when valid but not stored because another entry was rejected in all_or_nothing mode", func() {
+		Enum("added", "updated", "removed", "invalid", "not_found", "skipped")
+	})
+	Attribute("error", String, "Why the entry was rejected")
+	Attribute("item", TickerItem, "Stored item of an added or updated entry")
+	Required("symbol", "status")
+})
+
+// BulkModes lists the modes of the bulk watchlist methods.
+var BulkModes = []any{"all_or_nothing", "best_effort"}
+
+var WatchlistEvent = Type("WatchlistEvent", func() {
+	Description("Server-Sent Event announcing a change of the items of a watchlist")
+	Attribute("id", String, "Event ID, sent back in the Last-Event-ID header to resume the stream")
+	Attribute("type", String, "added, updated, removed or restored for an item; ready once the stream is open and the missed events sent; reset instead of ready when events were missed and the watchlists must be reloaded", func() {
+		Enum("added", "updated", "removed", "restored", "ready", "reset")
+	})
+	Attribute("data", WatchlistChange, "Change, omitted from ready and reset events")
+	Required("id", "type")
+})
+
+var WatchlistChange = Type("WatchlistChange", func() {
+	Attribute("watchlist", String, "ID of the changed watchlist")
+	Attribute("symbol", String, "Symbol of the changed item")
+	Attribute("item", TickerItem, "Item after the change, omitted when removed")
+	Attribute("changed_at", String, "Change timestamp")
+	Required("watchlist", "symbol", "changed_at")
+})
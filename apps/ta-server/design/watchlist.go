@@ -0,0 +1,571 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+// The watchlist service was designed in the watchlist module; ta-server
+// implements it and owns its API so that it can evolve with the instrument
+// master it references.
+var _ = Service("watchlist", func() {
+	Description("Manage user watchlists. Each user has a default watchlist, served by the /watchlist routes, and may create further named watchlists, share them with other users and publish them through a public link.")
+
+	Security(JWTAuth)
+
+	Error("unauthorized", ErrorResult, "Missing or invalid bearer token")
+	Error("not_found", ErrorResult, "Watchlist or watchlist item not found, or watchlist not shared with the user")
+	Error("forbidden", ErrorResult, "Watchlist shared with the user with a role that does not allow the change")
+	Error("conflict", ErrorResult, "Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner")
+	Error("precondition_failed", ErrorResult, "If-Match names no current version of the watchlist")
+	HTTP(func() {
+		Response("unauthorized", StatusUnauthorized)
+		Response("not_found", StatusNotFound)
+		Response("forbidden", StatusForbidden)
+		Response("conflict", StatusConflict)
+		Response("precondition_failed", StatusPreconditionFailed)
+	})
+
+	Method("list", func() {
+		Description("List the items of the default watchlist. The ETag header identifies the version of the watchlist; an If-None-Match header naming it returns 304 without items.")
+		Payload(func() {
+			TokenAttribute()
+			IfNoneMatchAttribute()
+		})
+		Result(TickerItemsResult)
+		HTTP(func() {
+			GET("/watchlist")
+			Header("if_none_match:If-None-Match")
+			TickerItemsResponses()
+		})
+	})
+
+	Method("add", func() {
+		Description("Add a ticker to the default watchlist, after its other items. The symbol is upper-cased and may name its listing exchange with a MIC suffix, as in VOD.XLON. Re-adding a ticker updates the given attributes and keeps the others.")
+		Payload(func() {
+			TokenAttribute()
+			Attribute("symbol", String, "Ticker symbol, optionally suffixed with the MIC of its listing exchange")
+			Attribute("on_hand", Boolean)
+			TickerItemAttributes()
+			IfMatchAttribute()
+			Required("symbol", "on_hand")
+		})
+		Result(TickerItem)
+		Error("invalid_symbol", ErrorResult, "Malformed symbol, unknown exchange, or symbol missing from or ambiguous in the instrument master")
+		HTTP(func() {
+			POST("/watchlist")
+			Header("if_match:If-Match")
+			Response(StatusOK)
+			Response("invalid_symbol", StatusBadRequest)
+		})
+	})
+
+	Method("update", func() {
+		Description("Update a ticker of the default watchlist in place; omitted attributes are unchanged")
+		Payload(func() {
+			TokenAttribute()
+			Attribute("symbol", String)
+			TickerItemUpdateAttributes()
+			IfMatchAttribute()
+			Required("symbol")
+		})
+		Result(TickerItem)
+		HTTP(func() {
+			PATCH("/watchlist/{symbol}")
+			Header("if_match:If-Match")
+			Response(StatusOK)
+		})
+	})
+
+	Method("remove", func() {
+		Description("Remove a ticker from the default watchlist. It is listed by list_removed and can be restored until it is purged at the end of the retention window.")
+		Payload(func() {
+			TokenAttribute()
+			Attribute("symbol", String)
+			IfMatchAttribute()
+			Required("symbol")
+		})
+		HTTP(func() {
+			DELETE("/watchlist/{symbol}")
+			Header("if_match:If-Match")
+			Response(StatusNoContent)
+		})
+	})
+
+	Method("list_removed", func() {
+		Description("List the tickers removed from a watchlist that can still be restored, most recently removed first")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistParamAttribute()
+		})
+		Result(ArrayOf(RemovedTickerItem))
+		HTTP(func() {
+			GET("/watchlist/removed")
+			Param("watchlist")
+			Response(StatusOK)
+		})
+	})
+
+	Method("restore", func() {
+		Description("Put a removed ticker back into a watchlist at the position it had, shifting the items after it")
+		Payload(func() {
+			TokenAttribute()
+			Attribute("symbol", String)
+			WatchlistParamAttribute()
+			IfMatchAttribute()
+			Required("symbol")
+		})
+		Result(TickerItem)
+		HTTP(func() {
+			POST("/watchlist/{symbol}/restore")
+			Header("if_match:If-Match")
+			Param("watchlist")
+			Response(StatusOK)
+		})
+	})
+
+	Method("events", func() {
+		Description("Stream the changes of the items of the user's watchlists, or of one watchlist, possibly shared with them, as Server-Sent Events. A client reconnecting with the Last-Event-ID header receives the events it missed, or a reset event when they are no longer retained. Clients that cannot send the Authorization header, such as browser EventSource, may pass a bearer token expiring within 5 minutes as the access_token query parameter instead.")
+		Payload(func() {
+			TokenAttribute()
+			Attribute("watchlist", String, "Watchlist ID, all of the user's watchlists when omitted")
+			Attribute("last_event_id", String, "ID of the last event received")
+		})
+		StreamingResult(WatchlistEvent)
+		HTTP(func() {
+			GET("/watchlist/events")
+			// Mapped as a plain header: the SSERequestID mapping of goa
+			// v3.23 generates code that does not compile.
+			Header("last_event_id:Last-Event-ID")
+			Param("watchlist")
+			ServerSentEvents(func() {
+				SSEEventID("id")
+				SSEEventType("type")
+				SSEEventData("data")
+			})
+			Response(StatusOK)
+		})
+	})
+
+	Method("bulk_add", func() {
+		Description("Add or update up to 100 tickers of a watchlist in one transaction, each as add does")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistParamAttribute()
+			BulkModeAttribute()
+			Attribute("items", ArrayOf(BulkAddItem), "Tickers to add", func() {
+				MinLength(1)
+				MaxLength(100)
+			})
+			IfMatchAttribute()
+			Required("items")
+		})
+		Result(WatchlistBulkResult)
+		HTTP(func() {
+			POST("/watchlist/bulk/add")
+			Header("if_match:If-Match")
+			Param("watchlist")
+			Response(StatusOK)
+		})
+	})
+
+	Method("bulk_remove", func() {
+		Description("Remove up to 100 tickers from a watchlist in one transaction. Unlike remove, a ticker that is not in the watchlist is rejected.")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistParamAttribute()
+			BulkModeAttribute()
+			Attribute("symbols", ArrayOf(String), "Tickers to remove", func() {
+				MinLength(1)
+				MaxLength(100)
+			})
+			IfMatchAttribute()
+			Required("symbols")
+		})
+		Result(WatchlistBulkResult)
+		HTTP(func() {
+			POST("/watchlist/bulk/remove")
+			Header("if_match:If-Match")
+			Param("watchlist")
+			Response(StatusOK)
+		})
+	})
+
+	Method("import", func() {
+		Description("Add or update the tickers of a CSV or JSON file in a watchlist, as add does. Nothing is stored unless every row is valid.")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistParamAttribute()
+			Attribute("format", String, "File format, detected from the content when omitted", func() {
+				Enum("csv", "json")
+			})
+			Attribute("dry_run", Boolean, "Validate the file and report the changes without storing them", func() {
+				Default(false)
+			})
+			IfMatchAttribute()
+		})
+		Result(WatchlistImportReport)
+		Error("invalid_file", ErrorResult, "Unreadable file, e.g. malformed JSON or a CSV file without a symbol column")
+		HTTP(func() {
+			POST("/watchlist/import")
+			Header("if_match:If-Match")
+			Param("watchlist")
+			Param("format")
+			Param("dry_run")
+			SkipRequestBodyEncodeDecode()
+			Response(StatusOK)
+			Response("invalid_file", StatusBadRequest)
+		})
+	})
+
+	Method("export", func() {
+		Description("Download the items of a watchlist as a CSV or JSON file that import accepts")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistParamAttribute()
+			Attribute("format", String, "File format", func() {
+				Enum("csv", "json")
+				Default("csv")
+			})
+		})
+		Result(func() {
+			Attribute("content_type", String)
+			Attribute("content_disposition", String)
+			Required("content_type", "content_disposition")
+		})
+		HTTP(func() {
+			GET("/watchlist/export")
+			Param("watchlist")
+			Param("format")
+			SkipResponseBodyEncodeDecode()
+			Response(StatusOK, func() {
+				Header("content_type:Content-Type")
+				Header("content_disposition:Content-Disposition")
+			})
+		})
+	})
+
+	Method("list_watchlists", func() {
+		Description("List the user's watchlists in their order, starting with the default watchlist when it has not been moved")
+		Payload(func() {
+			TokenAttribute()
+		})
+		Result(ArrayOf(Watchlist))
+		HTTP(func() {
+			GET("/watchlists")
+			Response(StatusOK)
+		})
+	})
+
+	Method("list_shared_watchlists", func() {
+		Description("List the watchlists of other users shared with the user, by name. They are read and changed through the /watchlists/{id} routes as their role allows.")
+		Payload(func() {
+			TokenAttribute()
+		})
+		Result(ArrayOf(Watchlist))
+		HTTP(func() {
+			GET("/watchlists/shared")
+			Response(StatusOK)
+		})
+	})
+
+	Method("create_watchlist", func() {
+		Description("Create an empty watchlist after the user's other watchlists")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistNameAttribute()
+			Required("name")
+		})
+		Result(Watchlist)
+		HTTP(func() {
+			POST("/watchlists")
+			Response(StatusCreated)
+		})
+	})
+
+	Method("get_watchlist", func() {
+		Payload(func() {
+			TokenAttribute()
+			WatchlistIDAttribute()
+			Required("id")
+		})
+		Result(Watchlist)
+		HTTP(func() {
+			GET("/watchlists/{id}")
+			Response(StatusOK)
+		})
+	})
+
+	Method("update_watchlist", func() {
+		Description("Rename a watchlist or move it to another position; the other watchlists shift to make room")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistIDAttribute()
+			WatchlistNameAttribute()
+			Attribute("position", Int, "New zero-based position; positions past the end move the watchlist last", func() {
+				Minimum(0)
+			})
+			IfMatchAttribute()
+			Required("id")
+		})
+		Result(Watchlist)
+		HTTP(func() {
+			PATCH("/watchlists/{id}")
+			Header("if_match:If-Match")
+			Response(StatusOK)
+		})
+	})
+
+	Method("delete_watchlist", func() {
+		Description("Delete a watchlist and its items, ending its shares and public link. The default watchlist cannot be deleted.")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistIDAttribute()
+			IfMatchAttribute()
+			Required("id")
+		})
+		HTTP(func() {
+			DELETE("/watchlists/{id}")
+			Header("if_match:If-Match")
+			Response(StatusNoContent)
+		})
+	})
+
+	Method("list_items", func() {
+		Description("List the items of a watchlist, with its ETag as list does")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistIDAttribute()
+			IfNoneMatchAttribute()
+			Required("id")
+		})
+		Result(TickerItemsResult)
+		HTTP(func() {
+			GET("/watchlists/{id}/items")
+			Header("if_none_match:If-None-Match")
+			TickerItemsResponses()
+		})
+	})
+
+	Method("add_item", func() {
+		Description("Add a ticker to a watchlist, normalized as by add")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistIDAttribute()
+			Attribute("symbol", String, "Ticker symbol, optionally suffixed with the MIC of its listing exchange")
+			Attribute("on_hand", Boolean)
+			TickerItemAttributes()
+			IfMatchAttribute()
+			Required("id", "symbol", "on_hand")
+		})
+		Result(TickerItem)
+		Error("invalid_symbol", ErrorResult, "Malformed symbol, unknown exchange, or symbol missing from or ambiguous in the instrument master")
+		HTTP(func() {
+			POST("/watchlists/{id}/items")
+			Header("if_match:If-Match")
+			Response(StatusOK)
+			Response("invalid_symbol", StatusBadRequest)
+		})
+	})
+
+	Method("update_item", func() {
+		Description("Update a ticker of a watchlist in place, as update does")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistIDAttribute()
+			Attribute("symbol", String)
+			TickerItemUpdateAttributes()
+			IfMatchAttribute()
+			Required("id", "symbol")
+		})
+		Result(TickerItem)
+		HTTP(func() {
+			PATCH("/watchlists/{id}/items/{symbol}")
+			Header("if_match:If-Match")
+			Response(StatusOK)
+		})
+	})
+
+	Method("remove_item", func() {
+		Description("Remove a ticker from a watchlist")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistIDAttribute()
+			Attribute("symbol", String)
+			IfMatchAttribute()
+			Required("id", "symbol")
+		})
+		HTTP(func() {
+			DELETE("/watchlists/{id}/items/{symbol}")
+			Header("if_match:If-Match")
+			Response(StatusNoContent)
+		})
+	})
+
+	Method("list_shares", func() {
+		Description("List the users a watchlist is shared with, by user ID. Only its owner may list them.")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistIDAttribute()
+			Required("id")
+		})
+		Result(ArrayOf(WatchlistShare))
+		HTTP(func() {
+			GET("/watchlists/{id}/shares")
+			Response(StatusOK)
+		})
+	})
+
+	Method("share_watchlist", func() {
+		Description("Share a watchlist with another user, or change the role it is shared with them with. Only its owner may share it.")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistIDAttribute()
+			ShareUserAttribute()
+			Attribute("role", String, "editor changes the items of the watchlist; viewer only reads them", func() {
+				Enum("editor", "viewer")
+			})
+			Required("id", "user", "role")
+		})
+		Result(WatchlistShare)
+		HTTP(func() {
+			PUT("/watchlists/{id}/shares/{user}")
+			Response(StatusOK)
+		})
+	})
+
+	Method("unshare_watchlist", func() {
+		Description("Stop sharing a watchlist with a user. Its owner may remove any share; other users only their own, to leave the watchlist.")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistIDAttribute()
+			ShareUserAttribute()
+			Required("id", "user")
+		})
+		HTTP(func() {
+			DELETE("/watchlists/{id}/shares/{user}")
+			Response(StatusNoContent)
+		})
+	})
+
+	Method("publish_watchlist", func() {
+		Description("Publish a watchlist through a public link that anyone knowing it can read without authentication. Publishing a published watchlist keeps its link; unpublish it first to replace the link.")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistIDAttribute()
+			Required("id")
+		})
+		Result(Watchlist)
+		HTTP(func() {
+			PUT("/watchlists/{id}/public")
+			Response(StatusOK)
+		})
+	})
+
+	Method("unpublish_watchlist", func() {
+		Description("Disable the public link of a watchlist")
+		Payload(func() {
+			TokenAttribute()
+			WatchlistIDAttribute()
+			Required("id")
+		})
+		HTTP(func() {
+			DELETE("/watchlists/{id}/public")
+			Response(StatusNoContent)
+		})
+	})
+
+	Method("get_public_watchlist", func() {
+		Description("Read a published watchlist through its public link. Served without authentication.")
+		NoSecurity()
+		Payload(func() {
+			Attribute("token", String, "Token of the public link")
+			Required("token")
+		})
+		Result(PublicWatchlist)
+		HTTP(func() {
+			GET("/public/watchlists/{token}")
+			Response(StatusOK)
+		})
+	})
+})
+
+// TokenAttribute declares the token payload attribute of the methods secured
+// by JWTAuth, read from the Authorization header. The methods act for the
+// subject of the token. It is not required so that a missing token is
+// rejected by JWTAuth with 401 rather than by the decoder with 400.
+func TokenAttribute() {
+	Token("token", String, "JWT bearer token, whose subject is the ID of the user")
+}
+
+// IfMatchAttribute declares the if_match payload attribute of the methods
+// changing a watchlist or its items, read from the If-Match header.
+func IfMatchAttribute() {
+	Attribute("if_match", String, "ETags of the watchlist versions the change is based on, or *; an outdated version returns 412")
+}
+
+// IfNoneMatchAttribute declares the if_none_match payload attribute of the
+// methods listing the items of a watchlist, read from the If-None-Match
+// header.
+func IfNoneMatchAttribute() {
+	Attribute("if_none_match", String, "ETags of watchlist versions the client has, or *")
+}
+
+// TickerItemsResponses declares the responses of the methods listing the
+// items of a watchlist: the items and their ETag, or 304 when the client
+// has them.
+func TickerItemsResponses() {
+	Response(StatusNotModified, func() {
+		Tag("outcome", "not_modified")
+		Header("etag:ETag")
+		Body(Empty)
+	})
+	Response(StatusOK, func() {
+		Header("etag:ETag")
+		Body("items")
+	})
+}
+
+// WatchlistIDAttribute declares the id payload attribute of the watchlist
+// methods.
+func WatchlistIDAttribute() {
+	Attribute("id", String, `Watchlist ID, or "default" for the default watchlist`)
+}
+
+// ShareUserAttribute declares the user payload attribute of the methods
+// managing the shares of a watchlist.
+func ShareUserAttribute() {
+	Attribute("user", String, "User ID the watchlist is shared with")
+}
+
+// TickerItemUpdateAttributes declares the attributes of the item update
+// payloads, all optional.
+func TickerItemUpdateAttributes() {
+	Attribute("on_hand", Boolean)
+	TickerItemAttributes()
+	Attribute("position", Int, "New zero-based position; positions past the end move the item last", func() {
+		Minimum(0)
+	})
+}
+
+// WatchlistParamAttribute declares the watchlist query parameter of the
+// methods that act on the default watchlist unless told otherwise.
+func WatchlistParamAttribute() {
+	Attribute("watchlist", String, "Watchlist ID, the default watchlist when omitted")
+}
+
+// BulkModeAttribute declares the mode attribute of the bulk payloads.
+func BulkModeAttribute() {
+	Attribute("mode", String, "all_or_nothing stores no entry unless every one is valid; best_effort stores the valid ones", func() {
+		Enum(BulkModes...)
+		Default("all_or_nothing")
+	})
+}
+
+// WatchlistNameAttribute declares the name attribute of the watchlist
+// payloads.
+func WatchlistNameAttribute() {
+	Attribute("name", String, "Watchlist name, unique per user regardless of case", func() {
+		Pattern(`\S`)
+		MaxLength(100)
+	})
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // exchange client
 //
@@ -15,29 +15,42 @@ import (
 
 // Client is the "exchange" service client.
 type Client struct {
-	ListEndpoint goa.Endpoint
-	GetEndpoint  goa.Endpoint
+	ListEndpoint     goa.Endpoint
+	GetEndpoint      goa.Endpoint
+	SegmentsEndpoint goa.Endpoint
+	CalendarEndpoint goa.Endpoint
+	StatusEndpoint   goa.Endpoint
 }
 
 // NewClient initializes a "exchange" service client given the endpoints.
-func NewClient(list, get goa.Endpoint) *Client {
+func NewClient(list, get, segments, calendar, status goa.Endpoint) *Client {
 	return &Client{
-		ListEndpoint: list,
-		GetEndpoint:  get,
+		ListEndpoint:     list,
+		GetEndpoint:      get,
+		SegmentsEndpoint: segments,
+		CalendarEndpoint: calendar,
+		StatusEndpoint:   status,
 	}
 }
 
 // List calls the "list" endpoint of the "exchange" service.
-func (c *Client) List(ctx context.Context, p *ListPayload) (res []*Exchange, err error) {
+// List may return the following errors:
+//   - "invalid_cursor" (type *goa.ServiceError): Malformed cursor, or cursor issued for another sort order
+//   - "not_found" (type *goa.ServiceError): Exchange not found
+//   - error: internal error
+func (c *Client) List(ctx context.Context, p *ListPayload) (res *ListResult, err error) {
 	var ires any
 	ires, err = c.ListEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
-	return ires.([]*Exchange), nil
+	return ires.(*ListResult), nil
 }
 
 // Get calls the "get" endpoint of the "exchange" service.
+// Get may return the following errors:
+//   - "not_found" (type *goa.ServiceError): Exchange not found
+//   - error: internal error
 func (c *Client) Get(ctx context.Context, p *GetPayload) (res *Exchange, err error) {
 	var ires any
 	ires, err = c.GetEndpoint(ctx, p)
@@ -46,3 +59,43 @@ func (c *Client) Get(ctx context.Context, p *GetPayload) (res *Exchange, err err
 	}
 	return ires.(*Exchange), nil
 }
+
+// Segments calls the "segments" endpoint of the "exchange" service.
+// Segments may return the following errors:
+//   - "not_found" (type *goa.ServiceError): Exchange not found
+//   - error: internal error
+func (c *Client) Segments(ctx context.Context, p *SegmentsPayload) (res *SegmentTree, err error) {
+	var ires any
+	ires, err = c.SegmentsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SegmentTree), nil
+}
+
+// Calendar calls the "calendar" endpoint of the "exchange" service.
+// Calendar may return the following errors:
+//   - "invalid_range" (type *goa.ServiceError): Invalid date range
+//   - "not_found" (type *goa.ServiceError): Exchange not found
+//   - error: internal error
+func (c *Client) Calendar(ctx context.Context, p *CalendarPayload) (res *TradingCalendar, err error) {
+	var ires any
+	ires, err = c.CalendarEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TradingCalendar), nil
+}
+
+// Status calls the "status" endpoint of the "exchange" service.
+// Status may return the following errors:
+//   - "not_found" (type *goa.ServiceError): Exchange not found
+//   - error: internal error
+func (c *Client) Status(ctx context.Context, p *StatusPayload) (res *MarketStatus, err error) {
+	var ires any
+	ires, err = c.StatusEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*MarketStatus), nil
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // exchange endpoints
 //
@@ -15,15 +15,21 @@ import (
 
 // Endpoints wraps the "exchange" service endpoints.
 type Endpoints struct {
-	List goa.Endpoint
-	Get  goa.Endpoint
+	List     goa.Endpoint
+	Get      goa.Endpoint
+	Segments goa.Endpoint
+	Calendar goa.Endpoint
+	Status   goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "exchange" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		List: NewListEndpoint(s),
-		Get:  NewGetEndpoint(s),
+		List:     NewListEndpoint(s),
+		Get:      NewGetEndpoint(s),
+		Segments: NewSegmentsEndpoint(s),
+		Calendar: NewCalendarEndpoint(s),
+		Status:   NewStatusEndpoint(s),
 	}
 }
 
@@ -31,6 +37,9 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.List = m(e.List)
 	e.Get = m(e.Get)
+	e.Segments = m(e.Segments)
+	e.Calendar = m(e.Calendar)
+	e.Status = m(e.Status)
 }
 
 // NewListEndpoint returns an endpoint function that calls the method "list" of
@@ -50,3 +59,30 @@ func NewGetEndpoint(s Service) goa.Endpoint {
 		return s.Get(ctx, p)
 	}
 }
+
+// NewSegmentsEndpoint returns an endpoint function that calls the method
+// "segments" of service "exchange".
+func NewSegmentsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SegmentsPayload)
+		return s.Segments(ctx, p)
+	}
+}
+
+// NewCalendarEndpoint returns an endpoint function that calls the method
+// "calendar" of service "exchange".
+func NewCalendarEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CalendarPayload)
+		return s.Calendar(ctx, p)
+	}
+}
+
+// NewStatusEndpoint returns an endpoint function that calls the method
+// "status" of service "exchange".
+func NewStatusEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*StatusPayload)
+		return s.Status(ctx, p)
+	}
+}
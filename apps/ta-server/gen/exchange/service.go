@@ -1,4 +1,4 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // exchange service
 //
@@ -9,28 +9,50 @@ package exchange
 
 import (
 	"context"
+
+	goa "goa.design/goa/v3/pkg"
 )
 
 // Manage financial exchanges
 type Service interface {
-	// List implements list.
-	List(context.Context, *ListPayload) (res []*Exchange, err error)
+	// List exchanges, optionally filtered, one page at a time
+	List(context.Context, *ListPayload) (res *ListResult, err error)
 	// Get implements get.
 	Get(context.Context, *GetPayload) (res *Exchange, err error)
+	// Segment MICs operated by an exchange
+	Segments(context.Context, *SegmentsPayload) (res *SegmentTree, err error)
+	// Trading calendar of an exchange; the range defaults to the next 30 days, is
+	// limited to 366 days and must fall within the years the calendar covers
+	Calendar(context.Context, *CalendarPayload) (res *TradingCalendar, err error)
+	// Whether an exchange is currently open and when it next opens and closes
+	Status(context.Context, *StatusPayload) (res *MarketStatus, err error)
 }
 
+// APIName is the name of the API as defined in the design.
+const APIName = "ta-server"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
 // ServiceName is the name of the service as defined in the design. This is the
 // same value that is set in the endpoint request contexts under the ServiceKey
 // key.
 const ServiceName = "exchange"
 
-// APIVersion is the version of the API as defined in the design.
-const APIVersion = "0.0.1"
-
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [2]string{"list", "get"}
+var MethodNames = [5]string{"list", "get", "segments", "calendar", "status"}
+
+// CalendarPayload is the payload type of the exchange service calendar method.
+type CalendarPayload struct {
+	// Operating MIC of the exchange
+	OperatingMic string
+	// First date, in the exchange time zone
+	From *string
+	// Last date (inclusive), in the exchange time zone
+	To *string
+}
 
 // Exchange is the result type of the exchange service get method.
 type Exchange struct {
@@ -46,6 +68,18 @@ type Exchange struct {
 	City string
 	// Short identifier
 	Acronym *string
+	// ISO 10383 market category code
+	MarketCategory string
+	// ISO 10383 status
+	Status string
+	// Website of the market
+	Website *string
+	// Date the MIC was created
+	CreationDate *string
+	// Date the MIC was last modified
+	LastModifiedDate *string
+	// Active segment MICs operated by the exchange
+	Segments []*Segment
 }
 
 // GetPayload is the payload type of the exchange service get method.
@@ -56,6 +90,135 @@ type GetPayload struct {
 
 // ListPayload is the payload type of the exchange service list method.
 type ListPayload struct {
-	// Optional search query (name or country)
+	// Optional search query, matched against the MIC, acronym, name, city and
+	// country with typo tolerance
 	Query *string
+	// ISO 3166 country code the exchange must be in
+	Country *string
+	// City the exchange must be in
+	City *string
+	// Acronym the exchange must have
+	Acronym *string
+	// Sort order; relevance with a query and country otherwise by default
+	Sort *string
+	// Opaque cursor from the X-Next-Cursor header of the previous page
+	Cursor *string
+	// Maximum number of items to return; every remaining item when omitted
+	Limit *int
+}
+
+// ListResult is the result type of the exchange service list method.
+type ListResult struct {
+	Exchanges []*Exchange
+	// Number of items matching the filters, across all pages
+	Total int
+	// Cursor of the next page, absent on the last page
+	NextCursor *string
+}
+
+// MarketStatus is the result type of the exchange service status method.
+type MarketStatus struct {
+	// 4-character ISO 10383 code
+	OperatingMic string
+	// IANA time zone of the exchange
+	Timezone string
+	// Market state; a lunch break is reported as closed, and the state is unknown
+	// outside the years the calendar covers
+	Status string
+	// Time the status was computed
+	AsOf string
+	// Next start of the regular session
+	NextOpen *string
+	// Next end of the regular session
+	NextClose *string
+}
+
+// Segment MIC: a section of an operating market
+type Segment struct {
+	// 4-character ISO 10383 segment code
+	Mic string
+	// Operating MIC the segment belongs to
+	OperatingMic string
+	// Full descriptive name
+	Name string
+	// City location
+	City string
+	// Short identifier
+	Acronym *string
+	// ISO 10383 market category code
+	MarketCategory string
+	// ISO 10383 status
+	Status string
+	// Website of the market
+	Website *string
+	// Date the MIC was created
+	CreationDate *string
+	// Date the MIC was last modified
+	LastModifiedDate *string
+}
+
+// SegmentTree is the result type of the exchange service segments method.
+type SegmentTree struct {
+	// 4-character ISO 10383 code
+	OperatingMic string
+	// Full descriptive name
+	ExchangeName string
+	// Active segment MICs
+	Segments []*Segment
+}
+
+// SegmentsPayload is the payload type of the exchange service segments method.
+type SegmentsPayload struct {
+	// Operating MIC of the exchange
+	OperatingMic string
+}
+
+// StatusPayload is the payload type of the exchange service status method.
+type StatusPayload struct {
+	// Operating MIC of the exchange
+	OperatingMic string
+}
+
+// TradingCalendar is the result type of the exchange service calendar method.
+type TradingCalendar struct {
+	// 4-character ISO 10383 code
+	OperatingMic string
+	// IANA time zone of the exchange
+	Timezone string
+	Days     []*TradingDay
+}
+
+type TradingDay struct {
+	// Calendar date in the exchange time zone
+	Date string
+	// Whether the market trades that day
+	Status string
+	// Holiday name when the market is closed for a holiday
+	Holiday *string
+	// Trading sessions in chronological order
+	Sessions []*TradingSession
+}
+
+type TradingSession struct {
+	// Session kind
+	Kind string
+	// Session start
+	Open string
+	// Session end
+	Close string
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// MakeInvalidCursor builds a goa.ServiceError from an error.
+func MakeInvalidCursor(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_cursor", false, false, false)
+}
+
+// MakeInvalidRange builds a goa.ServiceError from an error.
+func MakeInvalidRange(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_range", false, false, false)
 }
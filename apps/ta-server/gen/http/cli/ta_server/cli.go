@@ -0,0 +1,1530 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// ta-server HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
+
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	exchangec "github.com/reidlai/ta-workspace/apps/ta-server/gen/http/exchange/client"
+	instrumentc "github.com/reidlai/ta-workspace/apps/ta-server/gen/http/instrument/client"
+	watchlistc "github.com/reidlai/ta-workspace/apps/ta-server/gen/http/watchlist/client"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// UsageCommands returns the set of commands and sub-commands using the format
+//
+//	command (subcommand1|subcommand2|...)
+func UsageCommands() []string {
+	return []string{
+		"exchange (list|get|segments|calendar|status)",
+		"instrument (list|search|get|create|update|delete|import)",
+		"watchlist (list|add|update|remove|list-removed|restore|events|bulk-add|bulk-remove|import|export|list-watchlists|list-shared-watchlists|create-watchlist|get-watchlist|update-watchlist|delete-watchlist|list-items|add-item|update-item|remove-item|list-shares|share-watchlist|unshare-watchlist|publish-watchlist|unpublish-watchlist|get-public-watchlist)",
+	}
+}
+
+// UsageExamples produces an example of a valid invocation of the CLI tool.
+func UsageExamples() string {
+	return os.Args[0] + " " + "exchange list --query \"In ut consequuntur id consequatur.\" --country \"Accusamus iure velit veritatis mollitia maxime est.\" --city \"Laudantium recusandae sint.\" --acronym \"Aut animi a qui voluptates sed.\" --sort \"country\" --cursor \"Error qui ullam asperiores laboriosam accusantium numquam.\" --limit 367" + "\n" +
+		os.Args[0] + " " + "instrument list --exchange-mic \"Qui voluptatem consequatur aut quaerat natus.\" --asset-class \"bond\" --currency \"Pariatur voluptatem quas molestiae veritatis ea.\" --isin \"Ab sit et officia rem hic.\" --sort \"name\" --cursor \"Tempora perferendis et.\" --limit 293" + "\n" +
+		os.Args[0] + " " + "watchlist list --if-none-match \"Vero doloremque.\" --token \"Rem quos labore pariatur.\"" + "\n" +
+		""
+}
+
+// ParseEndpoint returns the endpoint and payload as specified on the command
+// line.
+func ParseEndpoint(
+	scheme, host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restore bool,
+) (goa.Endpoint, any, error) {
+	var (
+		exchangeFlags = flag.NewFlagSet("exchange", flag.ContinueOnError)
+
+		exchangeListFlags       = flag.NewFlagSet("list", flag.ExitOnError)
+		exchangeListQueryFlag   = exchangeListFlags.String("query", "", "")
+		exchangeListCountryFlag = exchangeListFlags.String("country", "", "")
+		exchangeListCityFlag    = exchangeListFlags.String("city", "", "")
+		exchangeListAcronymFlag = exchangeListFlags.String("acronym", "", "")
+		exchangeListSortFlag    = exchangeListFlags.String("sort", "", "")
+		exchangeListCursorFlag  = exchangeListFlags.String("cursor", "", "")
+		exchangeListLimitFlag   = exchangeListFlags.String("limit", "", "")
+
+		exchangeGetFlags            = flag.NewFlagSet("get", flag.ExitOnError)
+		exchangeGetOperatingMicFlag = exchangeGetFlags.String("operating-mic", "REQUIRED", "Operating MIC of the exchange")
+
+		exchangeSegmentsFlags            = flag.NewFlagSet("segments", flag.ExitOnError)
+		exchangeSegmentsOperatingMicFlag = exchangeSegmentsFlags.String("operating-mic", "REQUIRED", "Operating MIC of the exchange")
+
+		exchangeCalendarFlags            = flag.NewFlagSet("calendar", flag.ExitOnError)
+		exchangeCalendarOperatingMicFlag = exchangeCalendarFlags.String("operating-mic", "REQUIRED", "Operating MIC of the exchange")
+		exchangeCalendarFromFlag         = exchangeCalendarFlags.String("from", "", "")
+		exchangeCalendarToFlag           = exchangeCalendarFlags.String("to", "", "")
+
+		exchangeStatusFlags            = flag.NewFlagSet("status", flag.ExitOnError)
+		exchangeStatusOperatingMicFlag = exchangeStatusFlags.String("operating-mic", "REQUIRED", "Operating MIC of the exchange")
+
+		instrumentFlags = flag.NewFlagSet("instrument", flag.ContinueOnError)
+
+		instrumentListFlags           = flag.NewFlagSet("list", flag.ExitOnError)
+		instrumentListExchangeMicFlag = instrumentListFlags.String("exchange-mic", "", "")
+		instrumentListAssetClassFlag  = instrumentListFlags.String("asset-class", "", "")
+		instrumentListCurrencyFlag    = instrumentListFlags.String("currency", "", "")
+		instrumentListIsinFlag        = instrumentListFlags.String("isin", "", "")
+		instrumentListSortFlag        = instrumentListFlags.String("sort", "symbol", "")
+		instrumentListCursorFlag      = instrumentListFlags.String("cursor", "", "")
+		instrumentListLimitFlag       = instrumentListFlags.String("limit", "", "")
+
+		instrumentSearchFlags     = flag.NewFlagSet("search", flag.ExitOnError)
+		instrumentSearchQFlag     = instrumentSearchFlags.String("q", "REQUIRED", "")
+		instrumentSearchLimitFlag = instrumentSearchFlags.String("limit", "20", "")
+
+		instrumentGetFlags  = flag.NewFlagSet("get", flag.ExitOnError)
+		instrumentGetIDFlag = instrumentGetFlags.String("id", "REQUIRED", "Instrument ID")
+
+		instrumentCreateFlags    = flag.NewFlagSet("create", flag.ExitOnError)
+		instrumentCreateBodyFlag = instrumentCreateFlags.String("body", "REQUIRED", "")
+
+		instrumentUpdateFlags    = flag.NewFlagSet("update", flag.ExitOnError)
+		instrumentUpdateBodyFlag = instrumentUpdateFlags.String("body", "REQUIRED", "")
+		instrumentUpdateIDFlag   = instrumentUpdateFlags.String("id", "REQUIRED", "Instrument ID")
+
+		instrumentDeleteFlags  = flag.NewFlagSet("delete", flag.ExitOnError)
+		instrumentDeleteIDFlag = instrumentDeleteFlags.String("id", "REQUIRED", "Instrument ID")
+
+		instrumentImportFlags      = flag.NewFlagSet("import", flag.ExitOnError)
+		instrumentImportDryRunFlag = instrumentImportFlags.String("dry-run", "", "")
+		instrumentImportStreamFlag = instrumentImportFlags.String("stream", "REQUIRED", "path to file containing the streamed request body")
+
+		watchlistFlags = flag.NewFlagSet("watchlist", flag.ContinueOnError)
+
+		watchlistListFlags           = flag.NewFlagSet("list", flag.ExitOnError)
+		watchlistListIfNoneMatchFlag = watchlistListFlags.String("if-none-match", "", "")
+		watchlistListTokenFlag       = watchlistListFlags.String("token", "", "")
+
+		watchlistAddFlags       = flag.NewFlagSet("add", flag.ExitOnError)
+		watchlistAddBodyFlag    = watchlistAddFlags.String("body", "REQUIRED", "")
+		watchlistAddIfMatchFlag = watchlistAddFlags.String("if-match", "", "")
+		watchlistAddTokenFlag   = watchlistAddFlags.String("token", "", "")
+
+		watchlistUpdateFlags       = flag.NewFlagSet("update", flag.ExitOnError)
+		watchlistUpdateBodyFlag    = watchlistUpdateFlags.String("body", "REQUIRED", "")
+		watchlistUpdateSymbolFlag  = watchlistUpdateFlags.String("symbol", "REQUIRED", "")
+		watchlistUpdateIfMatchFlag = watchlistUpdateFlags.String("if-match", "", "")
+		watchlistUpdateTokenFlag   = watchlistUpdateFlags.String("token", "", "")
+
+		watchlistRemoveFlags       = flag.NewFlagSet("remove", flag.ExitOnError)
+		watchlistRemoveSymbolFlag  = watchlistRemoveFlags.String("symbol", "REQUIRED", "")
+		watchlistRemoveIfMatchFlag = watchlistRemoveFlags.String("if-match", "", "")
+		watchlistRemoveTokenFlag   = watchlistRemoveFlags.String("token", "", "")
+
+		watchlistListRemovedFlags          = flag.NewFlagSet("list-removed", flag.ExitOnError)
+		watchlistListRemovedWatchlist2Flag = watchlistListRemovedFlags.String("watchlist2", "", "")
+		watchlistListRemovedTokenFlag      = watchlistListRemovedFlags.String("token", "", "")
+
+		watchlistRestoreFlags          = flag.NewFlagSet("restore", flag.ExitOnError)
+		watchlistRestoreSymbolFlag     = watchlistRestoreFlags.String("symbol", "REQUIRED", "")
+		watchlistRestoreWatchlist2Flag = watchlistRestoreFlags.String("watchlist2", "", "")
+		watchlistRestoreIfMatchFlag    = watchlistRestoreFlags.String("if-match", "", "")
+		watchlistRestoreTokenFlag      = watchlistRestoreFlags.String("token", "", "")
+
+		watchlistEventsFlags           = flag.NewFlagSet("events", flag.ExitOnError)
+		watchlistEventsWatchlist2Flag  = watchlistEventsFlags.String("watchlist2", "", "")
+		watchlistEventsLastEventIDFlag = watchlistEventsFlags.String("last-event-id", "", "")
+		watchlistEventsTokenFlag       = watchlistEventsFlags.String("token", "", "")
+
+		watchlistBulkAddFlags          = flag.NewFlagSet("bulk-add", flag.ExitOnError)
+		watchlistBulkAddBodyFlag       = watchlistBulkAddFlags.String("body", "REQUIRED", "")
+		watchlistBulkAddWatchlist2Flag = watchlistBulkAddFlags.String("watchlist2", "", "")
+		watchlistBulkAddIfMatchFlag    = watchlistBulkAddFlags.String("if-match", "", "")
+		watchlistBulkAddTokenFlag      = watchlistBulkAddFlags.String("token", "", "")
+
+		watchlistBulkRemoveFlags          = flag.NewFlagSet("bulk-remove", flag.ExitOnError)
+		watchlistBulkRemoveBodyFlag       = watchlistBulkRemoveFlags.String("body", "REQUIRED", "")
+		watchlistBulkRemoveWatchlist2Flag = watchlistBulkRemoveFlags.String("watchlist2", "", "")
+		watchlistBulkRemoveIfMatchFlag    = watchlistBulkRemoveFlags.String("if-match", "", "")
+		watchlistBulkRemoveTokenFlag      = watchlistBulkRemoveFlags.String("token", "", "")
+
+		watchlistImportFlags          = flag.NewFlagSet("import", flag.ExitOnError)
+		watchlistImportWatchlist2Flag = watchlistImportFlags.String("watchlist2", "", "")
+		watchlistImportFormatFlag     = watchlistImportFlags.String("format", "", "")
+		watchlistImportDryRunFlag     = watchlistImportFlags.String("dry-run", "", "")
+		watchlistImportIfMatchFlag    = watchlistImportFlags.String("if-match", "", "")
+		watchlistImportTokenFlag      = watchlistImportFlags.String("token", "", "")
+		watchlistImportStreamFlag     = watchlistImportFlags.String("stream", "REQUIRED", "path to file containing the streamed request body")
+
+		watchlistExportFlags          = flag.NewFlagSet("export", flag.ExitOnError)
+		watchlistExportWatchlist2Flag = watchlistExportFlags.String("watchlist2", "", "")
+		watchlistExportFormatFlag     = watchlistExportFlags.String("format", "csv", "")
+		watchlistExportTokenFlag      = watchlistExportFlags.String("token", "", "")
+
+		watchlistListWatchlistsFlags     = flag.NewFlagSet("list-watchlists", flag.ExitOnError)
+		watchlistListWatchlistsTokenFlag = watchlistListWatchlistsFlags.String("token", "", "")
+
+		watchlistListSharedWatchlistsFlags     = flag.NewFlagSet("list-shared-watchlists", flag.ExitOnError)
+		watchlistListSharedWatchlistsTokenFlag = watchlistListSharedWatchlistsFlags.String("token", "", "")
+
+		watchlistCreateWatchlistFlags     = flag.NewFlagSet("create-watchlist", flag.ExitOnError)
+		watchlistCreateWatchlistBodyFlag  = watchlistCreateWatchlistFlags.String("body", "REQUIRED", "")
+		watchlistCreateWatchlistTokenFlag = watchlistCreateWatchlistFlags.String("token", "", "")
+
+		watchlistGetWatchlistFlags     = flag.NewFlagSet("get-watchlist", flag.ExitOnError)
+		watchlistGetWatchlistIDFlag    = watchlistGetWatchlistFlags.String("id", "REQUIRED", "Watchlist ID, or \"default\" for the default watchlist")
+		watchlistGetWatchlistTokenFlag = watchlistGetWatchlistFlags.String("token", "", "")
+
+		watchlistUpdateWatchlistFlags       = flag.NewFlagSet("update-watchlist", flag.ExitOnError)
+		watchlistUpdateWatchlistBodyFlag    = watchlistUpdateWatchlistFlags.String("body", "REQUIRED", "")
+		watchlistUpdateWatchlistIDFlag      = watchlistUpdateWatchlistFlags.String("id", "REQUIRED", "Watchlist ID, or \"default\" for the default watchlist")
+		watchlistUpdateWatchlistIfMatchFlag = watchlistUpdateWatchlistFlags.String("if-match", "", "")
+		watchlistUpdateWatchlistTokenFlag   = watchlistUpdateWatchlistFlags.String("token", "", "")
+
+		watchlistDeleteWatchlistFlags       = flag.NewFlagSet("delete-watchlist", flag.ExitOnError)
+		watchlistDeleteWatchlistIDFlag      = watchlistDeleteWatchlistFlags.String("id", "REQUIRED", "Watchlist ID, or \"default\" for the default watchlist")
+		watchlistDeleteWatchlistIfMatchFlag = watchlistDeleteWatchlistFlags.String("if-match", "", "")
+		watchlistDeleteWatchlistTokenFlag   = watchlistDeleteWatchlistFlags.String("token", "", "")
+
+		watchlistListItemsFlags           = flag.NewFlagSet("list-items", flag.ExitOnError)
+		watchlistListItemsIDFlag          = watchlistListItemsFlags.String("id", "REQUIRED", "Watchlist ID, or \"default\" for the default watchlist")
+		watchlistListItemsIfNoneMatchFlag = watchlistListItemsFlags.String("if-none-match", "", "")
+		watchlistListItemsTokenFlag       = watchlistListItemsFlags.String("token", "", "")
+
+		watchlistAddItemFlags       = flag.NewFlagSet("add-item", flag.ExitOnError)
+		watchlistAddItemBodyFlag    = watchlistAddItemFlags.String("body", "REQUIRED", "")
+		watchlistAddItemIDFlag      = watchlistAddItemFlags.String("id", "REQUIRED", "Watchlist ID, or \"default\" for the default watchlist")
+		watchlistAddItemIfMatchFlag = watchlistAddItemFlags.String("if-match", "", "")
+		watchlistAddItemTokenFlag   = watchlistAddItemFlags.String("token", "", "")
+
+		watchlistUpdateItemFlags       = flag.NewFlagSet("update-item", flag.ExitOnError)
+		watchlistUpdateItemBodyFlag    = watchlistUpdateItemFlags.String("body", "REQUIRED", "")
+		watchlistUpdateItemIDFlag      = watchlistUpdateItemFlags.String("id", "REQUIRED", "Watchlist ID, or \"default\" for the default watchlist")
+		watchlistUpdateItemSymbolFlag  = watchlistUpdateItemFlags.String("symbol", "REQUIRED", "")
+		watchlistUpdateItemIfMatchFlag = watchlistUpdateItemFlags.String("if-match", "", "")
+		watchlistUpdateItemTokenFlag   = watchlistUpdateItemFlags.String("token", "", "")
+
+		watchlistRemoveItemFlags       = flag.NewFlagSet("remove-item", flag.ExitOnError)
+		watchlistRemoveItemIDFlag      = watchlistRemoveItemFlags.String("id", "REQUIRED", "Watchlist ID, or \"default\" for the default watchlist")
+		watchlistRemoveItemSymbolFlag  = watchlistRemoveItemFlags.String("symbol", "REQUIRED", "")
+		watchlistRemoveItemIfMatchFlag = watchlistRemoveItemFlags.String("if-match", "", "")
+		watchlistRemoveItemTokenFlag   = watchlistRemoveItemFlags.String("token", "", "")
+
+		watchlistListSharesFlags     = flag.NewFlagSet("list-shares", flag.ExitOnError)
+		watchlistListSharesIDFlag    = watchlistListSharesFlags.String("id", "REQUIRED", "Watchlist ID, or \"default\" for the default watchlist")
+		watchlistListSharesTokenFlag = watchlistListSharesFlags.String("token", "", "")
+
+		watchlistShareWatchlistFlags     = flag.NewFlagSet("share-watchlist", flag.ExitOnError)
+		watchlistShareWatchlistBodyFlag  = watchlistShareWatchlistFlags.String("body", "REQUIRED", "")
+		watchlistShareWatchlistIDFlag    = watchlistShareWatchlistFlags.String("id", "REQUIRED", "Watchlist ID, or \"default\" for the default watchlist")
+		watchlistShareWatchlistUserFlag  = watchlistShareWatchlistFlags.String("user", "REQUIRED", "User ID the watchlist is shared with")
+		watchlistShareWatchlistTokenFlag = watchlistShareWatchlistFlags.String("token", "", "")
+
+		watchlistUnshareWatchlistFlags     = flag.NewFlagSet("unshare-watchlist", flag.ExitOnError)
+		watchlistUnshareWatchlistIDFlag    = watchlistUnshareWatchlistFlags.String("id", "REQUIRED", "Watchlist ID, or \"default\" for the default watchlist")
+		watchlistUnshareWatchlistUserFlag  = watchlistUnshareWatchlistFlags.String("user", "REQUIRED", "User ID the watchlist is shared with")
+		watchlistUnshareWatchlistTokenFlag = watchlistUnshareWatchlistFlags.String("token", "", "")
+
+		watchlistPublishWatchlistFlags     = flag.NewFlagSet("publish-watchlist", flag.ExitOnError)
+		watchlistPublishWatchlistIDFlag    = watchlistPublishWatchlistFlags.String("id", "REQUIRED", "Watchlist ID, or \"default\" for the default watchlist")
+		watchlistPublishWatchlistTokenFlag = watchlistPublishWatchlistFlags.String("token", "", "")
+
+		watchlistUnpublishWatchlistFlags     = flag.NewFlagSet("unpublish-watchlist", flag.ExitOnError)
+		watchlistUnpublishWatchlistIDFlag    = watchlistUnpublishWatchlistFlags.String("id", "REQUIRED", "Watchlist ID, or \"default\" for the default watchlist")
+		watchlistUnpublishWatchlistTokenFlag = watchlistUnpublishWatchlistFlags.String("token", "", "")
+
+		watchlistGetPublicWatchlistFlags     = flag.NewFlagSet("get-public-watchlist", flag.ExitOnError)
+		watchlistGetPublicWatchlistTokenFlag = watchlistGetPublicWatchlistFlags.String("token", "REQUIRED", "Token of the public link")
+	)
+	exchangeFlags.Usage = exchangeUsage
+	exchangeListFlags.Usage = exchangeListUsage
+	exchangeGetFlags.Usage = exchangeGetUsage
+	exchangeSegmentsFlags.Usage = exchangeSegmentsUsage
+	exchangeCalendarFlags.Usage = exchangeCalendarUsage
+	exchangeStatusFlags.Usage = exchangeStatusUsage
+
+	instrumentFlags.Usage = instrumentUsage
+	instrumentListFlags.Usage = instrumentListUsage
+	instrumentSearchFlags.Usage = instrumentSearchUsage
+	instrumentGetFlags.Usage = instrumentGetUsage
+	instrumentCreateFlags.Usage = instrumentCreateUsage
+	instrumentUpdateFlags.Usage = instrumentUpdateUsage
+	instrumentDeleteFlags.Usage = instrumentDeleteUsage
+	instrumentImportFlags.Usage = instrumentImportUsage
+
+	watchlistFlags.Usage = watchlistUsage
+	watchlistListFlags.Usage = watchlistListUsage
+	watchlistAddFlags.Usage = watchlistAddUsage
+	watchlistUpdateFlags.Usage = watchlistUpdateUsage
+	watchlistRemoveFlags.Usage = watchlistRemoveUsage
+	watchlistListRemovedFlags.Usage = watchlistListRemovedUsage
+	watchlistRestoreFlags.Usage = watchlistRestoreUsage
+	watchlistEventsFlags.Usage = watchlistEventsUsage
+	watchlistBulkAddFlags.Usage = watchlistBulkAddUsage
+	watchlistBulkRemoveFlags.Usage = watchlistBulkRemoveUsage
+	watchlistImportFlags.Usage = watchlistImportUsage
+	watchlistExportFlags.Usage = watchlistExportUsage
+	watchlistListWatchlistsFlags.Usage = watchlistListWatchlistsUsage
+	watchlistListSharedWatchlistsFlags.Usage = watchlistListSharedWatchlistsUsage
+	watchlistCreateWatchlistFlags.Usage = watchlistCreateWatchlistUsage
+	watchlistGetWatchlistFlags.Usage = watchlistGetWatchlistUsage
+	watchlistUpdateWatchlistFlags.Usage = watchlistUpdateWatchlistUsage
+	watchlistDeleteWatchlistFlags.Usage = watchlistDeleteWatchlistUsage
+	watchlistListItemsFlags.Usage = watchlistListItemsUsage
+	watchlistAddItemFlags.Usage = watchlistAddItemUsage
+	watchlistUpdateItemFlags.Usage = watchlistUpdateItemUsage
+	watchlistRemoveItemFlags.Usage = watchlistRemoveItemUsage
+	watchlistListSharesFlags.Usage = watchlistListSharesUsage
+	watchlistShareWatchlistFlags.Usage = watchlistShareWatchlistUsage
+	watchlistUnshareWatchlistFlags.Usage = watchlistUnshareWatchlistUsage
+	watchlistPublishWatchlistFlags.Usage = watchlistPublishWatchlistUsage
+	watchlistUnpublishWatchlistFlags.Usage = watchlistUnpublishWatchlistUsage
+	watchlistGetPublicWatchlistFlags.Usage = watchlistGetPublicWatchlistUsage
+
+	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
+		return nil, nil, err
+	}
+
+	if flag.NArg() < 2 { // two non flag args are required: SERVICE and ENDPOINT (aka COMMAND)
+		return nil, nil, fmt.Errorf("not enough arguments")
+	}
+
+	var (
+		svcn string
+		svcf *flag.FlagSet
+	)
+	{
+		svcn = flag.Arg(0)
+		switch svcn {
+		case "exchange":
+			svcf = exchangeFlags
+		case "instrument":
+			svcf = instrumentFlags
+		case "watchlist":
+			svcf = watchlistFlags
+		default:
+			return nil, nil, fmt.Errorf("unknown service %q", svcn)
+		}
+	}
+	if err := svcf.Parse(flag.Args()[1:]); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		epn string
+		epf *flag.FlagSet
+	)
+	{
+		epn = svcf.Arg(0)
+		switch svcn {
+		case "exchange":
+			switch epn {
+			case "list":
+				epf = exchangeListFlags
+
+			case "get":
+				epf = exchangeGetFlags
+
+			case "segments":
+				epf = exchangeSegmentsFlags
+
+			case "calendar":
+				epf = exchangeCalendarFlags
+
+			case "status":
+				epf = exchangeStatusFlags
+
+			}
+
+		case "instrument":
+			switch epn {
+			case "list":
+				epf = instrumentListFlags
+
+			case "search":
+				epf = instrumentSearchFlags
+
+			case "get":
+				epf = instrumentGetFlags
+
+			case "create":
+				epf = instrumentCreateFlags
+
+			case "update":
+				epf = instrumentUpdateFlags
+
+			case "delete":
+				epf = instrumentDeleteFlags
+
+			case "import":
+				epf = instrumentImportFlags
+
+			}
+
+		case "watchlist":
+			switch epn {
+			case "list":
+				epf = watchlistListFlags
+
+			case "add":
+				epf = watchlistAddFlags
+
+			case "update":
+				epf = watchlistUpdateFlags
+
+			case "remove":
+				epf = watchlistRemoveFlags
+
+			case "list-removed":
+				epf = watchlistListRemovedFlags
+
+			case "restore":
+				epf = watchlistRestoreFlags
+
+			case "events":
+				epf = watchlistEventsFlags
+
+			case "bulk-add":
+				epf = watchlistBulkAddFlags
+
+			case "bulk-remove":
+				epf = watchlistBulkRemoveFlags
+
+			case "import":
+				epf = watchlistImportFlags
+
+			case "export":
+				epf = watchlistExportFlags
+
+			case "list-watchlists":
+				epf = watchlistListWatchlistsFlags
+
+			case "list-shared-watchlists":
+				epf = watchlistListSharedWatchlistsFlags
+
+			case "create-watchlist":
+				epf = watchlistCreateWatchlistFlags
+
+			case "get-watchlist":
+				epf = watchlistGetWatchlistFlags
+
+			case "update-watchlist":
+				epf = watchlistUpdateWatchlistFlags
+
+			case "delete-watchlist":
+				epf = watchlistDeleteWatchlistFlags
+
+			case "list-items":
+				epf = watchlistListItemsFlags
+
+			case "add-item":
+				epf = watchlistAddItemFlags
+
+			case "update-item":
+				epf = watchlistUpdateItemFlags
+
+			case "remove-item":
+				epf = watchlistRemoveItemFlags
+
+			case "list-shares":
+				epf = watchlistListSharesFlags
+
+			case "share-watchlist":
+				epf = watchlistShareWatchlistFlags
+
+			case "unshare-watchlist":
+				epf = watchlistUnshareWatchlistFlags
+
+			case "publish-watchlist":
+				epf = watchlistPublishWatchlistFlags
+
+			case "unpublish-watchlist":
+				epf = watchlistUnpublishWatchlistFlags
+
+			case "get-public-watchlist":
+				epf = watchlistGetPublicWatchlistFlags
+
+			}
+
+		}
+	}
+	if epf == nil {
+		return nil, nil, fmt.Errorf("unknown %q endpoint %q", svcn, epn)
+	}
+
+	// Parse endpoint flags if any
+	if svcf.NArg() > 1 {
+		if err := epf.Parse(svcf.Args()[1:]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var (
+		data     any
+		endpoint goa.Endpoint
+		err      error
+	)
+	{
+		switch svcn {
+		case "exchange":
+			c := exchangec.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "list":
+				endpoint = c.List()
+				data, err = exchangec.BuildListPayload(*exchangeListQueryFlag, *exchangeListCountryFlag, *exchangeListCityFlag, *exchangeListAcronymFlag, *exchangeListSortFlag, *exchangeListCursorFlag, *exchangeListLimitFlag)
+			case "get":
+				endpoint = c.Get()
+				data, err = exchangec.BuildGetPayload(*exchangeGetOperatingMicFlag)
+			case "segments":
+				endpoint = c.Segments()
+				data, err = exchangec.BuildSegmentsPayload(*exchangeSegmentsOperatingMicFlag)
+			case "calendar":
+				endpoint = c.Calendar()
+				data, err = exchangec.BuildCalendarPayload(*exchangeCalendarOperatingMicFlag, *exchangeCalendarFromFlag, *exchangeCalendarToFlag)
+			case "status":
+				endpoint = c.Status()
+				data, err = exchangec.BuildStatusPayload(*exchangeStatusOperatingMicFlag)
+			}
+		case "instrument":
+			c := instrumentc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "list":
+				endpoint = c.List()
+				data, err = instrumentc.BuildListPayload(*instrumentListExchangeMicFlag, *instrumentListAssetClassFlag, *instrumentListCurrencyFlag, *instrumentListIsinFlag, *instrumentListSortFlag, *instrumentListCursorFlag, *instrumentListLimitFlag)
+			case "search":
+				endpoint = c.Search()
+				data, err = instrumentc.BuildSearchPayload(*instrumentSearchQFlag, *instrumentSearchLimitFlag)
+			case "get":
+				endpoint = c.Get()
+				data, err = instrumentc.BuildGetPayload(*instrumentGetIDFlag)
+			case "create":
+				endpoint = c.Create()
+				data, err = instrumentc.BuildCreatePayload(*instrumentCreateBodyFlag)
+			case "update":
+				endpoint = c.Update()
+				data, err = instrumentc.BuildUpdatePayload(*instrumentUpdateBodyFlag, *instrumentUpdateIDFlag)
+			case "delete":
+				endpoint = c.Delete()
+				data, err = instrumentc.BuildDeletePayload(*instrumentDeleteIDFlag)
+			case "import":
+				endpoint = c.Import()
+				data, err = instrumentc.BuildImportPayload(*instrumentImportDryRunFlag)
+				if err == nil {
+					data, err = instrumentc.BuildImportStreamPayload(data, *instrumentImportStreamFlag)
+				}
+			}
+		case "watchlist":
+			c := watchlistc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "list":
+				endpoint = c.List()
+				data, err = watchlistc.BuildListPayload(*watchlistListIfNoneMatchFlag, *watchlistListTokenFlag)
+			case "add":
+				endpoint = c.Add()
+				data, err = watchlistc.BuildAddPayload(*watchlistAddBodyFlag, *watchlistAddIfMatchFlag, *watchlistAddTokenFlag)
+			case "update":
+				endpoint = c.Update()
+				data, err = watchlistc.BuildUpdatePayload(*watchlistUpdateBodyFlag, *watchlistUpdateSymbolFlag, *watchlistUpdateIfMatchFlag, *watchlistUpdateTokenFlag)
+			case "remove":
+				endpoint = c.Remove()
+				data, err = watchlistc.BuildRemovePayload(*watchlistRemoveSymbolFlag, *watchlistRemoveIfMatchFlag, *watchlistRemoveTokenFlag)
+			case "list-removed":
+				endpoint = c.ListRemoved()
+				data, err = watchlistc.BuildListRemovedPayload(*watchlistListRemovedWatchlist2Flag, *watchlistListRemovedTokenFlag)
+			case "restore":
+				endpoint = c.Restore()
+				data, err = watchlistc.BuildRestorePayload(*watchlistRestoreSymbolFlag, *watchlistRestoreWatchlist2Flag, *watchlistRestoreIfMatchFlag, *watchlistRestoreTokenFlag)
+			case "events":
+				endpoint = c.Events()
+				data, err = watchlistc.BuildEventsPayload(*watchlistEventsWatchlist2Flag, *watchlistEventsLastEventIDFlag, *watchlistEventsTokenFlag)
+			case "bulk-add":
+				endpoint = c.BulkAdd()
+				data, err = watchlistc.BuildBulkAddPayload(*watchlistBulkAddBodyFlag, *watchlistBulkAddWatchlist2Flag, *watchlistBulkAddIfMatchFlag, *watchlistBulkAddTokenFlag)
+			case "bulk-remove":
+				endpoint = c.BulkRemove()
+				data, err = watchlistc.BuildBulkRemovePayload(*watchlistBulkRemoveBodyFlag, *watchlistBulkRemoveWatchlist2Flag, *watchlistBulkRemoveIfMatchFlag, *watchlistBulkRemoveTokenFlag)
+			case "import":
+				endpoint = c.Import()
+				data, err = watchlistc.BuildImportPayload(*watchlistImportWatchlist2Flag, *watchlistImportFormatFlag, *watchlistImportDryRunFlag, *watchlistImportIfMatchFlag, *watchlistImportTokenFlag)
+				if err == nil {
+					data, err = watchlistc.BuildImportStreamPayload(data, *watchlistImportStreamFlag)
+				}
+			case "export":
+				endpoint = c.Export()
+				data, err = watchlistc.BuildExportPayload(*watchlistExportWatchlist2Flag, *watchlistExportFormatFlag, *watchlistExportTokenFlag)
+			case "list-watchlists":
+				endpoint = c.ListWatchlists()
+				data, err = watchlistc.BuildListWatchlistsPayload(*watchlistListWatchlistsTokenFlag)
+			case "list-shared-watchlists":
+				endpoint = c.ListSharedWatchlists()
+				data, err = watchlistc.BuildListSharedWatchlistsPayload(*watchlistListSharedWatchlistsTokenFlag)
+			case "create-watchlist":
+				endpoint = c.CreateWatchlist()
+				data, err = watchlistc.BuildCreateWatchlistPayload(*watchlistCreateWatchlistBodyFlag, *watchlistCreateWatchlistTokenFlag)
+			case "get-watchlist":
+				endpoint = c.GetWatchlist()
+				data, err = watchlistc.BuildGetWatchlistPayload(*watchlistGetWatchlistIDFlag, *watchlistGetWatchlistTokenFlag)
+			case "update-watchlist":
+				endpoint = c.UpdateWatchlist()
+				data, err = watchlistc.BuildUpdateWatchlistPayload(*watchlistUpdateWatchlistBodyFlag, *watchlistUpdateWatchlistIDFlag, *watchlistUpdateWatchlistIfMatchFlag, *watchlistUpdateWatchlistTokenFlag)
+			case "delete-watchlist":
+				endpoint = c.DeleteWatchlist()
+				data, err = watchlistc.BuildDeleteWatchlistPayload(*watchlistDeleteWatchlistIDFlag, *watchlistDeleteWatchlistIfMatchFlag, *watchlistDeleteWatchlistTokenFlag)
+			case "list-items":
+				endpoint = c.ListItems()
+				data, err = watchlistc.BuildListItemsPayload(*watchlistListItemsIDFlag, *watchlistListItemsIfNoneMatchFlag, *watchlistListItemsTokenFlag)
+			case "add-item":
+				endpoint = c.AddItem()
+				data, err = watchlistc.BuildAddItemPayload(*watchlistAddItemBodyFlag, *watchlistAddItemIDFlag, *watchlistAddItemIfMatchFlag, *watchlistAddItemTokenFlag)
+			case "update-item":
+				endpoint = c.UpdateItem()
+				data, err = watchlistc.BuildUpdateItemPayload(*watchlistUpdateItemBodyFlag, *watchlistUpdateItemIDFlag, *watchlistUpdateItemSymbolFlag, *watchlistUpdateItemIfMatchFlag, *watchlistUpdateItemTokenFlag)
+			case "remove-item":
+				endpoint = c.RemoveItem()
+				data, err = watchlistc.BuildRemoveItemPayload(*watchlistRemoveItemIDFlag, *watchlistRemoveItemSymbolFlag, *watchlistRemoveItemIfMatchFlag, *watchlistRemoveItemTokenFlag)
+			case "list-shares":
+				endpoint = c.ListShares()
+				data, err = watchlistc.BuildListSharesPayload(*watchlistListSharesIDFlag, *watchlistListSharesTokenFlag)
+			case "share-watchlist":
+				endpoint = c.ShareWatchlist()
+				data, err = watchlistc.BuildShareWatchlistPayload(*watchlistShareWatchlistBodyFlag, *watchlistShareWatchlistIDFlag, *watchlistShareWatchlistUserFlag, *watchlistShareWatchlistTokenFlag)
+			case "unshare-watchlist":
+				endpoint = c.UnshareWatchlist()
+				data, err = watchlistc.BuildUnshareWatchlistPayload(*watchlistUnshareWatchlistIDFlag, *watchlistUnshareWatchlistUserFlag, *watchlistUnshareWatchlistTokenFlag)
+			case "publish-watchlist":
+				endpoint = c.PublishWatchlist()
+				data, err = watchlistc.BuildPublishWatchlistPayload(*watchlistPublishWatchlistIDFlag, *watchlistPublishWatchlistTokenFlag)
+			case "unpublish-watchlist":
+				endpoint = c.UnpublishWatchlist()
+				data, err = watchlistc.BuildUnpublishWatchlistPayload(*watchlistUnpublishWatchlistIDFlag, *watchlistUnpublishWatchlistTokenFlag)
+			case "get-public-watchlist":
+				endpoint = c.GetPublicWatchlist()
+				data, err = watchlistc.BuildGetPublicWatchlistPayload(*watchlistGetPublicWatchlistTokenFlag)
+			}
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return endpoint, data, nil
+}
+
+// exchangeUsage displays the usage of the exchange command and its subcommands.
+func exchangeUsage() {
+	fmt.Fprintln(os.Stderr, `Manage financial exchanges`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] exchange COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    list: List exchanges, optionally filtered, one page at a time`)
+	fmt.Fprintln(os.Stderr, `    get: Get implements get.`)
+	fmt.Fprintln(os.Stderr, `    segments: Segment MICs operated by an exchange`)
+	fmt.Fprintln(os.Stderr, `    calendar: Trading calendar of an exchange; the range defaults to the next 30 days, is limited to 366 days and must fall within the years the calendar covers`)
+	fmt.Fprintln(os.Stderr, `    status: Whether an exchange is currently open and when it next opens and closes`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s exchange COMMAND --help\n", os.Args[0])
+}
+func exchangeListUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] exchange list", os.Args[0])
+	fmt.Fprint(os.Stderr, " -query STRING")
+	fmt.Fprint(os.Stderr, " -country STRING")
+	fmt.Fprint(os.Stderr, " -city STRING")
+	fmt.Fprint(os.Stderr, " -acronym STRING")
+	fmt.Fprint(os.Stderr, " -sort STRING")
+	fmt.Fprint(os.Stderr, " -cursor STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List exchanges, optionally filtered, one page at a time`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -query STRING: `)
+	fmt.Fprintln(os.Stderr, `    -country STRING: `)
+	fmt.Fprintln(os.Stderr, `    -city STRING: `)
+	fmt.Fprintln(os.Stderr, `    -acronym STRING: `)
+	fmt.Fprintln(os.Stderr, `    -sort STRING: `)
+	fmt.Fprintln(os.Stderr, `    -cursor STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "exchange list --query \"In ut consequuntur id consequatur.\" --country \"Accusamus iure velit veritatis mollitia maxime est.\" --city \"Laudantium recusandae sint.\" --acronym \"Aut animi a qui voluptates sed.\" --sort \"country\" --cursor \"Error qui ullam asperiores laboriosam accusantium numquam.\" --limit 367")
+}
+
+func exchangeGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] exchange get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -operating-mic STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get implements get.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -operating-mic STRING: Operating MIC of the exchange`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "exchange get --operating-mic \"Est optio.\"")
+}
+
+func exchangeSegmentsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] exchange segments", os.Args[0])
+	fmt.Fprint(os.Stderr, " -operating-mic STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Segment MICs operated by an exchange`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -operating-mic STRING: Operating MIC of the exchange`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "exchange segments --operating-mic \"Rem est qui.\"")
+}
+
+func exchangeCalendarUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] exchange calendar", os.Args[0])
+	fmt.Fprint(os.Stderr, " -operating-mic STRING")
+	fmt.Fprint(os.Stderr, " -from STRING")
+	fmt.Fprint(os.Stderr, " -to STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Trading calendar of an exchange; the range defaults to the next 30 days, is limited to 366 days and must fall within the years the calendar covers`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -operating-mic STRING: Operating MIC of the exchange`)
+	fmt.Fprintln(os.Stderr, `    -from STRING: `)
+	fmt.Fprintln(os.Stderr, `    -to STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "exchange calendar --operating-mic \"Quaerat quidem dolorem omnis non.\" --from \"1988-01-25\" --to \"2015-09-17\"")
+}
+
+func exchangeStatusUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] exchange status", os.Args[0])
+	fmt.Fprint(os.Stderr, " -operating-mic STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Whether an exchange is currently open and when it next opens and closes`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -operating-mic STRING: Operating MIC of the exchange`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "exchange status --operating-mic \"Labore odit cupiditate et quia.\"")
+}
+
+// instrumentUsage displays the usage of the instrument command and its
+// subcommands.
+func instrumentUsage() {
+	fmt.Fprintln(os.Stderr, `Instrument master data referenced by watchlists and portfolios`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] instrument COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    list: List instruments, optionally filtered, one page at a time`)
+	fmt.Fprintln(os.Stderr, `    search: Find instruments by symbol, name, ISIN or FIGI, best matches first`)
+	fmt.Fprintln(os.Stderr, `    get: Get implements get.`)
+	fmt.Fprintln(os.Stderr, `    create: Create implements create.`)
+	fmt.Fprintln(os.Stderr, `    update: Replace the attributes of an instrument; the symbol and exchange are its identity and cannot change`)
+	fmt.Fprintln(os.Stderr, `    delete: Delete implements delete.`)
+	fmt.Fprintln(os.Stderr, `    import: Create or update instruments from a CSV file with the columns symbol, exchange_mic, name, asset_class, currency, isin and figi. Nothing is stored unless every row is valid.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s instrument COMMAND --help\n", os.Args[0])
+}
+func instrumentListUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] instrument list", os.Args[0])
+	fmt.Fprint(os.Stderr, " -exchange-mic STRING")
+	fmt.Fprint(os.Stderr, " -asset-class STRING")
+	fmt.Fprint(os.Stderr, " -currency STRING")
+	fmt.Fprint(os.Stderr, " -isin STRING")
+	fmt.Fprint(os.Stderr, " -sort STRING")
+	fmt.Fprint(os.Stderr, " -cursor STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List instruments, optionally filtered, one page at a time`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -exchange-mic STRING: `)
+	fmt.Fprintln(os.Stderr, `    -asset-class STRING: `)
+	fmt.Fprintln(os.Stderr, `    -currency STRING: `)
+	fmt.Fprintln(os.Stderr, `    -isin STRING: `)
+	fmt.Fprintln(os.Stderr, `    -sort STRING: `)
+	fmt.Fprintln(os.Stderr, `    -cursor STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "instrument list --exchange-mic \"Qui voluptatem consequatur aut quaerat natus.\" --asset-class \"bond\" --currency \"Pariatur voluptatem quas molestiae veritatis ea.\" --isin \"Ab sit et officia rem hic.\" --sort \"name\" --cursor \"Tempora perferendis et.\" --limit 293")
+}
+
+func instrumentSearchUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] instrument search", os.Args[0])
+	fmt.Fprint(os.Stderr, " -q STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Find instruments by symbol, name, ISIN or FIGI, best matches first`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -q STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "instrument search --q \"clj\" --limit 69")
+}
+
+func instrumentGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] instrument get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get implements get.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Instrument ID`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "instrument get --id \"Et debitis in numquam excepturi.\"")
+}
+
+func instrumentCreateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] instrument create", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Create implements create.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "instrument create --body '{\n      \"asset_class\": \"derivative\",\n      \"currency\": \"PRZ\",\n      \"exchange_mic\": \"6Kxq\",\n      \"figi\": \"RJGCZ8XF17N5\",\n      \"isin\": \"VFBODBHVKFK9\",\n      \"name\": \"u\",\n      \"symbol\": \"lvj\"\n   }'")
+}
+
+func instrumentUpdateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] instrument update", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replace the attributes of an instrument; the symbol and exchange are its identity and cannot change`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: Instrument ID`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "instrument update --body '{\n      \"asset_class\": \"etf\",\n      \"currency\": \"XYF\",\n      \"figi\": \"PVGXS6ZZ4N81\",\n      \"isin\": \"QI81U4BOB457\",\n      \"name\": \"x\"\n   }' --id \"Nesciunt soluta fugit quis ut aut.\"")
+}
+
+func instrumentDeleteUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] instrument delete", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete implements delete.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Instrument ID`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "instrument delete --id \"Impedit dolorem necessitatibus adipisci et itaque esse.\"")
+}
+
+func instrumentImportUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] instrument import", os.Args[0])
+	fmt.Fprint(os.Stderr, " -dry-run BOOL")
+	fmt.Fprint(os.Stderr, " -stream STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Create or update instruments from a CSV file with the columns symbol, exchange_mic, name, asset_class, currency, isin and figi. Nothing is stored unless every row is valid.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -dry-run BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -stream STRING: path to file containing the streamed request body`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "instrument import --dry-run false --stream \"goa.png\"")
+}
+
+// watchlistUsage displays the usage of the watchlist command and its
+// subcommands.
+func watchlistUsage() {
+	fmt.Fprintln(os.Stderr, `Manage user watchlists. Each user has a default watchlist, served by the /watchlist routes, and may create further named watchlists, share them with other users and publish them through a public link.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] watchlist COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    list: List the items of the default watchlist. The ETag header identifies the version of the watchlist; an If-None-Match header naming it returns 304 without items.`)
+	fmt.Fprintln(os.Stderr, `    add: Add a ticker to the default watchlist, after its other items. The symbol is upper-cased and may name its listing exchange with a MIC suffix, as in VOD.XLON. Re-adding a ticker updates the given attributes and keeps the others.`)
+	fmt.Fprintln(os.Stderr, `    update: Update a ticker of the default watchlist in place; omitted attributes are unchanged`)
+	fmt.Fprintln(os.Stderr, `    remove: Remove a ticker from the default watchlist. It is listed by list_removed and can be restored until it is purged at the end of the retention window.`)
+	fmt.Fprintln(os.Stderr, `    list-removed: List the tickers removed from a watchlist that can still be restored, most recently removed first`)
+	fmt.Fprintln(os.Stderr, `    restore: Put a removed ticker back into a watchlist at the position it had, shifting the items after it`)
+	fmt.Fprintln(os.Stderr, `    events: Stream the changes of the items of the user's watchlists, or of one watchlist, possibly shared with them, as Server-Sent Events. A client reconnecting with the Last-Event-ID header receives the events it missed, or a reset event when they are no longer retained. Clients that cannot send the Authorization header, such as browser EventSource, may pass a bearer token expiring within 5 minutes as the access_token query parameter instead.`)
+	fmt.Fprintln(os.Stderr, `    bulk-add: Add or update up to 100 tickers of a watchlist in one transaction, each as add does`)
+	fmt.Fprintln(os.Stderr, `    bulk-remove: Remove up to 100 tickers from a watchlist in one transaction. Unlike remove, a ticker that is not in the watchlist is rejected.`)
+	fmt.Fprintln(os.Stderr, `    import: Add or update the tickers of a CSV or JSON file in a watchlist, as add does. Nothing is stored unless every row is valid.`)
+	fmt.Fprintln(os.Stderr, `    export: Download the items of a watchlist as a CSV or JSON file that import accepts`)
+	fmt.Fprintln(os.Stderr, `    list-watchlists: List the user's watchlists in their order, starting with the default watchlist when it has not been moved`)
+	fmt.Fprintln(os.Stderr, `    list-shared-watchlists: List the watchlists of other users shared with the user, by name. They are read and changed through the /watchlists/{id} routes as their role allows.`)
+	fmt.Fprintln(os.Stderr, `    create-watchlist: Create an empty watchlist after the user's other watchlists`)
+	fmt.Fprintln(os.Stderr, `    get-watchlist: GetWatchlist implements get_watchlist.`)
+	fmt.Fprintln(os.Stderr, `    update-watchlist: Rename a watchlist or move it to another position; the other watchlists shift to make room`)
+	fmt.Fprintln(os.Stderr, `    delete-watchlist: Delete a watchlist and its items, ending its shares and public link. The default watchlist cannot be deleted.`)
+	fmt.Fprintln(os.Stderr, `    list-items: List the items of a watchlist, with its ETag as list does`)
+	fmt.Fprintln(os.Stderr, `    add-item: Add a ticker to a watchlist, normalized as by add`)
+	fmt.Fprintln(os.Stderr, `    update-item: Update a ticker of a watchlist in place, as update does`)
+	fmt.Fprintln(os.Stderr, `    remove-item: Remove a ticker from a watchlist`)
+	fmt.Fprintln(os.Stderr, `    list-shares: List the users a watchlist is shared with, by user ID. Only its owner may list them.`)
+	fmt.Fprintln(os.Stderr, `    share-watchlist: Share a watchlist with another user, or change the role it is shared with them with. Only its owner may share it.`)
+	fmt.Fprintln(os.Stderr, `    unshare-watchlist: Stop sharing a watchlist with a user. Its owner may remove any share; other users only their own, to leave the watchlist.`)
+	fmt.Fprintln(os.Stderr, `    publish-watchlist: Publish a watchlist through a public link that anyone knowing it can read without authentication. Publishing a published watchlist keeps its link; unpublish it first to replace the link.`)
+	fmt.Fprintln(os.Stderr, `    unpublish-watchlist: Disable the public link of a watchlist`)
+	fmt.Fprintln(os.Stderr, `    get-public-watchlist: Read a published watchlist through its public link. Served without authentication.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s watchlist COMMAND --help\n", os.Args[0])
+}
+func watchlistListUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist list", os.Args[0])
+	fmt.Fprint(os.Stderr, " -if-none-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the items of the default watchlist. The ETag header identifies the version of the watchlist; an If-None-Match header naming it returns 304 without items.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -if-none-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist list --if-none-match \"Vero doloremque.\" --token \"Rem quos labore pariatur.\"")
+}
+
+func watchlistAddUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist add", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Add a ticker to the default watchlist, after its other items. The symbol is upper-cased and may name its listing exchange with a MIC suffix, as in VOD.XLON. Re-adding a ticker updates the given attributes and keeps the others.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist add --body '{\n      \"buy_target\": 0.7302622286361922,\n      \"note\": \"ui0\",\n      \"on_hand\": true,\n      \"sell_target\": 0.9591026559189338,\n      \"symbol\": \"In fugit dolor.\",\n      \"tags\": [\n         \"wpo\",\n         \"btp\",\n         \"7m4\"\n      ]\n   }' --if-match \"Ut ducimus aliquid temporibus.\" --token \"Vel consequatur quos quos.\"")
+}
+
+func watchlistUpdateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist update", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -symbol STRING")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Update a ticker of the default watchlist in place; omitted attributes are unchanged`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -symbol STRING: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist update --body '{\n      \"buy_target\": 0.32139549049285693,\n      \"note\": \"q5r\",\n      \"on_hand\": false,\n      \"position\": 2102546174674104640,\n      \"sell_target\": 0.17033370826742666,\n      \"tags\": [\n         \"enf\",\n         \"699\",\n         \"2v2\"\n      ]\n   }' --symbol \"Aperiam debitis velit consectetur ea.\" --if-match \"Tenetur blanditiis vitae praesentium iusto esse.\" --token \"Alias reiciendis explicabo.\"")
+}
+
+func watchlistRemoveUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist remove", os.Args[0])
+	fmt.Fprint(os.Stderr, " -symbol STRING")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Remove a ticker from the default watchlist. It is listed by list_removed and can be restored until it is purged at the end of the retention window.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -symbol STRING: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist remove --symbol \"Minus est molestiae.\" --if-match \"Officia qui.\" --token \"Enim molestias qui assumenda iste.\"")
+}
+
+func watchlistListRemovedUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist list-removed", os.Args[0])
+	fmt.Fprint(os.Stderr, " -watchlist2 STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the tickers removed from a watchlist that can still be restored, most recently removed first`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -watchlist2 STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist list-removed --watchlist2 \"Sunt et et ad.\" --token \"Ratione labore ipsa.\"")
+}
+
+func watchlistRestoreUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist restore", os.Args[0])
+	fmt.Fprint(os.Stderr, " -symbol STRING")
+	fmt.Fprint(os.Stderr, " -watchlist2 STRING")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Put a removed ticker back into a watchlist at the position it had, shifting the items after it`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -symbol STRING: `)
+	fmt.Fprintln(os.Stderr, `    -watchlist2 STRING: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist restore --symbol \"Ab dolorum nesciunt.\" --watchlist2 \"Et vero et maiores modi sunt suscipit.\" --if-match \"Accusamus molestiae est occaecati nesciunt et.\" --token \"Totam distinctio quod dolor voluptas.\"")
+}
+
+func watchlistEventsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist events", os.Args[0])
+	fmt.Fprint(os.Stderr, " -watchlist2 STRING")
+	fmt.Fprint(os.Stderr, " -last-event-id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Stream the changes of the items of the user's watchlists, or of one watchlist, possibly shared with them, as Server-Sent Events. A client reconnecting with the Last-Event-ID header receives the events it missed, or a reset event when they are no longer retained. Clients that cannot send the Authorization header, such as browser EventSource, may pass a bearer token expiring within 5 minutes as the access_token query parameter instead.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -watchlist2 STRING: `)
+	fmt.Fprintln(os.Stderr, `    -last-event-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist events --watchlist2 \"Et incidunt corporis.\" --last-event-id \"Cupiditate eum eveniet doloremque.\" --token \"Ullam id quas voluptatum et eveniet.\"")
+}
+
+func watchlistBulkAddUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist bulk-add", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -watchlist2 STRING")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Add or update up to 100 tickers of a watchlist in one transaction, each as add does`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -watchlist2 STRING: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist bulk-add --body '{\n      \"items\": [\n         {\n            \"buy_target\": 0.07568598849097137,\n            \"note\": \"jih\",\n            \"on_hand\": false,\n            \"sell_target\": 0.7521908002781201,\n            \"symbol\": \"Magnam non.\",\n            \"tags\": [\n               \"u88\",\n               \"2mx\",\n               \"flt\"\n            ]\n         }\n      ],\n      \"mode\": \"all_or_nothing\"\n   }' --watchlist2 \"Minus rem praesentium.\" --if-match \"Facere ea eum eos.\" --token \"Voluptas est soluta aut et.\"")
+}
+
+func watchlistBulkRemoveUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist bulk-remove", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -watchlist2 STRING")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Remove up to 100 tickers from a watchlist in one transaction. Unlike remove, a ticker that is not in the watchlist is rejected.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -watchlist2 STRING: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist bulk-remove --body '{\n      \"mode\": \"best_effort\",\n      \"symbols\": [\n         \"Consequatur et ducimus nemo.\",\n         \"Ad perspiciatis quasi ut sint.\"\n      ]\n   }' --watchlist2 \"Sunt ad.\" --if-match \"Laborum voluptas inventore.\" --token \"Aut expedita qui eos error.\"")
+}
+
+func watchlistImportUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist import", os.Args[0])
+	fmt.Fprint(os.Stderr, " -watchlist2 STRING")
+	fmt.Fprint(os.Stderr, " -format STRING")
+	fmt.Fprint(os.Stderr, " -dry-run BOOL")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprint(os.Stderr, " -stream STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Add or update the tickers of a CSV or JSON file in a watchlist, as add does. Nothing is stored unless every row is valid.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -watchlist2 STRING: `)
+	fmt.Fprintln(os.Stderr, `    -format STRING: `)
+	fmt.Fprintln(os.Stderr, `    -dry-run BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -stream STRING: path to file containing the streamed request body`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist import --watchlist2 \"Distinctio nulla voluptatem possimus voluptates.\" --format \"json\" --dry-run false --if-match \"Sit nihil.\" --token \"Voluptatem in facere esse iure est ex.\" --stream \"goa.png\"")
+}
+
+func watchlistExportUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist export", os.Args[0])
+	fmt.Fprint(os.Stderr, " -watchlist2 STRING")
+	fmt.Fprint(os.Stderr, " -format STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Download the items of a watchlist as a CSV or JSON file that import accepts`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -watchlist2 STRING: `)
+	fmt.Fprintln(os.Stderr, `    -format STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist export --watchlist2 \"Cum minus iste.\" --format \"csv\" --token \"Voluptatem ad nesciunt.\"")
+}
+
+func watchlistListWatchlistsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist list-watchlists", os.Args[0])
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the user's watchlists in their order, starting with the default watchlist when it has not been moved`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist list-watchlists --token \"Quia cupiditate aut eos est.\"")
+}
+
+func watchlistListSharedWatchlistsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist list-shared-watchlists", os.Args[0])
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the watchlists of other users shared with the user, by name. They are read and changed through the /watchlists/{id} routes as their role allows.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist list-shared-watchlists --token \"Eos eos magni harum.\"")
+}
+
+func watchlistCreateWatchlistUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist create-watchlist", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Create an empty watchlist after the user's other watchlists`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist create-watchlist --body '{\n      \"name\": \"hky\"\n   }' --token \"Aperiam ut beatae neque.\"")
+}
+
+func watchlistGetWatchlistUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist get-watchlist", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `GetWatchlist implements get_watchlist.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Watchlist ID, or "default" for the default watchlist`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist get-watchlist --id \"Distinctio qui qui aut quas ex.\" --token \"Fuga ut beatae.\"")
+}
+
+func watchlistUpdateWatchlistUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist update-watchlist", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Rename a watchlist or move it to another position; the other watchlists shift to make room`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: Watchlist ID, or "default" for the default watchlist`)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist update-watchlist --body '{\n      \"name\": \"ek2\",\n      \"position\": 7197216116523730459\n   }' --id \"Vel eos consequatur laboriosam et aperiam sed.\" --if-match \"Laboriosam quo tempore et est doloremque.\" --token \"Ut error enim ea.\"")
+}
+
+func watchlistDeleteWatchlistUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist delete-watchlist", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete a watchlist and its items, ending its shares and public link. The default watchlist cannot be deleted.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Watchlist ID, or "default" for the default watchlist`)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist delete-watchlist --id \"Consequatur nihil exercitationem eveniet vero.\" --if-match \"Eum nesciunt id.\" --token \"Magnam aspernatur aut quis.\"")
+}
+
+func watchlistListItemsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist list-items", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -if-none-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the items of a watchlist, with its ETag as list does`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Watchlist ID, or "default" for the default watchlist`)
+	fmt.Fprintln(os.Stderr, `    -if-none-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist list-items --id \"Non qui.\" --if-none-match \"Consequuntur perferendis ea ducimus numquam eos asperiores.\" --token \"Dolorem explicabo voluptatem et ipsam.\"")
+}
+
+func watchlistAddItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist add-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Add a ticker to a watchlist, normalized as by add`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: Watchlist ID, or "default" for the default watchlist`)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist add-item --body '{\n      \"buy_target\": 0.0952479861125196,\n      \"note\": \"w8g\",\n      \"on_hand\": false,\n      \"sell_target\": 0.882507227633225,\n      \"symbol\": \"Voluptatem ut magnam consequatur eum tenetur ducimus.\",\n      \"tags\": [\n         \"wcq\",\n         \"m7x\",\n         \"bu4\"\n      ]\n   }' --id \"Ea odio rerum impedit laborum.\" --if-match \"Et repellendus nesciunt sit incidunt voluptate omnis.\" --token \"Nesciunt eum qui dignissimos officiis vel.\"")
+}
+
+func watchlistUpdateItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist update-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -symbol STRING")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Update a ticker of a watchlist in place, as update does`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: Watchlist ID, or "default" for the default watchlist`)
+	fmt.Fprintln(os.Stderr, `    -symbol STRING: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist update-item --body '{\n      \"buy_target\": 0.987705789726656,\n      \"note\": \"pl0\",\n      \"on_hand\": false,\n      \"position\": 2080565751603999241,\n      \"sell_target\": 0.9183921200074799,\n      \"tags\": [\n         \"wcj\",\n         \"vrg\",\n         \"2hb\"\n      ]\n   }' --id \"Autem architecto iusto qui doloremque aut.\" --symbol \"Illum qui.\" --if-match \"Deleniti officiis laborum voluptatibus ut enim qui.\" --token \"Saepe quo quis nihil omnis recusandae.\"")
+}
+
+func watchlistRemoveItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist remove-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -symbol STRING")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Remove a ticker from a watchlist`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Watchlist ID, or "default" for the default watchlist`)
+	fmt.Fprintln(os.Stderr, `    -symbol STRING: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist remove-item --id \"Et occaecati et qui.\" --symbol \"Quam ea rerum.\" --if-match \"Qui provident et reiciendis quisquam.\" --token \"Vero facilis quia aliquam.\"")
+}
+
+func watchlistListSharesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist list-shares", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the users a watchlist is shared with, by user ID. Only its owner may list them.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Watchlist ID, or "default" for the default watchlist`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist list-shares --id \"Ipsa vitae voluptatem.\" --token \"Dolore asperiores ut libero laboriosam harum veniam.\"")
+}
+
+func watchlistShareWatchlistUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist share-watchlist", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -user STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Share a watchlist with another user, or change the role it is shared with them with. Only its owner may share it.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: Watchlist ID, or "default" for the default watchlist`)
+	fmt.Fprintln(os.Stderr, `    -user STRING: User ID the watchlist is shared with`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist share-watchlist --body '{\n      \"role\": \"editor\"\n   }' --id \"Quia et illum et rerum minus.\" --user \"Ducimus omnis.\" --token \"Quaerat et et blanditiis error.\"")
+}
+
+func watchlistUnshareWatchlistUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist unshare-watchlist", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -user STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Stop sharing a watchlist with a user. Its owner may remove any share; other users only their own, to leave the watchlist.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Watchlist ID, or "default" for the default watchlist`)
+	fmt.Fprintln(os.Stderr, `    -user STRING: User ID the watchlist is shared with`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist unshare-watchlist --id \"Voluptas blanditiis dolor rerum sed.\" --user \"Quia facilis in impedit.\" --token \"Voluptas ab.\"")
+}
+
+func watchlistPublishWatchlistUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist publish-watchlist", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Publish a watchlist through a public link that anyone knowing it can read without authentication. Publishing a published watchlist keeps its link; unpublish it first to replace the link.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Watchlist ID, or "default" for the default watchlist`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist publish-watchlist --id \"Repellat illum praesentium facilis dolor assumenda.\" --token \"Ea accusantium.\"")
+}
+
+func watchlistUnpublishWatchlistUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist unpublish-watchlist", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Disable the public link of a watchlist`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Watchlist ID, or "default" for the default watchlist`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist unpublish-watchlist --id \"Autem eaque culpa quis id praesentium optio.\" --token \"Velit et deserunt quisquam.\"")
+}
+
+func watchlistGetPublicWatchlistUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] watchlist get-public-watchlist", os.Args[0])
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Read a published watchlist through its public link. Served without authentication.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -token STRING: Token of the public link`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "watchlist get-public-watchlist --token \"Eum nobis.\"")
+}
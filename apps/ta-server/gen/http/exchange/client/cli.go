@@ -1,4 +1,4 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // exchange HTTP client CLI support package
 //
@@ -8,20 +8,88 @@
 package client
 
 import (
+	"fmt"
+	"strconv"
+
 	exchange "github.com/reidlai/ta-workspace/apps/ta-server/gen/exchange"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildListPayload builds the payload for the exchange list endpoint from CLI
 // flags.
-func BuildListPayload(exchangeListQuery string) (*exchange.ListPayload, error) {
+func BuildListPayload(exchangeListQuery string, exchangeListCountry string, exchangeListCity string, exchangeListAcronym string, exchangeListSort string, exchangeListCursor string, exchangeListLimit string) (*exchange.ListPayload, error) {
+	var err error
 	var query *string
 	{
 		if exchangeListQuery != "" {
 			query = &exchangeListQuery
 		}
 	}
+	var country *string
+	{
+		if exchangeListCountry != "" {
+			country = &exchangeListCountry
+		}
+	}
+	var city *string
+	{
+		if exchangeListCity != "" {
+			city = &exchangeListCity
+		}
+	}
+	var acronym *string
+	{
+		if exchangeListAcronym != "" {
+			acronym = &exchangeListAcronym
+		}
+	}
+	var sort *string
+	{
+		if exchangeListSort != "" {
+			sort = &exchangeListSort
+			if !(*sort == "relevance" || *sort == "name" || *sort == "country" || *sort == "mic") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", *sort, []any{"relevance", "name", "country", "mic"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if exchangeListCursor != "" {
+			cursor = &exchangeListCursor
+		}
+	}
+	var limit *int
+	{
+		if exchangeListLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(exchangeListLimit, 10, strconv.IntSize)
+			val := int(v)
+			limit = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if *limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1, true))
+			}
+			if *limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	v := &exchange.ListPayload{}
 	v.Query = query
+	v.Country = country
+	v.City = city
+	v.Acronym = acronym
+	v.Sort = sort
+	v.Cursor = cursor
+	v.Limit = limit
 
 	return v, nil
 }
@@ -38,3 +106,65 @@ func BuildGetPayload(exchangeGetOperatingMic string) (*exchange.GetPayload, erro
 
 	return v, nil
 }
+
+// BuildSegmentsPayload builds the payload for the exchange segments endpoint
+// from CLI flags.
+func BuildSegmentsPayload(exchangeSegmentsOperatingMic string) (*exchange.SegmentsPayload, error) {
+	var operatingMic string
+	{
+		operatingMic = exchangeSegmentsOperatingMic
+	}
+	v := &exchange.SegmentsPayload{}
+	v.OperatingMic = operatingMic
+
+	return v, nil
+}
+
+// BuildCalendarPayload builds the payload for the exchange calendar endpoint
+// from CLI flags.
+func BuildCalendarPayload(exchangeCalendarOperatingMic string, exchangeCalendarFrom string, exchangeCalendarTo string) (*exchange.CalendarPayload, error) {
+	var err error
+	var operatingMic string
+	{
+		operatingMic = exchangeCalendarOperatingMic
+	}
+	var from *string
+	{
+		if exchangeCalendarFrom != "" {
+			from = &exchangeCalendarFrom
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var to *string
+	{
+		if exchangeCalendarTo != "" {
+			to = &exchangeCalendarTo
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &exchange.CalendarPayload{}
+	v.OperatingMic = operatingMic
+	v.From = from
+	v.To = to
+
+	return v, nil
+}
+
+// BuildStatusPayload builds the payload for the exchange status endpoint from
+// CLI flags.
+func BuildStatusPayload(exchangeStatusOperatingMic string) (*exchange.StatusPayload, error) {
+	var operatingMic string
+	{
+		operatingMic = exchangeStatusOperatingMic
+	}
+	v := &exchange.StatusPayload{}
+	v.OperatingMic = operatingMic
+
+	return v, nil
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // exchange client HTTP transport
 //
@@ -23,6 +23,17 @@ type Client struct {
 	// Get Doer is the HTTP client used to make requests to the get endpoint.
 	GetDoer goahttp.Doer
 
+	// Segments Doer is the HTTP client used to make requests to the segments
+	// endpoint.
+	SegmentsDoer goahttp.Doer
+
+	// Calendar Doer is the HTTP client used to make requests to the calendar
+	// endpoint.
+	CalendarDoer goahttp.Doer
+
+	// Status Doer is the HTTP client used to make requests to the status endpoint.
+	StatusDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -45,6 +56,9 @@ func NewClient(
 	return &Client{
 		ListDoer:            doer,
 		GetDoer:             doer,
+		SegmentsDoer:        doer,
+		CalendarDoer:        doer,
+		StatusDoer:          doer,
 		RestoreResponseBody: restoreBody,
 		scheme:              scheme,
 		host:                host,
@@ -95,3 +109,65 @@ func (c *Client) Get() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// Segments returns an endpoint that makes HTTP requests to the exchange
+// service segments server.
+func (c *Client) Segments() goa.Endpoint {
+	var (
+		decodeResponse = DecodeSegmentsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSegmentsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SegmentsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("exchange", "segments", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Calendar returns an endpoint that makes HTTP requests to the exchange
+// service calendar server.
+func (c *Client) Calendar() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCalendarRequest(c.encoder)
+		decodeResponse = DecodeCalendarResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCalendarRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CalendarDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("exchange", "calendar", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Status returns an endpoint that makes HTTP requests to the exchange service
+// status server.
+func (c *Client) Status() goa.Endpoint {
+	var (
+		decodeResponse = DecodeStatusResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildStatusRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.StatusDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("exchange", "status", err)
+		}
+		return decodeResponse(resp)
+	}
+}
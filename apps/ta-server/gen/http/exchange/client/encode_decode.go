@@ -1,4 +1,4 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // exchange HTTP client encoders and decoders
 //
@@ -10,9 +10,11 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	exchange "github.com/reidlai/ta-workspace/apps/ta-server/gen/exchange"
 	goahttp "goa.design/goa/v3/http"
@@ -46,6 +48,24 @@ func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.R
 		if p.Query != nil {
 			values.Add("query", *p.Query)
 		}
+		if p.Country != nil {
+			values.Add("country", *p.Country)
+		}
+		if p.City != nil {
+			values.Add("city", *p.City)
+		}
+		if p.Acronym != nil {
+			values.Add("acronym", *p.Acronym)
+		}
+		if p.Sort != nil {
+			values.Add("sort", *p.Sort)
+		}
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
@@ -54,6 +74,10 @@ func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.R
 // DecodeListResponse returns a decoder for responses returned by the exchange
 // list endpoint. restoreBody controls whether the response body should be
 // restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "invalid_cursor" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
 func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -80,7 +104,7 @@ func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBod
 			}
 			for _, e := range body {
 				if e != nil {
-					if err2 := ValidateExchangeResponse(e); err2 != nil {
+					if err2 := ValidateExchange(e); err2 != nil {
 						err = goa.MergeErrors(err, err2)
 					}
 				}
@@ -88,8 +112,58 @@ func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBod
 			if err != nil {
 				return nil, goahttp.ErrValidationError("exchange", "list", err)
 			}
-			res := NewListExchangeOK(body)
+			var (
+				total      int
+				nextCursor *string
+			)
+			{
+				totalRaw := resp.Header.Get("X-Total-Count")
+				if totalRaw == "" {
+					return nil, goahttp.ErrValidationError("exchange", "list", goa.MissingFieldError("total", "header"))
+				}
+				v, err2 := strconv.ParseInt(totalRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("total", totalRaw, "integer"))
+				}
+				total = int(v)
+			}
+			nextCursorRaw := resp.Header.Get("X-Next-Cursor")
+			if nextCursorRaw != "" {
+				nextCursor = &nextCursorRaw
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("exchange", "list", err)
+			}
+			res := NewListResultOK(body, total, nextCursor)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListInvalidCursorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("exchange", "list", err)
+			}
+			err = ValidateListInvalidCursorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("exchange", "list", err)
+			}
+			return nil, NewListInvalidCursor(&body)
+		case http.StatusNotFound:
+			var (
+				body ListNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("exchange", "list", err)
+			}
+			err = ValidateListNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("exchange", "list", err)
+			}
+			return nil, NewListNotFound(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("exchange", "list", resp.StatusCode, string(body))
@@ -125,6 +199,9 @@ func (c *Client) BuildGetRequest(ctx context.Context, v any) (*http.Request, err
 // DecodeGetResponse returns a decoder for responses returned by the exchange
 // get endpoint. restoreBody controls whether the response body should be
 // restored after having been read.
+// DecodeGetResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
 func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -155,6 +232,20 @@ func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody
 			}
 			res := NewGetExchangeOK(&body)
 			return res, nil
+		case http.StatusNotFound:
+			var (
+				body GetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("exchange", "get", err)
+			}
+			err = ValidateGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("exchange", "get", err)
+			}
+			return nil, NewGetNotFound(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("exchange", "get", resp.StatusCode, string(body))
@@ -162,16 +253,381 @@ func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody
 	}
 }
 
-// unmarshalExchangeResponseToExchangeExchange builds a value of type
-// *exchange.Exchange from a value of type *ExchangeResponse.
-func unmarshalExchangeResponseToExchangeExchange(v *ExchangeResponse) *exchange.Exchange {
+// BuildSegmentsRequest instantiates a HTTP request object with method and path
+// set to call the "exchange" service "segments" endpoint
+func (c *Client) BuildSegmentsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		operatingMic string
+	)
+	{
+		p, ok := v.(*exchange.SegmentsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("exchange", "segments", "*exchange.SegmentsPayload", v)
+		}
+		operatingMic = p.OperatingMic
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SegmentsExchangePath(operatingMic)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("exchange", "segments", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeSegmentsResponse returns a decoder for responses returned by the
+// exchange segments endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeSegmentsResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeSegmentsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SegmentsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("exchange", "segments", err)
+			}
+			err = ValidateSegmentsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("exchange", "segments", err)
+			}
+			res := NewSegmentsSegmentTreeOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body SegmentsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("exchange", "segments", err)
+			}
+			err = ValidateSegmentsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("exchange", "segments", err)
+			}
+			return nil, NewSegmentsNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("exchange", "segments", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCalendarRequest instantiates a HTTP request object with method and path
+// set to call the "exchange" service "calendar" endpoint
+func (c *Client) BuildCalendarRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		operatingMic string
+	)
+	{
+		p, ok := v.(*exchange.CalendarPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("exchange", "calendar", "*exchange.CalendarPayload", v)
+		}
+		operatingMic = p.OperatingMic
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CalendarExchangePath(operatingMic)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("exchange", "calendar", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCalendarRequest returns an encoder for requests sent to the exchange
+// calendar server.
+func EncodeCalendarRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*exchange.CalendarPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("exchange", "calendar", "*exchange.CalendarPayload", v)
+		}
+		values := req.URL.Query()
+		if p.From != nil {
+			values.Add("from", *p.From)
+		}
+		if p.To != nil {
+			values.Add("to", *p.To)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeCalendarResponse returns a decoder for responses returned by the
+// exchange calendar endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCalendarResponse may return the following errors:
+//   - "invalid_range" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeCalendarResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CalendarResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("exchange", "calendar", err)
+			}
+			err = ValidateCalendarResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("exchange", "calendar", err)
+			}
+			res := NewCalendarTradingCalendarOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CalendarInvalidRangeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("exchange", "calendar", err)
+			}
+			err = ValidateCalendarInvalidRangeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("exchange", "calendar", err)
+			}
+			return nil, NewCalendarInvalidRange(&body)
+		case http.StatusNotFound:
+			var (
+				body CalendarNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("exchange", "calendar", err)
+			}
+			err = ValidateCalendarNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("exchange", "calendar", err)
+			}
+			return nil, NewCalendarNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("exchange", "calendar", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildStatusRequest instantiates a HTTP request object with method and path
+// set to call the "exchange" service "status" endpoint
+func (c *Client) BuildStatusRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		operatingMic string
+	)
+	{
+		p, ok := v.(*exchange.StatusPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("exchange", "status", "*exchange.StatusPayload", v)
+		}
+		operatingMic = p.OperatingMic
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: StatusExchangePath(operatingMic)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("exchange", "status", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeStatusResponse returns a decoder for responses returned by the
+// exchange status endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeStatusResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeStatusResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body StatusResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("exchange", "status", err)
+			}
+			err = ValidateStatusResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("exchange", "status", err)
+			}
+			res := NewStatusMarketStatusOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body StatusNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("exchange", "status", err)
+			}
+			err = ValidateStatusNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("exchange", "status", err)
+			}
+			return nil, NewStatusNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("exchange", "status", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalExchangeToExchangeExchange builds a value of type
+// *exchange.Exchange from a value of type *Exchange.
+func unmarshalExchangeToExchangeExchange(v *Exchange) *exchange.Exchange {
 	res := &exchange.Exchange{
-		OperatingMic: *v.OperatingMic,
-		ExchangeName: *v.ExchangeName,
-		DisplayName:  *v.DisplayName,
-		Country:      *v.Country,
-		City:         *v.City,
-		Acronym:      v.Acronym,
+		OperatingMic:     *v.OperatingMic,
+		ExchangeName:     *v.ExchangeName,
+		DisplayName:      *v.DisplayName,
+		Country:          *v.Country,
+		City:             *v.City,
+		Acronym:          v.Acronym,
+		MarketCategory:   *v.MarketCategory,
+		Status:           *v.Status,
+		Website:          v.Website,
+		CreationDate:     v.CreationDate,
+		LastModifiedDate: v.LastModifiedDate,
+	}
+	res.Segments = make([]*exchange.Segment, len(v.Segments))
+	for i, val := range v.Segments {
+		if val == nil {
+			res.Segments[i] = nil
+			continue
+		}
+		res.Segments[i] = unmarshalSegmentToExchangeSegment(val)
+	}
+
+	return res
+}
+
+// unmarshalSegmentToExchangeSegment builds a value of type *exchange.Segment
+// from a value of type *Segment.
+func unmarshalSegmentToExchangeSegment(v *Segment) *exchange.Segment {
+	res := &exchange.Segment{
+		Mic:              *v.Mic,
+		OperatingMic:     *v.OperatingMic,
+		Name:             *v.Name,
+		City:             *v.City,
+		Acronym:          v.Acronym,
+		MarketCategory:   *v.MarketCategory,
+		Status:           *v.Status,
+		Website:          v.Website,
+		CreationDate:     v.CreationDate,
+		LastModifiedDate: v.LastModifiedDate,
+	}
+
+	return res
+}
+
+// unmarshalSegmentResponseBodyToExchangeSegment builds a value of type
+// *exchange.Segment from a value of type *SegmentResponseBody.
+func unmarshalSegmentResponseBodyToExchangeSegment(v *SegmentResponseBody) *exchange.Segment {
+	res := &exchange.Segment{
+		Mic:              *v.Mic,
+		OperatingMic:     *v.OperatingMic,
+		Name:             *v.Name,
+		City:             *v.City,
+		Acronym:          v.Acronym,
+		MarketCategory:   *v.MarketCategory,
+		Status:           *v.Status,
+		Website:          v.Website,
+		CreationDate:     v.CreationDate,
+		LastModifiedDate: v.LastModifiedDate,
+	}
+
+	return res
+}
+
+// unmarshalTradingDayResponseBodyToExchangeTradingDay builds a value of type
+// *exchange.TradingDay from a value of type *TradingDayResponseBody.
+func unmarshalTradingDayResponseBodyToExchangeTradingDay(v *TradingDayResponseBody) *exchange.TradingDay {
+	res := &exchange.TradingDay{
+		Date:    *v.Date,
+		Status:  *v.Status,
+		Holiday: v.Holiday,
+	}
+	res.Sessions = make([]*exchange.TradingSession, len(v.Sessions))
+	for i, val := range v.Sessions {
+		if val == nil {
+			res.Sessions[i] = nil
+			continue
+		}
+		res.Sessions[i] = unmarshalTradingSessionResponseBodyToExchangeTradingSession(val)
+	}
+
+	return res
+}
+
+// unmarshalTradingSessionResponseBodyToExchangeTradingSession builds a value
+// of type *exchange.TradingSession from a value of type
+// *TradingSessionResponseBody.
+func unmarshalTradingSessionResponseBodyToExchangeTradingSession(v *TradingSessionResponseBody) *exchange.TradingSession {
+	res := &exchange.TradingSession{
+		Kind:  *v.Kind,
+		Open:  *v.Open,
+		Close: *v.Close,
 	}
 
 	return res
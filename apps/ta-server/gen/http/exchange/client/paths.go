@@ -1,4 +1,4 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // HTTP request path constructors for the exchange service.
 //
@@ -20,3 +20,18 @@ func ListExchangePath() string {
 func GetExchangePath(operatingMic string) string {
 	return fmt.Sprintf("/exchanges/%v", operatingMic)
 }
+
+// SegmentsExchangePath returns the URL path to the exchange service segments HTTP endpoint.
+func SegmentsExchangePath(operatingMic string) string {
+	return fmt.Sprintf("/exchanges/%v/segments", operatingMic)
+}
+
+// CalendarExchangePath returns the URL path to the exchange service calendar HTTP endpoint.
+func CalendarExchangePath(operatingMic string) string {
+	return fmt.Sprintf("/exchanges/%v/calendar", operatingMic)
+}
+
+// StatusExchangePath returns the URL path to the exchange service status HTTP endpoint.
+func StatusExchangePath(operatingMic string) string {
+	return fmt.Sprintf("/exchanges/%v/status", operatingMic)
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // exchange HTTP client types
 //
@@ -8,15 +8,13 @@
 package client
 
 import (
-	"unicode/utf8"
-
 	exchange "github.com/reidlai/ta-workspace/apps/ta-server/gen/exchange"
 	goa "goa.design/goa/v3/pkg"
 )
 
 // ListResponseBody is the type of the "exchange" service "list" endpoint HTTP
 // response body.
-type ListResponseBody []*ExchangeResponse
+type ListResponseBody []*Exchange
 
 // GetResponseBody is the type of the "exchange" service "get" endpoint HTTP
 // response body.
@@ -33,10 +31,187 @@ type GetResponseBody struct {
 	City *string `form:"city,omitempty" json:"city,omitempty" xml:"city,omitempty"`
 	// Short identifier
 	Acronym *string `form:"acronym,omitempty" json:"acronym,omitempty" xml:"acronym,omitempty"`
+	// ISO 10383 market category code
+	MarketCategory *string `form:"market_category,omitempty" json:"market_category,omitempty" xml:"market_category,omitempty"`
+	// ISO 10383 status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Website of the market
+	Website *string `form:"website,omitempty" json:"website,omitempty" xml:"website,omitempty"`
+	// Date the MIC was created
+	CreationDate *string `form:"creation_date,omitempty" json:"creation_date,omitempty" xml:"creation_date,omitempty"`
+	// Date the MIC was last modified
+	LastModifiedDate *string `form:"last_modified_date,omitempty" json:"last_modified_date,omitempty" xml:"last_modified_date,omitempty"`
+	// Active segment MICs operated by the exchange
+	Segments []*SegmentResponseBody `form:"segments,omitempty" json:"segments,omitempty" xml:"segments,omitempty"`
+}
+
+// SegmentsResponseBody is the type of the "exchange" service "segments"
+// endpoint HTTP response body.
+type SegmentsResponseBody struct {
+	// 4-character ISO 10383 code
+	OperatingMic *string `form:"operating_mic,omitempty" json:"operating_mic,omitempty" xml:"operating_mic,omitempty"`
+	// Full descriptive name
+	ExchangeName *string `form:"exchange_name,omitempty" json:"exchange_name,omitempty" xml:"exchange_name,omitempty"`
+	// Active segment MICs
+	Segments []*SegmentResponseBody `form:"segments,omitempty" json:"segments,omitempty" xml:"segments,omitempty"`
+}
+
+// CalendarResponseBody is the type of the "exchange" service "calendar"
+// endpoint HTTP response body.
+type CalendarResponseBody struct {
+	// 4-character ISO 10383 code
+	OperatingMic *string `form:"operating_mic,omitempty" json:"operating_mic,omitempty" xml:"operating_mic,omitempty"`
+	// IANA time zone of the exchange
+	Timezone *string                   `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	Days     []*TradingDayResponseBody `form:"days,omitempty" json:"days,omitempty" xml:"days,omitempty"`
+}
+
+// StatusResponseBody is the type of the "exchange" service "status" endpoint
+// HTTP response body.
+type StatusResponseBody struct {
+	// 4-character ISO 10383 code
+	OperatingMic *string `form:"operating_mic,omitempty" json:"operating_mic,omitempty" xml:"operating_mic,omitempty"`
+	// IANA time zone of the exchange
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty" xml:"timezone,omitempty"`
+	// Market state; a lunch break is reported as closed, and the state is unknown
+	// outside the years the calendar covers
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Time the status was computed
+	AsOf *string `form:"as_of,omitempty" json:"as_of,omitempty" xml:"as_of,omitempty"`
+	// Next start of the regular session
+	NextOpen *string `form:"next_open,omitempty" json:"next_open,omitempty" xml:"next_open,omitempty"`
+	// Next end of the regular session
+	NextClose *string `form:"next_close,omitempty" json:"next_close,omitempty" xml:"next_close,omitempty"`
+}
+
+// ListInvalidCursorResponseBody is the type of the "exchange" service "list"
+// endpoint HTTP response body for the "invalid_cursor" error.
+type ListInvalidCursorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListNotFoundResponseBody is the type of the "exchange" service "list"
+// endpoint HTTP response body for the "not_found" error.
+type ListNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetNotFoundResponseBody is the type of the "exchange" service "get" endpoint
+// HTTP response body for the "not_found" error.
+type GetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SegmentsNotFoundResponseBody is the type of the "exchange" service
+// "segments" endpoint HTTP response body for the "not_found" error.
+type SegmentsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CalendarInvalidRangeResponseBody is the type of the "exchange" service
+// "calendar" endpoint HTTP response body for the "invalid_range" error.
+type CalendarInvalidRangeResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CalendarNotFoundResponseBody is the type of the "exchange" service
+// "calendar" endpoint HTTP response body for the "not_found" error.
+type CalendarNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// StatusNotFoundResponseBody is the type of the "exchange" service "status"
+// endpoint HTTP response body for the "not_found" error.
+type StatusNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ExchangeResponse is used to define fields on response body types.
-type ExchangeResponse struct {
+// Exchange is used to define fields on response body types.
+type Exchange struct {
 	// 4-character ISO 10383 code
 	OperatingMic *string `form:"operating_mic,omitempty" json:"operating_mic,omitempty" xml:"operating_mic,omitempty"`
 	// Full descriptive name
@@ -49,14 +224,134 @@ type ExchangeResponse struct {
 	City *string `form:"city,omitempty" json:"city,omitempty" xml:"city,omitempty"`
 	// Short identifier
 	Acronym *string `form:"acronym,omitempty" json:"acronym,omitempty" xml:"acronym,omitempty"`
+	// ISO 10383 market category code
+	MarketCategory *string `form:"market_category,omitempty" json:"market_category,omitempty" xml:"market_category,omitempty"`
+	// ISO 10383 status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Website of the market
+	Website *string `form:"website,omitempty" json:"website,omitempty" xml:"website,omitempty"`
+	// Date the MIC was created
+	CreationDate *string `form:"creation_date,omitempty" json:"creation_date,omitempty" xml:"creation_date,omitempty"`
+	// Date the MIC was last modified
+	LastModifiedDate *string `form:"last_modified_date,omitempty" json:"last_modified_date,omitempty" xml:"last_modified_date,omitempty"`
+	// Active segment MICs operated by the exchange
+	Segments []*Segment `form:"segments,omitempty" json:"segments,omitempty" xml:"segments,omitempty"`
+}
+
+// Segment is used to define fields on response body types.
+type Segment struct {
+	// 4-character ISO 10383 segment code
+	Mic *string `form:"mic,omitempty" json:"mic,omitempty" xml:"mic,omitempty"`
+	// Operating MIC the segment belongs to
+	OperatingMic *string `form:"operating_mic,omitempty" json:"operating_mic,omitempty" xml:"operating_mic,omitempty"`
+	// Full descriptive name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// City location
+	City *string `form:"city,omitempty" json:"city,omitempty" xml:"city,omitempty"`
+	// Short identifier
+	Acronym *string `form:"acronym,omitempty" json:"acronym,omitempty" xml:"acronym,omitempty"`
+	// ISO 10383 market category code
+	MarketCategory *string `form:"market_category,omitempty" json:"market_category,omitempty" xml:"market_category,omitempty"`
+	// ISO 10383 status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Website of the market
+	Website *string `form:"website,omitempty" json:"website,omitempty" xml:"website,omitempty"`
+	// Date the MIC was created
+	CreationDate *string `form:"creation_date,omitempty" json:"creation_date,omitempty" xml:"creation_date,omitempty"`
+	// Date the MIC was last modified
+	LastModifiedDate *string `form:"last_modified_date,omitempty" json:"last_modified_date,omitempty" xml:"last_modified_date,omitempty"`
+}
+
+// SegmentResponseBody is used to define fields on response body types.
+type SegmentResponseBody struct {
+	// 4-character ISO 10383 segment code
+	Mic *string `form:"mic,omitempty" json:"mic,omitempty" xml:"mic,omitempty"`
+	// Operating MIC the segment belongs to
+	OperatingMic *string `form:"operating_mic,omitempty" json:"operating_mic,omitempty" xml:"operating_mic,omitempty"`
+	// Full descriptive name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// City location
+	City *string `form:"city,omitempty" json:"city,omitempty" xml:"city,omitempty"`
+	// Short identifier
+	Acronym *string `form:"acronym,omitempty" json:"acronym,omitempty" xml:"acronym,omitempty"`
+	// ISO 10383 market category code
+	MarketCategory *string `form:"market_category,omitempty" json:"market_category,omitempty" xml:"market_category,omitempty"`
+	// ISO 10383 status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Website of the market
+	Website *string `form:"website,omitempty" json:"website,omitempty" xml:"website,omitempty"`
+	// Date the MIC was created
+	CreationDate *string `form:"creation_date,omitempty" json:"creation_date,omitempty" xml:"creation_date,omitempty"`
+	// Date the MIC was last modified
+	LastModifiedDate *string `form:"last_modified_date,omitempty" json:"last_modified_date,omitempty" xml:"last_modified_date,omitempty"`
+}
+
+// TradingDayResponseBody is used to define fields on response body types.
+type TradingDayResponseBody struct {
+	// Calendar date in the exchange time zone
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// Whether the market trades that day
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Holiday name when the market is closed for a holiday
+	Holiday *string `form:"holiday,omitempty" json:"holiday,omitempty" xml:"holiday,omitempty"`
+	// Trading sessions in chronological order
+	Sessions []*TradingSessionResponseBody `form:"sessions,omitempty" json:"sessions,omitempty" xml:"sessions,omitempty"`
+}
+
+// TradingSessionResponseBody is used to define fields on response body types.
+type TradingSessionResponseBody struct {
+	// Session kind
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Session start
+	Open *string `form:"open,omitempty" json:"open,omitempty" xml:"open,omitempty"`
+	// Session end
+	Close *string `form:"close,omitempty" json:"close,omitempty" xml:"close,omitempty"`
 }
 
-// NewListExchangeOK builds a "exchange" service "list" endpoint result from a
+// NewListResultOK builds a "exchange" service "list" endpoint result from a
 // HTTP "OK" response.
-func NewListExchangeOK(body []*ExchangeResponse) []*exchange.Exchange {
+func NewListResultOK(body []*Exchange, total int, nextCursor *string) *exchange.ListResult {
 	v := make([]*exchange.Exchange, len(body))
 	for i, val := range body {
-		v[i] = unmarshalExchangeResponseToExchangeExchange(val)
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalExchangeToExchangeExchange(val)
+	}
+	res := &exchange.ListResult{
+		Exchanges: v,
+	}
+	res.Total = total
+	res.NextCursor = nextCursor
+
+	return res
+}
+
+// NewListInvalidCursor builds a exchange service list endpoint invalid_cursor
+// error.
+func NewListInvalidCursor(body *ListInvalidCursorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListNotFound builds a exchange service list endpoint not_found error.
+func NewListNotFound(body *ListNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
 
 	return v
@@ -66,12 +361,151 @@ func NewListExchangeOK(body []*ExchangeResponse) []*exchange.Exchange {
 // HTTP "OK" response.
 func NewGetExchangeOK(body *GetResponseBody) *exchange.Exchange {
 	v := &exchange.Exchange{
+		OperatingMic:     *body.OperatingMic,
+		ExchangeName:     *body.ExchangeName,
+		DisplayName:      *body.DisplayName,
+		Country:          *body.Country,
+		City:             *body.City,
+		Acronym:          body.Acronym,
+		MarketCategory:   *body.MarketCategory,
+		Status:           *body.Status,
+		Website:          body.Website,
+		CreationDate:     body.CreationDate,
+		LastModifiedDate: body.LastModifiedDate,
+	}
+	v.Segments = make([]*exchange.Segment, len(body.Segments))
+	for i, val := range body.Segments {
+		if val == nil {
+			v.Segments[i] = nil
+			continue
+		}
+		v.Segments[i] = unmarshalSegmentResponseBodyToExchangeSegment(val)
+	}
+
+	return v
+}
+
+// NewGetNotFound builds a exchange service get endpoint not_found error.
+func NewGetNotFound(body *GetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSegmentsSegmentTreeOK builds a "exchange" service "segments" endpoint
+// result from a HTTP "OK" response.
+func NewSegmentsSegmentTreeOK(body *SegmentsResponseBody) *exchange.SegmentTree {
+	v := &exchange.SegmentTree{
 		OperatingMic: *body.OperatingMic,
 		ExchangeName: *body.ExchangeName,
-		DisplayName:  *body.DisplayName,
-		Country:      *body.Country,
-		City:         *body.City,
-		Acronym:      body.Acronym,
+	}
+	v.Segments = make([]*exchange.Segment, len(body.Segments))
+	for i, val := range body.Segments {
+		if val == nil {
+			v.Segments[i] = nil
+			continue
+		}
+		v.Segments[i] = unmarshalSegmentResponseBodyToExchangeSegment(val)
+	}
+
+	return v
+}
+
+// NewSegmentsNotFound builds a exchange service segments endpoint not_found
+// error.
+func NewSegmentsNotFound(body *SegmentsNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCalendarTradingCalendarOK builds a "exchange" service "calendar" endpoint
+// result from a HTTP "OK" response.
+func NewCalendarTradingCalendarOK(body *CalendarResponseBody) *exchange.TradingCalendar {
+	v := &exchange.TradingCalendar{
+		OperatingMic: *body.OperatingMic,
+		Timezone:     *body.Timezone,
+	}
+	v.Days = make([]*exchange.TradingDay, len(body.Days))
+	for i, val := range body.Days {
+		if val == nil {
+			v.Days[i] = nil
+			continue
+		}
+		v.Days[i] = unmarshalTradingDayResponseBodyToExchangeTradingDay(val)
+	}
+
+	return v
+}
+
+// NewCalendarInvalidRange builds a exchange service calendar endpoint
+// invalid_range error.
+func NewCalendarInvalidRange(body *CalendarInvalidRangeResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCalendarNotFound builds a exchange service calendar endpoint not_found
+// error.
+func NewCalendarNotFound(body *CalendarNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewStatusMarketStatusOK builds a "exchange" service "status" endpoint result
+// from a HTTP "OK" response.
+func NewStatusMarketStatusOK(body *StatusResponseBody) *exchange.MarketStatus {
+	v := &exchange.MarketStatus{
+		OperatingMic: *body.OperatingMic,
+		Timezone:     *body.Timezone,
+		Status:       *body.Status,
+		AsOf:         *body.AsOf,
+		NextOpen:     body.NextOpen,
+		NextClose:    body.NextClose,
+	}
+
+	return v
+}
+
+// NewStatusNotFound builds a exchange service status endpoint not_found error.
+func NewStatusNotFound(body *StatusNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
 
 	return v
@@ -94,31 +528,276 @@ func ValidateGetResponseBody(body *GetResponseBody) (err error) {
 	if body.City == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("city", "body"))
 	}
-	if body.OperatingMic != nil {
-		if utf8.RuneCountInString(*body.OperatingMic) < 4 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.operating_mic", *body.OperatingMic, utf8.RuneCountInString(*body.OperatingMic), 4, true))
+	if body.MarketCategory == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_category", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Segments == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("segments", "body"))
+	}
+	if body.CreationDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.creation_date", *body.CreationDate, goa.FormatDate))
+	}
+	if body.LastModifiedDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_modified_date", *body.LastModifiedDate, goa.FormatDate))
+	}
+	for _, e := range body.Segments {
+		if e != nil {
+			if err2 := ValidateSegmentResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
 		}
 	}
-	if body.OperatingMic != nil {
-		if utf8.RuneCountInString(*body.OperatingMic) > 4 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.operating_mic", *body.OperatingMic, utf8.RuneCountInString(*body.OperatingMic), 4, false))
+	return
+}
+
+// ValidateSegmentsResponseBody runs the validations defined on
+// SegmentsResponseBody
+func ValidateSegmentsResponseBody(body *SegmentsResponseBody) (err error) {
+	if body.OperatingMic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operating_mic", "body"))
+	}
+	if body.ExchangeName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exchange_name", "body"))
+	}
+	if body.Segments == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("segments", "body"))
+	}
+	for _, e := range body.Segments {
+		if e != nil {
+			if err2 := ValidateSegmentResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
 		}
 	}
-	if body.Country != nil {
-		if utf8.RuneCountInString(*body.Country) < 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.country", *body.Country, utf8.RuneCountInString(*body.Country), 2, true))
+	return
+}
+
+// ValidateCalendarResponseBody runs the validations defined on
+// CalendarResponseBody
+func ValidateCalendarResponseBody(body *CalendarResponseBody) (err error) {
+	if body.OperatingMic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operating_mic", "body"))
+	}
+	if body.Timezone == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timezone", "body"))
+	}
+	if body.Days == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("days", "body"))
+	}
+	for _, e := range body.Days {
+		if e != nil {
+			if err2 := ValidateTradingDayResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
 		}
 	}
-	if body.Country != nil {
-		if utf8.RuneCountInString(*body.Country) > 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.country", *body.Country, utf8.RuneCountInString(*body.Country), 2, false))
+	return
+}
+
+// ValidateStatusResponseBody runs the validations defined on StatusResponseBody
+func ValidateStatusResponseBody(body *StatusResponseBody) (err error) {
+	if body.OperatingMic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operating_mic", "body"))
+	}
+	if body.Timezone == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timezone", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.AsOf == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("as_of", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "open" || *body.Status == "closed" || *body.Status == "pre" || *body.Status == "post" || *body.Status == "unknown") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"open", "closed", "pre", "post", "unknown"}))
 		}
 	}
+	if body.AsOf != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.as_of", *body.AsOf, goa.FormatDateTime))
+	}
+	if body.NextOpen != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.next_open", *body.NextOpen, goa.FormatDateTime))
+	}
+	if body.NextClose != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.next_close", *body.NextClose, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateListInvalidCursorResponseBody runs the validations defined on
+// list_invalid_cursor_response_body
+func ValidateListInvalidCursorResponseBody(body *ListInvalidCursorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListNotFoundResponseBody runs the validations defined on
+// list_not_found_response_body
+func ValidateListNotFoundResponseBody(body *ListNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetNotFoundResponseBody runs the validations defined on
+// get_not_found_response_body
+func ValidateGetNotFoundResponseBody(body *GetNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSegmentsNotFoundResponseBody runs the validations defined on
+// segments_not_found_response_body
+func ValidateSegmentsNotFoundResponseBody(body *SegmentsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCalendarInvalidRangeResponseBody runs the validations defined on
+// calendar_invalid_range_response_body
+func ValidateCalendarInvalidRangeResponseBody(body *CalendarInvalidRangeResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCalendarNotFoundResponseBody runs the validations defined on
+// calendar_not_found_response_body
+func ValidateCalendarNotFoundResponseBody(body *CalendarNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateStatusNotFoundResponseBody runs the validations defined on
+// status_not_found_response_body
+func ValidateStatusNotFoundResponseBody(body *StatusNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
 	return
 }
 
-// ValidateExchangeResponse runs the validations defined on ExchangeResponse
-func ValidateExchangeResponse(body *ExchangeResponse) (err error) {
+// ValidateExchange runs the validations defined on Exchange
+func ValidateExchange(body *Exchange) (err error) {
 	if body.OperatingMic == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("operating_mic", "body"))
 	}
@@ -134,25 +813,142 @@ func ValidateExchangeResponse(body *ExchangeResponse) (err error) {
 	if body.City == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("city", "body"))
 	}
-	if body.OperatingMic != nil {
-		if utf8.RuneCountInString(*body.OperatingMic) < 4 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.operating_mic", *body.OperatingMic, utf8.RuneCountInString(*body.OperatingMic), 4, true))
+	if body.MarketCategory == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_category", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Segments == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("segments", "body"))
+	}
+	if body.CreationDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.creation_date", *body.CreationDate, goa.FormatDate))
+	}
+	if body.LastModifiedDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_modified_date", *body.LastModifiedDate, goa.FormatDate))
+	}
+	for _, e := range body.Segments {
+		if e != nil {
+			if err2 := ValidateSegment(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
 		}
 	}
-	if body.OperatingMic != nil {
-		if utf8.RuneCountInString(*body.OperatingMic) > 4 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.operating_mic", *body.OperatingMic, utf8.RuneCountInString(*body.OperatingMic), 4, false))
+	return
+}
+
+// ValidateSegment runs the validations defined on Segment
+func ValidateSegment(body *Segment) (err error) {
+	if body.Mic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mic", "body"))
+	}
+	if body.OperatingMic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operating_mic", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.City == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("city", "body"))
+	}
+	if body.MarketCategory == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_category", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreationDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.creation_date", *body.CreationDate, goa.FormatDate))
+	}
+	if body.LastModifiedDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_modified_date", *body.LastModifiedDate, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateSegmentResponseBody runs the validations defined on
+// SegmentResponseBody
+func ValidateSegmentResponseBody(body *SegmentResponseBody) (err error) {
+	if body.Mic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mic", "body"))
+	}
+	if body.OperatingMic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operating_mic", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.City == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("city", "body"))
+	}
+	if body.MarketCategory == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_category", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreationDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.creation_date", *body.CreationDate, goa.FormatDate))
+	}
+	if body.LastModifiedDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_modified_date", *body.LastModifiedDate, goa.FormatDate))
+	}
+	return
+}
+
+// ValidateTradingDayResponseBody runs the validations defined on
+// TradingDayResponseBody
+func ValidateTradingDayResponseBody(body *TradingDayResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Sessions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sessions", "body"))
+	}
+	if body.Date != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.date", *body.Date, goa.FormatDate))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "open" || *body.Status == "half_day" || *body.Status == "closed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"open", "half_day", "closed"}))
 		}
 	}
-	if body.Country != nil {
-		if utf8.RuneCountInString(*body.Country) < 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.country", *body.Country, utf8.RuneCountInString(*body.Country), 2, true))
+	for _, e := range body.Sessions {
+		if e != nil {
+			if err2 := ValidateTradingSessionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
 		}
 	}
-	if body.Country != nil {
-		if utf8.RuneCountInString(*body.Country) > 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.country", *body.Country, utf8.RuneCountInString(*body.Country), 2, false))
+	return
+}
+
+// ValidateTradingSessionResponseBody runs the validations defined on
+// TradingSessionResponseBody
+func ValidateTradingSessionResponseBody(body *TradingSessionResponseBody) (err error) {
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Open == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("open", "body"))
+	}
+	if body.Close == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("close", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "pre" || *body.Kind == "regular" || *body.Kind == "post") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"pre", "regular", "post"}))
 		}
 	}
+	if body.Open != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.open", *body.Open, goa.FormatDateTime))
+	}
+	if body.Close != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.close", *body.Close, goa.FormatDateTime))
+	}
 	return
 }
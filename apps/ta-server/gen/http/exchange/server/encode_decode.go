@@ -1,4 +1,4 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // exchange HTTP server encoders and decoders
 //
@@ -9,19 +9,30 @@ package server
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"strconv"
 
 	exchange "github.com/reidlai/ta-workspace/apps/ta-server/gen/exchange"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // EncodeListResponse returns an encoder for responses returned by the exchange
 // list endpoint.
 func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.([]*exchange.Exchange)
+		res, _ := v.(*exchange.ListResult)
 		enc := encoder(ctx, w)
 		body := NewListResponseBody(res)
+		{
+			val := res.Total
+			totals := strconv.Itoa(val)
+			w.Header().Set("X-Total-Count", totals)
+		}
+		if res.NextCursor != nil {
+			w.Header().Set("X-Next-Cursor", *res.NextCursor)
+		}
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
@@ -29,21 +40,120 @@ func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goaht
 
 // DecodeListRequest returns a decoder for requests sent to the exchange list
 // endpoint.
-func DecodeListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
-	return func(r *http.Request) (any, error) {
+func DecodeListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*exchange.ListPayload, error) {
+	return func(r *http.Request) (*exchange.ListPayload, error) {
 		var (
-			query *string
+			query   *string
+			country *string
+			city    *string
+			acronym *string
+			sort    *string
+			cursor  *string
+			limit   *int
+			err     error
 		)
-		queryRaw := r.URL.Query().Get("query")
+		qp := r.URL.Query()
+		queryRaw := qp.Get("query")
 		if queryRaw != "" {
 			query = &queryRaw
 		}
-		payload := NewListPayload(query)
+		countryRaw := qp.Get("country")
+		if countryRaw != "" {
+			country = &countryRaw
+		}
+		cityRaw := qp.Get("city")
+		if cityRaw != "" {
+			city = &cityRaw
+		}
+		acronymRaw := qp.Get("acronym")
+		if acronymRaw != "" {
+			acronym = &acronymRaw
+		}
+		sortRaw := qp.Get("sort")
+		if sortRaw != "" {
+			sort = &sortRaw
+		}
+		if sort != nil {
+			if !(*sort == "relevance" || *sort == "name" || *sort == "country" || *sort == "mic") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", *sort, []any{"relevance", "name", "country", "mic"}))
+			}
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw != "" {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				pv := int(v)
+				limit = &pv
+			}
+		}
+		if limit != nil {
+			if *limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1, true))
+			}
+		}
+		if limit != nil {
+			if *limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1000, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListPayload(query, country, city, acronym, sort, cursor, limit)
 
 		return payload, nil
 	}
 }
 
+// EncodeListError returns an encoder for errors returned by the list exchange
+// endpoint.
+func EncodeListError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_cursor":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListInvalidCursorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeGetResponse returns an encoder for responses returned by the exchange
 // get endpoint.
 func EncodeGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -58,8 +168,8 @@ func EncodeGetResponse(encoder func(context.Context, http.ResponseWriter) goahtt
 
 // DecodeGetRequest returns a decoder for requests sent to the exchange get
 // endpoint.
-func DecodeGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
-	return func(r *http.Request) (any, error) {
+func DecodeGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*exchange.GetPayload, error) {
+	return func(r *http.Request) (*exchange.GetPayload, error) {
 		var (
 			operatingMic string
 
@@ -72,16 +182,342 @@ func DecodeGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Dec
 	}
 }
 
-// marshalExchangeExchangeToExchangeResponse builds a value of type
-// *ExchangeResponse from a value of type *exchange.Exchange.
-func marshalExchangeExchangeToExchangeResponse(v *exchange.Exchange) *ExchangeResponse {
-	res := &ExchangeResponse{
-		OperatingMic: v.OperatingMic,
-		ExchangeName: v.ExchangeName,
-		DisplayName:  v.DisplayName,
-		Country:      v.Country,
-		City:         v.City,
-		Acronym:      v.Acronym,
+// EncodeGetError returns an encoder for errors returned by the get exchange
+// endpoint.
+func EncodeGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSegmentsResponse returns an encoder for responses returned by the
+// exchange segments endpoint.
+func EncodeSegmentsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*exchange.SegmentTree)
+		enc := encoder(ctx, w)
+		body := NewSegmentsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSegmentsRequest returns a decoder for requests sent to the exchange
+// segments endpoint.
+func DecodeSegmentsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*exchange.SegmentsPayload, error) {
+	return func(r *http.Request) (*exchange.SegmentsPayload, error) {
+		var (
+			operatingMic string
+
+			params = mux.Vars(r)
+		)
+		operatingMic = params["operating_mic"]
+		payload := NewSegmentsPayload(operatingMic)
+
+		return payload, nil
+	}
+}
+
+// EncodeSegmentsError returns an encoder for errors returned by the segments
+// exchange endpoint.
+func EncodeSegmentsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSegmentsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCalendarResponse returns an encoder for responses returned by the
+// exchange calendar endpoint.
+func EncodeCalendarResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*exchange.TradingCalendar)
+		enc := encoder(ctx, w)
+		body := NewCalendarResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCalendarRequest returns a decoder for requests sent to the exchange
+// calendar endpoint.
+func DecodeCalendarRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*exchange.CalendarPayload, error) {
+	return func(r *http.Request) (*exchange.CalendarPayload, error) {
+		var (
+			operatingMic string
+			from         *string
+			to           *string
+			err          error
+
+			params = mux.Vars(r)
+		)
+		operatingMic = params["operating_mic"]
+		qp := r.URL.Query()
+		fromRaw := qp.Get("from")
+		if fromRaw != "" {
+			from = &fromRaw
+		}
+		if from != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDate))
+		}
+		toRaw := qp.Get("to")
+		if toRaw != "" {
+			to = &toRaw
+		}
+		if to != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDate))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCalendarPayload(operatingMic, from, to)
+
+		return payload, nil
+	}
+}
+
+// EncodeCalendarError returns an encoder for errors returned by the calendar
+// exchange endpoint.
+func EncodeCalendarError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_range":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCalendarInvalidRangeResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCalendarNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeStatusResponse returns an encoder for responses returned by the
+// exchange status endpoint.
+func EncodeStatusResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*exchange.MarketStatus)
+		enc := encoder(ctx, w)
+		body := NewStatusResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeStatusRequest returns a decoder for requests sent to the exchange
+// status endpoint.
+func DecodeStatusRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*exchange.StatusPayload, error) {
+	return func(r *http.Request) (*exchange.StatusPayload, error) {
+		var (
+			operatingMic string
+
+			params = mux.Vars(r)
+		)
+		operatingMic = params["operating_mic"]
+		payload := NewStatusPayload(operatingMic)
+
+		return payload, nil
+	}
+}
+
+// EncodeStatusError returns an encoder for errors returned by the status
+// exchange endpoint.
+func EncodeStatusError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewStatusNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalExchangeExchangeToExchange builds a value of type *Exchange from a
+// value of type *exchange.Exchange.
+func marshalExchangeExchangeToExchange(v *exchange.Exchange) *Exchange {
+	res := &Exchange{
+		OperatingMic:     v.OperatingMic,
+		ExchangeName:     v.ExchangeName,
+		DisplayName:      v.DisplayName,
+		Country:          v.Country,
+		City:             v.City,
+		Acronym:          v.Acronym,
+		MarketCategory:   v.MarketCategory,
+		Status:           v.Status,
+		Website:          v.Website,
+		CreationDate:     v.CreationDate,
+		LastModifiedDate: v.LastModifiedDate,
+	}
+	if v.Segments != nil {
+		res.Segments = make([]*Segment, len(v.Segments))
+		for i, val := range v.Segments {
+			if val == nil {
+				res.Segments[i] = nil
+				continue
+			}
+			res.Segments[i] = marshalExchangeSegmentToSegment(val)
+		}
+	} else {
+		res.Segments = []*Segment{}
+	}
+
+	return res
+}
+
+// marshalExchangeSegmentToSegment builds a value of type *Segment from a value
+// of type *exchange.Segment.
+func marshalExchangeSegmentToSegment(v *exchange.Segment) *Segment {
+	res := &Segment{
+		Mic:              v.Mic,
+		OperatingMic:     v.OperatingMic,
+		Name:             v.Name,
+		City:             v.City,
+		Acronym:          v.Acronym,
+		MarketCategory:   v.MarketCategory,
+		Status:           v.Status,
+		Website:          v.Website,
+		CreationDate:     v.CreationDate,
+		LastModifiedDate: v.LastModifiedDate,
+	}
+
+	return res
+}
+
+// marshalExchangeSegmentToSegmentResponseBody builds a value of type
+// *SegmentResponseBody from a value of type *exchange.Segment.
+func marshalExchangeSegmentToSegmentResponseBody(v *exchange.Segment) *SegmentResponseBody {
+	res := &SegmentResponseBody{
+		Mic:              v.Mic,
+		OperatingMic:     v.OperatingMic,
+		Name:             v.Name,
+		City:             v.City,
+		Acronym:          v.Acronym,
+		MarketCategory:   v.MarketCategory,
+		Status:           v.Status,
+		Website:          v.Website,
+		CreationDate:     v.CreationDate,
+		LastModifiedDate: v.LastModifiedDate,
+	}
+
+	return res
+}
+
+// marshalExchangeTradingDayToTradingDayResponseBody builds a value of type
+// *TradingDayResponseBody from a value of type *exchange.TradingDay.
+func marshalExchangeTradingDayToTradingDayResponseBody(v *exchange.TradingDay) *TradingDayResponseBody {
+	res := &TradingDayResponseBody{
+		Date:    v.Date,
+		Status:  v.Status,
+		Holiday: v.Holiday,
+	}
+	if v.Sessions != nil {
+		res.Sessions = make([]*TradingSessionResponseBody, len(v.Sessions))
+		for i, val := range v.Sessions {
+			if val == nil {
+				res.Sessions[i] = nil
+				continue
+			}
+			res.Sessions[i] = marshalExchangeTradingSessionToTradingSessionResponseBody(val)
+		}
+	} else {
+		res.Sessions = []*TradingSessionResponseBody{}
+	}
+
+	return res
+}
+
+// marshalExchangeTradingSessionToTradingSessionResponseBody builds a value of
+// type *TradingSessionResponseBody from a value of type
+// *exchange.TradingSession.
+func marshalExchangeTradingSessionToTradingSessionResponseBody(v *exchange.TradingSession) *TradingSessionResponseBody {
+	res := &TradingSessionResponseBody{
+		Kind:  v.Kind,
+		Open:  v.Open,
+		Close: v.Close,
 	}
 
 	return res
@@ -1,4 +1,4 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // exchange HTTP server
 //
@@ -18,9 +18,12 @@ import (
 
 // Server lists the exchange service endpoint HTTP handlers.
 type Server struct {
-	Mounts []*MountPoint
-	List   http.Handler
-	Get    http.Handler
+	Mounts   []*MountPoint
+	List     http.Handler
+	Get      http.Handler
+	Segments http.Handler
+	Calendar http.Handler
+	Status   http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -52,9 +55,15 @@ func New(
 		Mounts: []*MountPoint{
 			{"List", "GET", "/exchanges"},
 			{"Get", "GET", "/exchanges/{operating_mic}"},
+			{"Segments", "GET", "/exchanges/{operating_mic}/segments"},
+			{"Calendar", "GET", "/exchanges/{operating_mic}/calendar"},
+			{"Status", "GET", "/exchanges/{operating_mic}/status"},
 		},
-		List: NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
-		Get:  NewGetHandler(e.Get, mux, decoder, encoder, errhandler, formatter),
+		List:     NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
+		Get:      NewGetHandler(e.Get, mux, decoder, encoder, errhandler, formatter),
+		Segments: NewSegmentsHandler(e.Segments, mux, decoder, encoder, errhandler, formatter),
+		Calendar: NewCalendarHandler(e.Calendar, mux, decoder, encoder, errhandler, formatter),
+		Status:   NewStatusHandler(e.Status, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -65,6 +74,9 @@ func (s *Server) Service() string { return "exchange" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.List = m(s.List)
 	s.Get = m(s.Get)
+	s.Segments = m(s.Segments)
+	s.Calendar = m(s.Calendar)
+	s.Status = m(s.Status)
 }
 
 // MethodNames returns the methods served.
@@ -74,6 +86,9 @@ func (s *Server) MethodNames() []string { return exchange.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountListHandler(mux, h.List)
 	MountGetHandler(mux, h.Get)
+	MountSegmentsHandler(mux, h.Segments)
+	MountCalendarHandler(mux, h.Calendar)
+	MountStatusHandler(mux, h.Status)
 }
 
 // Mount configures the mux to serve the exchange endpoints.
@@ -106,7 +121,7 @@ func NewListHandler(
 	var (
 		decodeRequest  = DecodeListRequest(mux, decoder)
 		encodeResponse = EncodeListResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeListError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -114,20 +129,22 @@ func NewListHandler(
 		ctx = context.WithValue(ctx, goa.ServiceKey, "exchange")
 		payload, err := decodeRequest(r)
 		if err != nil {
-			if err := encodeError(ctx, w, err); err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
 			}
 			return
 		}
 		res, err := endpoint(ctx, payload)
 		if err != nil {
-			if err := encodeError(ctx, w, err); err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
 			}
 			return
 		}
 		if err := encodeResponse(ctx, w, res); err != nil {
-			errhandler(ctx, w, err)
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
 		}
 	})
 }
@@ -157,7 +174,7 @@ func NewGetHandler(
 	var (
 		decodeRequest  = DecodeGetRequest(mux, decoder)
 		encodeResponse = EncodeGetResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeGetError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -165,20 +182,181 @@ func NewGetHandler(
 		ctx = context.WithValue(ctx, goa.ServiceKey, "exchange")
 		payload, err := decodeRequest(r)
 		if err != nil {
-			if err := encodeError(ctx, w, err); err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
 			}
 			return
 		}
 		res, err := endpoint(ctx, payload)
 		if err != nil {
-			if err := encodeError(ctx, w, err); err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
 			}
 			return
 		}
 		if err := encodeResponse(ctx, w, res); err != nil {
-			errhandler(ctx, w, err)
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSegmentsHandler configures the mux to serve the "exchange" service
+// "segments" endpoint.
+func MountSegmentsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/exchanges/{operating_mic}/segments", f)
+}
+
+// NewSegmentsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "exchange" service "segments" endpoint.
+func NewSegmentsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSegmentsRequest(mux, decoder)
+		encodeResponse = EncodeSegmentsResponse(encoder)
+		encodeError    = EncodeSegmentsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "segments")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "exchange")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCalendarHandler configures the mux to serve the "exchange" service
+// "calendar" endpoint.
+func MountCalendarHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/exchanges/{operating_mic}/calendar", f)
+}
+
+// NewCalendarHandler creates a HTTP handler which loads the HTTP request and
+// calls the "exchange" service "calendar" endpoint.
+func NewCalendarHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCalendarRequest(mux, decoder)
+		encodeResponse = EncodeCalendarResponse(encoder)
+		encodeError    = EncodeCalendarError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "calendar")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "exchange")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountStatusHandler configures the mux to serve the "exchange" service
+// "status" endpoint.
+func MountStatusHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/exchanges/{operating_mic}/status", f)
+}
+
+// NewStatusHandler creates a HTTP handler which loads the HTTP request and
+// calls the "exchange" service "status" endpoint.
+func NewStatusHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeStatusRequest(mux, decoder)
+		encodeResponse = EncodeStatusResponse(encoder)
+		encodeError    = EncodeStatusError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "status")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "exchange")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
 		}
 	})
 }
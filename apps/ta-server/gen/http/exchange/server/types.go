@@ -1,4 +1,4 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // exchange HTTP server types
 //
@@ -9,11 +9,12 @@ package server
 
 import (
 	exchange "github.com/reidlai/ta-workspace/apps/ta-server/gen/exchange"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // ListResponseBody is the type of the "exchange" service "list" endpoint HTTP
 // response body.
-type ListResponseBody []*ExchangeResponse
+type ListResponseBody []*Exchange
 
 // GetResponseBody is the type of the "exchange" service "get" endpoint HTTP
 // response body.
@@ -30,10 +31,187 @@ type GetResponseBody struct {
 	City string `form:"city" json:"city" xml:"city"`
 	// Short identifier
 	Acronym *string `form:"acronym,omitempty" json:"acronym,omitempty" xml:"acronym,omitempty"`
+	// ISO 10383 market category code
+	MarketCategory string `form:"market_category" json:"market_category" xml:"market_category"`
+	// ISO 10383 status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Website of the market
+	Website *string `form:"website,omitempty" json:"website,omitempty" xml:"website,omitempty"`
+	// Date the MIC was created
+	CreationDate *string `form:"creation_date,omitempty" json:"creation_date,omitempty" xml:"creation_date,omitempty"`
+	// Date the MIC was last modified
+	LastModifiedDate *string `form:"last_modified_date,omitempty" json:"last_modified_date,omitempty" xml:"last_modified_date,omitempty"`
+	// Active segment MICs operated by the exchange
+	Segments []*SegmentResponseBody `form:"segments" json:"segments" xml:"segments"`
 }
 
-// ExchangeResponse is used to define fields on response body types.
-type ExchangeResponse struct {
+// SegmentsResponseBody is the type of the "exchange" service "segments"
+// endpoint HTTP response body.
+type SegmentsResponseBody struct {
+	// 4-character ISO 10383 code
+	OperatingMic string `form:"operating_mic" json:"operating_mic" xml:"operating_mic"`
+	// Full descriptive name
+	ExchangeName string `form:"exchange_name" json:"exchange_name" xml:"exchange_name"`
+	// Active segment MICs
+	Segments []*SegmentResponseBody `form:"segments" json:"segments" xml:"segments"`
+}
+
+// CalendarResponseBody is the type of the "exchange" service "calendar"
+// endpoint HTTP response body.
+type CalendarResponseBody struct {
+	// 4-character ISO 10383 code
+	OperatingMic string `form:"operating_mic" json:"operating_mic" xml:"operating_mic"`
+	// IANA time zone of the exchange
+	Timezone string                    `form:"timezone" json:"timezone" xml:"timezone"`
+	Days     []*TradingDayResponseBody `form:"days" json:"days" xml:"days"`
+}
+
+// StatusResponseBody is the type of the "exchange" service "status" endpoint
+// HTTP response body.
+type StatusResponseBody struct {
+	// 4-character ISO 10383 code
+	OperatingMic string `form:"operating_mic" json:"operating_mic" xml:"operating_mic"`
+	// IANA time zone of the exchange
+	Timezone string `form:"timezone" json:"timezone" xml:"timezone"`
+	// Market state; a lunch break is reported as closed, and the state is unknown
+	// outside the years the calendar covers
+	Status string `form:"status" json:"status" xml:"status"`
+	// Time the status was computed
+	AsOf string `form:"as_of" json:"as_of" xml:"as_of"`
+	// Next start of the regular session
+	NextOpen *string `form:"next_open,omitempty" json:"next_open,omitempty" xml:"next_open,omitempty"`
+	// Next end of the regular session
+	NextClose *string `form:"next_close,omitempty" json:"next_close,omitempty" xml:"next_close,omitempty"`
+}
+
+// ListInvalidCursorResponseBody is the type of the "exchange" service "list"
+// endpoint HTTP response body for the "invalid_cursor" error.
+type ListInvalidCursorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListNotFoundResponseBody is the type of the "exchange" service "list"
+// endpoint HTTP response body for the "not_found" error.
+type ListNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetNotFoundResponseBody is the type of the "exchange" service "get" endpoint
+// HTTP response body for the "not_found" error.
+type GetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SegmentsNotFoundResponseBody is the type of the "exchange" service
+// "segments" endpoint HTTP response body for the "not_found" error.
+type SegmentsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CalendarInvalidRangeResponseBody is the type of the "exchange" service
+// "calendar" endpoint HTTP response body for the "invalid_range" error.
+type CalendarInvalidRangeResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CalendarNotFoundResponseBody is the type of the "exchange" service
+// "calendar" endpoint HTTP response body for the "not_found" error.
+type CalendarNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// StatusNotFoundResponseBody is the type of the "exchange" service "status"
+// endpoint HTTP response body for the "not_found" error.
+type StatusNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// Exchange is used to define fields on response body types.
+type Exchange struct {
 	// 4-character ISO 10383 code
 	OperatingMic string `form:"operating_mic" json:"operating_mic" xml:"operating_mic"`
 	// Full descriptive name
@@ -46,14 +224,100 @@ type ExchangeResponse struct {
 	City string `form:"city" json:"city" xml:"city"`
 	// Short identifier
 	Acronym *string `form:"acronym,omitempty" json:"acronym,omitempty" xml:"acronym,omitempty"`
+	// ISO 10383 market category code
+	MarketCategory string `form:"market_category" json:"market_category" xml:"market_category"`
+	// ISO 10383 status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Website of the market
+	Website *string `form:"website,omitempty" json:"website,omitempty" xml:"website,omitempty"`
+	// Date the MIC was created
+	CreationDate *string `form:"creation_date,omitempty" json:"creation_date,omitempty" xml:"creation_date,omitempty"`
+	// Date the MIC was last modified
+	LastModifiedDate *string `form:"last_modified_date,omitempty" json:"last_modified_date,omitempty" xml:"last_modified_date,omitempty"`
+	// Active segment MICs operated by the exchange
+	Segments []*Segment `form:"segments" json:"segments" xml:"segments"`
+}
+
+// Segment is used to define fields on response body types.
+type Segment struct {
+	// 4-character ISO 10383 segment code
+	Mic string `form:"mic" json:"mic" xml:"mic"`
+	// Operating MIC the segment belongs to
+	OperatingMic string `form:"operating_mic" json:"operating_mic" xml:"operating_mic"`
+	// Full descriptive name
+	Name string `form:"name" json:"name" xml:"name"`
+	// City location
+	City string `form:"city" json:"city" xml:"city"`
+	// Short identifier
+	Acronym *string `form:"acronym,omitempty" json:"acronym,omitempty" xml:"acronym,omitempty"`
+	// ISO 10383 market category code
+	MarketCategory string `form:"market_category" json:"market_category" xml:"market_category"`
+	// ISO 10383 status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Website of the market
+	Website *string `form:"website,omitempty" json:"website,omitempty" xml:"website,omitempty"`
+	// Date the MIC was created
+	CreationDate *string `form:"creation_date,omitempty" json:"creation_date,omitempty" xml:"creation_date,omitempty"`
+	// Date the MIC was last modified
+	LastModifiedDate *string `form:"last_modified_date,omitempty" json:"last_modified_date,omitempty" xml:"last_modified_date,omitempty"`
+}
+
+// SegmentResponseBody is used to define fields on response body types.
+type SegmentResponseBody struct {
+	// 4-character ISO 10383 segment code
+	Mic string `form:"mic" json:"mic" xml:"mic"`
+	// Operating MIC the segment belongs to
+	OperatingMic string `form:"operating_mic" json:"operating_mic" xml:"operating_mic"`
+	// Full descriptive name
+	Name string `form:"name" json:"name" xml:"name"`
+	// City location
+	City string `form:"city" json:"city" xml:"city"`
+	// Short identifier
+	Acronym *string `form:"acronym,omitempty" json:"acronym,omitempty" xml:"acronym,omitempty"`
+	// ISO 10383 market category code
+	MarketCategory string `form:"market_category" json:"market_category" xml:"market_category"`
+	// ISO 10383 status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Website of the market
+	Website *string `form:"website,omitempty" json:"website,omitempty" xml:"website,omitempty"`
+	// Date the MIC was created
+	CreationDate *string `form:"creation_date,omitempty" json:"creation_date,omitempty" xml:"creation_date,omitempty"`
+	// Date the MIC was last modified
+	LastModifiedDate *string `form:"last_modified_date,omitempty" json:"last_modified_date,omitempty" xml:"last_modified_date,omitempty"`
+}
+
+// TradingDayResponseBody is used to define fields on response body types.
+type TradingDayResponseBody struct {
+	// Calendar date in the exchange time zone
+	Date string `form:"date" json:"date" xml:"date"`
+	// Whether the market trades that day
+	Status string `form:"status" json:"status" xml:"status"`
+	// Holiday name when the market is closed for a holiday
+	Holiday *string `form:"holiday,omitempty" json:"holiday,omitempty" xml:"holiday,omitempty"`
+	// Trading sessions in chronological order
+	Sessions []*TradingSessionResponseBody `form:"sessions" json:"sessions" xml:"sessions"`
+}
+
+// TradingSessionResponseBody is used to define fields on response body types.
+type TradingSessionResponseBody struct {
+	// Session kind
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Session start
+	Open string `form:"open" json:"open" xml:"open"`
+	// Session end
+	Close string `form:"close" json:"close" xml:"close"`
 }
 
 // NewListResponseBody builds the HTTP response body from the result of the
 // "list" endpoint of the "exchange" service.
-func NewListResponseBody(res []*exchange.Exchange) ListResponseBody {
-	body := make([]*ExchangeResponse, len(res))
-	for i, val := range res {
-		body[i] = marshalExchangeExchangeToExchangeResponse(val)
+func NewListResponseBody(res *exchange.ListResult) ListResponseBody {
+	body := make([]*Exchange, len(res.Exchanges))
+	for i, val := range res.Exchanges {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalExchangeExchangeToExchange(val)
 	}
 	return body
 }
@@ -62,20 +326,199 @@ func NewListResponseBody(res []*exchange.Exchange) ListResponseBody {
 // "get" endpoint of the "exchange" service.
 func NewGetResponseBody(res *exchange.Exchange) *GetResponseBody {
 	body := &GetResponseBody{
+		OperatingMic:     res.OperatingMic,
+		ExchangeName:     res.ExchangeName,
+		DisplayName:      res.DisplayName,
+		Country:          res.Country,
+		City:             res.City,
+		Acronym:          res.Acronym,
+		MarketCategory:   res.MarketCategory,
+		Status:           res.Status,
+		Website:          res.Website,
+		CreationDate:     res.CreationDate,
+		LastModifiedDate: res.LastModifiedDate,
+	}
+	if res.Segments != nil {
+		body.Segments = make([]*SegmentResponseBody, len(res.Segments))
+		for i, val := range res.Segments {
+			if val == nil {
+				body.Segments[i] = nil
+				continue
+			}
+			body.Segments[i] = marshalExchangeSegmentToSegmentResponseBody(val)
+		}
+	} else {
+		body.Segments = []*SegmentResponseBody{}
+	}
+	return body
+}
+
+// NewSegmentsResponseBody builds the HTTP response body from the result of the
+// "segments" endpoint of the "exchange" service.
+func NewSegmentsResponseBody(res *exchange.SegmentTree) *SegmentsResponseBody {
+	body := &SegmentsResponseBody{
 		OperatingMic: res.OperatingMic,
 		ExchangeName: res.ExchangeName,
-		DisplayName:  res.DisplayName,
-		Country:      res.Country,
-		City:         res.City,
-		Acronym:      res.Acronym,
+	}
+	if res.Segments != nil {
+		body.Segments = make([]*SegmentResponseBody, len(res.Segments))
+		for i, val := range res.Segments {
+			if val == nil {
+				body.Segments[i] = nil
+				continue
+			}
+			body.Segments[i] = marshalExchangeSegmentToSegmentResponseBody(val)
+		}
+	} else {
+		body.Segments = []*SegmentResponseBody{}
+	}
+	return body
+}
+
+// NewCalendarResponseBody builds the HTTP response body from the result of the
+// "calendar" endpoint of the "exchange" service.
+func NewCalendarResponseBody(res *exchange.TradingCalendar) *CalendarResponseBody {
+	body := &CalendarResponseBody{
+		OperatingMic: res.OperatingMic,
+		Timezone:     res.Timezone,
+	}
+	if res.Days != nil {
+		body.Days = make([]*TradingDayResponseBody, len(res.Days))
+		for i, val := range res.Days {
+			if val == nil {
+				body.Days[i] = nil
+				continue
+			}
+			body.Days[i] = marshalExchangeTradingDayToTradingDayResponseBody(val)
+		}
+	} else {
+		body.Days = []*TradingDayResponseBody{}
+	}
+	return body
+}
+
+// NewStatusResponseBody builds the HTTP response body from the result of the
+// "status" endpoint of the "exchange" service.
+func NewStatusResponseBody(res *exchange.MarketStatus) *StatusResponseBody {
+	body := &StatusResponseBody{
+		OperatingMic: res.OperatingMic,
+		Timezone:     res.Timezone,
+		Status:       res.Status,
+		AsOf:         res.AsOf,
+		NextOpen:     res.NextOpen,
+		NextClose:    res.NextClose,
+	}
+	return body
+}
+
+// NewListInvalidCursorResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "exchange" service.
+func NewListInvalidCursorResponseBody(res *goa.ServiceError) *ListInvalidCursorResponseBody {
+	body := &ListInvalidCursorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListNotFoundResponseBody builds the HTTP response body from the result of
+// the "list" endpoint of the "exchange" service.
+func NewListNotFoundResponseBody(res *goa.ServiceError) *ListNotFoundResponseBody {
+	body := &ListNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetNotFoundResponseBody builds the HTTP response body from the result of
+// the "get" endpoint of the "exchange" service.
+func NewGetNotFoundResponseBody(res *goa.ServiceError) *GetNotFoundResponseBody {
+	body := &GetNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSegmentsNotFoundResponseBody builds the HTTP response body from the
+// result of the "segments" endpoint of the "exchange" service.
+func NewSegmentsNotFoundResponseBody(res *goa.ServiceError) *SegmentsNotFoundResponseBody {
+	body := &SegmentsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCalendarInvalidRangeResponseBody builds the HTTP response body from the
+// result of the "calendar" endpoint of the "exchange" service.
+func NewCalendarInvalidRangeResponseBody(res *goa.ServiceError) *CalendarInvalidRangeResponseBody {
+	body := &CalendarInvalidRangeResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCalendarNotFoundResponseBody builds the HTTP response body from the
+// result of the "calendar" endpoint of the "exchange" service.
+func NewCalendarNotFoundResponseBody(res *goa.ServiceError) *CalendarNotFoundResponseBody {
+	body := &CalendarNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewStatusNotFoundResponseBody builds the HTTP response body from the result
+// of the "status" endpoint of the "exchange" service.
+func NewStatusNotFoundResponseBody(res *goa.ServiceError) *StatusNotFoundResponseBody {
+	body := &StatusNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
 
 // NewListPayload builds a exchange service list endpoint payload.
-func NewListPayload(query *string) *exchange.ListPayload {
+func NewListPayload(query *string, country *string, city *string, acronym *string, sort *string, cursor *string, limit *int) *exchange.ListPayload {
 	v := &exchange.ListPayload{}
 	v.Query = query
+	v.Country = country
+	v.City = city
+	v.Acronym = acronym
+	v.Sort = sort
+	v.Cursor = cursor
+	v.Limit = limit
 
 	return v
 }
@@ -87,3 +530,29 @@ func NewGetPayload(operatingMic string) *exchange.GetPayload {
 
 	return v
 }
+
+// NewSegmentsPayload builds a exchange service segments endpoint payload.
+func NewSegmentsPayload(operatingMic string) *exchange.SegmentsPayload {
+	v := &exchange.SegmentsPayload{}
+	v.OperatingMic = operatingMic
+
+	return v
+}
+
+// NewCalendarPayload builds a exchange service calendar endpoint payload.
+func NewCalendarPayload(operatingMic string, from *string, to *string) *exchange.CalendarPayload {
+	v := &exchange.CalendarPayload{}
+	v.OperatingMic = operatingMic
+	v.From = from
+	v.To = to
+
+	return v
+}
+
+// NewStatusPayload builds a exchange service status endpoint payload.
+func NewStatusPayload(operatingMic string) *exchange.StatusPayload {
+	v := &exchange.StatusPayload{}
+	v.OperatingMic = operatingMic
+
+	return v
+}
@@ -0,0 +1,280 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// instrument HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	instrument "github.com/reidlai/ta-workspace/apps/ta-server/gen/instrument"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildListPayload builds the payload for the instrument list endpoint from
+// CLI flags.
+func BuildListPayload(instrumentListExchangeMic string, instrumentListAssetClass string, instrumentListCurrency string, instrumentListIsin string, instrumentListSort string, instrumentListCursor string, instrumentListLimit string) (*instrument.ListPayload, error) {
+	var err error
+	var exchangeMic *string
+	{
+		if instrumentListExchangeMic != "" {
+			exchangeMic = &instrumentListExchangeMic
+		}
+	}
+	var assetClass *string
+	{
+		if instrumentListAssetClass != "" {
+			assetClass = &instrumentListAssetClass
+			if !(*assetClass == "equity" || *assetClass == "etf" || *assetClass == "fund" || *assetClass == "bond" || *assetClass == "index" || *assetClass == "fx" || *assetClass == "crypto" || *assetClass == "derivative" || *assetClass == "other") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("asset_class", *assetClass, []any{"equity", "etf", "fund", "bond", "index", "fx", "crypto", "derivative", "other"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var currency *string
+	{
+		if instrumentListCurrency != "" {
+			currency = &instrumentListCurrency
+		}
+	}
+	var isin *string
+	{
+		if instrumentListIsin != "" {
+			isin = &instrumentListIsin
+		}
+	}
+	var sort string
+	{
+		if instrumentListSort != "" {
+			sort = instrumentListSort
+			if !(sort == "symbol" || sort == "name") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", sort, []any{"symbol", "name"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if instrumentListCursor != "" {
+			cursor = &instrumentListCursor
+		}
+	}
+	var limit *int
+	{
+		if instrumentListLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(instrumentListLimit, 10, strconv.IntSize)
+			val := int(v)
+			limit = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if *limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1, true))
+			}
+			if *limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", *limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &instrument.ListPayload{}
+	v.ExchangeMic = exchangeMic
+	v.AssetClass = assetClass
+	v.Currency = currency
+	v.Isin = isin
+	v.Sort = sort
+	v.Cursor = cursor
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildSearchPayload builds the payload for the instrument search endpoint
+// from CLI flags.
+func BuildSearchPayload(instrumentSearchQ string, instrumentSearchLimit string) (*instrument.SearchPayload, error) {
+	var err error
+	var q string
+	{
+		q = instrumentSearchQ
+		if utf8.RuneCountInString(q) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("q", q, utf8.RuneCountInString(q), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var limit int
+	{
+		if instrumentSearchLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(instrumentSearchLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &instrument.SearchPayload{}
+	v.Q = q
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildGetPayload builds the payload for the instrument get endpoint from CLI
+// flags.
+func BuildGetPayload(instrumentGetID string) (*instrument.GetPayload, error) {
+	var id string
+	{
+		id = instrumentGetID
+	}
+	v := &instrument.GetPayload{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildCreatePayload builds the payload for the instrument create endpoint
+// from CLI flags.
+func BuildCreatePayload(instrumentCreateBody string) (*instrument.CreatePayload, error) {
+	var err error
+	var body CreateRequestBody
+	{
+		err = json.Unmarshal([]byte(instrumentCreateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"asset_class\": \"crypto\",\n      \"currency\": \"GDX\",\n      \"exchange_mic\": \"04wN\",\n      \"figi\": \"JGG3KVBJRFT2\",\n      \"isin\": \"BW6PDJVRPIU5\",\n      \"name\": \"az\",\n      \"symbol\": \"i\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.symbol", body.Symbol, "^[A-Za-z0-9][A-Za-z0-9.\\-]{0,19}$"))
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.exchange_mic", body.ExchangeMic, "^[A-Za-z0-9]{4}$"))
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if utf8.RuneCountInString(body.Name) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 200, false))
+		}
+		if !(body.AssetClass == "equity" || body.AssetClass == "etf" || body.AssetClass == "fund" || body.AssetClass == "bond" || body.AssetClass == "index" || body.AssetClass == "fx" || body.AssetClass == "crypto" || body.AssetClass == "derivative" || body.AssetClass == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.asset_class", body.AssetClass, []any{"equity", "etf", "fund", "bond", "index", "fx", "crypto", "derivative", "other"}))
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.currency", body.Currency, "^[A-Z]{3}$"))
+		if body.Isin != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.isin", *body.Isin, "^[A-Z]{2}[A-Z0-9]{9}[0-9]$"))
+		}
+		if body.Figi != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.figi", *body.Figi, "^[B-DF-HJ-NP-TV-Z0-9]{2}G[B-DF-HJ-NP-TV-Z0-9]{8}[0-9]$"))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &instrument.CreatePayload{
+		Symbol:      body.Symbol,
+		ExchangeMic: body.ExchangeMic,
+		Name:        body.Name,
+		AssetClass:  body.AssetClass,
+		Currency:    body.Currency,
+		Isin:        body.Isin,
+		Figi:        body.Figi,
+	}
+
+	return v, nil
+}
+
+// BuildUpdatePayload builds the payload for the instrument update endpoint
+// from CLI flags.
+func BuildUpdatePayload(instrumentUpdateBody string, instrumentUpdateID string) (*instrument.UpdatePayload, error) {
+	var err error
+	var body UpdateRequestBody
+	{
+		err = json.Unmarshal([]byte(instrumentUpdateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"asset_class\": \"etf\",\n      \"currency\": \"WRO\",\n      \"figi\": \"HFGRJHNZTB64\",\n      \"isin\": \"CH2RFUK24ZH2\",\n      \"name\": \"upg\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if utf8.RuneCountInString(body.Name) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 200, false))
+		}
+		if !(body.AssetClass == "equity" || body.AssetClass == "etf" || body.AssetClass == "fund" || body.AssetClass == "bond" || body.AssetClass == "index" || body.AssetClass == "fx" || body.AssetClass == "crypto" || body.AssetClass == "derivative" || body.AssetClass == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.asset_class", body.AssetClass, []any{"equity", "etf", "fund", "bond", "index", "fx", "crypto", "derivative", "other"}))
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.currency", body.Currency, "^[A-Z]{3}$"))
+		if body.Isin != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.isin", *body.Isin, "^[A-Z]{2}[A-Z0-9]{9}[0-9]$"))
+		}
+		if body.Figi != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.figi", *body.Figi, "^[B-DF-HJ-NP-TV-Z0-9]{2}G[B-DF-HJ-NP-TV-Z0-9]{8}[0-9]$"))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = instrumentUpdateID
+	}
+	v := &instrument.UpdatePayload{
+		Name:       body.Name,
+		AssetClass: body.AssetClass,
+		Currency:   body.Currency,
+		Isin:       body.Isin,
+		Figi:       body.Figi,
+	}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildDeletePayload builds the payload for the instrument delete endpoint
+// from CLI flags.
+func BuildDeletePayload(instrumentDeleteID string) (*instrument.DeletePayload, error) {
+	var id string
+	{
+		id = instrumentDeleteID
+	}
+	v := &instrument.DeletePayload{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildImportPayload builds the payload for the instrument import endpoint
+// from CLI flags.
+func BuildImportPayload(instrumentImportDryRun string) (*instrument.ImportPayload, error) {
+	var err error
+	var dryRun bool
+	{
+		if instrumentImportDryRun != "" {
+			dryRun, err = strconv.ParseBool(instrumentImportDryRun)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for dryRun, must be BOOL")
+			}
+		}
+	}
+	v := &instrument.ImportPayload{}
+	v.DryRun = dryRun
+
+	return v, nil
+}
@@ -0,0 +1,903 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// instrument HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	instrument "github.com/reidlai/ta-workspace/apps/ta-server/gen/instrument"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "instrument" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListInstrumentPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("instrument", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRequest returns an encoder for requests sent to the instrument
+// list server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*instrument.ListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("instrument", "list", "*instrument.ListPayload", v)
+		}
+		values := req.URL.Query()
+		if p.ExchangeMic != nil {
+			values.Add("exchange_mic", *p.ExchangeMic)
+		}
+		if p.AssetClass != nil {
+			values.Add("asset_class", *p.AssetClass)
+		}
+		if p.Currency != nil {
+			values.Add("currency", *p.Currency)
+		}
+		if p.Isin != nil {
+			values.Add("isin", *p.Isin)
+		}
+		values.Add("sort", p.Sort)
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		if p.Limit != nil {
+			values.Add("limit", fmt.Sprintf("%v", *p.Limit))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListResponse returns a decoder for responses returned by the
+// instrument list endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "invalid_cursor" (type *goa.ServiceError): http.StatusBadRequest
+//   - "invalid_instrument" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "list", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateInstrument(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "list", err)
+			}
+			var (
+				total      int
+				nextCursor *string
+			)
+			{
+				totalRaw := resp.Header.Get("X-Total-Count")
+				if totalRaw == "" {
+					return nil, goahttp.ErrValidationError("instrument", "list", goa.MissingFieldError("total", "header"))
+				}
+				v, err2 := strconv.ParseInt(totalRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("total", totalRaw, "integer"))
+				}
+				total = int(v)
+			}
+			nextCursorRaw := resp.Header.Get("X-Next-Cursor")
+			if nextCursorRaw != "" {
+				nextCursor = &nextCursorRaw
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "list", err)
+			}
+			res := NewListResultOK(body, total, nextCursor)
+			return res, nil
+		case http.StatusBadRequest:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invalid_cursor":
+				var (
+					body ListInvalidCursorResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("instrument", "list", err)
+				}
+				err = ValidateListInvalidCursorResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("instrument", "list", err)
+				}
+				return nil, NewListInvalidCursor(&body)
+			case "invalid_instrument":
+				var (
+					body ListInvalidInstrumentResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("instrument", "list", err)
+				}
+				err = ValidateListInvalidInstrumentResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("instrument", "list", err)
+				}
+				return nil, NewListInvalidInstrument(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("instrument", "list", resp.StatusCode, string(body))
+			}
+		case http.StatusNotFound:
+			var (
+				body ListNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "list", err)
+			}
+			err = ValidateListNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "list", err)
+			}
+			return nil, NewListNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("instrument", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSearchRequest instantiates a HTTP request object with method and path
+// set to call the "instrument" service "search" endpoint
+func (c *Client) BuildSearchRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SearchInstrumentPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("instrument", "search", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSearchRequest returns an encoder for requests sent to the instrument
+// search server.
+func EncodeSearchRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*instrument.SearchPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("instrument", "search", "*instrument.SearchPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("q", p.Q)
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeSearchResponse returns a decoder for responses returned by the
+// instrument search endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeSearchResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_instrument" (type *goa.ServiceError): http.StatusBadRequest
+//   - error: internal error
+func DecodeSearchResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SearchResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "search", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateInstrumentResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "search", err)
+			}
+			res := NewSearchInstrumentOK(body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body SearchNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "search", err)
+			}
+			err = ValidateSearchNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "search", err)
+			}
+			return nil, NewSearchNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body SearchInvalidInstrumentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "search", err)
+			}
+			err = ValidateSearchInvalidInstrumentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "search", err)
+			}
+			return nil, NewSearchInvalidInstrument(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("instrument", "search", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetRequest instantiates a HTTP request object with method and path set
+// to call the "instrument" service "get" endpoint
+func (c *Client) BuildGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*instrument.GetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("instrument", "get", "*instrument.GetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetInstrumentPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("instrument", "get", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetResponse returns a decoder for responses returned by the instrument
+// get endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeGetResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_instrument" (type *goa.ServiceError): http.StatusBadRequest
+//   - error: internal error
+func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "get", err)
+			}
+			err = ValidateGetResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "get", err)
+			}
+			res := NewGetInstrumentOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body GetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "get", err)
+			}
+			err = ValidateGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "get", err)
+			}
+			return nil, NewGetNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetInvalidInstrumentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "get", err)
+			}
+			err = ValidateGetInvalidInstrumentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "get", err)
+			}
+			return nil, NewGetInvalidInstrument(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("instrument", "get", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateRequest instantiates a HTTP request object with method and path
+// set to call the "instrument" service "create" endpoint
+func (c *Client) BuildCreateRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateInstrumentPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("instrument", "create", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateRequest returns an encoder for requests sent to the instrument
+// create server.
+func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*instrument.CreatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("instrument", "create", "*instrument.CreatePayload", v)
+		}
+		body := NewCreateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("instrument", "create", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateResponse returns a decoder for responses returned by the
+// instrument create endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCreateResponse may return the following errors:
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_instrument" (type *goa.ServiceError): http.StatusBadRequest
+//   - error: internal error
+func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "create", err)
+			}
+			err = ValidateCreateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "create", err)
+			}
+			res := NewCreateInstrumentCreated(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body CreateConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "create", err)
+			}
+			err = ValidateCreateConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "create", err)
+			}
+			return nil, NewCreateConflict(&body)
+		case http.StatusNotFound:
+			var (
+				body CreateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "create", err)
+			}
+			err = ValidateCreateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "create", err)
+			}
+			return nil, NewCreateNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body CreateInvalidInstrumentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "create", err)
+			}
+			err = ValidateCreateInvalidInstrumentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "create", err)
+			}
+			return nil, NewCreateInvalidInstrument(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("instrument", "create", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateRequest instantiates a HTTP request object with method and path
+// set to call the "instrument" service "update" endpoint
+func (c *Client) BuildUpdateRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*instrument.UpdatePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("instrument", "update", "*instrument.UpdatePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateInstrumentPath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("instrument", "update", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateRequest returns an encoder for requests sent to the instrument
+// update server.
+func EncodeUpdateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*instrument.UpdatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("instrument", "update", "*instrument.UpdatePayload", v)
+		}
+		body := NewUpdateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("instrument", "update", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateResponse returns a decoder for responses returned by the
+// instrument update endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeUpdateResponse may return the following errors:
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_instrument" (type *goa.ServiceError): http.StatusBadRequest
+//   - error: internal error
+func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "update", err)
+			}
+			err = ValidateUpdateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "update", err)
+			}
+			res := NewUpdateInstrumentOK(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body UpdateConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "update", err)
+			}
+			err = ValidateUpdateConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "update", err)
+			}
+			return nil, NewUpdateConflict(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "update", err)
+			}
+			err = ValidateUpdateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "update", err)
+			}
+			return nil, NewUpdateNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body UpdateInvalidInstrumentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "update", err)
+			}
+			err = ValidateUpdateInvalidInstrumentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "update", err)
+			}
+			return nil, NewUpdateInvalidInstrument(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("instrument", "update", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteRequest instantiates a HTTP request object with method and path
+// set to call the "instrument" service "delete" endpoint
+func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*instrument.DeletePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("instrument", "delete", "*instrument.DeletePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteInstrumentPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("instrument", "delete", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeleteResponse returns a decoder for responses returned by the
+// instrument delete endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeleteResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_instrument" (type *goa.ServiceError): http.StatusBadRequest
+//   - error: internal error
+func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body DeleteNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "delete", err)
+			}
+			err = ValidateDeleteNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "delete", err)
+			}
+			return nil, NewDeleteNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body DeleteInvalidInstrumentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "delete", err)
+			}
+			err = ValidateDeleteInvalidInstrumentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "delete", err)
+			}
+			return nil, NewDeleteInvalidInstrument(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("instrument", "delete", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildImportRequest instantiates a HTTP request object with method and path
+// set to call the "instrument" service "import" endpoint
+func (c *Client) BuildImportRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		body io.Reader
+	)
+	rd, ok := v.(*instrument.ImportRequestData)
+	if !ok {
+		return nil, goahttp.ErrInvalidType("instrument", "import", "instrument.ImportRequestData", v)
+	}
+	body = rd.Body
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportInstrumentPath()}
+	req, err := http.NewRequest("POST", u.String(), body)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("instrument", "import", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeImportRequest returns an encoder for requests sent to the instrument
+// import server.
+func EncodeImportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		data, ok := v.(*instrument.ImportRequestData)
+		if !ok {
+			return goahttp.ErrInvalidType("instrument", "import", "*instrument.ImportRequestData", v)
+		}
+		p := data.Payload
+		values := req.URL.Query()
+		values.Add("dry_run", fmt.Sprintf("%v", p.DryRun))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeImportResponse returns a decoder for responses returned by the
+// instrument import endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeImportResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_instrument" (type *goa.ServiceError): http.StatusBadRequest
+//   - error: internal error
+func DecodeImportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ImportResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "import", err)
+			}
+			err = ValidateImportResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "import", err)
+			}
+			res := NewImportInstrumentImportReportOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body ImportNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "import", err)
+			}
+			err = ValidateImportNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "import", err)
+			}
+			return nil, NewImportNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body ImportInvalidInstrumentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("instrument", "import", err)
+			}
+			err = ValidateImportInvalidInstrumentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("instrument", "import", err)
+			}
+			return nil, NewImportInvalidInstrument(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("instrument", "import", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildImportStreamPayload creates a streaming endpoint request payload from
+// the method payload and the path to the file to be streamed
+func BuildImportStreamPayload(payload any, fpath string) (*instrument.ImportRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &instrument.ImportRequestData{
+		Payload: payload.(*instrument.ImportPayload),
+		Body:    f,
+	}, nil
+}
+
+// unmarshalInstrumentToInstrumentInstrument builds a value of type
+// *instrument.Instrument from a value of type *Instrument.
+func unmarshalInstrumentToInstrumentInstrument(v *Instrument) *instrument.Instrument {
+	res := &instrument.Instrument{
+		ID:          *v.ID,
+		Symbol:      *v.Symbol,
+		Name:        *v.Name,
+		AssetClass:  *v.AssetClass,
+		Currency:    *v.Currency,
+		Isin:        v.Isin,
+		Figi:        v.Figi,
+		ExchangeMic: *v.ExchangeMic,
+		CreatedAt:   *v.CreatedAt,
+		UpdatedAt:   *v.UpdatedAt,
+	}
+
+	return res
+}
+
+// unmarshalInstrumentResponseToInstrumentInstrument builds a value of type
+// *instrument.Instrument from a value of type *InstrumentResponse.
+func unmarshalInstrumentResponseToInstrumentInstrument(v *InstrumentResponse) *instrument.Instrument {
+	res := &instrument.Instrument{
+		ID:          *v.ID,
+		Symbol:      *v.Symbol,
+		Name:        *v.Name,
+		AssetClass:  *v.AssetClass,
+		Currency:    *v.Currency,
+		Isin:        v.Isin,
+		Figi:        v.Figi,
+		ExchangeMic: *v.ExchangeMic,
+		CreatedAt:   *v.CreatedAt,
+		UpdatedAt:   *v.UpdatedAt,
+	}
+
+	return res
+}
+
+// unmarshalImportRowErrorResponseBodyToInstrumentImportRowError builds a value
+// of type *instrument.ImportRowError from a value of type
+// *ImportRowErrorResponseBody.
+func unmarshalImportRowErrorResponseBodyToInstrumentImportRowError(v *ImportRowErrorResponseBody) *instrument.ImportRowError {
+	res := &instrument.ImportRowError{
+		Line:  *v.Line,
+		Error: *v.Error,
+	}
+
+	return res
+}
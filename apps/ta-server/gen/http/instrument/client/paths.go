@@ -0,0 +1,47 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// HTTP request path constructors for the instrument service.
+//
+// Command:
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// ListInstrumentPath returns the URL path to the instrument service list HTTP endpoint.
+func ListInstrumentPath() string {
+	return "/instruments"
+}
+
+// SearchInstrumentPath returns the URL path to the instrument service search HTTP endpoint.
+func SearchInstrumentPath() string {
+	return "/instruments/search"
+}
+
+// GetInstrumentPath returns the URL path to the instrument service get HTTP endpoint.
+func GetInstrumentPath(id string) string {
+	return fmt.Sprintf("/instruments/%v", id)
+}
+
+// CreateInstrumentPath returns the URL path to the instrument service create HTTP endpoint.
+func CreateInstrumentPath() string {
+	return "/instruments"
+}
+
+// UpdateInstrumentPath returns the URL path to the instrument service update HTTP endpoint.
+func UpdateInstrumentPath(id string) string {
+	return fmt.Sprintf("/instruments/%v", id)
+}
+
+// DeleteInstrumentPath returns the URL path to the instrument service delete HTTP endpoint.
+func DeleteInstrumentPath(id string) string {
+	return fmt.Sprintf("/instruments/%v", id)
+}
+
+// ImportInstrumentPath returns the URL path to the instrument service import HTTP endpoint.
+func ImportInstrumentPath() string {
+	return "/instruments/import"
+}
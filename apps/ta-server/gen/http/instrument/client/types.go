@@ -0,0 +1,1558 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// instrument HTTP client types
+//
+// Command:
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
+
+package client
+
+import (
+	instrument "github.com/reidlai/ta-workspace/apps/ta-server/gen/instrument"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "instrument" service "create" endpoint
+// HTTP request body.
+type CreateRequestBody struct {
+	// Ticker symbol on the listing exchange
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Operating or segment MIC of the listing exchange
+	ExchangeMic string `form:"exchange_mic" json:"exchange_mic" xml:"exchange_mic"`
+	// Instrument name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Asset class
+	AssetClass string `form:"asset_class" json:"asset_class" xml:"asset_class"`
+	// ISO 4217 trading currency
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+}
+
+// UpdateRequestBody is the type of the "instrument" service "update" endpoint
+// HTTP request body.
+type UpdateRequestBody struct {
+	// Instrument name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Asset class
+	AssetClass string `form:"asset_class" json:"asset_class" xml:"asset_class"`
+	// ISO 4217 trading currency
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+}
+
+// ListResponseBody is the type of the "instrument" service "list" endpoint
+// HTTP response body.
+type ListResponseBody []*Instrument
+
+// SearchResponseBody is the type of the "instrument" service "search" endpoint
+// HTTP response body.
+type SearchResponseBody []*InstrumentResponse
+
+// GetResponseBody is the type of the "instrument" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// Instrument ID, the listing exchange operating MIC and the symbol
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Ticker symbol on the listing exchange
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Instrument name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Asset class
+	AssetClass *string `form:"asset_class,omitempty" json:"asset_class,omitempty" xml:"asset_class,omitempty"`
+	// ISO 4217 trading currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+	// Operating MIC of the listing exchange
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update time
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// CreateResponseBody is the type of the "instrument" service "create" endpoint
+// HTTP response body.
+type CreateResponseBody struct {
+	// Instrument ID, the listing exchange operating MIC and the symbol
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Ticker symbol on the listing exchange
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Instrument name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Asset class
+	AssetClass *string `form:"asset_class,omitempty" json:"asset_class,omitempty" xml:"asset_class,omitempty"`
+	// ISO 4217 trading currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+	// Operating MIC of the listing exchange
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update time
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// UpdateResponseBody is the type of the "instrument" service "update" endpoint
+// HTTP response body.
+type UpdateResponseBody struct {
+	// Instrument ID, the listing exchange operating MIC and the symbol
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Ticker symbol on the listing exchange
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Instrument name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Asset class
+	AssetClass *string `form:"asset_class,omitempty" json:"asset_class,omitempty" xml:"asset_class,omitempty"`
+	// ISO 4217 trading currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+	// Operating MIC of the listing exchange
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update time
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// ImportResponseBody is the type of the "instrument" service "import" endpoint
+// HTTP response body.
+type ImportResponseBody struct {
+	// Whether the file was only validated
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// Whether the instruments were stored; false when any row is invalid
+	Applied *bool `form:"applied,omitempty" json:"applied,omitempty" xml:"applied,omitempty"`
+	// Number of data rows in the file
+	Rows *int `form:"rows,omitempty" json:"rows,omitempty" xml:"rows,omitempty"`
+	// Instruments created, or that would be
+	Created *int `form:"created,omitempty" json:"created,omitempty" xml:"created,omitempty"`
+	// Instruments updated, or that would be
+	Updated *int `form:"updated,omitempty" json:"updated,omitempty" xml:"updated,omitempty"`
+	// Instruments already up to date
+	Unchanged *int `form:"unchanged,omitempty" json:"unchanged,omitempty" xml:"unchanged,omitempty"`
+	// Invalid rows
+	Errors []*ImportRowErrorResponseBody `form:"errors,omitempty" json:"errors,omitempty" xml:"errors,omitempty"`
+}
+
+// ListInvalidCursorResponseBody is the type of the "instrument" service "list"
+// endpoint HTTP response body for the "invalid_cursor" error.
+type ListInvalidCursorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListInvalidInstrumentResponseBody is the type of the "instrument" service
+// "list" endpoint HTTP response body for the "invalid_instrument" error.
+type ListInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListNotFoundResponseBody is the type of the "instrument" service "list"
+// endpoint HTTP response body for the "not_found" error.
+type ListNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SearchNotFoundResponseBody is the type of the "instrument" service "search"
+// endpoint HTTP response body for the "not_found" error.
+type SearchNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SearchInvalidInstrumentResponseBody is the type of the "instrument" service
+// "search" endpoint HTTP response body for the "invalid_instrument" error.
+type SearchInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetNotFoundResponseBody is the type of the "instrument" service "get"
+// endpoint HTTP response body for the "not_found" error.
+type GetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetInvalidInstrumentResponseBody is the type of the "instrument" service
+// "get" endpoint HTTP response body for the "invalid_instrument" error.
+type GetInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateConflictResponseBody is the type of the "instrument" service "create"
+// endpoint HTTP response body for the "conflict" error.
+type CreateConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateNotFoundResponseBody is the type of the "instrument" service "create"
+// endpoint HTTP response body for the "not_found" error.
+type CreateNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateInvalidInstrumentResponseBody is the type of the "instrument" service
+// "create" endpoint HTTP response body for the "invalid_instrument" error.
+type CreateInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateConflictResponseBody is the type of the "instrument" service "update"
+// endpoint HTTP response body for the "conflict" error.
+type UpdateConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateNotFoundResponseBody is the type of the "instrument" service "update"
+// endpoint HTTP response body for the "not_found" error.
+type UpdateNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateInvalidInstrumentResponseBody is the type of the "instrument" service
+// "update" endpoint HTTP response body for the "invalid_instrument" error.
+type UpdateInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteNotFoundResponseBody is the type of the "instrument" service "delete"
+// endpoint HTTP response body for the "not_found" error.
+type DeleteNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteInvalidInstrumentResponseBody is the type of the "instrument" service
+// "delete" endpoint HTTP response body for the "invalid_instrument" error.
+type DeleteInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ImportNotFoundResponseBody is the type of the "instrument" service "import"
+// endpoint HTTP response body for the "not_found" error.
+type ImportNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ImportInvalidInstrumentResponseBody is the type of the "instrument" service
+// "import" endpoint HTTP response body for the "invalid_instrument" error.
+type ImportInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// Instrument is used to define fields on response body types.
+type Instrument struct {
+	// Instrument ID, the listing exchange operating MIC and the symbol
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Ticker symbol on the listing exchange
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Instrument name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Asset class
+	AssetClass *string `form:"asset_class,omitempty" json:"asset_class,omitempty" xml:"asset_class,omitempty"`
+	// ISO 4217 trading currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+	// Operating MIC of the listing exchange
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update time
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// InstrumentResponse is used to define fields on response body types.
+type InstrumentResponse struct {
+	// Instrument ID, the listing exchange operating MIC and the symbol
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Ticker symbol on the listing exchange
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Instrument name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Asset class
+	AssetClass *string `form:"asset_class,omitempty" json:"asset_class,omitempty" xml:"asset_class,omitempty"`
+	// ISO 4217 trading currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+	// Operating MIC of the listing exchange
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// Creation time
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update time
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// ImportRowErrorResponseBody is used to define fields on response body types.
+type ImportRowErrorResponseBody struct {
+	// Line of the row in the file
+	Line *int `form:"line,omitempty" json:"line,omitempty" xml:"line,omitempty"`
+	// Why the row is invalid
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// NewCreateRequestBody builds the HTTP request body from the payload of the
+// "create" endpoint of the "instrument" service.
+func NewCreateRequestBody(p *instrument.CreatePayload) *CreateRequestBody {
+	body := &CreateRequestBody{
+		Symbol:      p.Symbol,
+		ExchangeMic: p.ExchangeMic,
+		Name:        p.Name,
+		AssetClass:  p.AssetClass,
+		Currency:    p.Currency,
+		Isin:        p.Isin,
+		Figi:        p.Figi,
+	}
+	return body
+}
+
+// NewUpdateRequestBody builds the HTTP request body from the payload of the
+// "update" endpoint of the "instrument" service.
+func NewUpdateRequestBody(p *instrument.UpdatePayload) *UpdateRequestBody {
+	body := &UpdateRequestBody{
+		Name:       p.Name,
+		AssetClass: p.AssetClass,
+		Currency:   p.Currency,
+		Isin:       p.Isin,
+		Figi:       p.Figi,
+	}
+	return body
+}
+
+// NewListResultOK builds a "instrument" service "list" endpoint result from a
+// HTTP "OK" response.
+func NewListResultOK(body []*Instrument, total int, nextCursor *string) *instrument.ListResult {
+	v := make([]*instrument.Instrument, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalInstrumentToInstrumentInstrument(val)
+	}
+	res := &instrument.ListResult{
+		Instruments: v,
+	}
+	res.Total = total
+	res.NextCursor = nextCursor
+
+	return res
+}
+
+// NewListInvalidCursor builds a instrument service list endpoint
+// invalid_cursor error.
+func NewListInvalidCursor(body *ListInvalidCursorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListInvalidInstrument builds a instrument service list endpoint
+// invalid_instrument error.
+func NewListInvalidInstrument(body *ListInvalidInstrumentResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListNotFound builds a instrument service list endpoint not_found error.
+func NewListNotFound(body *ListNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSearchInstrumentOK builds a "instrument" service "search" endpoint result
+// from a HTTP "OK" response.
+func NewSearchInstrumentOK(body []*InstrumentResponse) []*instrument.Instrument {
+	v := make([]*instrument.Instrument, len(body))
+	for i, val := range body {
+		if val == nil {
+			v[i] = nil
+			continue
+		}
+		v[i] = unmarshalInstrumentResponseToInstrumentInstrument(val)
+	}
+
+	return v
+}
+
+// NewSearchNotFound builds a instrument service search endpoint not_found
+// error.
+func NewSearchNotFound(body *SearchNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSearchInvalidInstrument builds a instrument service search endpoint
+// invalid_instrument error.
+func NewSearchInvalidInstrument(body *SearchInvalidInstrumentResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetInstrumentOK builds a "instrument" service "get" endpoint result from
+// a HTTP "OK" response.
+func NewGetInstrumentOK(body *GetResponseBody) *instrument.Instrument {
+	v := &instrument.Instrument{
+		ID:          *body.ID,
+		Symbol:      *body.Symbol,
+		Name:        *body.Name,
+		AssetClass:  *body.AssetClass,
+		Currency:    *body.Currency,
+		Isin:        body.Isin,
+		Figi:        body.Figi,
+		ExchangeMic: *body.ExchangeMic,
+		CreatedAt:   *body.CreatedAt,
+		UpdatedAt:   *body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewGetNotFound builds a instrument service get endpoint not_found error.
+func NewGetNotFound(body *GetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetInvalidInstrument builds a instrument service get endpoint
+// invalid_instrument error.
+func NewGetInvalidInstrument(body *GetInvalidInstrumentResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateInstrumentCreated builds a "instrument" service "create" endpoint
+// result from a HTTP "Created" response.
+func NewCreateInstrumentCreated(body *CreateResponseBody) *instrument.Instrument {
+	v := &instrument.Instrument{
+		ID:          *body.ID,
+		Symbol:      *body.Symbol,
+		Name:        *body.Name,
+		AssetClass:  *body.AssetClass,
+		Currency:    *body.Currency,
+		Isin:        body.Isin,
+		Figi:        body.Figi,
+		ExchangeMic: *body.ExchangeMic,
+		CreatedAt:   *body.CreatedAt,
+		UpdatedAt:   *body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewCreateConflict builds a instrument service create endpoint conflict error.
+func NewCreateConflict(body *CreateConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateNotFound builds a instrument service create endpoint not_found
+// error.
+func NewCreateNotFound(body *CreateNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateInvalidInstrument builds a instrument service create endpoint
+// invalid_instrument error.
+func NewCreateInvalidInstrument(body *CreateInvalidInstrumentResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateInstrumentOK builds a "instrument" service "update" endpoint result
+// from a HTTP "OK" response.
+func NewUpdateInstrumentOK(body *UpdateResponseBody) *instrument.Instrument {
+	v := &instrument.Instrument{
+		ID:          *body.ID,
+		Symbol:      *body.Symbol,
+		Name:        *body.Name,
+		AssetClass:  *body.AssetClass,
+		Currency:    *body.Currency,
+		Isin:        body.Isin,
+		Figi:        body.Figi,
+		ExchangeMic: *body.ExchangeMic,
+		CreatedAt:   *body.CreatedAt,
+		UpdatedAt:   *body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewUpdateConflict builds a instrument service update endpoint conflict error.
+func NewUpdateConflict(body *UpdateConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateNotFound builds a instrument service update endpoint not_found
+// error.
+func NewUpdateNotFound(body *UpdateNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateInvalidInstrument builds a instrument service update endpoint
+// invalid_instrument error.
+func NewUpdateInvalidInstrument(body *UpdateInvalidInstrumentResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteNotFound builds a instrument service delete endpoint not_found
+// error.
+func NewDeleteNotFound(body *DeleteNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteInvalidInstrument builds a instrument service delete endpoint
+// invalid_instrument error.
+func NewDeleteInvalidInstrument(body *DeleteInvalidInstrumentResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewImportInstrumentImportReportOK builds a "instrument" service "import"
+// endpoint result from a HTTP "OK" response.
+func NewImportInstrumentImportReportOK(body *ImportResponseBody) *instrument.InstrumentImportReport {
+	v := &instrument.InstrumentImportReport{
+		DryRun:    *body.DryRun,
+		Applied:   *body.Applied,
+		Rows:      *body.Rows,
+		Created:   *body.Created,
+		Updated:   *body.Updated,
+		Unchanged: *body.Unchanged,
+	}
+	v.Errors = make([]*instrument.ImportRowError, len(body.Errors))
+	for i, val := range body.Errors {
+		if val == nil {
+			v.Errors[i] = nil
+			continue
+		}
+		v.Errors[i] = unmarshalImportRowErrorResponseBodyToInstrumentImportRowError(val)
+	}
+
+	return v
+}
+
+// NewImportNotFound builds a instrument service import endpoint not_found
+// error.
+func NewImportNotFound(body *ImportNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewImportInvalidInstrument builds a instrument service import endpoint
+// invalid_instrument error.
+func NewImportInvalidInstrument(body *ImportInvalidInstrumentResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateGetResponseBody runs the validations defined on GetResponseBody
+func ValidateGetResponseBody(body *GetResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.AssetClass == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("asset_class", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.ExchangeMic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exchange_mic", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.AssetClass != nil {
+		if !(*body.AssetClass == "equity" || *body.AssetClass == "etf" || *body.AssetClass == "fund" || *body.AssetClass == "bond" || *body.AssetClass == "index" || *body.AssetClass == "fx" || *body.AssetClass == "crypto" || *body.AssetClass == "derivative" || *body.AssetClass == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.asset_class", *body.AssetClass, []any{"equity", "etf", "fund", "bond", "index", "fx", "crypto", "derivative", "other"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCreateResponseBody runs the validations defined on CreateResponseBody
+func ValidateCreateResponseBody(body *CreateResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.AssetClass == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("asset_class", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.ExchangeMic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exchange_mic", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.AssetClass != nil {
+		if !(*body.AssetClass == "equity" || *body.AssetClass == "etf" || *body.AssetClass == "fund" || *body.AssetClass == "bond" || *body.AssetClass == "index" || *body.AssetClass == "fx" || *body.AssetClass == "crypto" || *body.AssetClass == "derivative" || *body.AssetClass == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.asset_class", *body.AssetClass, []any{"equity", "etf", "fund", "bond", "index", "fx", "crypto", "derivative", "other"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateUpdateResponseBody runs the validations defined on UpdateResponseBody
+func ValidateUpdateResponseBody(body *UpdateResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.AssetClass == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("asset_class", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.ExchangeMic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exchange_mic", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.AssetClass != nil {
+		if !(*body.AssetClass == "equity" || *body.AssetClass == "etf" || *body.AssetClass == "fund" || *body.AssetClass == "bond" || *body.AssetClass == "index" || *body.AssetClass == "fx" || *body.AssetClass == "crypto" || *body.AssetClass == "derivative" || *body.AssetClass == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.asset_class", *body.AssetClass, []any{"equity", "etf", "fund", "bond", "index", "fx", "crypto", "derivative", "other"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateImportResponseBody runs the validations defined on ImportResponseBody
+func ValidateImportResponseBody(body *ImportResponseBody) (err error) {
+	if body.DryRun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dry_run", "body"))
+	}
+	if body.Applied == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("applied", "body"))
+	}
+	if body.Rows == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rows", "body"))
+	}
+	if body.Created == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created", "body"))
+	}
+	if body.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "body"))
+	}
+	if body.Unchanged == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unchanged", "body"))
+	}
+	if body.Errors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("errors", "body"))
+	}
+	for _, e := range body.Errors {
+		if e != nil {
+			if err2 := ValidateImportRowErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListInvalidCursorResponseBody runs the validations defined on
+// list_invalid_cursor_response_body
+func ValidateListInvalidCursorResponseBody(body *ListInvalidCursorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListInvalidInstrumentResponseBody runs the validations defined on
+// list_invalid_instrument_response_body
+func ValidateListInvalidInstrumentResponseBody(body *ListInvalidInstrumentResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListNotFoundResponseBody runs the validations defined on
+// list_not_found_response_body
+func ValidateListNotFoundResponseBody(body *ListNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSearchNotFoundResponseBody runs the validations defined on
+// search_not_found_response_body
+func ValidateSearchNotFoundResponseBody(body *SearchNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSearchInvalidInstrumentResponseBody runs the validations defined on
+// search_invalid_instrument_response_body
+func ValidateSearchInvalidInstrumentResponseBody(body *SearchInvalidInstrumentResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetNotFoundResponseBody runs the validations defined on
+// get_not_found_response_body
+func ValidateGetNotFoundResponseBody(body *GetNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetInvalidInstrumentResponseBody runs the validations defined on
+// get_invalid_instrument_response_body
+func ValidateGetInvalidInstrumentResponseBody(body *GetInvalidInstrumentResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateConflictResponseBody runs the validations defined on
+// create_conflict_response_body
+func ValidateCreateConflictResponseBody(body *CreateConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateNotFoundResponseBody runs the validations defined on
+// create_not_found_response_body
+func ValidateCreateNotFoundResponseBody(body *CreateNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateInvalidInstrumentResponseBody runs the validations defined on
+// create_invalid_instrument_response_body
+func ValidateCreateInvalidInstrumentResponseBody(body *CreateInvalidInstrumentResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateConflictResponseBody runs the validations defined on
+// update_conflict_response_body
+func ValidateUpdateConflictResponseBody(body *UpdateConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateNotFoundResponseBody runs the validations defined on
+// update_not_found_response_body
+func ValidateUpdateNotFoundResponseBody(body *UpdateNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateInvalidInstrumentResponseBody runs the validations defined on
+// update_invalid_instrument_response_body
+func ValidateUpdateInvalidInstrumentResponseBody(body *UpdateInvalidInstrumentResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteNotFoundResponseBody runs the validations defined on
+// delete_not_found_response_body
+func ValidateDeleteNotFoundResponseBody(body *DeleteNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteInvalidInstrumentResponseBody runs the validations defined on
+// delete_invalid_instrument_response_body
+func ValidateDeleteInvalidInstrumentResponseBody(body *DeleteInvalidInstrumentResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateImportNotFoundResponseBody runs the validations defined on
+// import_not_found_response_body
+func ValidateImportNotFoundResponseBody(body *ImportNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateImportInvalidInstrumentResponseBody runs the validations defined on
+// import_invalid_instrument_response_body
+func ValidateImportInvalidInstrumentResponseBody(body *ImportInvalidInstrumentResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateInstrument runs the validations defined on Instrument
+func ValidateInstrument(body *Instrument) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.AssetClass == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("asset_class", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.ExchangeMic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exchange_mic", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.AssetClass != nil {
+		if !(*body.AssetClass == "equity" || *body.AssetClass == "etf" || *body.AssetClass == "fund" || *body.AssetClass == "bond" || *body.AssetClass == "index" || *body.AssetClass == "fx" || *body.AssetClass == "crypto" || *body.AssetClass == "derivative" || *body.AssetClass == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.asset_class", *body.AssetClass, []any{"equity", "etf", "fund", "bond", "index", "fx", "crypto", "derivative", "other"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateInstrumentResponse runs the validations defined on InstrumentResponse
+func ValidateInstrumentResponse(body *InstrumentResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.AssetClass == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("asset_class", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.ExchangeMic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exchange_mic", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.AssetClass != nil {
+		if !(*body.AssetClass == "equity" || *body.AssetClass == "etf" || *body.AssetClass == "fund" || *body.AssetClass == "bond" || *body.AssetClass == "index" || *body.AssetClass == "fx" || *body.AssetClass == "crypto" || *body.AssetClass == "derivative" || *body.AssetClass == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.asset_class", *body.AssetClass, []any{"equity", "etf", "fund", "bond", "index", "fx", "crypto", "derivative", "other"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateImportRowErrorResponseBody runs the validations defined on
+// ImportRowErrorResponseBody
+func ValidateImportRowErrorResponseBody(body *ImportRowErrorResponseBody) (err error) {
+	if body.Line == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("line", "body"))
+	}
+	if body.Error == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "body"))
+	}
+	return
+}
@@ -0,0 +1,1023 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// instrument HTTP server types
+//
+// Command:
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
+
+package server
+
+import (
+	"unicode/utf8"
+
+	instrument "github.com/reidlai/ta-workspace/apps/ta-server/gen/instrument"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "instrument" service "create" endpoint
+// HTTP request body.
+type CreateRequestBody struct {
+	// Ticker symbol on the listing exchange
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Operating or segment MIC of the listing exchange
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// Instrument name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Asset class
+	AssetClass *string `form:"asset_class,omitempty" json:"asset_class,omitempty" xml:"asset_class,omitempty"`
+	// ISO 4217 trading currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+}
+
+// UpdateRequestBody is the type of the "instrument" service "update" endpoint
+// HTTP request body.
+type UpdateRequestBody struct {
+	// Instrument name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Asset class
+	AssetClass *string `form:"asset_class,omitempty" json:"asset_class,omitempty" xml:"asset_class,omitempty"`
+	// ISO 4217 trading currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty" xml:"currency,omitempty"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+}
+
+// ListResponseBody is the type of the "instrument" service "list" endpoint
+// HTTP response body.
+type ListResponseBody []*Instrument
+
+// SearchResponseBody is the type of the "instrument" service "search" endpoint
+// HTTP response body.
+type SearchResponseBody []*InstrumentResponse
+
+// GetResponseBody is the type of the "instrument" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// Instrument ID, the listing exchange operating MIC and the symbol
+	ID string `form:"id" json:"id" xml:"id"`
+	// Ticker symbol on the listing exchange
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Instrument name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Asset class
+	AssetClass string `form:"asset_class" json:"asset_class" xml:"asset_class"`
+	// ISO 4217 trading currency
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+	// Operating MIC of the listing exchange
+	ExchangeMic string `form:"exchange_mic" json:"exchange_mic" xml:"exchange_mic"`
+	// Creation time
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last update time
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// CreateResponseBody is the type of the "instrument" service "create" endpoint
+// HTTP response body.
+type CreateResponseBody struct {
+	// Instrument ID, the listing exchange operating MIC and the symbol
+	ID string `form:"id" json:"id" xml:"id"`
+	// Ticker symbol on the listing exchange
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Instrument name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Asset class
+	AssetClass string `form:"asset_class" json:"asset_class" xml:"asset_class"`
+	// ISO 4217 trading currency
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+	// Operating MIC of the listing exchange
+	ExchangeMic string `form:"exchange_mic" json:"exchange_mic" xml:"exchange_mic"`
+	// Creation time
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last update time
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// UpdateResponseBody is the type of the "instrument" service "update" endpoint
+// HTTP response body.
+type UpdateResponseBody struct {
+	// Instrument ID, the listing exchange operating MIC and the symbol
+	ID string `form:"id" json:"id" xml:"id"`
+	// Ticker symbol on the listing exchange
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Instrument name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Asset class
+	AssetClass string `form:"asset_class" json:"asset_class" xml:"asset_class"`
+	// ISO 4217 trading currency
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+	// Operating MIC of the listing exchange
+	ExchangeMic string `form:"exchange_mic" json:"exchange_mic" xml:"exchange_mic"`
+	// Creation time
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last update time
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// ImportResponseBody is the type of the "instrument" service "import" endpoint
+// HTTP response body.
+type ImportResponseBody struct {
+	// Whether the file was only validated
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// Whether the instruments were stored; false when any row is invalid
+	Applied bool `form:"applied" json:"applied" xml:"applied"`
+	// Number of data rows in the file
+	Rows int `form:"rows" json:"rows" xml:"rows"`
+	// Instruments created, or that would be
+	Created int `form:"created" json:"created" xml:"created"`
+	// Instruments updated, or that would be
+	Updated int `form:"updated" json:"updated" xml:"updated"`
+	// Instruments already up to date
+	Unchanged int `form:"unchanged" json:"unchanged" xml:"unchanged"`
+	// Invalid rows
+	Errors []*ImportRowErrorResponseBody `form:"errors" json:"errors" xml:"errors"`
+}
+
+// ListInvalidCursorResponseBody is the type of the "instrument" service "list"
+// endpoint HTTP response body for the "invalid_cursor" error.
+type ListInvalidCursorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListInvalidInstrumentResponseBody is the type of the "instrument" service
+// "list" endpoint HTTP response body for the "invalid_instrument" error.
+type ListInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListNotFoundResponseBody is the type of the "instrument" service "list"
+// endpoint HTTP response body for the "not_found" error.
+type ListNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SearchNotFoundResponseBody is the type of the "instrument" service "search"
+// endpoint HTTP response body for the "not_found" error.
+type SearchNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SearchInvalidInstrumentResponseBody is the type of the "instrument" service
+// "search" endpoint HTTP response body for the "invalid_instrument" error.
+type SearchInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetNotFoundResponseBody is the type of the "instrument" service "get"
+// endpoint HTTP response body for the "not_found" error.
+type GetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetInvalidInstrumentResponseBody is the type of the "instrument" service
+// "get" endpoint HTTP response body for the "invalid_instrument" error.
+type GetInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateConflictResponseBody is the type of the "instrument" service "create"
+// endpoint HTTP response body for the "conflict" error.
+type CreateConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateNotFoundResponseBody is the type of the "instrument" service "create"
+// endpoint HTTP response body for the "not_found" error.
+type CreateNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateInvalidInstrumentResponseBody is the type of the "instrument" service
+// "create" endpoint HTTP response body for the "invalid_instrument" error.
+type CreateInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateConflictResponseBody is the type of the "instrument" service "update"
+// endpoint HTTP response body for the "conflict" error.
+type UpdateConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateNotFoundResponseBody is the type of the "instrument" service "update"
+// endpoint HTTP response body for the "not_found" error.
+type UpdateNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateInvalidInstrumentResponseBody is the type of the "instrument" service
+// "update" endpoint HTTP response body for the "invalid_instrument" error.
+type UpdateInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteNotFoundResponseBody is the type of the "instrument" service "delete"
+// endpoint HTTP response body for the "not_found" error.
+type DeleteNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteInvalidInstrumentResponseBody is the type of the "instrument" service
+// "delete" endpoint HTTP response body for the "invalid_instrument" error.
+type DeleteInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportNotFoundResponseBody is the type of the "instrument" service "import"
+// endpoint HTTP response body for the "not_found" error.
+type ImportNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportInvalidInstrumentResponseBody is the type of the "instrument" service
+// "import" endpoint HTTP response body for the "invalid_instrument" error.
+type ImportInvalidInstrumentResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// Instrument is used to define fields on response body types.
+type Instrument struct {
+	// Instrument ID, the listing exchange operating MIC and the symbol
+	ID string `form:"id" json:"id" xml:"id"`
+	// Ticker symbol on the listing exchange
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Instrument name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Asset class
+	AssetClass string `form:"asset_class" json:"asset_class" xml:"asset_class"`
+	// ISO 4217 trading currency
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+	// Operating MIC of the listing exchange
+	ExchangeMic string `form:"exchange_mic" json:"exchange_mic" xml:"exchange_mic"`
+	// Creation time
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last update time
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// InstrumentResponse is used to define fields on response body types.
+type InstrumentResponse struct {
+	// Instrument ID, the listing exchange operating MIC and the symbol
+	ID string `form:"id" json:"id" xml:"id"`
+	// Ticker symbol on the listing exchange
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Instrument name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Asset class
+	AssetClass string `form:"asset_class" json:"asset_class" xml:"asset_class"`
+	// ISO 4217 trading currency
+	Currency string `form:"currency" json:"currency" xml:"currency"`
+	// ISO 6166 International Securities Identification Number
+	Isin *string `form:"isin,omitempty" json:"isin,omitempty" xml:"isin,omitempty"`
+	// Financial Instrument Global Identifier of the listing
+	Figi *string `form:"figi,omitempty" json:"figi,omitempty" xml:"figi,omitempty"`
+	// Operating MIC of the listing exchange
+	ExchangeMic string `form:"exchange_mic" json:"exchange_mic" xml:"exchange_mic"`
+	// Creation time
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last update time
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// ImportRowErrorResponseBody is used to define fields on response body types.
+type ImportRowErrorResponseBody struct {
+	// Line of the row in the file
+	Line int `form:"line" json:"line" xml:"line"`
+	// Why the row is invalid
+	Error string `form:"error" json:"error" xml:"error"`
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "instrument" service.
+func NewListResponseBody(res *instrument.ListResult) ListResponseBody {
+	body := make([]*Instrument, len(res.Instruments))
+	for i, val := range res.Instruments {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalInstrumentInstrumentToInstrument(val)
+	}
+	return body
+}
+
+// NewSearchResponseBody builds the HTTP response body from the result of the
+// "search" endpoint of the "instrument" service.
+func NewSearchResponseBody(res []*instrument.Instrument) SearchResponseBody {
+	body := make([]*InstrumentResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalInstrumentInstrumentToInstrumentResponse(val)
+	}
+	return body
+}
+
+// NewGetResponseBody builds the HTTP response body from the result of the
+// "get" endpoint of the "instrument" service.
+func NewGetResponseBody(res *instrument.Instrument) *GetResponseBody {
+	body := &GetResponseBody{
+		ID:          res.ID,
+		Symbol:      res.Symbol,
+		Name:        res.Name,
+		AssetClass:  res.AssetClass,
+		Currency:    res.Currency,
+		Isin:        res.Isin,
+		Figi:        res.Figi,
+		ExchangeMic: res.ExchangeMic,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+	}
+	return body
+}
+
+// NewCreateResponseBody builds the HTTP response body from the result of the
+// "create" endpoint of the "instrument" service.
+func NewCreateResponseBody(res *instrument.Instrument) *CreateResponseBody {
+	body := &CreateResponseBody{
+		ID:          res.ID,
+		Symbol:      res.Symbol,
+		Name:        res.Name,
+		AssetClass:  res.AssetClass,
+		Currency:    res.Currency,
+		Isin:        res.Isin,
+		Figi:        res.Figi,
+		ExchangeMic: res.ExchangeMic,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+	}
+	return body
+}
+
+// NewUpdateResponseBody builds the HTTP response body from the result of the
+// "update" endpoint of the "instrument" service.
+func NewUpdateResponseBody(res *instrument.Instrument) *UpdateResponseBody {
+	body := &UpdateResponseBody{
+		ID:          res.ID,
+		Symbol:      res.Symbol,
+		Name:        res.Name,
+		AssetClass:  res.AssetClass,
+		Currency:    res.Currency,
+		Isin:        res.Isin,
+		Figi:        res.Figi,
+		ExchangeMic: res.ExchangeMic,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+	}
+	return body
+}
+
+// NewImportResponseBody builds the HTTP response body from the result of the
+// "import" endpoint of the "instrument" service.
+func NewImportResponseBody(res *instrument.InstrumentImportReport) *ImportResponseBody {
+	body := &ImportResponseBody{
+		DryRun:    res.DryRun,
+		Applied:   res.Applied,
+		Rows:      res.Rows,
+		Created:   res.Created,
+		Updated:   res.Updated,
+		Unchanged: res.Unchanged,
+	}
+	if res.Errors != nil {
+		body.Errors = make([]*ImportRowErrorResponseBody, len(res.Errors))
+		for i, val := range res.Errors {
+			if val == nil {
+				body.Errors[i] = nil
+				continue
+			}
+			body.Errors[i] = marshalInstrumentImportRowErrorToImportRowErrorResponseBody(val)
+		}
+	} else {
+		body.Errors = []*ImportRowErrorResponseBody{}
+	}
+	return body
+}
+
+// NewListInvalidCursorResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "instrument" service.
+func NewListInvalidCursorResponseBody(res *goa.ServiceError) *ListInvalidCursorResponseBody {
+	body := &ListInvalidCursorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListInvalidInstrumentResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "instrument" service.
+func NewListInvalidInstrumentResponseBody(res *goa.ServiceError) *ListInvalidInstrumentResponseBody {
+	body := &ListInvalidInstrumentResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListNotFoundResponseBody builds the HTTP response body from the result of
+// the "list" endpoint of the "instrument" service.
+func NewListNotFoundResponseBody(res *goa.ServiceError) *ListNotFoundResponseBody {
+	body := &ListNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSearchNotFoundResponseBody builds the HTTP response body from the result
+// of the "search" endpoint of the "instrument" service.
+func NewSearchNotFoundResponseBody(res *goa.ServiceError) *SearchNotFoundResponseBody {
+	body := &SearchNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSearchInvalidInstrumentResponseBody builds the HTTP response body from
+// the result of the "search" endpoint of the "instrument" service.
+func NewSearchInvalidInstrumentResponseBody(res *goa.ServiceError) *SearchInvalidInstrumentResponseBody {
+	body := &SearchInvalidInstrumentResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetNotFoundResponseBody builds the HTTP response body from the result of
+// the "get" endpoint of the "instrument" service.
+func NewGetNotFoundResponseBody(res *goa.ServiceError) *GetNotFoundResponseBody {
+	body := &GetNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetInvalidInstrumentResponseBody builds the HTTP response body from the
+// result of the "get" endpoint of the "instrument" service.
+func NewGetInvalidInstrumentResponseBody(res *goa.ServiceError) *GetInvalidInstrumentResponseBody {
+	body := &GetInvalidInstrumentResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateConflictResponseBody builds the HTTP response body from the result
+// of the "create" endpoint of the "instrument" service.
+func NewCreateConflictResponseBody(res *goa.ServiceError) *CreateConflictResponseBody {
+	body := &CreateConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateNotFoundResponseBody builds the HTTP response body from the result
+// of the "create" endpoint of the "instrument" service.
+func NewCreateNotFoundResponseBody(res *goa.ServiceError) *CreateNotFoundResponseBody {
+	body := &CreateNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateInvalidInstrumentResponseBody builds the HTTP response body from
+// the result of the "create" endpoint of the "instrument" service.
+func NewCreateInvalidInstrumentResponseBody(res *goa.ServiceError) *CreateInvalidInstrumentResponseBody {
+	body := &CreateInvalidInstrumentResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateConflictResponseBody builds the HTTP response body from the result
+// of the "update" endpoint of the "instrument" service.
+func NewUpdateConflictResponseBody(res *goa.ServiceError) *UpdateConflictResponseBody {
+	body := &UpdateConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateNotFoundResponseBody builds the HTTP response body from the result
+// of the "update" endpoint of the "instrument" service.
+func NewUpdateNotFoundResponseBody(res *goa.ServiceError) *UpdateNotFoundResponseBody {
+	body := &UpdateNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateInvalidInstrumentResponseBody builds the HTTP response body from
+// the result of the "update" endpoint of the "instrument" service.
+func NewUpdateInvalidInstrumentResponseBody(res *goa.ServiceError) *UpdateInvalidInstrumentResponseBody {
+	body := &UpdateInvalidInstrumentResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteNotFoundResponseBody builds the HTTP response body from the result
+// of the "delete" endpoint of the "instrument" service.
+func NewDeleteNotFoundResponseBody(res *goa.ServiceError) *DeleteNotFoundResponseBody {
+	body := &DeleteNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteInvalidInstrumentResponseBody builds the HTTP response body from
+// the result of the "delete" endpoint of the "instrument" service.
+func NewDeleteInvalidInstrumentResponseBody(res *goa.ServiceError) *DeleteInvalidInstrumentResponseBody {
+	body := &DeleteInvalidInstrumentResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportNotFoundResponseBody builds the HTTP response body from the result
+// of the "import" endpoint of the "instrument" service.
+func NewImportNotFoundResponseBody(res *goa.ServiceError) *ImportNotFoundResponseBody {
+	body := &ImportNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportInvalidInstrumentResponseBody builds the HTTP response body from
+// the result of the "import" endpoint of the "instrument" service.
+func NewImportInvalidInstrumentResponseBody(res *goa.ServiceError) *ImportInvalidInstrumentResponseBody {
+	body := &ImportInvalidInstrumentResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListPayload builds a instrument service list endpoint payload.
+func NewListPayload(exchangeMic *string, assetClass *string, currency *string, isin *string, sort string, cursor *string, limit *int) *instrument.ListPayload {
+	v := &instrument.ListPayload{}
+	v.ExchangeMic = exchangeMic
+	v.AssetClass = assetClass
+	v.Currency = currency
+	v.Isin = isin
+	v.Sort = sort
+	v.Cursor = cursor
+	v.Limit = limit
+
+	return v
+}
+
+// NewSearchPayload builds a instrument service search endpoint payload.
+func NewSearchPayload(q string, limit int) *instrument.SearchPayload {
+	v := &instrument.SearchPayload{}
+	v.Q = q
+	v.Limit = limit
+
+	return v
+}
+
+// NewGetPayload builds a instrument service get endpoint payload.
+func NewGetPayload(id string) *instrument.GetPayload {
+	v := &instrument.GetPayload{}
+	v.ID = id
+
+	return v
+}
+
+// NewCreatePayload builds a instrument service create endpoint payload.
+func NewCreatePayload(body *CreateRequestBody) *instrument.CreatePayload {
+	v := &instrument.CreatePayload{
+		Symbol:      *body.Symbol,
+		ExchangeMic: *body.ExchangeMic,
+		Name:        *body.Name,
+		AssetClass:  *body.AssetClass,
+		Currency:    *body.Currency,
+		Isin:        body.Isin,
+		Figi:        body.Figi,
+	}
+
+	return v
+}
+
+// NewUpdatePayload builds a instrument service update endpoint payload.
+func NewUpdatePayload(body *UpdateRequestBody, id string) *instrument.UpdatePayload {
+	v := &instrument.UpdatePayload{
+		Name:       *body.Name,
+		AssetClass: *body.AssetClass,
+		Currency:   *body.Currency,
+		Isin:       body.Isin,
+		Figi:       body.Figi,
+	}
+	v.ID = id
+
+	return v
+}
+
+// NewDeletePayload builds a instrument service delete endpoint payload.
+func NewDeletePayload(id string) *instrument.DeletePayload {
+	v := &instrument.DeletePayload{}
+	v.ID = id
+
+	return v
+}
+
+// NewImportPayload builds a instrument service import endpoint payload.
+func NewImportPayload(dryRun bool) *instrument.ImportPayload {
+	v := &instrument.ImportPayload{}
+	v.DryRun = dryRun
+
+	return v
+}
+
+// ValidateCreateRequestBody runs the validations defined on CreateRequestBody
+func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.ExchangeMic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exchange_mic", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.AssetClass == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("asset_class", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Symbol != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.symbol", *body.Symbol, "^[A-Za-z0-9][A-Za-z0-9.\\-]{0,19}$"))
+	}
+	if body.ExchangeMic != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.exchange_mic", *body.ExchangeMic, "^[A-Za-z0-9]{4}$"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 200, false))
+		}
+	}
+	if body.AssetClass != nil {
+		if !(*body.AssetClass == "equity" || *body.AssetClass == "etf" || *body.AssetClass == "fund" || *body.AssetClass == "bond" || *body.AssetClass == "index" || *body.AssetClass == "fx" || *body.AssetClass == "crypto" || *body.AssetClass == "derivative" || *body.AssetClass == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.asset_class", *body.AssetClass, []any{"equity", "etf", "fund", "bond", "index", "fx", "crypto", "derivative", "other"}))
+		}
+	}
+	if body.Currency != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.currency", *body.Currency, "^[A-Z]{3}$"))
+	}
+	if body.Isin != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.isin", *body.Isin, "^[A-Z]{2}[A-Z0-9]{9}[0-9]$"))
+	}
+	if body.Figi != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.figi", *body.Figi, "^[B-DF-HJ-NP-TV-Z0-9]{2}G[B-DF-HJ-NP-TV-Z0-9]{8}[0-9]$"))
+	}
+	return
+}
+
+// ValidateUpdateRequestBody runs the validations defined on UpdateRequestBody
+func ValidateUpdateRequestBody(body *UpdateRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.AssetClass == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("asset_class", "body"))
+	}
+	if body.Currency == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("currency", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 200, false))
+		}
+	}
+	if body.AssetClass != nil {
+		if !(*body.AssetClass == "equity" || *body.AssetClass == "etf" || *body.AssetClass == "fund" || *body.AssetClass == "bond" || *body.AssetClass == "index" || *body.AssetClass == "fx" || *body.AssetClass == "crypto" || *body.AssetClass == "derivative" || *body.AssetClass == "other") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.asset_class", *body.AssetClass, []any{"equity", "etf", "fund", "bond", "index", "fx", "crypto", "derivative", "other"}))
+		}
+	}
+	if body.Currency != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.currency", *body.Currency, "^[A-Z]{3}$"))
+	}
+	if body.Isin != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.isin", *body.Isin, "^[A-Z]{2}[A-Z0-9]{9}[0-9]$"))
+	}
+	if body.Figi != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.figi", *body.Figi, "^[B-DF-HJ-NP-TV-Z0-9]{2}G[B-DF-HJ-NP-TV-Z0-9]{8}[0-9]$"))
+	}
+	return
+}
@@ -3,68 +3,1053 @@
 // watchlist HTTP client CLI support package
 //
 // Command:
-// $ goa gen github.com/reidlai/ta-workspace/modules/watchlist/go/design
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
 
 package client
 
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"unicode/utf8"
 
 	watchlist "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildListPayload builds the payload for the watchlist list endpoint from CLI
 // flags.
-func BuildListPayload(watchlistListUserID string) (*watchlist.ListPayload, error) {
-	var userID string
+func BuildListPayload(watchlistListIfNoneMatch string, watchlistListToken string) (*watchlist.ListPayload, error) {
+	var ifNoneMatch *string
 	{
-		userID = watchlistListUserID
+		if watchlistListIfNoneMatch != "" {
+			ifNoneMatch = &watchlistListIfNoneMatch
+		}
+	}
+	var token *string
+	{
+		if watchlistListToken != "" {
+			token = &watchlistListToken
+		}
 	}
 	v := &watchlist.ListPayload{}
-	v.UserID = userID
+	v.IfNoneMatch = ifNoneMatch
+	v.Token = token
 
 	return v, nil
 }
 
 // BuildAddPayload builds the payload for the watchlist add endpoint from CLI
 // flags.
-func BuildAddPayload(watchlistAddBody string, watchlistAddUserID string) (*watchlist.AddPayload, error) {
+func BuildAddPayload(watchlistAddBody string, watchlistAddIfMatch string, watchlistAddToken string) (*watchlist.AddPayload, error) {
 	var err error
 	var body AddRequestBody
 	{
 		err = json.Unmarshal([]byte(watchlistAddBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"on_hand\": false,\n      \"symbol\": \"Vel ea fugit et ab nobis.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"buy_target\": 0.7302622286361922,\n      \"note\": \"ui0\",\n      \"on_hand\": true,\n      \"sell_target\": 0.9591026559189338,\n      \"symbol\": \"In fugit dolor.\",\n      \"tags\": [\n         \"wpo\",\n         \"btp\",\n         \"7m4\"\n      ]\n   }'")
+		}
+		if body.Note != nil {
+			if utf8.RuneCountInString(*body.Note) > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.note", *body.Note, utf8.RuneCountInString(*body.Note), 1000, false))
+			}
+		}
+		if len(body.Tags) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 20, false))
+		}
+		for _, e := range body.Tags {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.tags[*]", e, "\\S"))
+			if utf8.RuneCountInString(e) > 50 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags[*]", e, utf8.RuneCountInString(e), 50, false))
+			}
+		}
+		if body.BuyTarget != nil {
+			if *body.BuyTarget < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.buy_target", *body.BuyTarget, 0, true))
+			}
+		}
+		if body.SellTarget != nil {
+			if *body.SellTarget < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.sell_target", *body.SellTarget, 0, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var ifMatch *string
+	{
+		if watchlistAddIfMatch != "" {
+			ifMatch = &watchlistAddIfMatch
 		}
 	}
-	var userID string
+	var token *string
 	{
-		userID = watchlistAddUserID
+		if watchlistAddToken != "" {
+			token = &watchlistAddToken
+		}
 	}
 	v := &watchlist.AddPayload{
-		Symbol: body.Symbol,
-		OnHand: body.OnHand,
+		Symbol:     body.Symbol,
+		OnHand:     body.OnHand,
+		Note:       body.Note,
+		BuyTarget:  body.BuyTarget,
+		SellTarget: body.SellTarget,
+	}
+	if body.Tags != nil {
+		v.Tags = make([]string, len(body.Tags))
+		for i, val := range body.Tags {
+			v.Tags[i] = val
+		}
+	}
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUpdatePayload builds the payload for the watchlist update endpoint from
+// CLI flags.
+func BuildUpdatePayload(watchlistUpdateBody string, watchlistUpdateSymbol string, watchlistUpdateIfMatch string, watchlistUpdateToken string) (*watchlist.UpdatePayload, error) {
+	var err error
+	var body UpdateRequestBody
+	{
+		err = json.Unmarshal([]byte(watchlistUpdateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"buy_target\": 0.32139549049285693,\n      \"note\": \"q5r\",\n      \"on_hand\": false,\n      \"position\": 2102546174674104640,\n      \"sell_target\": 0.17033370826742666,\n      \"tags\": [\n         \"enf\",\n         \"699\",\n         \"2v2\"\n      ]\n   }'")
+		}
+		if body.Note != nil {
+			if utf8.RuneCountInString(*body.Note) > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.note", *body.Note, utf8.RuneCountInString(*body.Note), 1000, false))
+			}
+		}
+		if len(body.Tags) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 20, false))
+		}
+		for _, e := range body.Tags {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.tags[*]", e, "\\S"))
+			if utf8.RuneCountInString(e) > 50 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags[*]", e, utf8.RuneCountInString(e), 50, false))
+			}
+		}
+		if body.BuyTarget != nil {
+			if *body.BuyTarget < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.buy_target", *body.BuyTarget, 0, true))
+			}
+		}
+		if body.SellTarget != nil {
+			if *body.SellTarget < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.sell_target", *body.SellTarget, 0, true))
+			}
+		}
+		if body.Position != nil {
+			if *body.Position < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.position", *body.Position, 0, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var symbol string
+	{
+		symbol = watchlistUpdateSymbol
+	}
+	var ifMatch *string
+	{
+		if watchlistUpdateIfMatch != "" {
+			ifMatch = &watchlistUpdateIfMatch
+		}
 	}
-	v.UserID = userID
+	var token *string
+	{
+		if watchlistUpdateToken != "" {
+			token = &watchlistUpdateToken
+		}
+	}
+	v := &watchlist.UpdatePayload{
+		OnHand:     body.OnHand,
+		Note:       body.Note,
+		BuyTarget:  body.BuyTarget,
+		SellTarget: body.SellTarget,
+		Position:   body.Position,
+	}
+	if body.Tags != nil {
+		v.Tags = make([]string, len(body.Tags))
+		for i, val := range body.Tags {
+			v.Tags[i] = val
+		}
+	}
+	v.Symbol = symbol
+	v.IfMatch = ifMatch
+	v.Token = token
 
 	return v, nil
 }
 
 // BuildRemovePayload builds the payload for the watchlist remove endpoint from
 // CLI flags.
-func BuildRemovePayload(watchlistRemoveSymbol string, watchlistRemoveUserID string) (*watchlist.RemovePayload, error) {
+func BuildRemovePayload(watchlistRemoveSymbol string, watchlistRemoveIfMatch string, watchlistRemoveToken string) (*watchlist.RemovePayload, error) {
 	var symbol string
 	{
 		symbol = watchlistRemoveSymbol
 	}
-	var userID string
+	var ifMatch *string
+	{
+		if watchlistRemoveIfMatch != "" {
+			ifMatch = &watchlistRemoveIfMatch
+		}
+	}
+	var token *string
 	{
-		userID = watchlistRemoveUserID
+		if watchlistRemoveToken != "" {
+			token = &watchlistRemoveToken
+		}
 	}
 	v := &watchlist.RemovePayload{}
 	v.Symbol = symbol
-	v.UserID = userID
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListRemovedPayload builds the payload for the watchlist list_removed
+// endpoint from CLI flags.
+func BuildListRemovedPayload(watchlistListRemovedWatchlist2 string, watchlistListRemovedToken string) (*watchlist.ListRemovedPayload, error) {
+	var watchlist2 *string
+	{
+		if watchlistListRemovedWatchlist2 != "" {
+			watchlist2 = &watchlistListRemovedWatchlist2
+		}
+	}
+	var token *string
+	{
+		if watchlistListRemovedToken != "" {
+			token = &watchlistListRemovedToken
+		}
+	}
+	v := &watchlist.ListRemovedPayload{}
+	v.Watchlist = watchlist2
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRestorePayload builds the payload for the watchlist restore endpoint
+// from CLI flags.
+func BuildRestorePayload(watchlistRestoreSymbol string, watchlistRestoreWatchlist2 string, watchlistRestoreIfMatch string, watchlistRestoreToken string) (*watchlist.RestorePayload, error) {
+	var symbol string
+	{
+		symbol = watchlistRestoreSymbol
+	}
+	var watchlist2 *string
+	{
+		if watchlistRestoreWatchlist2 != "" {
+			watchlist2 = &watchlistRestoreWatchlist2
+		}
+	}
+	var ifMatch *string
+	{
+		if watchlistRestoreIfMatch != "" {
+			ifMatch = &watchlistRestoreIfMatch
+		}
+	}
+	var token *string
+	{
+		if watchlistRestoreToken != "" {
+			token = &watchlistRestoreToken
+		}
+	}
+	v := &watchlist.RestorePayload{}
+	v.Symbol = symbol
+	v.Watchlist = watchlist2
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildEventsPayload builds the payload for the watchlist events endpoint from
+// CLI flags.
+func BuildEventsPayload(watchlistEventsWatchlist2 string, watchlistEventsLastEventID string, watchlistEventsToken string) (*watchlist.EventsPayload, error) {
+	var watchlist2 *string
+	{
+		if watchlistEventsWatchlist2 != "" {
+			watchlist2 = &watchlistEventsWatchlist2
+		}
+	}
+	var lastEventID *string
+	{
+		if watchlistEventsLastEventID != "" {
+			lastEventID = &watchlistEventsLastEventID
+		}
+	}
+	var token *string
+	{
+		if watchlistEventsToken != "" {
+			token = &watchlistEventsToken
+		}
+	}
+	v := &watchlist.EventsPayload{}
+	v.Watchlist = watchlist2
+	v.LastEventID = lastEventID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildBulkAddPayload builds the payload for the watchlist bulk_add endpoint
+// from CLI flags.
+func BuildBulkAddPayload(watchlistBulkAddBody string, watchlistBulkAddWatchlist2 string, watchlistBulkAddIfMatch string, watchlistBulkAddToken string) (*watchlist.BulkAddPayload, error) {
+	var err error
+	var body BulkAddRequestBody
+	{
+		err = json.Unmarshal([]byte(watchlistBulkAddBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"items\": [\n         {\n            \"buy_target\": 0.07568598849097137,\n            \"note\": \"jih\",\n            \"on_hand\": false,\n            \"sell_target\": 0.7521908002781201,\n            \"symbol\": \"Magnam non.\",\n            \"tags\": [\n               \"u88\",\n               \"2mx\",\n               \"flt\"\n            ]\n         }\n      ],\n      \"mode\": \"all_or_nothing\"\n   }'")
+		}
+		if body.Items == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+		}
+		if !(body.Mode == "all_or_nothing" || body.Mode == "best_effort") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", body.Mode, []any{"all_or_nothing", "best_effort"}))
+		}
+		if len(body.Items) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 1, true))
+		}
+		if len(body.Items) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 100, false))
+		}
+		for _, e := range body.Items {
+			if e != nil {
+				if err2 := ValidateBulkAddItemRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var watchlist2 *string
+	{
+		if watchlistBulkAddWatchlist2 != "" {
+			watchlist2 = &watchlistBulkAddWatchlist2
+		}
+	}
+	var ifMatch *string
+	{
+		if watchlistBulkAddIfMatch != "" {
+			ifMatch = &watchlistBulkAddIfMatch
+		}
+	}
+	var token *string
+	{
+		if watchlistBulkAddToken != "" {
+			token = &watchlistBulkAddToken
+		}
+	}
+	v := &watchlist.BulkAddPayload{
+		Mode: body.Mode,
+	}
+	{
+		var zero string
+		if v.Mode == zero {
+			v.Mode = "all_or_nothing"
+		}
+	}
+	if body.Items != nil {
+		v.Items = make([]*watchlist.BulkAddItem, len(body.Items))
+		for i, val := range body.Items {
+			if val == nil {
+				v.Items[i] = nil
+				continue
+			}
+			v.Items[i] = marshalBulkAddItemRequestBodyToWatchlistBulkAddItem(val)
+		}
+	} else {
+		v.Items = []*watchlist.BulkAddItem{}
+	}
+	v.Watchlist = watchlist2
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildBulkRemovePayload builds the payload for the watchlist bulk_remove
+// endpoint from CLI flags.
+func BuildBulkRemovePayload(watchlistBulkRemoveBody string, watchlistBulkRemoveWatchlist2 string, watchlistBulkRemoveIfMatch string, watchlistBulkRemoveToken string) (*watchlist.BulkRemovePayload, error) {
+	var err error
+	var body BulkRemoveRequestBody
+	{
+		err = json.Unmarshal([]byte(watchlistBulkRemoveBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"mode\": \"best_effort\",\n      \"symbols\": [\n         \"Consequatur et ducimus nemo.\",\n         \"Ad perspiciatis quasi ut sint.\"\n      ]\n   }'")
+		}
+		if body.Symbols == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("symbols", "body"))
+		}
+		if !(body.Mode == "all_or_nothing" || body.Mode == "best_effort") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", body.Mode, []any{"all_or_nothing", "best_effort"}))
+		}
+		if len(body.Symbols) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.symbols", body.Symbols, len(body.Symbols), 1, true))
+		}
+		if len(body.Symbols) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.symbols", body.Symbols, len(body.Symbols), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var watchlist2 *string
+	{
+		if watchlistBulkRemoveWatchlist2 != "" {
+			watchlist2 = &watchlistBulkRemoveWatchlist2
+		}
+	}
+	var ifMatch *string
+	{
+		if watchlistBulkRemoveIfMatch != "" {
+			ifMatch = &watchlistBulkRemoveIfMatch
+		}
+	}
+	var token *string
+	{
+		if watchlistBulkRemoveToken != "" {
+			token = &watchlistBulkRemoveToken
+		}
+	}
+	v := &watchlist.BulkRemovePayload{
+		Mode: body.Mode,
+	}
+	{
+		var zero string
+		if v.Mode == zero {
+			v.Mode = "all_or_nothing"
+		}
+	}
+	if body.Symbols != nil {
+		v.Symbols = make([]string, len(body.Symbols))
+		for i, val := range body.Symbols {
+			v.Symbols[i] = val
+		}
+	} else {
+		v.Symbols = []string{}
+	}
+	v.Watchlist = watchlist2
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildImportPayload builds the payload for the watchlist import endpoint from
+// CLI flags.
+func BuildImportPayload(watchlistImportWatchlist2 string, watchlistImportFormat string, watchlistImportDryRun string, watchlistImportIfMatch string, watchlistImportToken string) (*watchlist.ImportPayload, error) {
+	var err error
+	var watchlist2 *string
+	{
+		if watchlistImportWatchlist2 != "" {
+			watchlist2 = &watchlistImportWatchlist2
+		}
+	}
+	var format *string
+	{
+		if watchlistImportFormat != "" {
+			format = &watchlistImportFormat
+			if !(*format == "csv" || *format == "json") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", *format, []any{"csv", "json"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var dryRun bool
+	{
+		if watchlistImportDryRun != "" {
+			dryRun, err = strconv.ParseBool(watchlistImportDryRun)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for dryRun, must be BOOL")
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if watchlistImportIfMatch != "" {
+			ifMatch = &watchlistImportIfMatch
+		}
+	}
+	var token *string
+	{
+		if watchlistImportToken != "" {
+			token = &watchlistImportToken
+		}
+	}
+	v := &watchlist.ImportPayload{}
+	v.Watchlist = watchlist2
+	v.Format = format
+	v.DryRun = dryRun
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildExportPayload builds the payload for the watchlist export endpoint from
+// CLI flags.
+func BuildExportPayload(watchlistExportWatchlist2 string, watchlistExportFormat string, watchlistExportToken string) (*watchlist.ExportPayload, error) {
+	var err error
+	var watchlist2 *string
+	{
+		if watchlistExportWatchlist2 != "" {
+			watchlist2 = &watchlistExportWatchlist2
+		}
+	}
+	var format string
+	{
+		if watchlistExportFormat != "" {
+			format = watchlistExportFormat
+			if !(format == "csv" || format == "json") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"csv", "json"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if watchlistExportToken != "" {
+			token = &watchlistExportToken
+		}
+	}
+	v := &watchlist.ExportPayload{}
+	v.Watchlist = watchlist2
+	v.Format = format
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListWatchlistsPayload builds the payload for the watchlist
+// list_watchlists endpoint from CLI flags.
+func BuildListWatchlistsPayload(watchlistListWatchlistsToken string) (*watchlist.ListWatchlistsPayload, error) {
+	var token *string
+	{
+		if watchlistListWatchlistsToken != "" {
+			token = &watchlistListWatchlistsToken
+		}
+	}
+	v := &watchlist.ListWatchlistsPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListSharedWatchlistsPayload builds the payload for the watchlist
+// list_shared_watchlists endpoint from CLI flags.
+func BuildListSharedWatchlistsPayload(watchlistListSharedWatchlistsToken string) (*watchlist.ListSharedWatchlistsPayload, error) {
+	var token *string
+	{
+		if watchlistListSharedWatchlistsToken != "" {
+			token = &watchlistListSharedWatchlistsToken
+		}
+	}
+	v := &watchlist.ListSharedWatchlistsPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCreateWatchlistPayload builds the payload for the watchlist
+// create_watchlist endpoint from CLI flags.
+func BuildCreateWatchlistPayload(watchlistCreateWatchlistBody string, watchlistCreateWatchlistToken string) (*watchlist.CreateWatchlistPayload, error) {
+	var err error
+	var body CreateWatchlistRequestBody
+	{
+		err = json.Unmarshal([]byte(watchlistCreateWatchlistBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"hky\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.name", body.Name, "\\S"))
+		if utf8.RuneCountInString(body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if watchlistCreateWatchlistToken != "" {
+			token = &watchlistCreateWatchlistToken
+		}
+	}
+	v := &watchlist.CreateWatchlistPayload{
+		Name: body.Name,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetWatchlistPayload builds the payload for the watchlist get_watchlist
+// endpoint from CLI flags.
+func BuildGetWatchlistPayload(watchlistGetWatchlistID string, watchlistGetWatchlistToken string) (*watchlist.GetWatchlistPayload, error) {
+	var id string
+	{
+		id = watchlistGetWatchlistID
+	}
+	var token *string
+	{
+		if watchlistGetWatchlistToken != "" {
+			token = &watchlistGetWatchlistToken
+		}
+	}
+	v := &watchlist.GetWatchlistPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUpdateWatchlistPayload builds the payload for the watchlist
+// update_watchlist endpoint from CLI flags.
+func BuildUpdateWatchlistPayload(watchlistUpdateWatchlistBody string, watchlistUpdateWatchlistID string, watchlistUpdateWatchlistIfMatch string, watchlistUpdateWatchlistToken string) (*watchlist.UpdateWatchlistPayload, error) {
+	var err error
+	var body UpdateWatchlistRequestBody
+	{
+		err = json.Unmarshal([]byte(watchlistUpdateWatchlistBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"ek2\",\n      \"position\": 7197216116523730459\n   }'")
+		}
+		if body.Name != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.name", *body.Name, "\\S"))
+		}
+		if body.Name != nil {
+			if utf8.RuneCountInString(*body.Name) > 100 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 100, false))
+			}
+		}
+		if body.Position != nil {
+			if *body.Position < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.position", *body.Position, 0, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = watchlistUpdateWatchlistID
+	}
+	var ifMatch *string
+	{
+		if watchlistUpdateWatchlistIfMatch != "" {
+			ifMatch = &watchlistUpdateWatchlistIfMatch
+		}
+	}
+	var token *string
+	{
+		if watchlistUpdateWatchlistToken != "" {
+			token = &watchlistUpdateWatchlistToken
+		}
+	}
+	v := &watchlist.UpdateWatchlistPayload{
+		Name:     body.Name,
+		Position: body.Position,
+	}
+	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeleteWatchlistPayload builds the payload for the watchlist
+// delete_watchlist endpoint from CLI flags.
+func BuildDeleteWatchlistPayload(watchlistDeleteWatchlistID string, watchlistDeleteWatchlistIfMatch string, watchlistDeleteWatchlistToken string) (*watchlist.DeleteWatchlistPayload, error) {
+	var id string
+	{
+		id = watchlistDeleteWatchlistID
+	}
+	var ifMatch *string
+	{
+		if watchlistDeleteWatchlistIfMatch != "" {
+			ifMatch = &watchlistDeleteWatchlistIfMatch
+		}
+	}
+	var token *string
+	{
+		if watchlistDeleteWatchlistToken != "" {
+			token = &watchlistDeleteWatchlistToken
+		}
+	}
+	v := &watchlist.DeleteWatchlistPayload{}
+	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListItemsPayload builds the payload for the watchlist list_items
+// endpoint from CLI flags.
+func BuildListItemsPayload(watchlistListItemsID string, watchlistListItemsIfNoneMatch string, watchlistListItemsToken string) (*watchlist.ListItemsPayload, error) {
+	var id string
+	{
+		id = watchlistListItemsID
+	}
+	var ifNoneMatch *string
+	{
+		if watchlistListItemsIfNoneMatch != "" {
+			ifNoneMatch = &watchlistListItemsIfNoneMatch
+		}
+	}
+	var token *string
+	{
+		if watchlistListItemsToken != "" {
+			token = &watchlistListItemsToken
+		}
+	}
+	v := &watchlist.ListItemsPayload{}
+	v.ID = id
+	v.IfNoneMatch = ifNoneMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildAddItemPayload builds the payload for the watchlist add_item endpoint
+// from CLI flags.
+func BuildAddItemPayload(watchlistAddItemBody string, watchlistAddItemID string, watchlistAddItemIfMatch string, watchlistAddItemToken string) (*watchlist.AddItemPayload, error) {
+	var err error
+	var body AddItemRequestBody
+	{
+		err = json.Unmarshal([]byte(watchlistAddItemBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"buy_target\": 0.0952479861125196,\n      \"note\": \"w8g\",\n      \"on_hand\": false,\n      \"sell_target\": 0.882507227633225,\n      \"symbol\": \"Voluptatem ut magnam consequatur eum tenetur ducimus.\",\n      \"tags\": [\n         \"wcq\",\n         \"m7x\",\n         \"bu4\"\n      ]\n   }'")
+		}
+		if body.Note != nil {
+			if utf8.RuneCountInString(*body.Note) > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.note", *body.Note, utf8.RuneCountInString(*body.Note), 1000, false))
+			}
+		}
+		if len(body.Tags) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 20, false))
+		}
+		for _, e := range body.Tags {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.tags[*]", e, "\\S"))
+			if utf8.RuneCountInString(e) > 50 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags[*]", e, utf8.RuneCountInString(e), 50, false))
+			}
+		}
+		if body.BuyTarget != nil {
+			if *body.BuyTarget < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.buy_target", *body.BuyTarget, 0, true))
+			}
+		}
+		if body.SellTarget != nil {
+			if *body.SellTarget < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.sell_target", *body.SellTarget, 0, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = watchlistAddItemID
+	}
+	var ifMatch *string
+	{
+		if watchlistAddItemIfMatch != "" {
+			ifMatch = &watchlistAddItemIfMatch
+		}
+	}
+	var token *string
+	{
+		if watchlistAddItemToken != "" {
+			token = &watchlistAddItemToken
+		}
+	}
+	v := &watchlist.AddItemPayload{
+		Symbol:     body.Symbol,
+		OnHand:     body.OnHand,
+		Note:       body.Note,
+		BuyTarget:  body.BuyTarget,
+		SellTarget: body.SellTarget,
+	}
+	if body.Tags != nil {
+		v.Tags = make([]string, len(body.Tags))
+		for i, val := range body.Tags {
+			v.Tags[i] = val
+		}
+	}
+	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUpdateItemPayload builds the payload for the watchlist update_item
+// endpoint from CLI flags.
+func BuildUpdateItemPayload(watchlistUpdateItemBody string, watchlistUpdateItemID string, watchlistUpdateItemSymbol string, watchlistUpdateItemIfMatch string, watchlistUpdateItemToken string) (*watchlist.UpdateItemPayload, error) {
+	var err error
+	var body UpdateItemRequestBody
+	{
+		err = json.Unmarshal([]byte(watchlistUpdateItemBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"buy_target\": 0.987705789726656,\n      \"note\": \"pl0\",\n      \"on_hand\": false,\n      \"position\": 2080565751603999241,\n      \"sell_target\": 0.9183921200074799,\n      \"tags\": [\n         \"wcj\",\n         \"vrg\",\n         \"2hb\"\n      ]\n   }'")
+		}
+		if body.Note != nil {
+			if utf8.RuneCountInString(*body.Note) > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.note", *body.Note, utf8.RuneCountInString(*body.Note), 1000, false))
+			}
+		}
+		if len(body.Tags) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 20, false))
+		}
+		for _, e := range body.Tags {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.tags[*]", e, "\\S"))
+			if utf8.RuneCountInString(e) > 50 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags[*]", e, utf8.RuneCountInString(e), 50, false))
+			}
+		}
+		if body.BuyTarget != nil {
+			if *body.BuyTarget < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.buy_target", *body.BuyTarget, 0, true))
+			}
+		}
+		if body.SellTarget != nil {
+			if *body.SellTarget < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.sell_target", *body.SellTarget, 0, true))
+			}
+		}
+		if body.Position != nil {
+			if *body.Position < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.position", *body.Position, 0, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = watchlistUpdateItemID
+	}
+	var symbol string
+	{
+		symbol = watchlistUpdateItemSymbol
+	}
+	var ifMatch *string
+	{
+		if watchlistUpdateItemIfMatch != "" {
+			ifMatch = &watchlistUpdateItemIfMatch
+		}
+	}
+	var token *string
+	{
+		if watchlistUpdateItemToken != "" {
+			token = &watchlistUpdateItemToken
+		}
+	}
+	v := &watchlist.UpdateItemPayload{
+		OnHand:     body.OnHand,
+		Note:       body.Note,
+		BuyTarget:  body.BuyTarget,
+		SellTarget: body.SellTarget,
+		Position:   body.Position,
+	}
+	if body.Tags != nil {
+		v.Tags = make([]string, len(body.Tags))
+		for i, val := range body.Tags {
+			v.Tags[i] = val
+		}
+	}
+	v.ID = id
+	v.Symbol = symbol
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRemoveItemPayload builds the payload for the watchlist remove_item
+// endpoint from CLI flags.
+func BuildRemoveItemPayload(watchlistRemoveItemID string, watchlistRemoveItemSymbol string, watchlistRemoveItemIfMatch string, watchlistRemoveItemToken string) (*watchlist.RemoveItemPayload, error) {
+	var id string
+	{
+		id = watchlistRemoveItemID
+	}
+	var symbol string
+	{
+		symbol = watchlistRemoveItemSymbol
+	}
+	var ifMatch *string
+	{
+		if watchlistRemoveItemIfMatch != "" {
+			ifMatch = &watchlistRemoveItemIfMatch
+		}
+	}
+	var token *string
+	{
+		if watchlistRemoveItemToken != "" {
+			token = &watchlistRemoveItemToken
+		}
+	}
+	v := &watchlist.RemoveItemPayload{}
+	v.ID = id
+	v.Symbol = symbol
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListSharesPayload builds the payload for the watchlist list_shares
+// endpoint from CLI flags.
+func BuildListSharesPayload(watchlistListSharesID string, watchlistListSharesToken string) (*watchlist.ListSharesPayload, error) {
+	var id string
+	{
+		id = watchlistListSharesID
+	}
+	var token *string
+	{
+		if watchlistListSharesToken != "" {
+			token = &watchlistListSharesToken
+		}
+	}
+	v := &watchlist.ListSharesPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildShareWatchlistPayload builds the payload for the watchlist
+// share_watchlist endpoint from CLI flags.
+func BuildShareWatchlistPayload(watchlistShareWatchlistBody string, watchlistShareWatchlistID string, watchlistShareWatchlistUser string, watchlistShareWatchlistToken string) (*watchlist.ShareWatchlistPayload, error) {
+	var err error
+	var body ShareWatchlistRequestBody
+	{
+		err = json.Unmarshal([]byte(watchlistShareWatchlistBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"role\": \"editor\"\n   }'")
+		}
+		if !(body.Role == "editor" || body.Role == "viewer") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", body.Role, []any{"editor", "viewer"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = watchlistShareWatchlistID
+	}
+	var user string
+	{
+		user = watchlistShareWatchlistUser
+	}
+	var token *string
+	{
+		if watchlistShareWatchlistToken != "" {
+			token = &watchlistShareWatchlistToken
+		}
+	}
+	v := &watchlist.ShareWatchlistPayload{
+		Role: body.Role,
+	}
+	v.ID = id
+	v.User = user
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUnshareWatchlistPayload builds the payload for the watchlist
+// unshare_watchlist endpoint from CLI flags.
+func BuildUnshareWatchlistPayload(watchlistUnshareWatchlistID string, watchlistUnshareWatchlistUser string, watchlistUnshareWatchlistToken string) (*watchlist.UnshareWatchlistPayload, error) {
+	var id string
+	{
+		id = watchlistUnshareWatchlistID
+	}
+	var user string
+	{
+		user = watchlistUnshareWatchlistUser
+	}
+	var token *string
+	{
+		if watchlistUnshareWatchlistToken != "" {
+			token = &watchlistUnshareWatchlistToken
+		}
+	}
+	v := &watchlist.UnshareWatchlistPayload{}
+	v.ID = id
+	v.User = user
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildPublishWatchlistPayload builds the payload for the watchlist
+// publish_watchlist endpoint from CLI flags.
+func BuildPublishWatchlistPayload(watchlistPublishWatchlistID string, watchlistPublishWatchlistToken string) (*watchlist.PublishWatchlistPayload, error) {
+	var id string
+	{
+		id = watchlistPublishWatchlistID
+	}
+	var token *string
+	{
+		if watchlistPublishWatchlistToken != "" {
+			token = &watchlistPublishWatchlistToken
+		}
+	}
+	v := &watchlist.PublishWatchlistPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUnpublishWatchlistPayload builds the payload for the watchlist
+// unpublish_watchlist endpoint from CLI flags.
+func BuildUnpublishWatchlistPayload(watchlistUnpublishWatchlistID string, watchlistUnpublishWatchlistToken string) (*watchlist.UnpublishWatchlistPayload, error) {
+	var id string
+	{
+		id = watchlistUnpublishWatchlistID
+	}
+	var token *string
+	{
+		if watchlistUnpublishWatchlistToken != "" {
+			token = &watchlistUnpublishWatchlistToken
+		}
+	}
+	v := &watchlist.UnpublishWatchlistPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetPublicWatchlistPayload builds the payload for the watchlist
+// get_public_watchlist endpoint from CLI flags.
+func BuildGetPublicWatchlistPayload(watchlistGetPublicWatchlistToken string) (*watchlist.GetPublicWatchlistPayload, error) {
+	var token string
+	{
+		token = watchlistGetPublicWatchlistToken
+	}
+	v := &watchlist.GetPublicWatchlistPayload{}
+	v.Token = token
 
 	return v, nil
 }
@@ -1,16 +1,19 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // watchlist client HTTP transport
 //
 // Command:
-// $ goa gen github.com/reidlai/ta-workspace/modules/watchlist/go/design
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
 
 package client
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
+	watchlist "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -23,9 +26,101 @@ type Client struct {
 	// Add Doer is the HTTP client used to make requests to the add endpoint.
 	AddDoer goahttp.Doer
 
+	// Update Doer is the HTTP client used to make requests to the update endpoint.
+	UpdateDoer goahttp.Doer
+
 	// Remove Doer is the HTTP client used to make requests to the remove endpoint.
 	RemoveDoer goahttp.Doer
 
+	// ListRemoved Doer is the HTTP client used to make requests to the
+	// list_removed endpoint.
+	ListRemovedDoer goahttp.Doer
+
+	// Restore Doer is the HTTP client used to make requests to the restore
+	// endpoint.
+	RestoreDoer goahttp.Doer
+
+	// Events Doer is the HTTP client used to make requests to the events endpoint.
+	EventsDoer goahttp.Doer
+
+	// BulkAdd Doer is the HTTP client used to make requests to the bulk_add
+	// endpoint.
+	BulkAddDoer goahttp.Doer
+
+	// BulkRemove Doer is the HTTP client used to make requests to the bulk_remove
+	// endpoint.
+	BulkRemoveDoer goahttp.Doer
+
+	// Import Doer is the HTTP client used to make requests to the import endpoint.
+	ImportDoer goahttp.Doer
+
+	// Export Doer is the HTTP client used to make requests to the export endpoint.
+	ExportDoer goahttp.Doer
+
+	// ListWatchlists Doer is the HTTP client used to make requests to the
+	// list_watchlists endpoint.
+	ListWatchlistsDoer goahttp.Doer
+
+	// ListSharedWatchlists Doer is the HTTP client used to make requests to the
+	// list_shared_watchlists endpoint.
+	ListSharedWatchlistsDoer goahttp.Doer
+
+	// CreateWatchlist Doer is the HTTP client used to make requests to the
+	// create_watchlist endpoint.
+	CreateWatchlistDoer goahttp.Doer
+
+	// GetWatchlist Doer is the HTTP client used to make requests to the
+	// get_watchlist endpoint.
+	GetWatchlistDoer goahttp.Doer
+
+	// UpdateWatchlist Doer is the HTTP client used to make requests to the
+	// update_watchlist endpoint.
+	UpdateWatchlistDoer goahttp.Doer
+
+	// DeleteWatchlist Doer is the HTTP client used to make requests to the
+	// delete_watchlist endpoint.
+	DeleteWatchlistDoer goahttp.Doer
+
+	// ListItems Doer is the HTTP client used to make requests to the list_items
+	// endpoint.
+	ListItemsDoer goahttp.Doer
+
+	// AddItem Doer is the HTTP client used to make requests to the add_item
+	// endpoint.
+	AddItemDoer goahttp.Doer
+
+	// UpdateItem Doer is the HTTP client used to make requests to the update_item
+	// endpoint.
+	UpdateItemDoer goahttp.Doer
+
+	// RemoveItem Doer is the HTTP client used to make requests to the remove_item
+	// endpoint.
+	RemoveItemDoer goahttp.Doer
+
+	// ListShares Doer is the HTTP client used to make requests to the list_shares
+	// endpoint.
+	ListSharesDoer goahttp.Doer
+
+	// ShareWatchlist Doer is the HTTP client used to make requests to the
+	// share_watchlist endpoint.
+	ShareWatchlistDoer goahttp.Doer
+
+	// UnshareWatchlist Doer is the HTTP client used to make requests to the
+	// unshare_watchlist endpoint.
+	UnshareWatchlistDoer goahttp.Doer
+
+	// PublishWatchlist Doer is the HTTP client used to make requests to the
+	// publish_watchlist endpoint.
+	PublishWatchlistDoer goahttp.Doer
+
+	// UnpublishWatchlist Doer is the HTTP client used to make requests to the
+	// unpublish_watchlist endpoint.
+	UnpublishWatchlistDoer goahttp.Doer
+
+	// GetPublicWatchlist Doer is the HTTP client used to make requests to the
+	// get_public_watchlist endpoint.
+	GetPublicWatchlistDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -46,14 +141,38 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		ListDoer:            doer,
-		AddDoer:             doer,
-		RemoveDoer:          doer,
-		RestoreResponseBody: restoreBody,
-		scheme:              scheme,
-		host:                host,
-		decoder:             dec,
-		encoder:             enc,
+		ListDoer:                 doer,
+		AddDoer:                  doer,
+		UpdateDoer:               doer,
+		RemoveDoer:               doer,
+		ListRemovedDoer:          doer,
+		RestoreDoer:              doer,
+		EventsDoer:               doer,
+		BulkAddDoer:              doer,
+		BulkRemoveDoer:           doer,
+		ImportDoer:               doer,
+		ExportDoer:               doer,
+		ListWatchlistsDoer:       doer,
+		ListSharedWatchlistsDoer: doer,
+		CreateWatchlistDoer:      doer,
+		GetWatchlistDoer:         doer,
+		UpdateWatchlistDoer:      doer,
+		DeleteWatchlistDoer:      doer,
+		ListItemsDoer:            doer,
+		AddItemDoer:              doer,
+		UpdateItemDoer:           doer,
+		RemoveItemDoer:           doer,
+		ListSharesDoer:           doer,
+		ShareWatchlistDoer:       doer,
+		UnshareWatchlistDoer:     doer,
+		PublishWatchlistDoer:     doer,
+		UnpublishWatchlistDoer:   doer,
+		GetPublicWatchlistDoer:   doer,
+		RestoreResponseBody:      restoreBody,
+		scheme:                   scheme,
+		host:                     host,
+		decoder:                  dec,
+		encoder:                  enc,
 	}
 }
 
@@ -105,6 +224,30 @@ func (c *Client) Add() goa.Endpoint {
 	}
 }
 
+// Update returns an endpoint that makes HTTP requests to the watchlist service
+// update server.
+func (c *Client) Update() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateRequest(c.encoder)
+		decodeResponse = DecodeUpdateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "update", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // Remove returns an endpoint that makes HTTP requests to the watchlist service
 // remove server.
 func (c *Client) Remove() goa.Endpoint {
@@ -128,3 +271,567 @@ func (c *Client) Remove() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// ListRemoved returns an endpoint that makes HTTP requests to the watchlist
+// service list_removed server.
+func (c *Client) ListRemoved() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListRemovedRequest(c.encoder)
+		decodeResponse = DecodeListRemovedResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListRemovedRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListRemovedDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "list_removed", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Restore returns an endpoint that makes HTTP requests to the watchlist
+// service restore server.
+func (c *Client) Restore() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRestoreRequest(c.encoder)
+		decodeResponse = DecodeRestoreResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRestoreRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RestoreDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "restore", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Events returns an endpoint that makes HTTP requests to the watchlist service
+// events server.
+func (c *Client) Events() goa.Endpoint {
+	var (
+		encodeRequest = EncodeEventsRequest(c.encoder)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildEventsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		// For SSE endpoints, connect and return a stream
+		resp, err := c.EventsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "events", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected status from SSE endpoint: %d", resp.StatusCode)
+		}
+
+		contentType := resp.Header.Get("Content-Type")
+		if contentType != "" && !strings.HasPrefix(contentType, "text/event-stream") {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected content type: %s (expected text/event-stream)", contentType)
+		}
+
+		return NewEventsStream(resp, c.decoder), nil
+	}
+}
+
+// BulkAdd returns an endpoint that makes HTTP requests to the watchlist
+// service bulk_add server.
+func (c *Client) BulkAdd() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBulkAddRequest(c.encoder)
+		decodeResponse = DecodeBulkAddResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBulkAddRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BulkAddDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "bulk_add", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// BulkRemove returns an endpoint that makes HTTP requests to the watchlist
+// service bulk_remove server.
+func (c *Client) BulkRemove() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBulkRemoveRequest(c.encoder)
+		decodeResponse = DecodeBulkRemoveResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBulkRemoveRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BulkRemoveDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "bulk_remove", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Import returns an endpoint that makes HTTP requests to the watchlist service
+// import server.
+func (c *Client) Import() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeImportRequest(c.encoder)
+		decodeResponse = DecodeImportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildImportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ImportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "import", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Export returns an endpoint that makes HTTP requests to the watchlist service
+// export server.
+func (c *Client) Export() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeExportRequest(c.encoder)
+		decodeResponse = DecodeExportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildExportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ExportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "export", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &watchlist.ExportResponseData{Result: res.(*watchlist.ExportResult), Body: resp.Body}, nil
+	}
+}
+
+// ListWatchlists returns an endpoint that makes HTTP requests to the watchlist
+// service list_watchlists server.
+func (c *Client) ListWatchlists() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWatchlistsRequest(c.encoder)
+		decodeResponse = DecodeListWatchlistsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWatchlistsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWatchlistsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "list_watchlists", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListSharedWatchlists returns an endpoint that makes HTTP requests to the
+// watchlist service list_shared_watchlists server.
+func (c *Client) ListSharedWatchlists() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListSharedWatchlistsRequest(c.encoder)
+		decodeResponse = DecodeListSharedWatchlistsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListSharedWatchlistsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListSharedWatchlistsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "list_shared_watchlists", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateWatchlist returns an endpoint that makes HTTP requests to the
+// watchlist service create_watchlist server.
+func (c *Client) CreateWatchlist() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateWatchlistRequest(c.encoder)
+		decodeResponse = DecodeCreateWatchlistResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateWatchlistRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateWatchlistDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "create_watchlist", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetWatchlist returns an endpoint that makes HTTP requests to the watchlist
+// service get_watchlist server.
+func (c *Client) GetWatchlist() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetWatchlistRequest(c.encoder)
+		decodeResponse = DecodeGetWatchlistResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetWatchlistRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetWatchlistDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "get_watchlist", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateWatchlist returns an endpoint that makes HTTP requests to the
+// watchlist service update_watchlist server.
+func (c *Client) UpdateWatchlist() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateWatchlistRequest(c.encoder)
+		decodeResponse = DecodeUpdateWatchlistResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateWatchlistRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateWatchlistDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "update_watchlist", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteWatchlist returns an endpoint that makes HTTP requests to the
+// watchlist service delete_watchlist server.
+func (c *Client) DeleteWatchlist() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteWatchlistRequest(c.encoder)
+		decodeResponse = DecodeDeleteWatchlistResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteWatchlistRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteWatchlistDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "delete_watchlist", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListItems returns an endpoint that makes HTTP requests to the watchlist
+// service list_items server.
+func (c *Client) ListItems() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListItemsRequest(c.encoder)
+		decodeResponse = DecodeListItemsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListItemsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListItemsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "list_items", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AddItem returns an endpoint that makes HTTP requests to the watchlist
+// service add_item server.
+func (c *Client) AddItem() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAddItemRequest(c.encoder)
+		decodeResponse = DecodeAddItemResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAddItemRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AddItemDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "add_item", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateItem returns an endpoint that makes HTTP requests to the watchlist
+// service update_item server.
+func (c *Client) UpdateItem() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateItemRequest(c.encoder)
+		decodeResponse = DecodeUpdateItemResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateItemRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateItemDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "update_item", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RemoveItem returns an endpoint that makes HTTP requests to the watchlist
+// service remove_item server.
+func (c *Client) RemoveItem() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRemoveItemRequest(c.encoder)
+		decodeResponse = DecodeRemoveItemResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRemoveItemRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RemoveItemDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "remove_item", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListShares returns an endpoint that makes HTTP requests to the watchlist
+// service list_shares server.
+func (c *Client) ListShares() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListSharesRequest(c.encoder)
+		decodeResponse = DecodeListSharesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListSharesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListSharesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "list_shares", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ShareWatchlist returns an endpoint that makes HTTP requests to the watchlist
+// service share_watchlist server.
+func (c *Client) ShareWatchlist() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeShareWatchlistRequest(c.encoder)
+		decodeResponse = DecodeShareWatchlistResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildShareWatchlistRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ShareWatchlistDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "share_watchlist", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UnshareWatchlist returns an endpoint that makes HTTP requests to the
+// watchlist service unshare_watchlist server.
+func (c *Client) UnshareWatchlist() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUnshareWatchlistRequest(c.encoder)
+		decodeResponse = DecodeUnshareWatchlistResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUnshareWatchlistRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UnshareWatchlistDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "unshare_watchlist", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PublishWatchlist returns an endpoint that makes HTTP requests to the
+// watchlist service publish_watchlist server.
+func (c *Client) PublishWatchlist() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePublishWatchlistRequest(c.encoder)
+		decodeResponse = DecodePublishWatchlistResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPublishWatchlistRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PublishWatchlistDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "publish_watchlist", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UnpublishWatchlist returns an endpoint that makes HTTP requests to the
+// watchlist service unpublish_watchlist server.
+func (c *Client) UnpublishWatchlist() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUnpublishWatchlistRequest(c.encoder)
+		decodeResponse = DecodeUnpublishWatchlistResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUnpublishWatchlistRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UnpublishWatchlistDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "unpublish_watchlist", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetPublicWatchlist returns an endpoint that makes HTTP requests to the
+// watchlist service get_public_watchlist server.
+func (c *Client) GetPublicWatchlist() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetPublicWatchlistResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetPublicWatchlistRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetPublicWatchlistDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("watchlist", "get_public_watchlist", err)
+		}
+		return decodeResponse(resp)
+	}
+}
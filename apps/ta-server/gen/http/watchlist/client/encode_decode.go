@@ -1,18 +1,21 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // watchlist HTTP client encoders and decoders
 //
 // Command:
-// $ goa gen github.com/reidlai/ta-workspace/modules/watchlist/go/design
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
 
 package client
 
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"strings"
 
 	watchlist "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
 	goahttp "goa.design/goa/v3/http"
@@ -42,9 +45,17 @@ func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.R
 		if !ok {
 			return goahttp.ErrInvalidType("watchlist", "list", "*watchlist.ListPayload", v)
 		}
-		{
-			head := p.UserID
-			req.Header.Set("X-User-ID", head)
+		if p.IfNoneMatch != nil {
+			head := *p.IfNoneMatch
+			req.Header.Set("If-None-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
 		}
 		return nil
 	}
@@ -53,6 +64,13 @@ func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.R
 // DecodeListResponse returns a decoder for responses returned by the watchlist
 // list endpoint. restoreBody controls whether the response body should be
 // restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
 func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -68,6 +86,23 @@ func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBod
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
+		case http.StatusNotModified:
+			var (
+				etag string
+				err  error
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list", err)
+			}
+			res := NewListTickerItemsResultNotModified(etag)
+			tmp := "not_modified"
+			res.Outcome = &tmp
+			return res, nil
 		case http.StatusOK:
 			var (
 				body ListResponseBody
@@ -79,7 +114,7 @@ func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBod
 			}
 			for _, e := range body {
 				if e != nil {
-					if err2 := ValidateTickerItemResponse(e); err2 != nil {
+					if err2 := ValidateTickerItem(e); err2 != nil {
 						err = goa.MergeErrors(err, err2)
 					}
 				}
@@ -87,8 +122,89 @@ func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBod
 			if err != nil {
 				return nil, goahttp.ErrValidationError("watchlist", "list", err)
 			}
-			res := NewListTickerItemOK(body)
+			var (
+				etag string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list", err)
+			}
+			res := NewListTickerItemsResultOK(body, etag)
 			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list", err)
+			}
+			err = ValidateListUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list", err)
+			}
+			return nil, NewListUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body ListNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list", err)
+			}
+			err = ValidateListNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list", err)
+			}
+			return nil, NewListNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body ListForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list", err)
+			}
+			err = ValidateListForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list", err)
+			}
+			return nil, NewListForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body ListConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list", err)
+			}
+			err = ValidateListConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list", err)
+			}
+			return nil, NewListConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body ListPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list", err)
+			}
+			err = ValidateListPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list", err)
+			}
+			return nil, NewListPreconditionFailed(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("watchlist", "list", resp.StatusCode, string(body))
@@ -119,9 +235,17 @@ func EncodeAddRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Re
 		if !ok {
 			return goahttp.ErrInvalidType("watchlist", "add", "*watchlist.AddPayload", v)
 		}
-		{
-			head := p.UserID
-			req.Header.Set("X-User-ID", head)
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
 		}
 		body := NewAddRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
@@ -134,6 +258,14 @@ func EncodeAddRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Re
 // DecodeAddResponse returns a decoder for responses returned by the watchlist
 // add endpoint. restoreBody controls whether the response body should be
 // restored after having been read.
+// DecodeAddResponse may return the following errors:
+//   - "invalid_symbol" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
 func DecodeAddResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -164,6 +296,90 @@ func DecodeAddResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody
 			}
 			res := NewAddTickerItemOK(&body)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body AddInvalidSymbolResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "add", err)
+			}
+			err = ValidateAddInvalidSymbolResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "add", err)
+			}
+			return nil, NewAddInvalidSymbol(&body)
+		case http.StatusUnauthorized:
+			var (
+				body AddUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "add", err)
+			}
+			err = ValidateAddUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "add", err)
+			}
+			return nil, NewAddUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body AddNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "add", err)
+			}
+			err = ValidateAddNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "add", err)
+			}
+			return nil, NewAddNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body AddForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "add", err)
+			}
+			err = ValidateAddForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "add", err)
+			}
+			return nil, NewAddForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body AddConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "add", err)
+			}
+			err = ValidateAddConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "add", err)
+			}
+			return nil, NewAddConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body AddPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "add", err)
+			}
+			err = ValidateAddPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "add", err)
+			}
+			return nil, NewAddPreconditionFailed(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("watchlist", "add", resp.StatusCode, string(body))
@@ -171,6 +387,176 @@ func DecodeAddResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody
 	}
 }
 
+// BuildUpdateRequest instantiates a HTTP request object with method and path
+// set to call the "watchlist" service "update" endpoint
+func (c *Client) BuildUpdateRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		symbol string
+	)
+	{
+		p, ok := v.(*watchlist.UpdatePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "update", "*watchlist.UpdatePayload", v)
+		}
+		symbol = p.Symbol
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateWatchlistPath(symbol)}
+	req, err := http.NewRequest("PATCH", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "update", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateRequest returns an encoder for requests sent to the watchlist
+// update server.
+func EncodeUpdateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.UpdatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "update", "*watchlist.UpdatePayload", v)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("watchlist", "update", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateResponse returns a decoder for responses returned by the
+// watchlist update endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeUpdateResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update", err)
+			}
+			err = ValidateUpdateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update", err)
+			}
+			res := NewUpdateTickerItemOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body UpdateUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update", err)
+			}
+			err = ValidateUpdateUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update", err)
+			}
+			return nil, NewUpdateUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update", err)
+			}
+			err = ValidateUpdateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update", err)
+			}
+			return nil, NewUpdateNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update", err)
+			}
+			err = ValidateUpdateForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update", err)
+			}
+			return nil, NewUpdateForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update", err)
+			}
+			err = ValidateUpdateConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update", err)
+			}
+			return nil, NewUpdateConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body UpdatePreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update", err)
+			}
+			err = ValidateUpdatePreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update", err)
+			}
+			return nil, NewUpdatePreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "update", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildRemoveRequest instantiates a HTTP request object with method and path
 // set to call the "watchlist" service "remove" endpoint
 func (c *Client) BuildRemoveRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -204,9 +590,17 @@ func EncodeRemoveRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 		if !ok {
 			return goahttp.ErrInvalidType("watchlist", "remove", "*watchlist.RemovePayload", v)
 		}
-		{
-			head := p.UserID
-			req.Header.Set("X-User-ID", head)
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
 		}
 		return nil
 	}
@@ -215,6 +609,13 @@ func EncodeRemoveRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 // DecodeRemoveResponse returns a decoder for responses returned by the
 // watchlist remove endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
+// DecodeRemoveResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
 func DecodeRemoveResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -232,20 +633,4101 @@ func DecodeRemoveResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 		switch resp.StatusCode {
 		case http.StatusNoContent:
 			return nil, nil
-		default:
-			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("watchlist", "remove", resp.StatusCode, string(body))
-		}
-	}
-}
-
-// unmarshalTickerItemResponseToWatchlistTickerItem builds a value of type
-// *watchlist.TickerItem from a value of type *TickerItemResponse.
-func unmarshalTickerItemResponseToWatchlistTickerItem(v *TickerItemResponse) *watchlist.TickerItem {
-	res := &watchlist.TickerItem{
-		Symbol:    *v.Symbol,
-		OnHand:    *v.OnHand,
-		CreatedAt: v.CreatedAt,
+		case http.StatusUnauthorized:
+			var (
+				body RemoveUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "remove", err)
+			}
+			err = ValidateRemoveUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "remove", err)
+			}
+			return nil, NewRemoveUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body RemoveNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "remove", err)
+			}
+			err = ValidateRemoveNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "remove", err)
+			}
+			return nil, NewRemoveNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body RemoveForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "remove", err)
+			}
+			err = ValidateRemoveForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "remove", err)
+			}
+			return nil, NewRemoveForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body RemoveConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "remove", err)
+			}
+			err = ValidateRemoveConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "remove", err)
+			}
+			return nil, NewRemoveConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body RemovePreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "remove", err)
+			}
+			err = ValidateRemovePreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "remove", err)
+			}
+			return nil, NewRemovePreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "remove", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListRemovedRequest instantiates a HTTP request object with method and
+// path set to call the "watchlist" service "list_removed" endpoint
+func (c *Client) BuildListRemovedRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListRemovedWatchlistPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "list_removed", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRemovedRequest returns an encoder for requests sent to the
+// watchlist list_removed server.
+func EncodeListRemovedRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.ListRemovedPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "list_removed", "*watchlist.ListRemovedPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Watchlist != nil {
+			values.Add("watchlist", *p.Watchlist)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListRemovedResponse returns a decoder for responses returned by the
+// watchlist list_removed endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListRemovedResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeListRemovedResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListRemovedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_removed", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateRemovedTickerItemResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_removed", err)
+			}
+			res := NewListRemovedRemovedTickerItemOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListRemovedUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_removed", err)
+			}
+			err = ValidateListRemovedUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_removed", err)
+			}
+			return nil, NewListRemovedUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body ListRemovedNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_removed", err)
+			}
+			err = ValidateListRemovedNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_removed", err)
+			}
+			return nil, NewListRemovedNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body ListRemovedForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_removed", err)
+			}
+			err = ValidateListRemovedForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_removed", err)
+			}
+			return nil, NewListRemovedForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body ListRemovedConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_removed", err)
+			}
+			err = ValidateListRemovedConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_removed", err)
+			}
+			return nil, NewListRemovedConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body ListRemovedPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_removed", err)
+			}
+			err = ValidateListRemovedPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_removed", err)
+			}
+			return nil, NewListRemovedPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "list_removed", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRestoreRequest instantiates a HTTP request object with method and path
+// set to call the "watchlist" service "restore" endpoint
+func (c *Client) BuildRestoreRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		symbol string
+	)
+	{
+		p, ok := v.(*watchlist.RestorePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "restore", "*watchlist.RestorePayload", v)
+		}
+		symbol = p.Symbol
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RestoreWatchlistPath(symbol)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "restore", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRestoreRequest returns an encoder for requests sent to the watchlist
+// restore server.
+func EncodeRestoreRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.RestorePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "restore", "*watchlist.RestorePayload", v)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Watchlist != nil {
+			values.Add("watchlist", *p.Watchlist)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeRestoreResponse returns a decoder for responses returned by the
+// watchlist restore endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeRestoreResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeRestoreResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RestoreResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "restore", err)
+			}
+			err = ValidateRestoreResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "restore", err)
+			}
+			res := NewRestoreTickerItemOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body RestoreUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "restore", err)
+			}
+			err = ValidateRestoreUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "restore", err)
+			}
+			return nil, NewRestoreUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body RestoreNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "restore", err)
+			}
+			err = ValidateRestoreNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "restore", err)
+			}
+			return nil, NewRestoreNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body RestoreForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "restore", err)
+			}
+			err = ValidateRestoreForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "restore", err)
+			}
+			return nil, NewRestoreForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body RestoreConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "restore", err)
+			}
+			err = ValidateRestoreConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "restore", err)
+			}
+			return nil, NewRestoreConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body RestorePreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "restore", err)
+			}
+			err = ValidateRestorePreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "restore", err)
+			}
+			return nil, NewRestorePreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "restore", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildEventsRequest instantiates a HTTP request object with method and path
+// set to call the "watchlist" service "events" endpoint
+func (c *Client) BuildEventsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: EventsWatchlistPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "events", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeEventsRequest returns an encoder for requests sent to the watchlist
+// events server.
+func EncodeEventsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.EventsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "events", "*watchlist.EventsPayload", v)
+		}
+		if p.LastEventID != nil {
+			head := *p.LastEventID
+			req.Header.Set("Last-Event-ID", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Watchlist != nil {
+			values.Add("watchlist", *p.Watchlist)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeEventsResponse returns a decoder for responses returned by the
+// watchlist events endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeEventsResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeEventsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body EventsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "events", err)
+			}
+			err = ValidateEventsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "events", err)
+			}
+			res := NewEventsWatchlistEventOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body EventsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "events", err)
+			}
+			err = ValidateEventsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "events", err)
+			}
+			return nil, NewEventsUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body EventsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "events", err)
+			}
+			err = ValidateEventsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "events", err)
+			}
+			return nil, NewEventsNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body EventsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "events", err)
+			}
+			err = ValidateEventsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "events", err)
+			}
+			return nil, NewEventsForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body EventsConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "events", err)
+			}
+			err = ValidateEventsConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "events", err)
+			}
+			return nil, NewEventsConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body EventsPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "events", err)
+			}
+			err = ValidateEventsPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "events", err)
+			}
+			return nil, NewEventsPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "events", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBulkAddRequest instantiates a HTTP request object with method and path
+// set to call the "watchlist" service "bulk_add" endpoint
+func (c *Client) BuildBulkAddRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BulkAddWatchlistPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "bulk_add", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBulkAddRequest returns an encoder for requests sent to the watchlist
+// bulk_add server.
+func EncodeBulkAddRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.BulkAddPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "bulk_add", "*watchlist.BulkAddPayload", v)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Watchlist != nil {
+			values.Add("watchlist", *p.Watchlist)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewBulkAddRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("watchlist", "bulk_add", err)
+		}
+		return nil
+	}
+}
+
+// DecodeBulkAddResponse returns a decoder for responses returned by the
+// watchlist bulk_add endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeBulkAddResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeBulkAddResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BulkAddResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "bulk_add", err)
+			}
+			err = ValidateBulkAddResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "bulk_add", err)
+			}
+			res := NewBulkAddWatchlistBulkResultOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body BulkAddUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "bulk_add", err)
+			}
+			err = ValidateBulkAddUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "bulk_add", err)
+			}
+			return nil, NewBulkAddUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body BulkAddNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "bulk_add", err)
+			}
+			err = ValidateBulkAddNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "bulk_add", err)
+			}
+			return nil, NewBulkAddNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body BulkAddForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "bulk_add", err)
+			}
+			err = ValidateBulkAddForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "bulk_add", err)
+			}
+			return nil, NewBulkAddForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body BulkAddConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "bulk_add", err)
+			}
+			err = ValidateBulkAddConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "bulk_add", err)
+			}
+			return nil, NewBulkAddConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body BulkAddPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "bulk_add", err)
+			}
+			err = ValidateBulkAddPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "bulk_add", err)
+			}
+			return nil, NewBulkAddPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "bulk_add", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBulkRemoveRequest instantiates a HTTP request object with method and
+// path set to call the "watchlist" service "bulk_remove" endpoint
+func (c *Client) BuildBulkRemoveRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BulkRemoveWatchlistPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "bulk_remove", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBulkRemoveRequest returns an encoder for requests sent to the
+// watchlist bulk_remove server.
+func EncodeBulkRemoveRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.BulkRemovePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "bulk_remove", "*watchlist.BulkRemovePayload", v)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Watchlist != nil {
+			values.Add("watchlist", *p.Watchlist)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewBulkRemoveRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("watchlist", "bulk_remove", err)
+		}
+		return nil
+	}
+}
+
+// DecodeBulkRemoveResponse returns a decoder for responses returned by the
+// watchlist bulk_remove endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeBulkRemoveResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeBulkRemoveResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BulkRemoveResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "bulk_remove", err)
+			}
+			err = ValidateBulkRemoveResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "bulk_remove", err)
+			}
+			res := NewBulkRemoveWatchlistBulkResultOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body BulkRemoveUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "bulk_remove", err)
+			}
+			err = ValidateBulkRemoveUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "bulk_remove", err)
+			}
+			return nil, NewBulkRemoveUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body BulkRemoveNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "bulk_remove", err)
+			}
+			err = ValidateBulkRemoveNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "bulk_remove", err)
+			}
+			return nil, NewBulkRemoveNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body BulkRemoveForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "bulk_remove", err)
+			}
+			err = ValidateBulkRemoveForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "bulk_remove", err)
+			}
+			return nil, NewBulkRemoveForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body BulkRemoveConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "bulk_remove", err)
+			}
+			err = ValidateBulkRemoveConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "bulk_remove", err)
+			}
+			return nil, NewBulkRemoveConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body BulkRemovePreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "bulk_remove", err)
+			}
+			err = ValidateBulkRemovePreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "bulk_remove", err)
+			}
+			return nil, NewBulkRemovePreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "bulk_remove", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildImportRequest instantiates a HTTP request object with method and path
+// set to call the "watchlist" service "import" endpoint
+func (c *Client) BuildImportRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		body io.Reader
+	)
+	rd, ok := v.(*watchlist.ImportRequestData)
+	if !ok {
+		return nil, goahttp.ErrInvalidType("watchlist", "import", "watchlist.ImportRequestData", v)
+	}
+	body = rd.Body
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportWatchlistPath()}
+	req, err := http.NewRequest("POST", u.String(), body)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "import", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeImportRequest returns an encoder for requests sent to the watchlist
+// import server.
+func EncodeImportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		data, ok := v.(*watchlist.ImportRequestData)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "import", "*watchlist.ImportRequestData", v)
+		}
+		p := data.Payload
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Watchlist != nil {
+			values.Add("watchlist", *p.Watchlist)
+		}
+		if p.Format != nil {
+			values.Add("format", *p.Format)
+		}
+		values.Add("dry_run", fmt.Sprintf("%v", p.DryRun))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeImportResponse returns a decoder for responses returned by the
+// watchlist import endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeImportResponse may return the following errors:
+//   - "invalid_file" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeImportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ImportResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "import", err)
+			}
+			err = ValidateImportResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "import", err)
+			}
+			res := NewImportWatchlistImportReportOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ImportInvalidFileResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "import", err)
+			}
+			err = ValidateImportInvalidFileResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "import", err)
+			}
+			return nil, NewImportInvalidFile(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ImportUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "import", err)
+			}
+			err = ValidateImportUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "import", err)
+			}
+			return nil, NewImportUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body ImportNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "import", err)
+			}
+			err = ValidateImportNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "import", err)
+			}
+			return nil, NewImportNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body ImportForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "import", err)
+			}
+			err = ValidateImportForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "import", err)
+			}
+			return nil, NewImportForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body ImportConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "import", err)
+			}
+			err = ValidateImportConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "import", err)
+			}
+			return nil, NewImportConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body ImportPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "import", err)
+			}
+			err = ValidateImportPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "import", err)
+			}
+			return nil, NewImportPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "import", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildImportStreamPayload creates a streaming endpoint request payload from
+// the method payload and the path to the file to be streamed
+func BuildImportStreamPayload(payload any, fpath string) (*watchlist.ImportRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &watchlist.ImportRequestData{
+		Payload: payload.(*watchlist.ImportPayload),
+		Body:    f,
+	}, nil
+}
+
+// BuildExportRequest instantiates a HTTP request object with method and path
+// set to call the "watchlist" service "export" endpoint
+func (c *Client) BuildExportRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ExportWatchlistPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "export", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeExportRequest returns an encoder for requests sent to the watchlist
+// export server.
+func EncodeExportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.ExportPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "export", "*watchlist.ExportPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Watchlist != nil {
+			values.Add("watchlist", *p.Watchlist)
+		}
+		values.Add("format", p.Format)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeExportResponse returns a decoder for responses returned by the
+// watchlist export endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeExportResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeExportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType        string
+				contentDisposition string
+				err                error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "header"))
+			}
+			contentType = contentTypeRaw
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "export", err)
+			}
+			res := NewExportResultOK(contentType, contentDisposition)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ExportUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "export", err)
+			}
+			err = ValidateExportUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "export", err)
+			}
+			return nil, NewExportUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body ExportNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "export", err)
+			}
+			err = ValidateExportNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "export", err)
+			}
+			return nil, NewExportNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body ExportForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "export", err)
+			}
+			err = ValidateExportForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "export", err)
+			}
+			return nil, NewExportForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body ExportConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "export", err)
+			}
+			err = ValidateExportConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "export", err)
+			}
+			return nil, NewExportConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body ExportPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "export", err)
+			}
+			err = ValidateExportPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "export", err)
+			}
+			return nil, NewExportPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "export", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWatchlistsRequest instantiates a HTTP request object with method
+// and path set to call the "watchlist" service "list_watchlists" endpoint
+func (c *Client) BuildListWatchlistsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWatchlistsWatchlistPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "list_watchlists", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWatchlistsRequest returns an encoder for requests sent to the
+// watchlist list_watchlists server.
+func EncodeListWatchlistsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.ListWatchlistsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "list_watchlists", "*watchlist.ListWatchlistsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListWatchlistsResponse returns a decoder for responses returned by the
+// watchlist list_watchlists endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListWatchlistsResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeListWatchlistsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWatchlistsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_watchlists", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWatchlistResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_watchlists", err)
+			}
+			res := NewListWatchlistsWatchlistOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListWatchlistsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_watchlists", err)
+			}
+			err = ValidateListWatchlistsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_watchlists", err)
+			}
+			return nil, NewListWatchlistsUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body ListWatchlistsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_watchlists", err)
+			}
+			err = ValidateListWatchlistsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_watchlists", err)
+			}
+			return nil, NewListWatchlistsNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body ListWatchlistsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_watchlists", err)
+			}
+			err = ValidateListWatchlistsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_watchlists", err)
+			}
+			return nil, NewListWatchlistsForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body ListWatchlistsConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_watchlists", err)
+			}
+			err = ValidateListWatchlistsConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_watchlists", err)
+			}
+			return nil, NewListWatchlistsConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body ListWatchlistsPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_watchlists", err)
+			}
+			err = ValidateListWatchlistsPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_watchlists", err)
+			}
+			return nil, NewListWatchlistsPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "list_watchlists", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListSharedWatchlistsRequest instantiates a HTTP request object with
+// method and path set to call the "watchlist" service "list_shared_watchlists"
+// endpoint
+func (c *Client) BuildListSharedWatchlistsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListSharedWatchlistsWatchlistPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "list_shared_watchlists", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListSharedWatchlistsRequest returns an encoder for requests sent to
+// the watchlist list_shared_watchlists server.
+func EncodeListSharedWatchlistsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.ListSharedWatchlistsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "list_shared_watchlists", "*watchlist.ListSharedWatchlistsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListSharedWatchlistsResponse returns a decoder for responses returned
+// by the watchlist list_shared_watchlists endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeListSharedWatchlistsResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeListSharedWatchlistsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListSharedWatchlistsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_shared_watchlists", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWatchlistResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_shared_watchlists", err)
+			}
+			res := NewListSharedWatchlistsWatchlistOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListSharedWatchlistsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_shared_watchlists", err)
+			}
+			err = ValidateListSharedWatchlistsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_shared_watchlists", err)
+			}
+			return nil, NewListSharedWatchlistsUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body ListSharedWatchlistsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_shared_watchlists", err)
+			}
+			err = ValidateListSharedWatchlistsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_shared_watchlists", err)
+			}
+			return nil, NewListSharedWatchlistsNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body ListSharedWatchlistsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_shared_watchlists", err)
+			}
+			err = ValidateListSharedWatchlistsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_shared_watchlists", err)
+			}
+			return nil, NewListSharedWatchlistsForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body ListSharedWatchlistsConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_shared_watchlists", err)
+			}
+			err = ValidateListSharedWatchlistsConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_shared_watchlists", err)
+			}
+			return nil, NewListSharedWatchlistsConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body ListSharedWatchlistsPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_shared_watchlists", err)
+			}
+			err = ValidateListSharedWatchlistsPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_shared_watchlists", err)
+			}
+			return nil, NewListSharedWatchlistsPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "list_shared_watchlists", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateWatchlistRequest instantiates a HTTP request object with method
+// and path set to call the "watchlist" service "create_watchlist" endpoint
+func (c *Client) BuildCreateWatchlistRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateWatchlistWatchlistPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "create_watchlist", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateWatchlistRequest returns an encoder for requests sent to the
+// watchlist create_watchlist server.
+func EncodeCreateWatchlistRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.CreateWatchlistPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "create_watchlist", "*watchlist.CreateWatchlistPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCreateWatchlistRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("watchlist", "create_watchlist", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateWatchlistResponse returns a decoder for responses returned by
+// the watchlist create_watchlist endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeCreateWatchlistResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeCreateWatchlistResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateWatchlistResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "create_watchlist", err)
+			}
+			err = ValidateCreateWatchlistResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "create_watchlist", err)
+			}
+			res := NewCreateWatchlistWatchlistCreated(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CreateWatchlistUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "create_watchlist", err)
+			}
+			err = ValidateCreateWatchlistUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "create_watchlist", err)
+			}
+			return nil, NewCreateWatchlistUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body CreateWatchlistNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "create_watchlist", err)
+			}
+			err = ValidateCreateWatchlistNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "create_watchlist", err)
+			}
+			return nil, NewCreateWatchlistNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateWatchlistForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "create_watchlist", err)
+			}
+			err = ValidateCreateWatchlistForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "create_watchlist", err)
+			}
+			return nil, NewCreateWatchlistForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body CreateWatchlistConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "create_watchlist", err)
+			}
+			err = ValidateCreateWatchlistConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "create_watchlist", err)
+			}
+			return nil, NewCreateWatchlistConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body CreateWatchlistPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "create_watchlist", err)
+			}
+			err = ValidateCreateWatchlistPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "create_watchlist", err)
+			}
+			return nil, NewCreateWatchlistPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "create_watchlist", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetWatchlistRequest instantiates a HTTP request object with method and
+// path set to call the "watchlist" service "get_watchlist" endpoint
+func (c *Client) BuildGetWatchlistRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*watchlist.GetWatchlistPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "get_watchlist", "*watchlist.GetWatchlistPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetWatchlistWatchlistPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "get_watchlist", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetWatchlistRequest returns an encoder for requests sent to the
+// watchlist get_watchlist server.
+func EncodeGetWatchlistRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.GetWatchlistPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "get_watchlist", "*watchlist.GetWatchlistPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetWatchlistResponse returns a decoder for responses returned by the
+// watchlist get_watchlist endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeGetWatchlistResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeGetWatchlistResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetWatchlistResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "get_watchlist", err)
+			}
+			err = ValidateGetWatchlistResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "get_watchlist", err)
+			}
+			res := NewGetWatchlistWatchlistOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body GetWatchlistUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "get_watchlist", err)
+			}
+			err = ValidateGetWatchlistUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "get_watchlist", err)
+			}
+			return nil, NewGetWatchlistUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body GetWatchlistNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "get_watchlist", err)
+			}
+			err = ValidateGetWatchlistNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "get_watchlist", err)
+			}
+			return nil, NewGetWatchlistNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body GetWatchlistForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "get_watchlist", err)
+			}
+			err = ValidateGetWatchlistForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "get_watchlist", err)
+			}
+			return nil, NewGetWatchlistForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body GetWatchlistConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "get_watchlist", err)
+			}
+			err = ValidateGetWatchlistConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "get_watchlist", err)
+			}
+			return nil, NewGetWatchlistConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body GetWatchlistPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "get_watchlist", err)
+			}
+			err = ValidateGetWatchlistPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "get_watchlist", err)
+			}
+			return nil, NewGetWatchlistPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "get_watchlist", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateWatchlistRequest instantiates a HTTP request object with method
+// and path set to call the "watchlist" service "update_watchlist" endpoint
+func (c *Client) BuildUpdateWatchlistRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*watchlist.UpdateWatchlistPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "update_watchlist", "*watchlist.UpdateWatchlistPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateWatchlistWatchlistPath(id)}
+	req, err := http.NewRequest("PATCH", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "update_watchlist", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateWatchlistRequest returns an encoder for requests sent to the
+// watchlist update_watchlist server.
+func EncodeUpdateWatchlistRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.UpdateWatchlistPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "update_watchlist", "*watchlist.UpdateWatchlistPayload", v)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateWatchlistRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("watchlist", "update_watchlist", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateWatchlistResponse returns a decoder for responses returned by
+// the watchlist update_watchlist endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeUpdateWatchlistResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeUpdateWatchlistResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateWatchlistResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update_watchlist", err)
+			}
+			err = ValidateUpdateWatchlistResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update_watchlist", err)
+			}
+			res := NewUpdateWatchlistWatchlistOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body UpdateWatchlistUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update_watchlist", err)
+			}
+			err = ValidateUpdateWatchlistUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update_watchlist", err)
+			}
+			return nil, NewUpdateWatchlistUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateWatchlistNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update_watchlist", err)
+			}
+			err = ValidateUpdateWatchlistNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update_watchlist", err)
+			}
+			return nil, NewUpdateWatchlistNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateWatchlistForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update_watchlist", err)
+			}
+			err = ValidateUpdateWatchlistForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update_watchlist", err)
+			}
+			return nil, NewUpdateWatchlistForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateWatchlistConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update_watchlist", err)
+			}
+			err = ValidateUpdateWatchlistConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update_watchlist", err)
+			}
+			return nil, NewUpdateWatchlistConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body UpdateWatchlistPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update_watchlist", err)
+			}
+			err = ValidateUpdateWatchlistPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update_watchlist", err)
+			}
+			return nil, NewUpdateWatchlistPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "update_watchlist", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteWatchlistRequest instantiates a HTTP request object with method
+// and path set to call the "watchlist" service "delete_watchlist" endpoint
+func (c *Client) BuildDeleteWatchlistRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*watchlist.DeleteWatchlistPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "delete_watchlist", "*watchlist.DeleteWatchlistPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteWatchlistWatchlistPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "delete_watchlist", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteWatchlistRequest returns an encoder for requests sent to the
+// watchlist delete_watchlist server.
+func EncodeDeleteWatchlistRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.DeleteWatchlistPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "delete_watchlist", "*watchlist.DeleteWatchlistPayload", v)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteWatchlistResponse returns a decoder for responses returned by
+// the watchlist delete_watchlist endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDeleteWatchlistResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeDeleteWatchlistResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body DeleteWatchlistUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "delete_watchlist", err)
+			}
+			err = ValidateDeleteWatchlistUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "delete_watchlist", err)
+			}
+			return nil, NewDeleteWatchlistUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteWatchlistNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "delete_watchlist", err)
+			}
+			err = ValidateDeleteWatchlistNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "delete_watchlist", err)
+			}
+			return nil, NewDeleteWatchlistNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteWatchlistForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "delete_watchlist", err)
+			}
+			err = ValidateDeleteWatchlistForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "delete_watchlist", err)
+			}
+			return nil, NewDeleteWatchlistForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body DeleteWatchlistConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "delete_watchlist", err)
+			}
+			err = ValidateDeleteWatchlistConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "delete_watchlist", err)
+			}
+			return nil, NewDeleteWatchlistConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body DeleteWatchlistPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "delete_watchlist", err)
+			}
+			err = ValidateDeleteWatchlistPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "delete_watchlist", err)
+			}
+			return nil, NewDeleteWatchlistPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "delete_watchlist", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListItemsRequest instantiates a HTTP request object with method and
+// path set to call the "watchlist" service "list_items" endpoint
+func (c *Client) BuildListItemsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*watchlist.ListItemsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "list_items", "*watchlist.ListItemsPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListItemsWatchlistPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "list_items", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListItemsRequest returns an encoder for requests sent to the watchlist
+// list_items server.
+func EncodeListItemsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.ListItemsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "list_items", "*watchlist.ListItemsPayload", v)
+		}
+		if p.IfNoneMatch != nil {
+			head := *p.IfNoneMatch
+			req.Header.Set("If-None-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListItemsResponse returns a decoder for responses returned by the
+// watchlist list_items endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListItemsResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeListItemsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNotModified:
+			var (
+				etag string
+				err  error
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_items", err)
+			}
+			res := NewListItemsTickerItemsResultNotModified(etag)
+			tmp := "not_modified"
+			res.Outcome = &tmp
+			return res, nil
+		case http.StatusOK:
+			var (
+				body ListItemsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_items", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateTickerItem(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_items", err)
+			}
+			var (
+				etag string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_items", err)
+			}
+			res := NewListItemsTickerItemsResultOK(body, etag)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListItemsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_items", err)
+			}
+			err = ValidateListItemsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_items", err)
+			}
+			return nil, NewListItemsUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body ListItemsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_items", err)
+			}
+			err = ValidateListItemsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_items", err)
+			}
+			return nil, NewListItemsNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body ListItemsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_items", err)
+			}
+			err = ValidateListItemsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_items", err)
+			}
+			return nil, NewListItemsForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body ListItemsConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_items", err)
+			}
+			err = ValidateListItemsConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_items", err)
+			}
+			return nil, NewListItemsConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body ListItemsPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_items", err)
+			}
+			err = ValidateListItemsPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_items", err)
+			}
+			return nil, NewListItemsPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "list_items", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAddItemRequest instantiates a HTTP request object with method and path
+// set to call the "watchlist" service "add_item" endpoint
+func (c *Client) BuildAddItemRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*watchlist.AddItemPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "add_item", "*watchlist.AddItemPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AddItemWatchlistPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "add_item", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAddItemRequest returns an encoder for requests sent to the watchlist
+// add_item server.
+func EncodeAddItemRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.AddItemPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "add_item", "*watchlist.AddItemPayload", v)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewAddItemRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("watchlist", "add_item", err)
+		}
+		return nil
+	}
+}
+
+// DecodeAddItemResponse returns a decoder for responses returned by the
+// watchlist add_item endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeAddItemResponse may return the following errors:
+//   - "invalid_symbol" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeAddItemResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body AddItemResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "add_item", err)
+			}
+			err = ValidateAddItemResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "add_item", err)
+			}
+			res := NewAddItemTickerItemOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body AddItemInvalidSymbolResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "add_item", err)
+			}
+			err = ValidateAddItemInvalidSymbolResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "add_item", err)
+			}
+			return nil, NewAddItemInvalidSymbol(&body)
+		case http.StatusUnauthorized:
+			var (
+				body AddItemUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "add_item", err)
+			}
+			err = ValidateAddItemUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "add_item", err)
+			}
+			return nil, NewAddItemUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body AddItemNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "add_item", err)
+			}
+			err = ValidateAddItemNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "add_item", err)
+			}
+			return nil, NewAddItemNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body AddItemForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "add_item", err)
+			}
+			err = ValidateAddItemForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "add_item", err)
+			}
+			return nil, NewAddItemForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body AddItemConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "add_item", err)
+			}
+			err = ValidateAddItemConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "add_item", err)
+			}
+			return nil, NewAddItemConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body AddItemPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "add_item", err)
+			}
+			err = ValidateAddItemPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "add_item", err)
+			}
+			return nil, NewAddItemPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "add_item", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateItemRequest instantiates a HTTP request object with method and
+// path set to call the "watchlist" service "update_item" endpoint
+func (c *Client) BuildUpdateItemRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id     string
+		symbol string
+	)
+	{
+		p, ok := v.(*watchlist.UpdateItemPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "update_item", "*watchlist.UpdateItemPayload", v)
+		}
+		id = p.ID
+		symbol = p.Symbol
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateItemWatchlistPath(id, symbol)}
+	req, err := http.NewRequest("PATCH", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "update_item", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateItemRequest returns an encoder for requests sent to the
+// watchlist update_item server.
+func EncodeUpdateItemRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.UpdateItemPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "update_item", "*watchlist.UpdateItemPayload", v)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateItemRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("watchlist", "update_item", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateItemResponse returns a decoder for responses returned by the
+// watchlist update_item endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeUpdateItemResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeUpdateItemResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateItemResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update_item", err)
+			}
+			err = ValidateUpdateItemResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update_item", err)
+			}
+			res := NewUpdateItemTickerItemOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body UpdateItemUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update_item", err)
+			}
+			err = ValidateUpdateItemUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update_item", err)
+			}
+			return nil, NewUpdateItemUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateItemNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update_item", err)
+			}
+			err = ValidateUpdateItemNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update_item", err)
+			}
+			return nil, NewUpdateItemNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateItemForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update_item", err)
+			}
+			err = ValidateUpdateItemForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update_item", err)
+			}
+			return nil, NewUpdateItemForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateItemConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update_item", err)
+			}
+			err = ValidateUpdateItemConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update_item", err)
+			}
+			return nil, NewUpdateItemConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body UpdateItemPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "update_item", err)
+			}
+			err = ValidateUpdateItemPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "update_item", err)
+			}
+			return nil, NewUpdateItemPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "update_item", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRemoveItemRequest instantiates a HTTP request object with method and
+// path set to call the "watchlist" service "remove_item" endpoint
+func (c *Client) BuildRemoveItemRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id     string
+		symbol string
+	)
+	{
+		p, ok := v.(*watchlist.RemoveItemPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "remove_item", "*watchlist.RemoveItemPayload", v)
+		}
+		id = p.ID
+		symbol = p.Symbol
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RemoveItemWatchlistPath(id, symbol)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "remove_item", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRemoveItemRequest returns an encoder for requests sent to the
+// watchlist remove_item server.
+func EncodeRemoveItemRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.RemoveItemPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "remove_item", "*watchlist.RemoveItemPayload", v)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRemoveItemResponse returns a decoder for responses returned by the
+// watchlist remove_item endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeRemoveItemResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeRemoveItemResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body RemoveItemUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "remove_item", err)
+			}
+			err = ValidateRemoveItemUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "remove_item", err)
+			}
+			return nil, NewRemoveItemUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body RemoveItemNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "remove_item", err)
+			}
+			err = ValidateRemoveItemNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "remove_item", err)
+			}
+			return nil, NewRemoveItemNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body RemoveItemForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "remove_item", err)
+			}
+			err = ValidateRemoveItemForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "remove_item", err)
+			}
+			return nil, NewRemoveItemForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body RemoveItemConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "remove_item", err)
+			}
+			err = ValidateRemoveItemConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "remove_item", err)
+			}
+			return nil, NewRemoveItemConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body RemoveItemPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "remove_item", err)
+			}
+			err = ValidateRemoveItemPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "remove_item", err)
+			}
+			return nil, NewRemoveItemPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "remove_item", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListSharesRequest instantiates a HTTP request object with method and
+// path set to call the "watchlist" service "list_shares" endpoint
+func (c *Client) BuildListSharesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*watchlist.ListSharesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "list_shares", "*watchlist.ListSharesPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListSharesWatchlistPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "list_shares", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListSharesRequest returns an encoder for requests sent to the
+// watchlist list_shares server.
+func EncodeListSharesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.ListSharesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "list_shares", "*watchlist.ListSharesPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListSharesResponse returns a decoder for responses returned by the
+// watchlist list_shares endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListSharesResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeListSharesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListSharesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_shares", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWatchlistShareResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_shares", err)
+			}
+			res := NewListSharesWatchlistShareOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListSharesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_shares", err)
+			}
+			err = ValidateListSharesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_shares", err)
+			}
+			return nil, NewListSharesUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body ListSharesNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_shares", err)
+			}
+			err = ValidateListSharesNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_shares", err)
+			}
+			return nil, NewListSharesNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body ListSharesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_shares", err)
+			}
+			err = ValidateListSharesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_shares", err)
+			}
+			return nil, NewListSharesForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body ListSharesConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_shares", err)
+			}
+			err = ValidateListSharesConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_shares", err)
+			}
+			return nil, NewListSharesConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body ListSharesPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "list_shares", err)
+			}
+			err = ValidateListSharesPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "list_shares", err)
+			}
+			return nil, NewListSharesPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "list_shares", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildShareWatchlistRequest instantiates a HTTP request object with method
+// and path set to call the "watchlist" service "share_watchlist" endpoint
+func (c *Client) BuildShareWatchlistRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id   string
+		user string
+	)
+	{
+		p, ok := v.(*watchlist.ShareWatchlistPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "share_watchlist", "*watchlist.ShareWatchlistPayload", v)
+		}
+		id = p.ID
+		user = p.User
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ShareWatchlistWatchlistPath(id, user)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "share_watchlist", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeShareWatchlistRequest returns an encoder for requests sent to the
+// watchlist share_watchlist server.
+func EncodeShareWatchlistRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.ShareWatchlistPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "share_watchlist", "*watchlist.ShareWatchlistPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewShareWatchlistRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("watchlist", "share_watchlist", err)
+		}
+		return nil
+	}
+}
+
+// DecodeShareWatchlistResponse returns a decoder for responses returned by the
+// watchlist share_watchlist endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeShareWatchlistResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeShareWatchlistResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ShareWatchlistResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "share_watchlist", err)
+			}
+			err = ValidateShareWatchlistResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "share_watchlist", err)
+			}
+			res := NewShareWatchlistWatchlistShareOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ShareWatchlistUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "share_watchlist", err)
+			}
+			err = ValidateShareWatchlistUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "share_watchlist", err)
+			}
+			return nil, NewShareWatchlistUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body ShareWatchlistNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "share_watchlist", err)
+			}
+			err = ValidateShareWatchlistNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "share_watchlist", err)
+			}
+			return nil, NewShareWatchlistNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body ShareWatchlistForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "share_watchlist", err)
+			}
+			err = ValidateShareWatchlistForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "share_watchlist", err)
+			}
+			return nil, NewShareWatchlistForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body ShareWatchlistConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "share_watchlist", err)
+			}
+			err = ValidateShareWatchlistConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "share_watchlist", err)
+			}
+			return nil, NewShareWatchlistConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body ShareWatchlistPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "share_watchlist", err)
+			}
+			err = ValidateShareWatchlistPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "share_watchlist", err)
+			}
+			return nil, NewShareWatchlistPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "share_watchlist", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUnshareWatchlistRequest instantiates a HTTP request object with method
+// and path set to call the "watchlist" service "unshare_watchlist" endpoint
+func (c *Client) BuildUnshareWatchlistRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id   string
+		user string
+	)
+	{
+		p, ok := v.(*watchlist.UnshareWatchlistPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "unshare_watchlist", "*watchlist.UnshareWatchlistPayload", v)
+		}
+		id = p.ID
+		user = p.User
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UnshareWatchlistWatchlistPath(id, user)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "unshare_watchlist", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUnshareWatchlistRequest returns an encoder for requests sent to the
+// watchlist unshare_watchlist server.
+func EncodeUnshareWatchlistRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.UnshareWatchlistPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "unshare_watchlist", "*watchlist.UnshareWatchlistPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeUnshareWatchlistResponse returns a decoder for responses returned by
+// the watchlist unshare_watchlist endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeUnshareWatchlistResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeUnshareWatchlistResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body UnshareWatchlistUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "unshare_watchlist", err)
+			}
+			err = ValidateUnshareWatchlistUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "unshare_watchlist", err)
+			}
+			return nil, NewUnshareWatchlistUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body UnshareWatchlistNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "unshare_watchlist", err)
+			}
+			err = ValidateUnshareWatchlistNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "unshare_watchlist", err)
+			}
+			return nil, NewUnshareWatchlistNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body UnshareWatchlistForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "unshare_watchlist", err)
+			}
+			err = ValidateUnshareWatchlistForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "unshare_watchlist", err)
+			}
+			return nil, NewUnshareWatchlistForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body UnshareWatchlistConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "unshare_watchlist", err)
+			}
+			err = ValidateUnshareWatchlistConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "unshare_watchlist", err)
+			}
+			return nil, NewUnshareWatchlistConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body UnshareWatchlistPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "unshare_watchlist", err)
+			}
+			err = ValidateUnshareWatchlistPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "unshare_watchlist", err)
+			}
+			return nil, NewUnshareWatchlistPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "unshare_watchlist", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPublishWatchlistRequest instantiates a HTTP request object with method
+// and path set to call the "watchlist" service "publish_watchlist" endpoint
+func (c *Client) BuildPublishWatchlistRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*watchlist.PublishWatchlistPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "publish_watchlist", "*watchlist.PublishWatchlistPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PublishWatchlistWatchlistPath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "publish_watchlist", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePublishWatchlistRequest returns an encoder for requests sent to the
+// watchlist publish_watchlist server.
+func EncodePublishWatchlistRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.PublishWatchlistPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "publish_watchlist", "*watchlist.PublishWatchlistPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodePublishWatchlistResponse returns a decoder for responses returned by
+// the watchlist publish_watchlist endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodePublishWatchlistResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodePublishWatchlistResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PublishWatchlistResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "publish_watchlist", err)
+			}
+			err = ValidatePublishWatchlistResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "publish_watchlist", err)
+			}
+			res := NewPublishWatchlistWatchlistOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body PublishWatchlistUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "publish_watchlist", err)
+			}
+			err = ValidatePublishWatchlistUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "publish_watchlist", err)
+			}
+			return nil, NewPublishWatchlistUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body PublishWatchlistNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "publish_watchlist", err)
+			}
+			err = ValidatePublishWatchlistNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "publish_watchlist", err)
+			}
+			return nil, NewPublishWatchlistNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body PublishWatchlistForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "publish_watchlist", err)
+			}
+			err = ValidatePublishWatchlistForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "publish_watchlist", err)
+			}
+			return nil, NewPublishWatchlistForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body PublishWatchlistConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "publish_watchlist", err)
+			}
+			err = ValidatePublishWatchlistConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "publish_watchlist", err)
+			}
+			return nil, NewPublishWatchlistConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body PublishWatchlistPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "publish_watchlist", err)
+			}
+			err = ValidatePublishWatchlistPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "publish_watchlist", err)
+			}
+			return nil, NewPublishWatchlistPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "publish_watchlist", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUnpublishWatchlistRequest instantiates a HTTP request object with
+// method and path set to call the "watchlist" service "unpublish_watchlist"
+// endpoint
+func (c *Client) BuildUnpublishWatchlistRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*watchlist.UnpublishWatchlistPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "unpublish_watchlist", "*watchlist.UnpublishWatchlistPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UnpublishWatchlistWatchlistPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "unpublish_watchlist", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUnpublishWatchlistRequest returns an encoder for requests sent to the
+// watchlist unpublish_watchlist server.
+func EncodeUnpublishWatchlistRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*watchlist.UnpublishWatchlistPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("watchlist", "unpublish_watchlist", "*watchlist.UnpublishWatchlistPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeUnpublishWatchlistResponse returns a decoder for responses returned by
+// the watchlist unpublish_watchlist endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeUnpublishWatchlistResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeUnpublishWatchlistResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body UnpublishWatchlistUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "unpublish_watchlist", err)
+			}
+			err = ValidateUnpublishWatchlistUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "unpublish_watchlist", err)
+			}
+			return nil, NewUnpublishWatchlistUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body UnpublishWatchlistNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "unpublish_watchlist", err)
+			}
+			err = ValidateUnpublishWatchlistNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "unpublish_watchlist", err)
+			}
+			return nil, NewUnpublishWatchlistNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body UnpublishWatchlistForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "unpublish_watchlist", err)
+			}
+			err = ValidateUnpublishWatchlistForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "unpublish_watchlist", err)
+			}
+			return nil, NewUnpublishWatchlistForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body UnpublishWatchlistConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "unpublish_watchlist", err)
+			}
+			err = ValidateUnpublishWatchlistConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "unpublish_watchlist", err)
+			}
+			return nil, NewUnpublishWatchlistConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body UnpublishWatchlistPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "unpublish_watchlist", err)
+			}
+			err = ValidateUnpublishWatchlistPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "unpublish_watchlist", err)
+			}
+			return nil, NewUnpublishWatchlistPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "unpublish_watchlist", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetPublicWatchlistRequest instantiates a HTTP request object with
+// method and path set to call the "watchlist" service "get_public_watchlist"
+// endpoint
+func (c *Client) BuildGetPublicWatchlistRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		token string
+	)
+	{
+		p, ok := v.(*watchlist.GetPublicWatchlistPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("watchlist", "get_public_watchlist", "*watchlist.GetPublicWatchlistPayload", v)
+		}
+		token = p.Token
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetPublicWatchlistWatchlistPath(token)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("watchlist", "get_public_watchlist", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetPublicWatchlistResponse returns a decoder for responses returned by
+// the watchlist get_public_watchlist endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetPublicWatchlistResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "precondition_failed" (type *goa.ServiceError): http.StatusPreconditionFailed
+//   - error: internal error
+func DecodeGetPublicWatchlistResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetPublicWatchlistResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "get_public_watchlist", err)
+			}
+			err = ValidateGetPublicWatchlistResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "get_public_watchlist", err)
+			}
+			res := NewGetPublicWatchlistPublicWatchlistOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body GetPublicWatchlistUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "get_public_watchlist", err)
+			}
+			err = ValidateGetPublicWatchlistUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "get_public_watchlist", err)
+			}
+			return nil, NewGetPublicWatchlistUnauthorized(&body)
+		case http.StatusNotFound:
+			var (
+				body GetPublicWatchlistNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "get_public_watchlist", err)
+			}
+			err = ValidateGetPublicWatchlistNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "get_public_watchlist", err)
+			}
+			return nil, NewGetPublicWatchlistNotFound(&body)
+		case http.StatusForbidden:
+			var (
+				body GetPublicWatchlistForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "get_public_watchlist", err)
+			}
+			err = ValidateGetPublicWatchlistForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "get_public_watchlist", err)
+			}
+			return nil, NewGetPublicWatchlistForbidden(&body)
+		case http.StatusConflict:
+			var (
+				body GetPublicWatchlistConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "get_public_watchlist", err)
+			}
+			err = ValidateGetPublicWatchlistConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "get_public_watchlist", err)
+			}
+			return nil, NewGetPublicWatchlistConflict(&body)
+		case http.StatusPreconditionFailed:
+			var (
+				body GetPublicWatchlistPreconditionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("watchlist", "get_public_watchlist", err)
+			}
+			err = ValidateGetPublicWatchlistPreconditionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("watchlist", "get_public_watchlist", err)
+			}
+			return nil, NewGetPublicWatchlistPreconditionFailed(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("watchlist", "get_public_watchlist", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalTickerItemToWatchlistTickerItem builds a value of type
+// *watchlist.TickerItem from a value of type *TickerItem.
+func unmarshalTickerItemToWatchlistTickerItem(v *TickerItem) *watchlist.TickerItem {
+	res := &watchlist.TickerItem{
+		Symbol:       *v.Symbol,
+		OnHand:       *v.OnHand,
+		CreatedAt:    v.CreatedAt,
+		UpdatedAt:    v.UpdatedAt,
+		ExchangeMic:  v.ExchangeMic,
+		InstrumentID: v.InstrumentID,
+		Note:         v.Note,
+		BuyTarget:    v.BuyTarget,
+		SellTarget:   v.SellTarget,
+		Position:     *v.Position,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalRemovedTickerItemResponseToWatchlistRemovedTickerItem builds a
+// value of type *watchlist.RemovedTickerItem from a value of type
+// *RemovedTickerItemResponse.
+func unmarshalRemovedTickerItemResponseToWatchlistRemovedTickerItem(v *RemovedTickerItemResponse) *watchlist.RemovedTickerItem {
+	res := &watchlist.RemovedTickerItem{
+		RemovedAt:    *v.RemovedAt,
+		PurgeAt:      *v.PurgeAt,
+		Symbol:       *v.Symbol,
+		OnHand:       *v.OnHand,
+		CreatedAt:    v.CreatedAt,
+		UpdatedAt:    v.UpdatedAt,
+		ExchangeMic:  v.ExchangeMic,
+		InstrumentID: v.InstrumentID,
+		Note:         v.Note,
+		BuyTarget:    v.BuyTarget,
+		SellTarget:   v.SellTarget,
+		Position:     *v.Position,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalWatchlistChangeResponseBodyToWatchlistWatchlistChange builds a
+// value of type *watchlist.WatchlistChange from a value of type
+// *WatchlistChangeResponseBody.
+func unmarshalWatchlistChangeResponseBodyToWatchlistWatchlistChange(v *WatchlistChangeResponseBody) *watchlist.WatchlistChange {
+	if v == nil {
+		return nil
+	}
+	res := &watchlist.WatchlistChange{
+		Watchlist: *v.Watchlist,
+		Symbol:    *v.Symbol,
+		ChangedAt: *v.ChangedAt,
+	}
+	if v.Item != nil {
+		res.Item = unmarshalTickerItemResponseBodyToWatchlistTickerItem(v.Item)
+	}
+
+	return res
+}
+
+// unmarshalTickerItemResponseBodyToWatchlistTickerItem builds a value of type
+// *watchlist.TickerItem from a value of type *TickerItemResponseBody.
+func unmarshalTickerItemResponseBodyToWatchlistTickerItem(v *TickerItemResponseBody) *watchlist.TickerItem {
+	if v == nil {
+		return nil
+	}
+	res := &watchlist.TickerItem{
+		Symbol:       *v.Symbol,
+		OnHand:       *v.OnHand,
+		CreatedAt:    v.CreatedAt,
+		UpdatedAt:    v.UpdatedAt,
+		ExchangeMic:  v.ExchangeMic,
+		InstrumentID: v.InstrumentID,
+		Note:         v.Note,
+		BuyTarget:    v.BuyTarget,
+		SellTarget:   v.SellTarget,
+		Position:     *v.Position,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalWatchlistBulkAddItemToBulkAddItemRequestBody builds a value of type
+// *BulkAddItemRequestBody from a value of type *watchlist.BulkAddItem.
+func marshalWatchlistBulkAddItemToBulkAddItemRequestBody(v *watchlist.BulkAddItem) *BulkAddItemRequestBody {
+	res := &BulkAddItemRequestBody{
+		Symbol:     v.Symbol,
+		OnHand:     v.OnHand,
+		Note:       v.Note,
+		BuyTarget:  v.BuyTarget,
+		SellTarget: v.SellTarget,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalBulkAddItemRequestBodyToWatchlistBulkAddItem builds a value of type
+// *watchlist.BulkAddItem from a value of type *BulkAddItemRequestBody.
+func marshalBulkAddItemRequestBodyToWatchlistBulkAddItem(v *BulkAddItemRequestBody) *watchlist.BulkAddItem {
+	res := &watchlist.BulkAddItem{
+		Symbol:     v.Symbol,
+		OnHand:     v.OnHand,
+		Note:       v.Note,
+		BuyTarget:  v.BuyTarget,
+		SellTarget: v.SellTarget,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalBulkItemResultResponseBodyToWatchlistBulkItemResult builds a value
+// of type *watchlist.BulkItemResult from a value of type
+// *BulkItemResultResponseBody.
+func unmarshalBulkItemResultResponseBodyToWatchlistBulkItemResult(v *BulkItemResultResponseBody) *watchlist.BulkItemResult {
+	res := &watchlist.BulkItemResult{
+		Symbol: *v.Symbol,
+		Status: *v.Status,
+		Error:  v.Error,
+	}
+	if v.Item != nil {
+		res.Item = unmarshalTickerItemResponseBodyToWatchlistTickerItem(v.Item)
+	}
+
+	return res
+}
+
+// unmarshalImportRowErrorResponseBodyToWatchlistImportRowError builds a value
+// of type *watchlist.ImportRowError from a value of type
+// *ImportRowErrorResponseBody.
+func unmarshalImportRowErrorResponseBodyToWatchlistImportRowError(v *ImportRowErrorResponseBody) *watchlist.ImportRowError {
+	res := &watchlist.ImportRowError{
+		Line:  *v.Line,
+		Error: *v.Error,
+	}
+
+	return res
+}
+
+// unmarshalWatchlistResponseToWatchlistWatchlist builds a value of type
+// *watchlist.Watchlist from a value of type *WatchlistResponse.
+func unmarshalWatchlistResponseToWatchlistWatchlist(v *WatchlistResponse) *watchlist.Watchlist {
+	res := &watchlist.Watchlist{
+		ID:          *v.ID,
+		Name:        *v.Name,
+		Position:    *v.Position,
+		Default:     *v.Default,
+		ItemCount:   *v.ItemCount,
+		CreatedAt:   *v.CreatedAt,
+		UpdatedAt:   *v.UpdatedAt,
+		Owner:       *v.Owner,
+		Role:        *v.Role,
+		PublicToken: v.PublicToken,
+	}
+
+	return res
+}
+
+// unmarshalWatchlistShareResponseToWatchlistWatchlistShare builds a value of
+// type *watchlist.WatchlistShare from a value of type *WatchlistShareResponse.
+func unmarshalWatchlistShareResponseToWatchlistWatchlistShare(v *WatchlistShareResponse) *watchlist.WatchlistShare {
+	res := &watchlist.WatchlistShare{
+		UserID:    *v.UserID,
+		Role:      *v.Role,
+		CreatedAt: *v.CreatedAt,
+	}
+
+	return res
+}
+
+// unmarshalPublicTickerItemResponseBodyToWatchlistPublicTickerItem builds a
+// value of type *watchlist.PublicTickerItem from a value of type
+// *PublicTickerItemResponseBody.
+func unmarshalPublicTickerItemResponseBodyToWatchlistPublicTickerItem(v *PublicTickerItemResponseBody) *watchlist.PublicTickerItem {
+	res := &watchlist.PublicTickerItem{
+		Symbol:       *v.Symbol,
+		ExchangeMic:  v.ExchangeMic,
+		InstrumentID: v.InstrumentID,
+		Note:         v.Note,
+		BuyTarget:    v.BuyTarget,
+		SellTarget:   v.SellTarget,
+		Position:     *v.Position,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
 	}
 
 	return res
@@ -1,9 +1,9 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // HTTP request path constructors for the watchlist service.
 //
 // Command:
-// $ goa gen github.com/reidlai/ta-workspace/modules/watchlist/go/design
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
 
 package server
 
@@ -21,7 +21,127 @@ func AddWatchlistPath() string {
 	return "/watchlist"
 }
 
+// UpdateWatchlistPath returns the URL path to the watchlist service update HTTP endpoint.
+func UpdateWatchlistPath(symbol string) string {
+	return fmt.Sprintf("/watchlist/%v", symbol)
+}
+
 // RemoveWatchlistPath returns the URL path to the watchlist service remove HTTP endpoint.
 func RemoveWatchlistPath(symbol string) string {
 	return fmt.Sprintf("/watchlist/%v", symbol)
 }
+
+// ListRemovedWatchlistPath returns the URL path to the watchlist service list_removed HTTP endpoint.
+func ListRemovedWatchlistPath() string {
+	return "/watchlist/removed"
+}
+
+// RestoreWatchlistPath returns the URL path to the watchlist service restore HTTP endpoint.
+func RestoreWatchlistPath(symbol string) string {
+	return fmt.Sprintf("/watchlist/%v/restore", symbol)
+}
+
+// EventsWatchlistPath returns the URL path to the watchlist service events HTTP endpoint.
+func EventsWatchlistPath() string {
+	return "/watchlist/events"
+}
+
+// BulkAddWatchlistPath returns the URL path to the watchlist service bulk_add HTTP endpoint.
+func BulkAddWatchlistPath() string {
+	return "/watchlist/bulk/add"
+}
+
+// BulkRemoveWatchlistPath returns the URL path to the watchlist service bulk_remove HTTP endpoint.
+func BulkRemoveWatchlistPath() string {
+	return "/watchlist/bulk/remove"
+}
+
+// ImportWatchlistPath returns the URL path to the watchlist service import HTTP endpoint.
+func ImportWatchlistPath() string {
+	return "/watchlist/import"
+}
+
+// ExportWatchlistPath returns the URL path to the watchlist service export HTTP endpoint.
+func ExportWatchlistPath() string {
+	return "/watchlist/export"
+}
+
+// ListWatchlistsWatchlistPath returns the URL path to the watchlist service list_watchlists HTTP endpoint.
+func ListWatchlistsWatchlistPath() string {
+	return "/watchlists"
+}
+
+// ListSharedWatchlistsWatchlistPath returns the URL path to the watchlist service list_shared_watchlists HTTP endpoint.
+func ListSharedWatchlistsWatchlistPath() string {
+	return "/watchlists/shared"
+}
+
+// CreateWatchlistWatchlistPath returns the URL path to the watchlist service create_watchlist HTTP endpoint.
+func CreateWatchlistWatchlistPath() string {
+	return "/watchlists"
+}
+
+// GetWatchlistWatchlistPath returns the URL path to the watchlist service get_watchlist HTTP endpoint.
+func GetWatchlistWatchlistPath(id string) string {
+	return fmt.Sprintf("/watchlists/%v", id)
+}
+
+// UpdateWatchlistWatchlistPath returns the URL path to the watchlist service update_watchlist HTTP endpoint.
+func UpdateWatchlistWatchlistPath(id string) string {
+	return fmt.Sprintf("/watchlists/%v", id)
+}
+
+// DeleteWatchlistWatchlistPath returns the URL path to the watchlist service delete_watchlist HTTP endpoint.
+func DeleteWatchlistWatchlistPath(id string) string {
+	return fmt.Sprintf("/watchlists/%v", id)
+}
+
+// ListItemsWatchlistPath returns the URL path to the watchlist service list_items HTTP endpoint.
+func ListItemsWatchlistPath(id string) string {
+	return fmt.Sprintf("/watchlists/%v/items", id)
+}
+
+// AddItemWatchlistPath returns the URL path to the watchlist service add_item HTTP endpoint.
+func AddItemWatchlistPath(id string) string {
+	return fmt.Sprintf("/watchlists/%v/items", id)
+}
+
+// UpdateItemWatchlistPath returns the URL path to the watchlist service update_item HTTP endpoint.
+func UpdateItemWatchlistPath(id string, symbol string) string {
+	return fmt.Sprintf("/watchlists/%v/items/%v", id, symbol)
+}
+
+// RemoveItemWatchlistPath returns the URL path to the watchlist service remove_item HTTP endpoint.
+func RemoveItemWatchlistPath(id string, symbol string) string {
+	return fmt.Sprintf("/watchlists/%v/items/%v", id, symbol)
+}
+
+// ListSharesWatchlistPath returns the URL path to the watchlist service list_shares HTTP endpoint.
+func ListSharesWatchlistPath(id string) string {
+	return fmt.Sprintf("/watchlists/%v/shares", id)
+}
+
+// ShareWatchlistWatchlistPath returns the URL path to the watchlist service share_watchlist HTTP endpoint.
+func ShareWatchlistWatchlistPath(id string, user string) string {
+	return fmt.Sprintf("/watchlists/%v/shares/%v", id, user)
+}
+
+// UnshareWatchlistWatchlistPath returns the URL path to the watchlist service unshare_watchlist HTTP endpoint.
+func UnshareWatchlistWatchlistPath(id string, user string) string {
+	return fmt.Sprintf("/watchlists/%v/shares/%v", id, user)
+}
+
+// PublishWatchlistWatchlistPath returns the URL path to the watchlist service publish_watchlist HTTP endpoint.
+func PublishWatchlistWatchlistPath(id string) string {
+	return fmt.Sprintf("/watchlists/%v/public", id)
+}
+
+// UnpublishWatchlistWatchlistPath returns the URL path to the watchlist service unpublish_watchlist HTTP endpoint.
+func UnpublishWatchlistWatchlistPath(id string) string {
+	return fmt.Sprintf("/watchlists/%v/public", id)
+}
+
+// GetPublicWatchlistWatchlistPath returns the URL path to the watchlist service get_public_watchlist HTTP endpoint.
+func GetPublicWatchlistWatchlistPath(token string) string {
+	return fmt.Sprintf("/public/watchlists/%v", token)
+}
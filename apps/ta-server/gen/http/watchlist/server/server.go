@@ -1,14 +1,16 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // watchlist HTTP server
 //
 // Command:
-// $ goa gen github.com/reidlai/ta-workspace/modules/watchlist/go/design
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
 
 package server
 
 import (
+	"bufio"
 	"context"
+	"io"
 	"net/http"
 
 	watchlist "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
@@ -18,10 +20,34 @@ import (
 
 // Server lists the watchlist service endpoint HTTP handlers.
 type Server struct {
-	Mounts []*MountPoint
-	List   http.Handler
-	Add    http.Handler
-	Remove http.Handler
+	Mounts               []*MountPoint
+	List                 http.Handler
+	Add                  http.Handler
+	Update               http.Handler
+	Remove               http.Handler
+	ListRemoved          http.Handler
+	Restore              http.Handler
+	Events               http.Handler
+	BulkAdd              http.Handler
+	BulkRemove           http.Handler
+	Import               http.Handler
+	Export               http.Handler
+	ListWatchlists       http.Handler
+	ListSharedWatchlists http.Handler
+	CreateWatchlist      http.Handler
+	GetWatchlist         http.Handler
+	UpdateWatchlist      http.Handler
+	DeleteWatchlist      http.Handler
+	ListItems            http.Handler
+	AddItem              http.Handler
+	UpdateItem           http.Handler
+	RemoveItem           http.Handler
+	ListShares           http.Handler
+	ShareWatchlist       http.Handler
+	UnshareWatchlist     http.Handler
+	PublishWatchlist     http.Handler
+	UnpublishWatchlist   http.Handler
+	GetPublicWatchlist   http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -53,11 +79,59 @@ func New(
 		Mounts: []*MountPoint{
 			{"List", "GET", "/watchlist"},
 			{"Add", "POST", "/watchlist"},
+			{"Update", "PATCH", "/watchlist/{symbol}"},
 			{"Remove", "DELETE", "/watchlist/{symbol}"},
+			{"ListRemoved", "GET", "/watchlist/removed"},
+			{"Restore", "POST", "/watchlist/{symbol}/restore"},
+			{"Events", "GET", "/watchlist/events"},
+			{"BulkAdd", "POST", "/watchlist/bulk/add"},
+			{"BulkRemove", "POST", "/watchlist/bulk/remove"},
+			{"Import", "POST", "/watchlist/import"},
+			{"Export", "GET", "/watchlist/export"},
+			{"ListWatchlists", "GET", "/watchlists"},
+			{"ListSharedWatchlists", "GET", "/watchlists/shared"},
+			{"CreateWatchlist", "POST", "/watchlists"},
+			{"GetWatchlist", "GET", "/watchlists/{id}"},
+			{"UpdateWatchlist", "PATCH", "/watchlists/{id}"},
+			{"DeleteWatchlist", "DELETE", "/watchlists/{id}"},
+			{"ListItems", "GET", "/watchlists/{id}/items"},
+			{"AddItem", "POST", "/watchlists/{id}/items"},
+			{"UpdateItem", "PATCH", "/watchlists/{id}/items/{symbol}"},
+			{"RemoveItem", "DELETE", "/watchlists/{id}/items/{symbol}"},
+			{"ListShares", "GET", "/watchlists/{id}/shares"},
+			{"ShareWatchlist", "PUT", "/watchlists/{id}/shares/{user}"},
+			{"UnshareWatchlist", "DELETE", "/watchlists/{id}/shares/{user}"},
+			{"PublishWatchlist", "PUT", "/watchlists/{id}/public"},
+			{"UnpublishWatchlist", "DELETE", "/watchlists/{id}/public"},
+			{"GetPublicWatchlist", "GET", "/public/watchlists/{token}"},
 		},
-		List:   NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
-		Add:    NewAddHandler(e.Add, mux, decoder, encoder, errhandler, formatter),
-		Remove: NewRemoveHandler(e.Remove, mux, decoder, encoder, errhandler, formatter),
+		List:                 NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
+		Add:                  NewAddHandler(e.Add, mux, decoder, encoder, errhandler, formatter),
+		Update:               NewUpdateHandler(e.Update, mux, decoder, encoder, errhandler, formatter),
+		Remove:               NewRemoveHandler(e.Remove, mux, decoder, encoder, errhandler, formatter),
+		ListRemoved:          NewListRemovedHandler(e.ListRemoved, mux, decoder, encoder, errhandler, formatter),
+		Restore:              NewRestoreHandler(e.Restore, mux, decoder, encoder, errhandler, formatter),
+		Events:               NewEventsHandler(e.Events, mux, decoder, encoder, errhandler, formatter),
+		BulkAdd:              NewBulkAddHandler(e.BulkAdd, mux, decoder, encoder, errhandler, formatter),
+		BulkRemove:           NewBulkRemoveHandler(e.BulkRemove, mux, decoder, encoder, errhandler, formatter),
+		Import:               NewImportHandler(e.Import, mux, decoder, encoder, errhandler, formatter),
+		Export:               NewExportHandler(e.Export, mux, decoder, encoder, errhandler, formatter),
+		ListWatchlists:       NewListWatchlistsHandler(e.ListWatchlists, mux, decoder, encoder, errhandler, formatter),
+		ListSharedWatchlists: NewListSharedWatchlistsHandler(e.ListSharedWatchlists, mux, decoder, encoder, errhandler, formatter),
+		CreateWatchlist:      NewCreateWatchlistHandler(e.CreateWatchlist, mux, decoder, encoder, errhandler, formatter),
+		GetWatchlist:         NewGetWatchlistHandler(e.GetWatchlist, mux, decoder, encoder, errhandler, formatter),
+		UpdateWatchlist:      NewUpdateWatchlistHandler(e.UpdateWatchlist, mux, decoder, encoder, errhandler, formatter),
+		DeleteWatchlist:      NewDeleteWatchlistHandler(e.DeleteWatchlist, mux, decoder, encoder, errhandler, formatter),
+		ListItems:            NewListItemsHandler(e.ListItems, mux, decoder, encoder, errhandler, formatter),
+		AddItem:              NewAddItemHandler(e.AddItem, mux, decoder, encoder, errhandler, formatter),
+		UpdateItem:           NewUpdateItemHandler(e.UpdateItem, mux, decoder, encoder, errhandler, formatter),
+		RemoveItem:           NewRemoveItemHandler(e.RemoveItem, mux, decoder, encoder, errhandler, formatter),
+		ListShares:           NewListSharesHandler(e.ListShares, mux, decoder, encoder, errhandler, formatter),
+		ShareWatchlist:       NewShareWatchlistHandler(e.ShareWatchlist, mux, decoder, encoder, errhandler, formatter),
+		UnshareWatchlist:     NewUnshareWatchlistHandler(e.UnshareWatchlist, mux, decoder, encoder, errhandler, formatter),
+		PublishWatchlist:     NewPublishWatchlistHandler(e.PublishWatchlist, mux, decoder, encoder, errhandler, formatter),
+		UnpublishWatchlist:   NewUnpublishWatchlistHandler(e.UnpublishWatchlist, mux, decoder, encoder, errhandler, formatter),
+		GetPublicWatchlist:   NewGetPublicWatchlistHandler(e.GetPublicWatchlist, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -68,7 +142,31 @@ func (s *Server) Service() string { return "watchlist" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.List = m(s.List)
 	s.Add = m(s.Add)
+	s.Update = m(s.Update)
 	s.Remove = m(s.Remove)
+	s.ListRemoved = m(s.ListRemoved)
+	s.Restore = m(s.Restore)
+	s.Events = m(s.Events)
+	s.BulkAdd = m(s.BulkAdd)
+	s.BulkRemove = m(s.BulkRemove)
+	s.Import = m(s.Import)
+	s.Export = m(s.Export)
+	s.ListWatchlists = m(s.ListWatchlists)
+	s.ListSharedWatchlists = m(s.ListSharedWatchlists)
+	s.CreateWatchlist = m(s.CreateWatchlist)
+	s.GetWatchlist = m(s.GetWatchlist)
+	s.UpdateWatchlist = m(s.UpdateWatchlist)
+	s.DeleteWatchlist = m(s.DeleteWatchlist)
+	s.ListItems = m(s.ListItems)
+	s.AddItem = m(s.AddItem)
+	s.UpdateItem = m(s.UpdateItem)
+	s.RemoveItem = m(s.RemoveItem)
+	s.ListShares = m(s.ListShares)
+	s.ShareWatchlist = m(s.ShareWatchlist)
+	s.UnshareWatchlist = m(s.UnshareWatchlist)
+	s.PublishWatchlist = m(s.PublishWatchlist)
+	s.UnpublishWatchlist = m(s.UnpublishWatchlist)
+	s.GetPublicWatchlist = m(s.GetPublicWatchlist)
 }
 
 // MethodNames returns the methods served.
@@ -78,7 +176,31 @@ func (s *Server) MethodNames() []string { return watchlist.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountListHandler(mux, h.List)
 	MountAddHandler(mux, h.Add)
+	MountUpdateHandler(mux, h.Update)
 	MountRemoveHandler(mux, h.Remove)
+	MountListRemovedHandler(mux, h.ListRemoved)
+	MountRestoreHandler(mux, h.Restore)
+	MountEventsHandler(mux, h.Events)
+	MountBulkAddHandler(mux, h.BulkAdd)
+	MountBulkRemoveHandler(mux, h.BulkRemove)
+	MountImportHandler(mux, h.Import)
+	MountExportHandler(mux, h.Export)
+	MountListWatchlistsHandler(mux, h.ListWatchlists)
+	MountListSharedWatchlistsHandler(mux, h.ListSharedWatchlists)
+	MountCreateWatchlistHandler(mux, h.CreateWatchlist)
+	MountGetWatchlistHandler(mux, h.GetWatchlist)
+	MountUpdateWatchlistHandler(mux, h.UpdateWatchlist)
+	MountDeleteWatchlistHandler(mux, h.DeleteWatchlist)
+	MountListItemsHandler(mux, h.ListItems)
+	MountAddItemHandler(mux, h.AddItem)
+	MountUpdateItemHandler(mux, h.UpdateItem)
+	MountRemoveItemHandler(mux, h.RemoveItem)
+	MountListSharesHandler(mux, h.ListShares)
+	MountShareWatchlistHandler(mux, h.ShareWatchlist)
+	MountUnshareWatchlistHandler(mux, h.UnshareWatchlist)
+	MountPublishWatchlistHandler(mux, h.PublishWatchlist)
+	MountUnpublishWatchlistHandler(mux, h.UnpublishWatchlist)
+	MountGetPublicWatchlistHandler(mux, h.GetPublicWatchlist)
 }
 
 // Mount configures the mux to serve the watchlist endpoints.
@@ -111,7 +233,7 @@ func NewListHandler(
 	var (
 		decodeRequest  = DecodeListRequest(mux, decoder)
 		encodeResponse = EncodeListResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeListError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -119,20 +241,22 @@ func NewListHandler(
 		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
 		payload, err := decodeRequest(r)
 		if err != nil {
-			if err := encodeError(ctx, w, err); err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
 			}
 			return
 		}
 		res, err := endpoint(ctx, payload)
 		if err != nil {
-			if err := encodeError(ctx, w, err); err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
 			}
 			return
 		}
 		if err := encodeResponse(ctx, w, res); err != nil {
-			errhandler(ctx, w, err)
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
 		}
 	})
 }
@@ -162,7 +286,7 @@ func NewAddHandler(
 	var (
 		decodeRequest  = DecodeAddRequest(mux, decoder)
 		encodeResponse = EncodeAddResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeAddError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -170,20 +294,75 @@ func NewAddHandler(
 		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
 		payload, err := decodeRequest(r)
 		if err != nil {
-			if err := encodeError(ctx, w, err); err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateHandler configures the mux to serve the "watchlist" service
+// "update" endpoint.
+func MountUpdateHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PATCH", "/watchlist/{symbol}", f)
+}
+
+// NewUpdateHandler creates a HTTP handler which loads the HTTP request and
+// calls the "watchlist" service "update" endpoint.
+func NewUpdateHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateRequest(mux, decoder)
+		encodeResponse = EncodeUpdateResponse(encoder)
+		encodeError    = EncodeUpdateError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
 			}
 			return
 		}
 		res, err := endpoint(ctx, payload)
 		if err != nil {
-			if err := encodeError(ctx, w, err); err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
 			}
 			return
 		}
 		if err := encodeResponse(ctx, w, res); err != nil {
-			errhandler(ctx, w, err)
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
 		}
 	})
 }
@@ -213,7 +392,7 @@ func NewRemoveHandler(
 	var (
 		decodeRequest  = DecodeRemoveRequest(mux, decoder)
 		encodeResponse = EncodeRemoveResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeRemoveError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -221,20 +400,1278 @@ func NewRemoveHandler(
 		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
 		payload, err := decodeRequest(r)
 		if err != nil {
-			if err := encodeError(ctx, w, err); err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListRemovedHandler configures the mux to serve the "watchlist" service
+// "list_removed" endpoint.
+func MountListRemovedHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/watchlist/removed", f)
+}
+
+// NewListRemovedHandler creates a HTTP handler which loads the HTTP request
+// and calls the "watchlist" service "list_removed" endpoint.
+func NewListRemovedHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListRemovedRequest(mux, decoder)
+		encodeResponse = EncodeListRemovedResponse(encoder)
+		encodeError    = EncodeListRemovedError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_removed")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRestoreHandler configures the mux to serve the "watchlist" service
+// "restore" endpoint.
+func MountRestoreHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/watchlist/{symbol}/restore", f)
+}
+
+// NewRestoreHandler creates a HTTP handler which loads the HTTP request and
+// calls the "watchlist" service "restore" endpoint.
+func NewRestoreHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRestoreRequest(mux, decoder)
+		encodeResponse = EncodeRestoreResponse(encoder)
+		encodeError    = EncodeRestoreError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "restore")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountEventsHandler configures the mux to serve the "watchlist" service
+// "events" endpoint.
+func MountEventsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/watchlist/events", f)
+}
+
+// NewEventsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "watchlist" service "events" endpoint.
+func NewEventsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest = DecodeEventsRequest(mux, decoder)
+		encodeError   = EncodeEventsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "events")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		v := &watchlist.EventsEndpointInput{
+			Stream: &EventsServerStream{
+				w: w,
+				r: r,
+			},
+			Payload: payload,
+		}
+		_, err = endpoint(ctx, v)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+	})
+}
+
+// MountBulkAddHandler configures the mux to serve the "watchlist" service
+// "bulk_add" endpoint.
+func MountBulkAddHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/watchlist/bulk/add", f)
+}
+
+// NewBulkAddHandler creates a HTTP handler which loads the HTTP request and
+// calls the "watchlist" service "bulk_add" endpoint.
+func NewBulkAddHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBulkAddRequest(mux, decoder)
+		encodeResponse = EncodeBulkAddResponse(encoder)
+		encodeError    = EncodeBulkAddError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "bulk_add")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountBulkRemoveHandler configures the mux to serve the "watchlist" service
+// "bulk_remove" endpoint.
+func MountBulkRemoveHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/watchlist/bulk/remove", f)
+}
+
+// NewBulkRemoveHandler creates a HTTP handler which loads the HTTP request and
+// calls the "watchlist" service "bulk_remove" endpoint.
+func NewBulkRemoveHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBulkRemoveRequest(mux, decoder)
+		encodeResponse = EncodeBulkRemoveResponse(encoder)
+		encodeError    = EncodeBulkRemoveError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "bulk_remove")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
 			}
 			return
 		}
 		res, err := endpoint(ctx, payload)
 		if err != nil {
-			if err := encodeError(ctx, w, err); err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
 			}
 			return
 		}
 		if err := encodeResponse(ctx, w, res); err != nil {
-			errhandler(ctx, w, err)
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountImportHandler configures the mux to serve the "watchlist" service
+// "import" endpoint.
+func MountImportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/watchlist/import", f)
+}
+
+// NewImportHandler creates a HTTP handler which loads the HTTP request and
+// calls the "watchlist" service "import" endpoint.
+func NewImportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeImportRequest(mux, decoder)
+		encodeResponse = EncodeImportResponse(encoder)
+		encodeError    = EncodeImportError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "import")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		data := &watchlist.ImportRequestData{Payload: payload, Body: r.Body}
+		res, err := endpoint(ctx, data)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountExportHandler configures the mux to serve the "watchlist" service
+// "export" endpoint.
+func MountExportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/watchlist/export", f)
+}
+
+// NewExportHandler creates a HTTP handler which loads the HTTP request and
+// calls the "watchlist" service "export" endpoint.
+func NewExportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeExportRequest(mux, decoder)
+		encodeResponse = EncodeExportResponse(encoder)
+		encodeError    = EncodeExportError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "export")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*watchlist.ExportResponseData)
+		defer o.Body.Close()
+		if wt, ok := o.Body.(io.WriterTo); ok {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
+				if errhandler != nil {
+					errhandler(ctx, w, err)
+				}
+				return
+			}
+			n, err := wt.WriteTo(w)
+			if err != nil {
+				if n == 0 {
+					if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+						errhandler(ctx, w, err)
+					}
+				} else {
+					http.NewResponseController(w).Flush()
+					panic(http.ErrAbortHandler) // too late to write an error
+				}
+			}
+			return
+		}
+		// handle immediate read error like a returned error
+		buf := bufio.NewReader(o.Body)
+		if _, err := buf.Peek(1); err != nil && err != io.EOF {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if _, err := io.Copy(w, buf); err != nil {
+			http.NewResponseController(w).Flush()
+			panic(http.ErrAbortHandler) // too late to write an error
+		}
+	})
+}
+
+// MountListWatchlistsHandler configures the mux to serve the "watchlist"
+// service "list_watchlists" endpoint.
+func MountListWatchlistsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/watchlists", f)
+}
+
+// NewListWatchlistsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "watchlist" service "list_watchlists" endpoint.
+func NewListWatchlistsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListWatchlistsRequest(mux, decoder)
+		encodeResponse = EncodeListWatchlistsResponse(encoder)
+		encodeError    = EncodeListWatchlistsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_watchlists")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListSharedWatchlistsHandler configures the mux to serve the "watchlist"
+// service "list_shared_watchlists" endpoint.
+func MountListSharedWatchlistsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/watchlists/shared", f)
+}
+
+// NewListSharedWatchlistsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "watchlist" service "list_shared_watchlists" endpoint.
+func NewListSharedWatchlistsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListSharedWatchlistsRequest(mux, decoder)
+		encodeResponse = EncodeListSharedWatchlistsResponse(encoder)
+		encodeError    = EncodeListSharedWatchlistsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_shared_watchlists")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCreateWatchlistHandler configures the mux to serve the "watchlist"
+// service "create_watchlist" endpoint.
+func MountCreateWatchlistHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/watchlists", f)
+}
+
+// NewCreateWatchlistHandler creates a HTTP handler which loads the HTTP
+// request and calls the "watchlist" service "create_watchlist" endpoint.
+func NewCreateWatchlistHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateWatchlistRequest(mux, decoder)
+		encodeResponse = EncodeCreateWatchlistResponse(encoder)
+		encodeError    = EncodeCreateWatchlistError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create_watchlist")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetWatchlistHandler configures the mux to serve the "watchlist" service
+// "get_watchlist" endpoint.
+func MountGetWatchlistHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/watchlists/{id}", f)
+}
+
+// NewGetWatchlistHandler creates a HTTP handler which loads the HTTP request
+// and calls the "watchlist" service "get_watchlist" endpoint.
+func NewGetWatchlistHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetWatchlistRequest(mux, decoder)
+		encodeResponse = EncodeGetWatchlistResponse(encoder)
+		encodeError    = EncodeGetWatchlistError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_watchlist")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateWatchlistHandler configures the mux to serve the "watchlist"
+// service "update_watchlist" endpoint.
+func MountUpdateWatchlistHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PATCH", "/watchlists/{id}", f)
+}
+
+// NewUpdateWatchlistHandler creates a HTTP handler which loads the HTTP
+// request and calls the "watchlist" service "update_watchlist" endpoint.
+func NewUpdateWatchlistHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateWatchlistRequest(mux, decoder)
+		encodeResponse = EncodeUpdateWatchlistResponse(encoder)
+		encodeError    = EncodeUpdateWatchlistError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update_watchlist")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteWatchlistHandler configures the mux to serve the "watchlist"
+// service "delete_watchlist" endpoint.
+func MountDeleteWatchlistHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/watchlists/{id}", f)
+}
+
+// NewDeleteWatchlistHandler creates a HTTP handler which loads the HTTP
+// request and calls the "watchlist" service "delete_watchlist" endpoint.
+func NewDeleteWatchlistHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteWatchlistRequest(mux, decoder)
+		encodeResponse = EncodeDeleteWatchlistResponse(encoder)
+		encodeError    = EncodeDeleteWatchlistError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete_watchlist")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListItemsHandler configures the mux to serve the "watchlist" service
+// "list_items" endpoint.
+func MountListItemsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/watchlists/{id}/items", f)
+}
+
+// NewListItemsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "watchlist" service "list_items" endpoint.
+func NewListItemsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListItemsRequest(mux, decoder)
+		encodeResponse = EncodeListItemsResponse(encoder)
+		encodeError    = EncodeListItemsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_items")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountAddItemHandler configures the mux to serve the "watchlist" service
+// "add_item" endpoint.
+func MountAddItemHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/watchlists/{id}/items", f)
+}
+
+// NewAddItemHandler creates a HTTP handler which loads the HTTP request and
+// calls the "watchlist" service "add_item" endpoint.
+func NewAddItemHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAddItemRequest(mux, decoder)
+		encodeResponse = EncodeAddItemResponse(encoder)
+		encodeError    = EncodeAddItemError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "add_item")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateItemHandler configures the mux to serve the "watchlist" service
+// "update_item" endpoint.
+func MountUpdateItemHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PATCH", "/watchlists/{id}/items/{symbol}", f)
+}
+
+// NewUpdateItemHandler creates a HTTP handler which loads the HTTP request and
+// calls the "watchlist" service "update_item" endpoint.
+func NewUpdateItemHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateItemRequest(mux, decoder)
+		encodeResponse = EncodeUpdateItemResponse(encoder)
+		encodeError    = EncodeUpdateItemError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update_item")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRemoveItemHandler configures the mux to serve the "watchlist" service
+// "remove_item" endpoint.
+func MountRemoveItemHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/watchlists/{id}/items/{symbol}", f)
+}
+
+// NewRemoveItemHandler creates a HTTP handler which loads the HTTP request and
+// calls the "watchlist" service "remove_item" endpoint.
+func NewRemoveItemHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRemoveItemRequest(mux, decoder)
+		encodeResponse = EncodeRemoveItemResponse(encoder)
+		encodeError    = EncodeRemoveItemError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "remove_item")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListSharesHandler configures the mux to serve the "watchlist" service
+// "list_shares" endpoint.
+func MountListSharesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/watchlists/{id}/shares", f)
+}
+
+// NewListSharesHandler creates a HTTP handler which loads the HTTP request and
+// calls the "watchlist" service "list_shares" endpoint.
+func NewListSharesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListSharesRequest(mux, decoder)
+		encodeResponse = EncodeListSharesResponse(encoder)
+		encodeError    = EncodeListSharesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_shares")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountShareWatchlistHandler configures the mux to serve the "watchlist"
+// service "share_watchlist" endpoint.
+func MountShareWatchlistHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/watchlists/{id}/shares/{user}", f)
+}
+
+// NewShareWatchlistHandler creates a HTTP handler which loads the HTTP request
+// and calls the "watchlist" service "share_watchlist" endpoint.
+func NewShareWatchlistHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeShareWatchlistRequest(mux, decoder)
+		encodeResponse = EncodeShareWatchlistResponse(encoder)
+		encodeError    = EncodeShareWatchlistError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "share_watchlist")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUnshareWatchlistHandler configures the mux to serve the "watchlist"
+// service "unshare_watchlist" endpoint.
+func MountUnshareWatchlistHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/watchlists/{id}/shares/{user}", f)
+}
+
+// NewUnshareWatchlistHandler creates a HTTP handler which loads the HTTP
+// request and calls the "watchlist" service "unshare_watchlist" endpoint.
+func NewUnshareWatchlistHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUnshareWatchlistRequest(mux, decoder)
+		encodeResponse = EncodeUnshareWatchlistResponse(encoder)
+		encodeError    = EncodeUnshareWatchlistError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "unshare_watchlist")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPublishWatchlistHandler configures the mux to serve the "watchlist"
+// service "publish_watchlist" endpoint.
+func MountPublishWatchlistHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/watchlists/{id}/public", f)
+}
+
+// NewPublishWatchlistHandler creates a HTTP handler which loads the HTTP
+// request and calls the "watchlist" service "publish_watchlist" endpoint.
+func NewPublishWatchlistHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePublishWatchlistRequest(mux, decoder)
+		encodeResponse = EncodePublishWatchlistResponse(encoder)
+		encodeError    = EncodePublishWatchlistError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "publish_watchlist")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUnpublishWatchlistHandler configures the mux to serve the "watchlist"
+// service "unpublish_watchlist" endpoint.
+func MountUnpublishWatchlistHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/watchlists/{id}/public", f)
+}
+
+// NewUnpublishWatchlistHandler creates a HTTP handler which loads the HTTP
+// request and calls the "watchlist" service "unpublish_watchlist" endpoint.
+func NewUnpublishWatchlistHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUnpublishWatchlistRequest(mux, decoder)
+		encodeResponse = EncodeUnpublishWatchlistResponse(encoder)
+		encodeError    = EncodeUnpublishWatchlistError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "unpublish_watchlist")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetPublicWatchlistHandler configures the mux to serve the "watchlist"
+// service "get_public_watchlist" endpoint.
+func MountGetPublicWatchlistHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/public/watchlists/{token}", f)
+}
+
+// NewGetPublicWatchlistHandler creates a HTTP handler which loads the HTTP
+// request and calls the "watchlist" service "get_public_watchlist" endpoint.
+func NewGetPublicWatchlistHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetPublicWatchlistRequest(mux, decoder)
+		encodeResponse = EncodeGetPublicWatchlistResponse(encoder)
+		encodeError    = EncodeGetPublicWatchlistError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_public_watchlist")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "watchlist")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
 		}
 	})
 }
@@ -1,13 +1,15 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // watchlist HTTP server types
 //
 // Command:
-// $ goa gen github.com/reidlai/ta-workspace/modules/watchlist/go/design
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
 
 package server
 
 import (
+	"unicode/utf8"
+
 	watchlist "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -15,13 +17,113 @@ import (
 // AddRequestBody is the type of the "watchlist" service "add" endpoint HTTP
 // request body.
 type AddRequestBody struct {
+	// Ticker symbol, optionally suffixed with the MIC of its listing exchange
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	OnHand *bool   `form:"on_hand,omitempty" json:"on_hand,omitempty" xml:"on_hand,omitempty"`
+	// Free-text note; empty clears it
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags, replacing the current ones; empty clears them
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price; 0 clears it
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price; 0 clears it
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+}
+
+// UpdateRequestBody is the type of the "watchlist" service "update" endpoint
+// HTTP request body.
+type UpdateRequestBody struct {
+	OnHand *bool `form:"on_hand,omitempty" json:"on_hand,omitempty" xml:"on_hand,omitempty"`
+	// Free-text note; empty clears it
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags, replacing the current ones; empty clears them
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price; 0 clears it
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price; 0 clears it
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+	// New zero-based position; positions past the end move the item last
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+}
+
+// BulkAddRequestBody is the type of the "watchlist" service "bulk_add"
+// endpoint HTTP request body.
+type BulkAddRequestBody struct {
+	// all_or_nothing stores no entry unless every one is valid; best_effort stores
+	// the valid ones
+	Mode *string `form:"mode,omitempty" json:"mode,omitempty" xml:"mode,omitempty"`
+	// Tickers to add
+	Items []*BulkAddItemRequestBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+}
+
+// BulkRemoveRequestBody is the type of the "watchlist" service "bulk_remove"
+// endpoint HTTP request body.
+type BulkRemoveRequestBody struct {
+	// all_or_nothing stores no entry unless every one is valid; best_effort stores
+	// the valid ones
+	Mode *string `form:"mode,omitempty" json:"mode,omitempty" xml:"mode,omitempty"`
+	// Tickers to remove
+	Symbols []string `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+}
+
+// CreateWatchlistRequestBody is the type of the "watchlist" service
+// "create_watchlist" endpoint HTTP request body.
+type CreateWatchlistRequestBody struct {
+	// Watchlist name, unique per user regardless of case
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// UpdateWatchlistRequestBody is the type of the "watchlist" service
+// "update_watchlist" endpoint HTTP request body.
+type UpdateWatchlistRequestBody struct {
+	// Watchlist name, unique per user regardless of case
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// New zero-based position; positions past the end move the watchlist last
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+}
+
+// AddItemRequestBody is the type of the "watchlist" service "add_item"
+// endpoint HTTP request body.
+type AddItemRequestBody struct {
+	// Ticker symbol, optionally suffixed with the MIC of its listing exchange
 	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
 	OnHand *bool   `form:"on_hand,omitempty" json:"on_hand,omitempty" xml:"on_hand,omitempty"`
+	// Free-text note; empty clears it
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags, replacing the current ones; empty clears them
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price; 0 clears it
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price; 0 clears it
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+}
+
+// UpdateItemRequestBody is the type of the "watchlist" service "update_item"
+// endpoint HTTP request body.
+type UpdateItemRequestBody struct {
+	OnHand *bool `form:"on_hand,omitempty" json:"on_hand,omitempty" xml:"on_hand,omitempty"`
+	// Free-text note; empty clears it
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags, replacing the current ones; empty clears them
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price; 0 clears it
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price; 0 clears it
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+	// New zero-based position; positions past the end move the item last
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+}
+
+// ShareWatchlistRequestBody is the type of the "watchlist" service
+// "share_watchlist" endpoint HTTP request body.
+type ShareWatchlistRequestBody struct {
+	// editor changes the items of the watchlist; viewer only reads them
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
 }
 
 // ListResponseBody is the type of the "watchlist" service "list" endpoint HTTP
 // response body.
-type ListResponseBody []*TickerItemResponse
+type ListResponseBody []*TickerItem
 
 // AddResponseBody is the type of the "watchlist" service "add" endpoint HTTP
 // response body.
@@ -32,74 +134,6010 @@ type AddResponseBody struct {
 	OnHand bool `form:"on_hand" json:"on_hand" xml:"on_hand"`
 	// Creation timestamp
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Operating MIC of the listing exchange, when known
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// ID of the instrument in the instrument master, when listed there
+	InstrumentID *string `form:"instrument_id,omitempty" json:"instrument_id,omitempty" xml:"instrument_id,omitempty"`
+	// Free-text note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price, when set
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price, when set
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+	// Zero-based position in the watchlist
+	Position int `form:"position" json:"position" xml:"position"`
 }
 
-// TickerItemResponse is used to define fields on response body types.
-type TickerItemResponse struct {
+// UpdateResponseBody is the type of the "watchlist" service "update" endpoint
+// HTTP response body.
+type UpdateResponseBody struct {
 	// Stock Symbol
 	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
 	// Whether user holds the stock
 	OnHand bool `form:"on_hand" json:"on_hand" xml:"on_hand"`
 	// Creation timestamp
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Operating MIC of the listing exchange, when known
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// ID of the instrument in the instrument master, when listed there
+	InstrumentID *string `form:"instrument_id,omitempty" json:"instrument_id,omitempty" xml:"instrument_id,omitempty"`
+	// Free-text note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price, when set
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price, when set
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+	// Zero-based position in the watchlist
+	Position int `form:"position" json:"position" xml:"position"`
 }
 
-// NewListResponseBody builds the HTTP response body from the result of the
-// "list" endpoint of the "watchlist" service.
-func NewListResponseBody(res []*watchlist.TickerItem) ListResponseBody {
-	body := make([]*TickerItemResponse, len(res))
-	for i, val := range res {
-		body[i] = marshalWatchlistTickerItemToTickerItemResponse(val)
-	}
-	return body
+// ListRemovedResponseBody is the type of the "watchlist" service
+// "list_removed" endpoint HTTP response body.
+type ListRemovedResponseBody []*RemovedTickerItemResponse
+
+// RestoreResponseBody is the type of the "watchlist" service "restore"
+// endpoint HTTP response body.
+type RestoreResponseBody struct {
+	// Stock Symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Whether user holds the stock
+	OnHand bool `form:"on_hand" json:"on_hand" xml:"on_hand"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Operating MIC of the listing exchange, when known
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// ID of the instrument in the instrument master, when listed there
+	InstrumentID *string `form:"instrument_id,omitempty" json:"instrument_id,omitempty" xml:"instrument_id,omitempty"`
+	// Free-text note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price, when set
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price, when set
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+	// Zero-based position in the watchlist
+	Position int `form:"position" json:"position" xml:"position"`
 }
 
-// NewAddResponseBody builds the HTTP response body from the result of the
-// "add" endpoint of the "watchlist" service.
-func NewAddResponseBody(res *watchlist.TickerItem) *AddResponseBody {
-	body := &AddResponseBody{
-		Symbol:    res.Symbol,
-		OnHand:    res.OnHand,
-		CreatedAt: res.CreatedAt,
-	}
-	return body
+// EventsResponseBody is the type of the "watchlist" service "events" endpoint
+// HTTP response body.
+type EventsResponseBody struct {
+	// Event ID, sent back in the Last-Event-ID header to resume the stream
+	ID string `form:"id" json:"id" xml:"id"`
+	// added, updated, removed or restored for an item; ready once the stream is
+	// open and the missed events sent; reset instead of ready when events were
+	// missed and the watchlists must be reloaded
+	Type string `form:"type" json:"type" xml:"type"`
+	// Change, omitted from ready and reset events
+	Data *WatchlistChangeResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
 }
 
-// NewListPayload builds a watchlist service list endpoint payload.
-func NewListPayload(userID string) *watchlist.ListPayload {
-	v := &watchlist.ListPayload{}
-	v.UserID = userID
+// BulkAddResponseBody is the type of the "watchlist" service "bulk_add"
+// endpoint HTTP response body.
+type BulkAddResponseBody struct {
+	// Mode of the request
+	Mode string `form:"mode" json:"mode" xml:"mode"`
+	// Entries stored
+	Succeeded int `form:"succeeded" json:"succeeded" xml:"succeeded"`
+	// Entries rejected
+	Failed int `form:"failed" json:"failed" xml:"failed"`
+	// Result of each entry, in request order
+	Results []*BulkItemResultResponseBody `form:"results" json:"results" xml:"results"`
+}
 
-	return v
+// BulkRemoveResponseBody is the type of the "watchlist" service "bulk_remove"
+// endpoint HTTP response body.
+type BulkRemoveResponseBody struct {
+	// Mode of the request
+	Mode string `form:"mode" json:"mode" xml:"mode"`
+	// Entries stored
+	Succeeded int `form:"succeeded" json:"succeeded" xml:"succeeded"`
+	// Entries rejected
+	Failed int `form:"failed" json:"failed" xml:"failed"`
+	// Result of each entry, in request order
+	Results []*BulkItemResultResponseBody `form:"results" json:"results" xml:"results"`
 }
 
-// NewAddPayload builds a watchlist service add endpoint payload.
-func NewAddPayload(body *AddRequestBody, userID string) *watchlist.AddPayload {
-	v := &watchlist.AddPayload{
-		Symbol: *body.Symbol,
-		OnHand: *body.OnHand,
-	}
-	v.UserID = userID
+// ImportResponseBody is the type of the "watchlist" service "import" endpoint
+// HTTP response body.
+type ImportResponseBody struct {
+	// Whether the file was only validated
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// Whether the items were stored; false when any row is invalid
+	Applied bool `form:"applied" json:"applied" xml:"applied"`
+	// Number of data rows in the file
+	Rows int `form:"rows" json:"rows" xml:"rows"`
+	// Items added, or that would be
+	Added int `form:"added" json:"added" xml:"added"`
+	// Items updated, or that would be
+	Updated int `form:"updated" json:"updated" xml:"updated"`
+	// Items already up to date
+	Unchanged int `form:"unchanged" json:"unchanged" xml:"unchanged"`
+	// Invalid rows
+	Errors []*ImportRowErrorResponseBody `form:"errors" json:"errors" xml:"errors"`
+}
 
-	return v
+// ListWatchlistsResponseBody is the type of the "watchlist" service
+// "list_watchlists" endpoint HTTP response body.
+type ListWatchlistsResponseBody []*WatchlistResponse
+
+// ListSharedWatchlistsResponseBody is the type of the "watchlist" service
+// "list_shared_watchlists" endpoint HTTP response body.
+type ListSharedWatchlistsResponseBody []*WatchlistResponse
+
+// CreateWatchlistResponseBody is the type of the "watchlist" service
+// "create_watchlist" endpoint HTTP response body.
+type CreateWatchlistResponseBody struct {
+	// Watchlist ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Watchlist name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Zero-based position among the user's watchlists
+	Position int `form:"position" json:"position" xml:"position"`
+	// Whether this is the default watchlist, served by the /watchlist routes
+	Default bool `form:"default" json:"default" xml:"default"`
+	// Number of items
+	ItemCount int `form:"item_count" json:"item_count" xml:"item_count"`
+	// Creation time
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last rename or move
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// User ID of the owner
+	Owner string `form:"owner" json:"owner" xml:"owner"`
+	// Access of the user: owner of the watchlist, or the role it is shared with
+	// them with
+	Role string `form:"role" json:"role" xml:"role"`
+	// Token of the public link, /public/watchlists/{public_token}, when published;
+	// shown to the owner only
+	PublicToken *string `form:"public_token,omitempty" json:"public_token,omitempty" xml:"public_token,omitempty"`
 }
 
-// NewRemovePayload builds a watchlist service remove endpoint payload.
-func NewRemovePayload(symbol string, userID string) *watchlist.RemovePayload {
-	v := &watchlist.RemovePayload{}
-	v.Symbol = symbol
-	v.UserID = userID
+// GetWatchlistResponseBody is the type of the "watchlist" service
+// "get_watchlist" endpoint HTTP response body.
+type GetWatchlistResponseBody struct {
+	// Watchlist ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Watchlist name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Zero-based position among the user's watchlists
+	Position int `form:"position" json:"position" xml:"position"`
+	// Whether this is the default watchlist, served by the /watchlist routes
+	Default bool `form:"default" json:"default" xml:"default"`
+	// Number of items
+	ItemCount int `form:"item_count" json:"item_count" xml:"item_count"`
+	// Creation time
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last rename or move
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// User ID of the owner
+	Owner string `form:"owner" json:"owner" xml:"owner"`
+	// Access of the user: owner of the watchlist, or the role it is shared with
+	// them with
+	Role string `form:"role" json:"role" xml:"role"`
+	// Token of the public link, /public/watchlists/{public_token}, when published;
+	// shown to the owner only
+	PublicToken *string `form:"public_token,omitempty" json:"public_token,omitempty" xml:"public_token,omitempty"`
+}
 
-	return v
+// UpdateWatchlistResponseBody is the type of the "watchlist" service
+// "update_watchlist" endpoint HTTP response body.
+type UpdateWatchlistResponseBody struct {
+	// Watchlist ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Watchlist name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Zero-based position among the user's watchlists
+	Position int `form:"position" json:"position" xml:"position"`
+	// Whether this is the default watchlist, served by the /watchlist routes
+	Default bool `form:"default" json:"default" xml:"default"`
+	// Number of items
+	ItemCount int `form:"item_count" json:"item_count" xml:"item_count"`
+	// Creation time
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last rename or move
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// User ID of the owner
+	Owner string `form:"owner" json:"owner" xml:"owner"`
+	// Access of the user: owner of the watchlist, or the role it is shared with
+	// them with
+	Role string `form:"role" json:"role" xml:"role"`
+	// Token of the public link, /public/watchlists/{public_token}, when published;
+	// shown to the owner only
+	PublicToken *string `form:"public_token,omitempty" json:"public_token,omitempty" xml:"public_token,omitempty"`
 }
 
-// ValidateAddRequestBody runs the validations defined on AddRequestBody
-func ValidateAddRequestBody(body *AddRequestBody) (err error) {
-	if body.Symbol == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
-	}
-	if body.OnHand == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("on_hand", "body"))
+// ListItemsResponseBody is the type of the "watchlist" service "list_items"
+// endpoint HTTP response body.
+type ListItemsResponseBody []*TickerItem
+
+// AddItemResponseBody is the type of the "watchlist" service "add_item"
+// endpoint HTTP response body.
+type AddItemResponseBody struct {
+	// Stock Symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Whether user holds the stock
+	OnHand bool `form:"on_hand" json:"on_hand" xml:"on_hand"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Operating MIC of the listing exchange, when known
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// ID of the instrument in the instrument master, when listed there
+	InstrumentID *string `form:"instrument_id,omitempty" json:"instrument_id,omitempty" xml:"instrument_id,omitempty"`
+	// Free-text note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price, when set
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price, when set
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+	// Zero-based position in the watchlist
+	Position int `form:"position" json:"position" xml:"position"`
+}
+
+// UpdateItemResponseBody is the type of the "watchlist" service "update_item"
+// endpoint HTTP response body.
+type UpdateItemResponseBody struct {
+	// Stock Symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Whether user holds the stock
+	OnHand bool `form:"on_hand" json:"on_hand" xml:"on_hand"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Operating MIC of the listing exchange, when known
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// ID of the instrument in the instrument master, when listed there
+	InstrumentID *string `form:"instrument_id,omitempty" json:"instrument_id,omitempty" xml:"instrument_id,omitempty"`
+	// Free-text note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price, when set
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price, when set
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+	// Zero-based position in the watchlist
+	Position int `form:"position" json:"position" xml:"position"`
+}
+
+// ListSharesResponseBody is the type of the "watchlist" service "list_shares"
+// endpoint HTTP response body.
+type ListSharesResponseBody []*WatchlistShareResponse
+
+// ShareWatchlistResponseBody is the type of the "watchlist" service
+// "share_watchlist" endpoint HTTP response body.
+type ShareWatchlistResponseBody struct {
+	// User ID the watchlist is shared with
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// editor changes the items of the watchlist; viewer only reads them
+	Role string `form:"role" json:"role" xml:"role"`
+	// When the watchlist was first shared with the user
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// PublishWatchlistResponseBody is the type of the "watchlist" service
+// "publish_watchlist" endpoint HTTP response body.
+type PublishWatchlistResponseBody struct {
+	// Watchlist ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Watchlist name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Zero-based position among the user's watchlists
+	Position int `form:"position" json:"position" xml:"position"`
+	// Whether this is the default watchlist, served by the /watchlist routes
+	Default bool `form:"default" json:"default" xml:"default"`
+	// Number of items
+	ItemCount int `form:"item_count" json:"item_count" xml:"item_count"`
+	// Creation time
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last rename or move
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// User ID of the owner
+	Owner string `form:"owner" json:"owner" xml:"owner"`
+	// Access of the user: owner of the watchlist, or the role it is shared with
+	// them with
+	Role string `form:"role" json:"role" xml:"role"`
+	// Token of the public link, /public/watchlists/{public_token}, when published;
+	// shown to the owner only
+	PublicToken *string `form:"public_token,omitempty" json:"public_token,omitempty" xml:"public_token,omitempty"`
+}
+
+// GetPublicWatchlistResponseBody is the type of the "watchlist" service
+// "get_public_watchlist" endpoint HTTP response body.
+type GetPublicWatchlistResponseBody struct {
+	// Watchlist name
+	Name  string                          `form:"name" json:"name" xml:"name"`
+	Items []*PublicTickerItemResponseBody `form:"items" json:"items" xml:"items"`
+}
+
+// ListUnauthorizedResponseBody is the type of the "watchlist" service "list"
+// endpoint HTTP response body for the "unauthorized" error.
+type ListUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListNotFoundResponseBody is the type of the "watchlist" service "list"
+// endpoint HTTP response body for the "not_found" error.
+type ListNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListForbiddenResponseBody is the type of the "watchlist" service "list"
+// endpoint HTTP response body for the "forbidden" error.
+type ListForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListConflictResponseBody is the type of the "watchlist" service "list"
+// endpoint HTTP response body for the "conflict" error.
+type ListConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListPreconditionFailedResponseBody is the type of the "watchlist" service
+// "list" endpoint HTTP response body for the "precondition_failed" error.
+type ListPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddInvalidSymbolResponseBody is the type of the "watchlist" service "add"
+// endpoint HTTP response body for the "invalid_symbol" error.
+type AddInvalidSymbolResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddUnauthorizedResponseBody is the type of the "watchlist" service "add"
+// endpoint HTTP response body for the "unauthorized" error.
+type AddUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddNotFoundResponseBody is the type of the "watchlist" service "add"
+// endpoint HTTP response body for the "not_found" error.
+type AddNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddForbiddenResponseBody is the type of the "watchlist" service "add"
+// endpoint HTTP response body for the "forbidden" error.
+type AddForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddConflictResponseBody is the type of the "watchlist" service "add"
+// endpoint HTTP response body for the "conflict" error.
+type AddConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddPreconditionFailedResponseBody is the type of the "watchlist" service
+// "add" endpoint HTTP response body for the "precondition_failed" error.
+type AddPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateUnauthorizedResponseBody is the type of the "watchlist" service
+// "update" endpoint HTTP response body for the "unauthorized" error.
+type UpdateUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateNotFoundResponseBody is the type of the "watchlist" service "update"
+// endpoint HTTP response body for the "not_found" error.
+type UpdateNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateForbiddenResponseBody is the type of the "watchlist" service "update"
+// endpoint HTTP response body for the "forbidden" error.
+type UpdateForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateConflictResponseBody is the type of the "watchlist" service "update"
+// endpoint HTTP response body for the "conflict" error.
+type UpdateConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdatePreconditionFailedResponseBody is the type of the "watchlist" service
+// "update" endpoint HTTP response body for the "precondition_failed" error.
+type UpdatePreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RemoveUnauthorizedResponseBody is the type of the "watchlist" service
+// "remove" endpoint HTTP response body for the "unauthorized" error.
+type RemoveUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RemoveNotFoundResponseBody is the type of the "watchlist" service "remove"
+// endpoint HTTP response body for the "not_found" error.
+type RemoveNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RemoveForbiddenResponseBody is the type of the "watchlist" service "remove"
+// endpoint HTTP response body for the "forbidden" error.
+type RemoveForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RemoveConflictResponseBody is the type of the "watchlist" service "remove"
+// endpoint HTTP response body for the "conflict" error.
+type RemoveConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RemovePreconditionFailedResponseBody is the type of the "watchlist" service
+// "remove" endpoint HTTP response body for the "precondition_failed" error.
+type RemovePreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListRemovedUnauthorizedResponseBody is the type of the "watchlist" service
+// "list_removed" endpoint HTTP response body for the "unauthorized" error.
+type ListRemovedUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListRemovedNotFoundResponseBody is the type of the "watchlist" service
+// "list_removed" endpoint HTTP response body for the "not_found" error.
+type ListRemovedNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListRemovedForbiddenResponseBody is the type of the "watchlist" service
+// "list_removed" endpoint HTTP response body for the "forbidden" error.
+type ListRemovedForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListRemovedConflictResponseBody is the type of the "watchlist" service
+// "list_removed" endpoint HTTP response body for the "conflict" error.
+type ListRemovedConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListRemovedPreconditionFailedResponseBody is the type of the "watchlist"
+// service "list_removed" endpoint HTTP response body for the
+// "precondition_failed" error.
+type ListRemovedPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RestoreUnauthorizedResponseBody is the type of the "watchlist" service
+// "restore" endpoint HTTP response body for the "unauthorized" error.
+type RestoreUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RestoreNotFoundResponseBody is the type of the "watchlist" service "restore"
+// endpoint HTTP response body for the "not_found" error.
+type RestoreNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RestoreForbiddenResponseBody is the type of the "watchlist" service
+// "restore" endpoint HTTP response body for the "forbidden" error.
+type RestoreForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RestoreConflictResponseBody is the type of the "watchlist" service "restore"
+// endpoint HTTP response body for the "conflict" error.
+type RestoreConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RestorePreconditionFailedResponseBody is the type of the "watchlist" service
+// "restore" endpoint HTTP response body for the "precondition_failed" error.
+type RestorePreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// EventsUnauthorizedResponseBody is the type of the "watchlist" service
+// "events" endpoint HTTP response body for the "unauthorized" error.
+type EventsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// EventsNotFoundResponseBody is the type of the "watchlist" service "events"
+// endpoint HTTP response body for the "not_found" error.
+type EventsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// EventsForbiddenResponseBody is the type of the "watchlist" service "events"
+// endpoint HTTP response body for the "forbidden" error.
+type EventsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// EventsConflictResponseBody is the type of the "watchlist" service "events"
+// endpoint HTTP response body for the "conflict" error.
+type EventsConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// EventsPreconditionFailedResponseBody is the type of the "watchlist" service
+// "events" endpoint HTTP response body for the "precondition_failed" error.
+type EventsPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BulkAddUnauthorizedResponseBody is the type of the "watchlist" service
+// "bulk_add" endpoint HTTP response body for the "unauthorized" error.
+type BulkAddUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BulkAddNotFoundResponseBody is the type of the "watchlist" service
+// "bulk_add" endpoint HTTP response body for the "not_found" error.
+type BulkAddNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BulkAddForbiddenResponseBody is the type of the "watchlist" service
+// "bulk_add" endpoint HTTP response body for the "forbidden" error.
+type BulkAddForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BulkAddConflictResponseBody is the type of the "watchlist" service
+// "bulk_add" endpoint HTTP response body for the "conflict" error.
+type BulkAddConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BulkAddPreconditionFailedResponseBody is the type of the "watchlist" service
+// "bulk_add" endpoint HTTP response body for the "precondition_failed" error.
+type BulkAddPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BulkRemoveUnauthorizedResponseBody is the type of the "watchlist" service
+// "bulk_remove" endpoint HTTP response body for the "unauthorized" error.
+type BulkRemoveUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BulkRemoveNotFoundResponseBody is the type of the "watchlist" service
+// "bulk_remove" endpoint HTTP response body for the "not_found" error.
+type BulkRemoveNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BulkRemoveForbiddenResponseBody is the type of the "watchlist" service
+// "bulk_remove" endpoint HTTP response body for the "forbidden" error.
+type BulkRemoveForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BulkRemoveConflictResponseBody is the type of the "watchlist" service
+// "bulk_remove" endpoint HTTP response body for the "conflict" error.
+type BulkRemoveConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BulkRemovePreconditionFailedResponseBody is the type of the "watchlist"
+// service "bulk_remove" endpoint HTTP response body for the
+// "precondition_failed" error.
+type BulkRemovePreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportInvalidFileResponseBody is the type of the "watchlist" service
+// "import" endpoint HTTP response body for the "invalid_file" error.
+type ImportInvalidFileResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportUnauthorizedResponseBody is the type of the "watchlist" service
+// "import" endpoint HTTP response body for the "unauthorized" error.
+type ImportUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportNotFoundResponseBody is the type of the "watchlist" service "import"
+// endpoint HTTP response body for the "not_found" error.
+type ImportNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportForbiddenResponseBody is the type of the "watchlist" service "import"
+// endpoint HTTP response body for the "forbidden" error.
+type ImportForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportConflictResponseBody is the type of the "watchlist" service "import"
+// endpoint HTTP response body for the "conflict" error.
+type ImportConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportPreconditionFailedResponseBody is the type of the "watchlist" service
+// "import" endpoint HTTP response body for the "precondition_failed" error.
+type ImportPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExportUnauthorizedResponseBody is the type of the "watchlist" service
+// "export" endpoint HTTP response body for the "unauthorized" error.
+type ExportUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExportNotFoundResponseBody is the type of the "watchlist" service "export"
+// endpoint HTTP response body for the "not_found" error.
+type ExportNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExportForbiddenResponseBody is the type of the "watchlist" service "export"
+// endpoint HTTP response body for the "forbidden" error.
+type ExportForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExportConflictResponseBody is the type of the "watchlist" service "export"
+// endpoint HTTP response body for the "conflict" error.
+type ExportConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExportPreconditionFailedResponseBody is the type of the "watchlist" service
+// "export" endpoint HTTP response body for the "precondition_failed" error.
+type ExportPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWatchlistsUnauthorizedResponseBody is the type of the "watchlist"
+// service "list_watchlists" endpoint HTTP response body for the "unauthorized"
+// error.
+type ListWatchlistsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWatchlistsNotFoundResponseBody is the type of the "watchlist" service
+// "list_watchlists" endpoint HTTP response body for the "not_found" error.
+type ListWatchlistsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWatchlistsForbiddenResponseBody is the type of the "watchlist" service
+// "list_watchlists" endpoint HTTP response body for the "forbidden" error.
+type ListWatchlistsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWatchlistsConflictResponseBody is the type of the "watchlist" service
+// "list_watchlists" endpoint HTTP response body for the "conflict" error.
+type ListWatchlistsConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWatchlistsPreconditionFailedResponseBody is the type of the "watchlist"
+// service "list_watchlists" endpoint HTTP response body for the
+// "precondition_failed" error.
+type ListWatchlistsPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSharedWatchlistsUnauthorizedResponseBody is the type of the "watchlist"
+// service "list_shared_watchlists" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListSharedWatchlistsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSharedWatchlistsNotFoundResponseBody is the type of the "watchlist"
+// service "list_shared_watchlists" endpoint HTTP response body for the
+// "not_found" error.
+type ListSharedWatchlistsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSharedWatchlistsForbiddenResponseBody is the type of the "watchlist"
+// service "list_shared_watchlists" endpoint HTTP response body for the
+// "forbidden" error.
+type ListSharedWatchlistsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSharedWatchlistsConflictResponseBody is the type of the "watchlist"
+// service "list_shared_watchlists" endpoint HTTP response body for the
+// "conflict" error.
+type ListSharedWatchlistsConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSharedWatchlistsPreconditionFailedResponseBody is the type of the
+// "watchlist" service "list_shared_watchlists" endpoint HTTP response body for
+// the "precondition_failed" error.
+type ListSharedWatchlistsPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWatchlistUnauthorizedResponseBody is the type of the "watchlist"
+// service "create_watchlist" endpoint HTTP response body for the
+// "unauthorized" error.
+type CreateWatchlistUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWatchlistNotFoundResponseBody is the type of the "watchlist" service
+// "create_watchlist" endpoint HTTP response body for the "not_found" error.
+type CreateWatchlistNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWatchlistForbiddenResponseBody is the type of the "watchlist" service
+// "create_watchlist" endpoint HTTP response body for the "forbidden" error.
+type CreateWatchlistForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWatchlistConflictResponseBody is the type of the "watchlist" service
+// "create_watchlist" endpoint HTTP response body for the "conflict" error.
+type CreateWatchlistConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWatchlistPreconditionFailedResponseBody is the type of the "watchlist"
+// service "create_watchlist" endpoint HTTP response body for the
+// "precondition_failed" error.
+type CreateWatchlistPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetWatchlistUnauthorizedResponseBody is the type of the "watchlist" service
+// "get_watchlist" endpoint HTTP response body for the "unauthorized" error.
+type GetWatchlistUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetWatchlistNotFoundResponseBody is the type of the "watchlist" service
+// "get_watchlist" endpoint HTTP response body for the "not_found" error.
+type GetWatchlistNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetWatchlistForbiddenResponseBody is the type of the "watchlist" service
+// "get_watchlist" endpoint HTTP response body for the "forbidden" error.
+type GetWatchlistForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetWatchlistConflictResponseBody is the type of the "watchlist" service
+// "get_watchlist" endpoint HTTP response body for the "conflict" error.
+type GetWatchlistConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetWatchlistPreconditionFailedResponseBody is the type of the "watchlist"
+// service "get_watchlist" endpoint HTTP response body for the
+// "precondition_failed" error.
+type GetWatchlistPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateWatchlistUnauthorizedResponseBody is the type of the "watchlist"
+// service "update_watchlist" endpoint HTTP response body for the
+// "unauthorized" error.
+type UpdateWatchlistUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateWatchlistNotFoundResponseBody is the type of the "watchlist" service
+// "update_watchlist" endpoint HTTP response body for the "not_found" error.
+type UpdateWatchlistNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateWatchlistForbiddenResponseBody is the type of the "watchlist" service
+// "update_watchlist" endpoint HTTP response body for the "forbidden" error.
+type UpdateWatchlistForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateWatchlistConflictResponseBody is the type of the "watchlist" service
+// "update_watchlist" endpoint HTTP response body for the "conflict" error.
+type UpdateWatchlistConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateWatchlistPreconditionFailedResponseBody is the type of the "watchlist"
+// service "update_watchlist" endpoint HTTP response body for the
+// "precondition_failed" error.
+type UpdateWatchlistPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteWatchlistUnauthorizedResponseBody is the type of the "watchlist"
+// service "delete_watchlist" endpoint HTTP response body for the
+// "unauthorized" error.
+type DeleteWatchlistUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteWatchlistNotFoundResponseBody is the type of the "watchlist" service
+// "delete_watchlist" endpoint HTTP response body for the "not_found" error.
+type DeleteWatchlistNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteWatchlistForbiddenResponseBody is the type of the "watchlist" service
+// "delete_watchlist" endpoint HTTP response body for the "forbidden" error.
+type DeleteWatchlistForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteWatchlistConflictResponseBody is the type of the "watchlist" service
+// "delete_watchlist" endpoint HTTP response body for the "conflict" error.
+type DeleteWatchlistConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteWatchlistPreconditionFailedResponseBody is the type of the "watchlist"
+// service "delete_watchlist" endpoint HTTP response body for the
+// "precondition_failed" error.
+type DeleteWatchlistPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListItemsUnauthorizedResponseBody is the type of the "watchlist" service
+// "list_items" endpoint HTTP response body for the "unauthorized" error.
+type ListItemsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListItemsNotFoundResponseBody is the type of the "watchlist" service
+// "list_items" endpoint HTTP response body for the "not_found" error.
+type ListItemsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListItemsForbiddenResponseBody is the type of the "watchlist" service
+// "list_items" endpoint HTTP response body for the "forbidden" error.
+type ListItemsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListItemsConflictResponseBody is the type of the "watchlist" service
+// "list_items" endpoint HTTP response body for the "conflict" error.
+type ListItemsConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListItemsPreconditionFailedResponseBody is the type of the "watchlist"
+// service "list_items" endpoint HTTP response body for the
+// "precondition_failed" error.
+type ListItemsPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddItemInvalidSymbolResponseBody is the type of the "watchlist" service
+// "add_item" endpoint HTTP response body for the "invalid_symbol" error.
+type AddItemInvalidSymbolResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddItemUnauthorizedResponseBody is the type of the "watchlist" service
+// "add_item" endpoint HTTP response body for the "unauthorized" error.
+type AddItemUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddItemNotFoundResponseBody is the type of the "watchlist" service
+// "add_item" endpoint HTTP response body for the "not_found" error.
+type AddItemNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddItemForbiddenResponseBody is the type of the "watchlist" service
+// "add_item" endpoint HTTP response body for the "forbidden" error.
+type AddItemForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddItemConflictResponseBody is the type of the "watchlist" service
+// "add_item" endpoint HTTP response body for the "conflict" error.
+type AddItemConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AddItemPreconditionFailedResponseBody is the type of the "watchlist" service
+// "add_item" endpoint HTTP response body for the "precondition_failed" error.
+type AddItemPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateItemUnauthorizedResponseBody is the type of the "watchlist" service
+// "update_item" endpoint HTTP response body for the "unauthorized" error.
+type UpdateItemUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateItemNotFoundResponseBody is the type of the "watchlist" service
+// "update_item" endpoint HTTP response body for the "not_found" error.
+type UpdateItemNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateItemForbiddenResponseBody is the type of the "watchlist" service
+// "update_item" endpoint HTTP response body for the "forbidden" error.
+type UpdateItemForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateItemConflictResponseBody is the type of the "watchlist" service
+// "update_item" endpoint HTTP response body for the "conflict" error.
+type UpdateItemConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateItemPreconditionFailedResponseBody is the type of the "watchlist"
+// service "update_item" endpoint HTTP response body for the
+// "precondition_failed" error.
+type UpdateItemPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RemoveItemUnauthorizedResponseBody is the type of the "watchlist" service
+// "remove_item" endpoint HTTP response body for the "unauthorized" error.
+type RemoveItemUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RemoveItemNotFoundResponseBody is the type of the "watchlist" service
+// "remove_item" endpoint HTTP response body for the "not_found" error.
+type RemoveItemNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RemoveItemForbiddenResponseBody is the type of the "watchlist" service
+// "remove_item" endpoint HTTP response body for the "forbidden" error.
+type RemoveItemForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RemoveItemConflictResponseBody is the type of the "watchlist" service
+// "remove_item" endpoint HTTP response body for the "conflict" error.
+type RemoveItemConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RemoveItemPreconditionFailedResponseBody is the type of the "watchlist"
+// service "remove_item" endpoint HTTP response body for the
+// "precondition_failed" error.
+type RemoveItemPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSharesUnauthorizedResponseBody is the type of the "watchlist" service
+// "list_shares" endpoint HTTP response body for the "unauthorized" error.
+type ListSharesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSharesNotFoundResponseBody is the type of the "watchlist" service
+// "list_shares" endpoint HTTP response body for the "not_found" error.
+type ListSharesNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSharesForbiddenResponseBody is the type of the "watchlist" service
+// "list_shares" endpoint HTTP response body for the "forbidden" error.
+type ListSharesForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSharesConflictResponseBody is the type of the "watchlist" service
+// "list_shares" endpoint HTTP response body for the "conflict" error.
+type ListSharesConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSharesPreconditionFailedResponseBody is the type of the "watchlist"
+// service "list_shares" endpoint HTTP response body for the
+// "precondition_failed" error.
+type ListSharesPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ShareWatchlistUnauthorizedResponseBody is the type of the "watchlist"
+// service "share_watchlist" endpoint HTTP response body for the "unauthorized"
+// error.
+type ShareWatchlistUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ShareWatchlistNotFoundResponseBody is the type of the "watchlist" service
+// "share_watchlist" endpoint HTTP response body for the "not_found" error.
+type ShareWatchlistNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ShareWatchlistForbiddenResponseBody is the type of the "watchlist" service
+// "share_watchlist" endpoint HTTP response body for the "forbidden" error.
+type ShareWatchlistForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ShareWatchlistConflictResponseBody is the type of the "watchlist" service
+// "share_watchlist" endpoint HTTP response body for the "conflict" error.
+type ShareWatchlistConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ShareWatchlistPreconditionFailedResponseBody is the type of the "watchlist"
+// service "share_watchlist" endpoint HTTP response body for the
+// "precondition_failed" error.
+type ShareWatchlistPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnshareWatchlistUnauthorizedResponseBody is the type of the "watchlist"
+// service "unshare_watchlist" endpoint HTTP response body for the
+// "unauthorized" error.
+type UnshareWatchlistUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnshareWatchlistNotFoundResponseBody is the type of the "watchlist" service
+// "unshare_watchlist" endpoint HTTP response body for the "not_found" error.
+type UnshareWatchlistNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnshareWatchlistForbiddenResponseBody is the type of the "watchlist" service
+// "unshare_watchlist" endpoint HTTP response body for the "forbidden" error.
+type UnshareWatchlistForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnshareWatchlistConflictResponseBody is the type of the "watchlist" service
+// "unshare_watchlist" endpoint HTTP response body for the "conflict" error.
+type UnshareWatchlistConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnshareWatchlistPreconditionFailedResponseBody is the type of the
+// "watchlist" service "unshare_watchlist" endpoint HTTP response body for the
+// "precondition_failed" error.
+type UnshareWatchlistPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PublishWatchlistUnauthorizedResponseBody is the type of the "watchlist"
+// service "publish_watchlist" endpoint HTTP response body for the
+// "unauthorized" error.
+type PublishWatchlistUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PublishWatchlistNotFoundResponseBody is the type of the "watchlist" service
+// "publish_watchlist" endpoint HTTP response body for the "not_found" error.
+type PublishWatchlistNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PublishWatchlistForbiddenResponseBody is the type of the "watchlist" service
+// "publish_watchlist" endpoint HTTP response body for the "forbidden" error.
+type PublishWatchlistForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PublishWatchlistConflictResponseBody is the type of the "watchlist" service
+// "publish_watchlist" endpoint HTTP response body for the "conflict" error.
+type PublishWatchlistConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PublishWatchlistPreconditionFailedResponseBody is the type of the
+// "watchlist" service "publish_watchlist" endpoint HTTP response body for the
+// "precondition_failed" error.
+type PublishWatchlistPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnpublishWatchlistUnauthorizedResponseBody is the type of the "watchlist"
+// service "unpublish_watchlist" endpoint HTTP response body for the
+// "unauthorized" error.
+type UnpublishWatchlistUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnpublishWatchlistNotFoundResponseBody is the type of the "watchlist"
+// service "unpublish_watchlist" endpoint HTTP response body for the
+// "not_found" error.
+type UnpublishWatchlistNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnpublishWatchlistForbiddenResponseBody is the type of the "watchlist"
+// service "unpublish_watchlist" endpoint HTTP response body for the
+// "forbidden" error.
+type UnpublishWatchlistForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnpublishWatchlistConflictResponseBody is the type of the "watchlist"
+// service "unpublish_watchlist" endpoint HTTP response body for the "conflict"
+// error.
+type UnpublishWatchlistConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnpublishWatchlistPreconditionFailedResponseBody is the type of the
+// "watchlist" service "unpublish_watchlist" endpoint HTTP response body for
+// the "precondition_failed" error.
+type UnpublishWatchlistPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetPublicWatchlistUnauthorizedResponseBody is the type of the "watchlist"
+// service "get_public_watchlist" endpoint HTTP response body for the
+// "unauthorized" error.
+type GetPublicWatchlistUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetPublicWatchlistNotFoundResponseBody is the type of the "watchlist"
+// service "get_public_watchlist" endpoint HTTP response body for the
+// "not_found" error.
+type GetPublicWatchlistNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetPublicWatchlistForbiddenResponseBody is the type of the "watchlist"
+// service "get_public_watchlist" endpoint HTTP response body for the
+// "forbidden" error.
+type GetPublicWatchlistForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetPublicWatchlistConflictResponseBody is the type of the "watchlist"
+// service "get_public_watchlist" endpoint HTTP response body for the
+// "conflict" error.
+type GetPublicWatchlistConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetPublicWatchlistPreconditionFailedResponseBody is the type of the
+// "watchlist" service "get_public_watchlist" endpoint HTTP response body for
+// the "precondition_failed" error.
+type GetPublicWatchlistPreconditionFailedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// TickerItem is used to define fields on response body types.
+type TickerItem struct {
+	// Stock Symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Whether user holds the stock
+	OnHand bool `form:"on_hand" json:"on_hand" xml:"on_hand"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Operating MIC of the listing exchange, when known
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// ID of the instrument in the instrument master, when listed there
+	InstrumentID *string `form:"instrument_id,omitempty" json:"instrument_id,omitempty" xml:"instrument_id,omitempty"`
+	// Free-text note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price, when set
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price, when set
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+	// Zero-based position in the watchlist
+	Position int `form:"position" json:"position" xml:"position"`
+}
+
+// RemovedTickerItemResponse is used to define fields on response body types.
+type RemovedTickerItemResponse struct {
+	// Removal timestamp
+	RemovedAt string `form:"removed_at" json:"removed_at" xml:"removed_at"`
+	// Time after which the ticker is purged and can no longer be restored
+	PurgeAt string `form:"purge_at" json:"purge_at" xml:"purge_at"`
+	// Stock Symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Whether user holds the stock
+	OnHand bool `form:"on_hand" json:"on_hand" xml:"on_hand"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Operating MIC of the listing exchange, when known
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// ID of the instrument in the instrument master, when listed there
+	InstrumentID *string `form:"instrument_id,omitempty" json:"instrument_id,omitempty" xml:"instrument_id,omitempty"`
+	// Free-text note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price, when set
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price, when set
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+	// Zero-based position in the watchlist
+	Position int `form:"position" json:"position" xml:"position"`
+}
+
+// WatchlistChangeResponseBody is used to define fields on response body types.
+type WatchlistChangeResponseBody struct {
+	// ID of the changed watchlist
+	Watchlist string `form:"watchlist" json:"watchlist" xml:"watchlist"`
+	// Symbol of the changed item
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Item after the change, omitted when removed
+	Item *TickerItemResponseBody `form:"item,omitempty" json:"item,omitempty" xml:"item,omitempty"`
+	// Change timestamp
+	ChangedAt string `form:"changed_at" json:"changed_at" xml:"changed_at"`
+}
+
+// TickerItemResponseBody is used to define fields on response body types.
+type TickerItemResponseBody struct {
+	// Stock Symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Whether user holds the stock
+	OnHand bool `form:"on_hand" json:"on_hand" xml:"on_hand"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Operating MIC of the listing exchange, when known
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// ID of the instrument in the instrument master, when listed there
+	InstrumentID *string `form:"instrument_id,omitempty" json:"instrument_id,omitempty" xml:"instrument_id,omitempty"`
+	// Free-text note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price, when set
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price, when set
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+	// Zero-based position in the watchlist
+	Position int `form:"position" json:"position" xml:"position"`
+}
+
+// BulkItemResultResponseBody is used to define fields on response body types.
+type BulkItemResultResponseBody struct {
+	// Symbol as given in the request
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// added, updated or removed when stored; invalid or not_found when rejected;
+	// skipped when valid but not stored because another entry was rejected in
+	// all_or_nothing mode
+	Status string `form:"status" json:"status" xml:"status"`
+	// Why the entry was rejected
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Stored item of an added or updated entry
+	Item *TickerItemResponseBody `form:"item,omitempty" json:"item,omitempty" xml:"item,omitempty"`
+}
+
+// ImportRowErrorResponseBody is used to define fields on response body types.
+type ImportRowErrorResponseBody struct {
+	// Line of the row in the file
+	Line int `form:"line" json:"line" xml:"line"`
+	// Why the row is invalid
+	Error string `form:"error" json:"error" xml:"error"`
+}
+
+// WatchlistResponse is used to define fields on response body types.
+type WatchlistResponse struct {
+	// Watchlist ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Watchlist name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Zero-based position among the user's watchlists
+	Position int `form:"position" json:"position" xml:"position"`
+	// Whether this is the default watchlist, served by the /watchlist routes
+	Default bool `form:"default" json:"default" xml:"default"`
+	// Number of items
+	ItemCount int `form:"item_count" json:"item_count" xml:"item_count"`
+	// Creation time
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last rename or move
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// User ID of the owner
+	Owner string `form:"owner" json:"owner" xml:"owner"`
+	// Access of the user: owner of the watchlist, or the role it is shared with
+	// them with
+	Role string `form:"role" json:"role" xml:"role"`
+	// Token of the public link, /public/watchlists/{public_token}, when published;
+	// shown to the owner only
+	PublicToken *string `form:"public_token,omitempty" json:"public_token,omitempty" xml:"public_token,omitempty"`
+}
+
+// WatchlistShareResponse is used to define fields on response body types.
+type WatchlistShareResponse struct {
+	// User ID the watchlist is shared with
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// editor changes the items of the watchlist; viewer only reads them
+	Role string `form:"role" json:"role" xml:"role"`
+	// When the watchlist was first shared with the user
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// PublicTickerItemResponseBody is used to define fields on response body types.
+type PublicTickerItemResponseBody struct {
+	// Stock Symbol
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// Operating MIC of the listing exchange, when known
+	ExchangeMic *string `form:"exchange_mic,omitempty" json:"exchange_mic,omitempty" xml:"exchange_mic,omitempty"`
+	// ID of the instrument in the instrument master, when listed there
+	InstrumentID *string `form:"instrument_id,omitempty" json:"instrument_id,omitempty" xml:"instrument_id,omitempty"`
+	// Free-text note
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price, when set
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price, when set
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+	// Zero-based position in the watchlist
+	Position int `form:"position" json:"position" xml:"position"`
+}
+
+// BulkAddItemRequestBody is used to define fields on request body types.
+type BulkAddItemRequestBody struct {
+	// Ticker symbol, optionally suffixed with the MIC of its listing exchange
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// Whether user holds the stock; unchanged when omitted, false for new items
+	OnHand *bool `form:"on_hand,omitempty" json:"on_hand,omitempty" xml:"on_hand,omitempty"`
+	// Free-text note; empty clears it
+	Note *string `form:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	// Free-form tags, replacing the current ones; empty clears them
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Target buy price; 0 clears it
+	BuyTarget *float64 `form:"buy_target,omitempty" json:"buy_target,omitempty" xml:"buy_target,omitempty"`
+	// Target sell price; 0 clears it
+	SellTarget *float64 `form:"sell_target,omitempty" json:"sell_target,omitempty" xml:"sell_target,omitempty"`
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "watchlist" service.
+func NewListResponseBody(res *watchlist.TickerItemsResult) ListResponseBody {
+	body := make([]*TickerItem, len(res.Items))
+	for i, val := range res.Items {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalWatchlistTickerItemToTickerItem(val)
+	}
+	return body
+}
+
+// NewAddResponseBody builds the HTTP response body from the result of the
+// "add" endpoint of the "watchlist" service.
+func NewAddResponseBody(res *watchlist.TickerItem) *AddResponseBody {
+	body := &AddResponseBody{
+		Symbol:       res.Symbol,
+		OnHand:       res.OnHand,
+		CreatedAt:    res.CreatedAt,
+		UpdatedAt:    res.UpdatedAt,
+		ExchangeMic:  res.ExchangeMic,
+		InstrumentID: res.InstrumentID,
+		Note:         res.Note,
+		BuyTarget:    res.BuyTarget,
+		SellTarget:   res.SellTarget,
+		Position:     res.Position,
+	}
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	}
+	return body
+}
+
+// NewUpdateResponseBody builds the HTTP response body from the result of the
+// "update" endpoint of the "watchlist" service.
+func NewUpdateResponseBody(res *watchlist.TickerItem) *UpdateResponseBody {
+	body := &UpdateResponseBody{
+		Symbol:       res.Symbol,
+		OnHand:       res.OnHand,
+		CreatedAt:    res.CreatedAt,
+		UpdatedAt:    res.UpdatedAt,
+		ExchangeMic:  res.ExchangeMic,
+		InstrumentID: res.InstrumentID,
+		Note:         res.Note,
+		BuyTarget:    res.BuyTarget,
+		SellTarget:   res.SellTarget,
+		Position:     res.Position,
+	}
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	}
+	return body
+}
+
+// NewListRemovedResponseBody builds the HTTP response body from the result of
+// the "list_removed" endpoint of the "watchlist" service.
+func NewListRemovedResponseBody(res []*watchlist.RemovedTickerItem) ListRemovedResponseBody {
+	body := make([]*RemovedTickerItemResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalWatchlistRemovedTickerItemToRemovedTickerItemResponse(val)
+	}
+	return body
+}
+
+// NewRestoreResponseBody builds the HTTP response body from the result of the
+// "restore" endpoint of the "watchlist" service.
+func NewRestoreResponseBody(res *watchlist.TickerItem) *RestoreResponseBody {
+	body := &RestoreResponseBody{
+		Symbol:       res.Symbol,
+		OnHand:       res.OnHand,
+		CreatedAt:    res.CreatedAt,
+		UpdatedAt:    res.UpdatedAt,
+		ExchangeMic:  res.ExchangeMic,
+		InstrumentID: res.InstrumentID,
+		Note:         res.Note,
+		BuyTarget:    res.BuyTarget,
+		SellTarget:   res.SellTarget,
+		Position:     res.Position,
+	}
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	}
+	return body
+}
+
+// NewEventsResponseBody builds the HTTP response body from the result of the
+// "events" endpoint of the "watchlist" service.
+func NewEventsResponseBody(res *watchlist.WatchlistEvent) *EventsResponseBody {
+	body := &EventsResponseBody{
+		ID:   res.ID,
+		Type: res.Type,
+	}
+	if res.Data != nil {
+		body.Data = marshalWatchlistWatchlistChangeToWatchlistChangeResponseBody(res.Data)
+	}
+	return body
+}
+
+// NewBulkAddResponseBody builds the HTTP response body from the result of the
+// "bulk_add" endpoint of the "watchlist" service.
+func NewBulkAddResponseBody(res *watchlist.WatchlistBulkResult) *BulkAddResponseBody {
+	body := &BulkAddResponseBody{
+		Mode:      res.Mode,
+		Succeeded: res.Succeeded,
+		Failed:    res.Failed,
+	}
+	if res.Results != nil {
+		body.Results = make([]*BulkItemResultResponseBody, len(res.Results))
+		for i, val := range res.Results {
+			if val == nil {
+				body.Results[i] = nil
+				continue
+			}
+			body.Results[i] = marshalWatchlistBulkItemResultToBulkItemResultResponseBody(val)
+		}
+	} else {
+		body.Results = []*BulkItemResultResponseBody{}
+	}
+	return body
+}
+
+// NewBulkRemoveResponseBody builds the HTTP response body from the result of
+// the "bulk_remove" endpoint of the "watchlist" service.
+func NewBulkRemoveResponseBody(res *watchlist.WatchlistBulkResult) *BulkRemoveResponseBody {
+	body := &BulkRemoveResponseBody{
+		Mode:      res.Mode,
+		Succeeded: res.Succeeded,
+		Failed:    res.Failed,
+	}
+	if res.Results != nil {
+		body.Results = make([]*BulkItemResultResponseBody, len(res.Results))
+		for i, val := range res.Results {
+			if val == nil {
+				body.Results[i] = nil
+				continue
+			}
+			body.Results[i] = marshalWatchlistBulkItemResultToBulkItemResultResponseBody(val)
+		}
+	} else {
+		body.Results = []*BulkItemResultResponseBody{}
+	}
+	return body
+}
+
+// NewImportResponseBody builds the HTTP response body from the result of the
+// "import" endpoint of the "watchlist" service.
+func NewImportResponseBody(res *watchlist.WatchlistImportReport) *ImportResponseBody {
+	body := &ImportResponseBody{
+		DryRun:    res.DryRun,
+		Applied:   res.Applied,
+		Rows:      res.Rows,
+		Added:     res.Added,
+		Updated:   res.Updated,
+		Unchanged: res.Unchanged,
+	}
+	if res.Errors != nil {
+		body.Errors = make([]*ImportRowErrorResponseBody, len(res.Errors))
+		for i, val := range res.Errors {
+			if val == nil {
+				body.Errors[i] = nil
+				continue
+			}
+			body.Errors[i] = marshalWatchlistImportRowErrorToImportRowErrorResponseBody(val)
+		}
+	} else {
+		body.Errors = []*ImportRowErrorResponseBody{}
+	}
+	return body
+}
+
+// NewListWatchlistsResponseBody builds the HTTP response body from the result
+// of the "list_watchlists" endpoint of the "watchlist" service.
+func NewListWatchlistsResponseBody(res []*watchlist.Watchlist) ListWatchlistsResponseBody {
+	body := make([]*WatchlistResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalWatchlistWatchlistToWatchlistResponse(val)
+	}
+	return body
+}
+
+// NewListSharedWatchlistsResponseBody builds the HTTP response body from the
+// result of the "list_shared_watchlists" endpoint of the "watchlist" service.
+func NewListSharedWatchlistsResponseBody(res []*watchlist.Watchlist) ListSharedWatchlistsResponseBody {
+	body := make([]*WatchlistResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalWatchlistWatchlistToWatchlistResponse(val)
+	}
+	return body
+}
+
+// NewCreateWatchlistResponseBody builds the HTTP response body from the result
+// of the "create_watchlist" endpoint of the "watchlist" service.
+func NewCreateWatchlistResponseBody(res *watchlist.Watchlist) *CreateWatchlistResponseBody {
+	body := &CreateWatchlistResponseBody{
+		ID:          res.ID,
+		Name:        res.Name,
+		Position:    res.Position,
+		Default:     res.Default,
+		ItemCount:   res.ItemCount,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+		Owner:       res.Owner,
+		Role:        res.Role,
+		PublicToken: res.PublicToken,
+	}
+	return body
+}
+
+// NewGetWatchlistResponseBody builds the HTTP response body from the result of
+// the "get_watchlist" endpoint of the "watchlist" service.
+func NewGetWatchlistResponseBody(res *watchlist.Watchlist) *GetWatchlistResponseBody {
+	body := &GetWatchlistResponseBody{
+		ID:          res.ID,
+		Name:        res.Name,
+		Position:    res.Position,
+		Default:     res.Default,
+		ItemCount:   res.ItemCount,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+		Owner:       res.Owner,
+		Role:        res.Role,
+		PublicToken: res.PublicToken,
+	}
+	return body
+}
+
+// NewUpdateWatchlistResponseBody builds the HTTP response body from the result
+// of the "update_watchlist" endpoint of the "watchlist" service.
+func NewUpdateWatchlistResponseBody(res *watchlist.Watchlist) *UpdateWatchlistResponseBody {
+	body := &UpdateWatchlistResponseBody{
+		ID:          res.ID,
+		Name:        res.Name,
+		Position:    res.Position,
+		Default:     res.Default,
+		ItemCount:   res.ItemCount,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+		Owner:       res.Owner,
+		Role:        res.Role,
+		PublicToken: res.PublicToken,
+	}
+	return body
+}
+
+// NewListItemsResponseBody builds the HTTP response body from the result of
+// the "list_items" endpoint of the "watchlist" service.
+func NewListItemsResponseBody(res *watchlist.TickerItemsResult) ListItemsResponseBody {
+	body := make([]*TickerItem, len(res.Items))
+	for i, val := range res.Items {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalWatchlistTickerItemToTickerItem(val)
+	}
+	return body
+}
+
+// NewAddItemResponseBody builds the HTTP response body from the result of the
+// "add_item" endpoint of the "watchlist" service.
+func NewAddItemResponseBody(res *watchlist.TickerItem) *AddItemResponseBody {
+	body := &AddItemResponseBody{
+		Symbol:       res.Symbol,
+		OnHand:       res.OnHand,
+		CreatedAt:    res.CreatedAt,
+		UpdatedAt:    res.UpdatedAt,
+		ExchangeMic:  res.ExchangeMic,
+		InstrumentID: res.InstrumentID,
+		Note:         res.Note,
+		BuyTarget:    res.BuyTarget,
+		SellTarget:   res.SellTarget,
+		Position:     res.Position,
+	}
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	}
+	return body
+}
+
+// NewUpdateItemResponseBody builds the HTTP response body from the result of
+// the "update_item" endpoint of the "watchlist" service.
+func NewUpdateItemResponseBody(res *watchlist.TickerItem) *UpdateItemResponseBody {
+	body := &UpdateItemResponseBody{
+		Symbol:       res.Symbol,
+		OnHand:       res.OnHand,
+		CreatedAt:    res.CreatedAt,
+		UpdatedAt:    res.UpdatedAt,
+		ExchangeMic:  res.ExchangeMic,
+		InstrumentID: res.InstrumentID,
+		Note:         res.Note,
+		BuyTarget:    res.BuyTarget,
+		SellTarget:   res.SellTarget,
+		Position:     res.Position,
+	}
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	}
+	return body
+}
+
+// NewListSharesResponseBody builds the HTTP response body from the result of
+// the "list_shares" endpoint of the "watchlist" service.
+func NewListSharesResponseBody(res []*watchlist.WatchlistShare) ListSharesResponseBody {
+	body := make([]*WatchlistShareResponse, len(res))
+	for i, val := range res {
+		if val == nil {
+			body[i] = nil
+			continue
+		}
+		body[i] = marshalWatchlistWatchlistShareToWatchlistShareResponse(val)
+	}
+	return body
+}
+
+// NewShareWatchlistResponseBody builds the HTTP response body from the result
+// of the "share_watchlist" endpoint of the "watchlist" service.
+func NewShareWatchlistResponseBody(res *watchlist.WatchlistShare) *ShareWatchlistResponseBody {
+	body := &ShareWatchlistResponseBody{
+		UserID:    res.UserID,
+		Role:      res.Role,
+		CreatedAt: res.CreatedAt,
+	}
+	return body
+}
+
+// NewPublishWatchlistResponseBody builds the HTTP response body from the
+// result of the "publish_watchlist" endpoint of the "watchlist" service.
+func NewPublishWatchlistResponseBody(res *watchlist.Watchlist) *PublishWatchlistResponseBody {
+	body := &PublishWatchlistResponseBody{
+		ID:          res.ID,
+		Name:        res.Name,
+		Position:    res.Position,
+		Default:     res.Default,
+		ItemCount:   res.ItemCount,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+		Owner:       res.Owner,
+		Role:        res.Role,
+		PublicToken: res.PublicToken,
+	}
+	return body
+}
+
+// NewGetPublicWatchlistResponseBody builds the HTTP response body from the
+// result of the "get_public_watchlist" endpoint of the "watchlist" service.
+func NewGetPublicWatchlistResponseBody(res *watchlist.PublicWatchlist) *GetPublicWatchlistResponseBody {
+	body := &GetPublicWatchlistResponseBody{
+		Name: res.Name,
+	}
+	if res.Items != nil {
+		body.Items = make([]*PublicTickerItemResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			if val == nil {
+				body.Items[i] = nil
+				continue
+			}
+			body.Items[i] = marshalWatchlistPublicTickerItemToPublicTickerItemResponseBody(val)
+		}
+	} else {
+		body.Items = []*PublicTickerItemResponseBody{}
+	}
+	return body
+}
+
+// NewListUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "watchlist" service.
+func NewListUnauthorizedResponseBody(res *goa.ServiceError) *ListUnauthorizedResponseBody {
+	body := &ListUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListNotFoundResponseBody builds the HTTP response body from the result of
+// the "list" endpoint of the "watchlist" service.
+func NewListNotFoundResponseBody(res *goa.ServiceError) *ListNotFoundResponseBody {
+	body := &ListNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListForbiddenResponseBody builds the HTTP response body from the result
+// of the "list" endpoint of the "watchlist" service.
+func NewListForbiddenResponseBody(res *goa.ServiceError) *ListForbiddenResponseBody {
+	body := &ListForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListConflictResponseBody builds the HTTP response body from the result of
+// the "list" endpoint of the "watchlist" service.
+func NewListConflictResponseBody(res *goa.ServiceError) *ListConflictResponseBody {
+	body := &ListConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListPreconditionFailedResponseBody builds the HTTP response body from the
+// result of the "list" endpoint of the "watchlist" service.
+func NewListPreconditionFailedResponseBody(res *goa.ServiceError) *ListPreconditionFailedResponseBody {
+	body := &ListPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddInvalidSymbolResponseBody builds the HTTP response body from the
+// result of the "add" endpoint of the "watchlist" service.
+func NewAddInvalidSymbolResponseBody(res *goa.ServiceError) *AddInvalidSymbolResponseBody {
+	body := &AddInvalidSymbolResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddUnauthorizedResponseBody builds the HTTP response body from the result
+// of the "add" endpoint of the "watchlist" service.
+func NewAddUnauthorizedResponseBody(res *goa.ServiceError) *AddUnauthorizedResponseBody {
+	body := &AddUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddNotFoundResponseBody builds the HTTP response body from the result of
+// the "add" endpoint of the "watchlist" service.
+func NewAddNotFoundResponseBody(res *goa.ServiceError) *AddNotFoundResponseBody {
+	body := &AddNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddForbiddenResponseBody builds the HTTP response body from the result of
+// the "add" endpoint of the "watchlist" service.
+func NewAddForbiddenResponseBody(res *goa.ServiceError) *AddForbiddenResponseBody {
+	body := &AddForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddConflictResponseBody builds the HTTP response body from the result of
+// the "add" endpoint of the "watchlist" service.
+func NewAddConflictResponseBody(res *goa.ServiceError) *AddConflictResponseBody {
+	body := &AddConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddPreconditionFailedResponseBody builds the HTTP response body from the
+// result of the "add" endpoint of the "watchlist" service.
+func NewAddPreconditionFailedResponseBody(res *goa.ServiceError) *AddPreconditionFailedResponseBody {
+	body := &AddPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "update" endpoint of the "watchlist" service.
+func NewUpdateUnauthorizedResponseBody(res *goa.ServiceError) *UpdateUnauthorizedResponseBody {
+	body := &UpdateUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateNotFoundResponseBody builds the HTTP response body from the result
+// of the "update" endpoint of the "watchlist" service.
+func NewUpdateNotFoundResponseBody(res *goa.ServiceError) *UpdateNotFoundResponseBody {
+	body := &UpdateNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateForbiddenResponseBody builds the HTTP response body from the result
+// of the "update" endpoint of the "watchlist" service.
+func NewUpdateForbiddenResponseBody(res *goa.ServiceError) *UpdateForbiddenResponseBody {
+	body := &UpdateForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateConflictResponseBody builds the HTTP response body from the result
+// of the "update" endpoint of the "watchlist" service.
+func NewUpdateConflictResponseBody(res *goa.ServiceError) *UpdateConflictResponseBody {
+	body := &UpdateConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdatePreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "update" endpoint of the "watchlist" service.
+func NewUpdatePreconditionFailedResponseBody(res *goa.ServiceError) *UpdatePreconditionFailedResponseBody {
+	body := &UpdatePreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRemoveUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "remove" endpoint of the "watchlist" service.
+func NewRemoveUnauthorizedResponseBody(res *goa.ServiceError) *RemoveUnauthorizedResponseBody {
+	body := &RemoveUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRemoveNotFoundResponseBody builds the HTTP response body from the result
+// of the "remove" endpoint of the "watchlist" service.
+func NewRemoveNotFoundResponseBody(res *goa.ServiceError) *RemoveNotFoundResponseBody {
+	body := &RemoveNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRemoveForbiddenResponseBody builds the HTTP response body from the result
+// of the "remove" endpoint of the "watchlist" service.
+func NewRemoveForbiddenResponseBody(res *goa.ServiceError) *RemoveForbiddenResponseBody {
+	body := &RemoveForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRemoveConflictResponseBody builds the HTTP response body from the result
+// of the "remove" endpoint of the "watchlist" service.
+func NewRemoveConflictResponseBody(res *goa.ServiceError) *RemoveConflictResponseBody {
+	body := &RemoveConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRemovePreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "remove" endpoint of the "watchlist" service.
+func NewRemovePreconditionFailedResponseBody(res *goa.ServiceError) *RemovePreconditionFailedResponseBody {
+	body := &RemovePreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListRemovedUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "list_removed" endpoint of the "watchlist" service.
+func NewListRemovedUnauthorizedResponseBody(res *goa.ServiceError) *ListRemovedUnauthorizedResponseBody {
+	body := &ListRemovedUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListRemovedNotFoundResponseBody builds the HTTP response body from the
+// result of the "list_removed" endpoint of the "watchlist" service.
+func NewListRemovedNotFoundResponseBody(res *goa.ServiceError) *ListRemovedNotFoundResponseBody {
+	body := &ListRemovedNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListRemovedForbiddenResponseBody builds the HTTP response body from the
+// result of the "list_removed" endpoint of the "watchlist" service.
+func NewListRemovedForbiddenResponseBody(res *goa.ServiceError) *ListRemovedForbiddenResponseBody {
+	body := &ListRemovedForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListRemovedConflictResponseBody builds the HTTP response body from the
+// result of the "list_removed" endpoint of the "watchlist" service.
+func NewListRemovedConflictResponseBody(res *goa.ServiceError) *ListRemovedConflictResponseBody {
+	body := &ListRemovedConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListRemovedPreconditionFailedResponseBody builds the HTTP response body
+// from the result of the "list_removed" endpoint of the "watchlist" service.
+func NewListRemovedPreconditionFailedResponseBody(res *goa.ServiceError) *ListRemovedPreconditionFailedResponseBody {
+	body := &ListRemovedPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRestoreUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "restore" endpoint of the "watchlist" service.
+func NewRestoreUnauthorizedResponseBody(res *goa.ServiceError) *RestoreUnauthorizedResponseBody {
+	body := &RestoreUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRestoreNotFoundResponseBody builds the HTTP response body from the result
+// of the "restore" endpoint of the "watchlist" service.
+func NewRestoreNotFoundResponseBody(res *goa.ServiceError) *RestoreNotFoundResponseBody {
+	body := &RestoreNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRestoreForbiddenResponseBody builds the HTTP response body from the
+// result of the "restore" endpoint of the "watchlist" service.
+func NewRestoreForbiddenResponseBody(res *goa.ServiceError) *RestoreForbiddenResponseBody {
+	body := &RestoreForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRestoreConflictResponseBody builds the HTTP response body from the result
+// of the "restore" endpoint of the "watchlist" service.
+func NewRestoreConflictResponseBody(res *goa.ServiceError) *RestoreConflictResponseBody {
+	body := &RestoreConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRestorePreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "restore" endpoint of the "watchlist" service.
+func NewRestorePreconditionFailedResponseBody(res *goa.ServiceError) *RestorePreconditionFailedResponseBody {
+	body := &RestorePreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewEventsUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "events" endpoint of the "watchlist" service.
+func NewEventsUnauthorizedResponseBody(res *goa.ServiceError) *EventsUnauthorizedResponseBody {
+	body := &EventsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewEventsNotFoundResponseBody builds the HTTP response body from the result
+// of the "events" endpoint of the "watchlist" service.
+func NewEventsNotFoundResponseBody(res *goa.ServiceError) *EventsNotFoundResponseBody {
+	body := &EventsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewEventsForbiddenResponseBody builds the HTTP response body from the result
+// of the "events" endpoint of the "watchlist" service.
+func NewEventsForbiddenResponseBody(res *goa.ServiceError) *EventsForbiddenResponseBody {
+	body := &EventsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewEventsConflictResponseBody builds the HTTP response body from the result
+// of the "events" endpoint of the "watchlist" service.
+func NewEventsConflictResponseBody(res *goa.ServiceError) *EventsConflictResponseBody {
+	body := &EventsConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewEventsPreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "events" endpoint of the "watchlist" service.
+func NewEventsPreconditionFailedResponseBody(res *goa.ServiceError) *EventsPreconditionFailedResponseBody {
+	body := &EventsPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBulkAddUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "bulk_add" endpoint of the "watchlist" service.
+func NewBulkAddUnauthorizedResponseBody(res *goa.ServiceError) *BulkAddUnauthorizedResponseBody {
+	body := &BulkAddUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBulkAddNotFoundResponseBody builds the HTTP response body from the result
+// of the "bulk_add" endpoint of the "watchlist" service.
+func NewBulkAddNotFoundResponseBody(res *goa.ServiceError) *BulkAddNotFoundResponseBody {
+	body := &BulkAddNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBulkAddForbiddenResponseBody builds the HTTP response body from the
+// result of the "bulk_add" endpoint of the "watchlist" service.
+func NewBulkAddForbiddenResponseBody(res *goa.ServiceError) *BulkAddForbiddenResponseBody {
+	body := &BulkAddForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBulkAddConflictResponseBody builds the HTTP response body from the result
+// of the "bulk_add" endpoint of the "watchlist" service.
+func NewBulkAddConflictResponseBody(res *goa.ServiceError) *BulkAddConflictResponseBody {
+	body := &BulkAddConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBulkAddPreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "bulk_add" endpoint of the "watchlist" service.
+func NewBulkAddPreconditionFailedResponseBody(res *goa.ServiceError) *BulkAddPreconditionFailedResponseBody {
+	body := &BulkAddPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBulkRemoveUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "bulk_remove" endpoint of the "watchlist" service.
+func NewBulkRemoveUnauthorizedResponseBody(res *goa.ServiceError) *BulkRemoveUnauthorizedResponseBody {
+	body := &BulkRemoveUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBulkRemoveNotFoundResponseBody builds the HTTP response body from the
+// result of the "bulk_remove" endpoint of the "watchlist" service.
+func NewBulkRemoveNotFoundResponseBody(res *goa.ServiceError) *BulkRemoveNotFoundResponseBody {
+	body := &BulkRemoveNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBulkRemoveForbiddenResponseBody builds the HTTP response body from the
+// result of the "bulk_remove" endpoint of the "watchlist" service.
+func NewBulkRemoveForbiddenResponseBody(res *goa.ServiceError) *BulkRemoveForbiddenResponseBody {
+	body := &BulkRemoveForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBulkRemoveConflictResponseBody builds the HTTP response body from the
+// result of the "bulk_remove" endpoint of the "watchlist" service.
+func NewBulkRemoveConflictResponseBody(res *goa.ServiceError) *BulkRemoveConflictResponseBody {
+	body := &BulkRemoveConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBulkRemovePreconditionFailedResponseBody builds the HTTP response body
+// from the result of the "bulk_remove" endpoint of the "watchlist" service.
+func NewBulkRemovePreconditionFailedResponseBody(res *goa.ServiceError) *BulkRemovePreconditionFailedResponseBody {
+	body := &BulkRemovePreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportInvalidFileResponseBody builds the HTTP response body from the
+// result of the "import" endpoint of the "watchlist" service.
+func NewImportInvalidFileResponseBody(res *goa.ServiceError) *ImportInvalidFileResponseBody {
+	body := &ImportInvalidFileResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "import" endpoint of the "watchlist" service.
+func NewImportUnauthorizedResponseBody(res *goa.ServiceError) *ImportUnauthorizedResponseBody {
+	body := &ImportUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportNotFoundResponseBody builds the HTTP response body from the result
+// of the "import" endpoint of the "watchlist" service.
+func NewImportNotFoundResponseBody(res *goa.ServiceError) *ImportNotFoundResponseBody {
+	body := &ImportNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportForbiddenResponseBody builds the HTTP response body from the result
+// of the "import" endpoint of the "watchlist" service.
+func NewImportForbiddenResponseBody(res *goa.ServiceError) *ImportForbiddenResponseBody {
+	body := &ImportForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportConflictResponseBody builds the HTTP response body from the result
+// of the "import" endpoint of the "watchlist" service.
+func NewImportConflictResponseBody(res *goa.ServiceError) *ImportConflictResponseBody {
+	body := &ImportConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportPreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "import" endpoint of the "watchlist" service.
+func NewImportPreconditionFailedResponseBody(res *goa.ServiceError) *ImportPreconditionFailedResponseBody {
+	body := &ImportPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExportUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "export" endpoint of the "watchlist" service.
+func NewExportUnauthorizedResponseBody(res *goa.ServiceError) *ExportUnauthorizedResponseBody {
+	body := &ExportUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExportNotFoundResponseBody builds the HTTP response body from the result
+// of the "export" endpoint of the "watchlist" service.
+func NewExportNotFoundResponseBody(res *goa.ServiceError) *ExportNotFoundResponseBody {
+	body := &ExportNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExportForbiddenResponseBody builds the HTTP response body from the result
+// of the "export" endpoint of the "watchlist" service.
+func NewExportForbiddenResponseBody(res *goa.ServiceError) *ExportForbiddenResponseBody {
+	body := &ExportForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExportConflictResponseBody builds the HTTP response body from the result
+// of the "export" endpoint of the "watchlist" service.
+func NewExportConflictResponseBody(res *goa.ServiceError) *ExportConflictResponseBody {
+	body := &ExportConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExportPreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "export" endpoint of the "watchlist" service.
+func NewExportPreconditionFailedResponseBody(res *goa.ServiceError) *ExportPreconditionFailedResponseBody {
+	body := &ExportPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWatchlistsUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "list_watchlists" endpoint of the "watchlist" service.
+func NewListWatchlistsUnauthorizedResponseBody(res *goa.ServiceError) *ListWatchlistsUnauthorizedResponseBody {
+	body := &ListWatchlistsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWatchlistsNotFoundResponseBody builds the HTTP response body from the
+// result of the "list_watchlists" endpoint of the "watchlist" service.
+func NewListWatchlistsNotFoundResponseBody(res *goa.ServiceError) *ListWatchlistsNotFoundResponseBody {
+	body := &ListWatchlistsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWatchlistsForbiddenResponseBody builds the HTTP response body from
+// the result of the "list_watchlists" endpoint of the "watchlist" service.
+func NewListWatchlistsForbiddenResponseBody(res *goa.ServiceError) *ListWatchlistsForbiddenResponseBody {
+	body := &ListWatchlistsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWatchlistsConflictResponseBody builds the HTTP response body from the
+// result of the "list_watchlists" endpoint of the "watchlist" service.
+func NewListWatchlistsConflictResponseBody(res *goa.ServiceError) *ListWatchlistsConflictResponseBody {
+	body := &ListWatchlistsConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWatchlistsPreconditionFailedResponseBody builds the HTTP response
+// body from the result of the "list_watchlists" endpoint of the "watchlist"
+// service.
+func NewListWatchlistsPreconditionFailedResponseBody(res *goa.ServiceError) *ListWatchlistsPreconditionFailedResponseBody {
+	body := &ListWatchlistsPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSharedWatchlistsUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "list_shared_watchlists" endpoint of the
+// "watchlist" service.
+func NewListSharedWatchlistsUnauthorizedResponseBody(res *goa.ServiceError) *ListSharedWatchlistsUnauthorizedResponseBody {
+	body := &ListSharedWatchlistsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSharedWatchlistsNotFoundResponseBody builds the HTTP response body
+// from the result of the "list_shared_watchlists" endpoint of the "watchlist"
+// service.
+func NewListSharedWatchlistsNotFoundResponseBody(res *goa.ServiceError) *ListSharedWatchlistsNotFoundResponseBody {
+	body := &ListSharedWatchlistsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSharedWatchlistsForbiddenResponseBody builds the HTTP response body
+// from the result of the "list_shared_watchlists" endpoint of the "watchlist"
+// service.
+func NewListSharedWatchlistsForbiddenResponseBody(res *goa.ServiceError) *ListSharedWatchlistsForbiddenResponseBody {
+	body := &ListSharedWatchlistsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSharedWatchlistsConflictResponseBody builds the HTTP response body
+// from the result of the "list_shared_watchlists" endpoint of the "watchlist"
+// service.
+func NewListSharedWatchlistsConflictResponseBody(res *goa.ServiceError) *ListSharedWatchlistsConflictResponseBody {
+	body := &ListSharedWatchlistsConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSharedWatchlistsPreconditionFailedResponseBody builds the HTTP
+// response body from the result of the "list_shared_watchlists" endpoint of
+// the "watchlist" service.
+func NewListSharedWatchlistsPreconditionFailedResponseBody(res *goa.ServiceError) *ListSharedWatchlistsPreconditionFailedResponseBody {
+	body := &ListSharedWatchlistsPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWatchlistUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "create_watchlist" endpoint of the "watchlist"
+// service.
+func NewCreateWatchlistUnauthorizedResponseBody(res *goa.ServiceError) *CreateWatchlistUnauthorizedResponseBody {
+	body := &CreateWatchlistUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWatchlistNotFoundResponseBody builds the HTTP response body from
+// the result of the "create_watchlist" endpoint of the "watchlist" service.
+func NewCreateWatchlistNotFoundResponseBody(res *goa.ServiceError) *CreateWatchlistNotFoundResponseBody {
+	body := &CreateWatchlistNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWatchlistForbiddenResponseBody builds the HTTP response body from
+// the result of the "create_watchlist" endpoint of the "watchlist" service.
+func NewCreateWatchlistForbiddenResponseBody(res *goa.ServiceError) *CreateWatchlistForbiddenResponseBody {
+	body := &CreateWatchlistForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWatchlistConflictResponseBody builds the HTTP response body from
+// the result of the "create_watchlist" endpoint of the "watchlist" service.
+func NewCreateWatchlistConflictResponseBody(res *goa.ServiceError) *CreateWatchlistConflictResponseBody {
+	body := &CreateWatchlistConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWatchlistPreconditionFailedResponseBody builds the HTTP response
+// body from the result of the "create_watchlist" endpoint of the "watchlist"
+// service.
+func NewCreateWatchlistPreconditionFailedResponseBody(res *goa.ServiceError) *CreateWatchlistPreconditionFailedResponseBody {
+	body := &CreateWatchlistPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetWatchlistUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "get_watchlist" endpoint of the "watchlist" service.
+func NewGetWatchlistUnauthorizedResponseBody(res *goa.ServiceError) *GetWatchlistUnauthorizedResponseBody {
+	body := &GetWatchlistUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetWatchlistNotFoundResponseBody builds the HTTP response body from the
+// result of the "get_watchlist" endpoint of the "watchlist" service.
+func NewGetWatchlistNotFoundResponseBody(res *goa.ServiceError) *GetWatchlistNotFoundResponseBody {
+	body := &GetWatchlistNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetWatchlistForbiddenResponseBody builds the HTTP response body from the
+// result of the "get_watchlist" endpoint of the "watchlist" service.
+func NewGetWatchlistForbiddenResponseBody(res *goa.ServiceError) *GetWatchlistForbiddenResponseBody {
+	body := &GetWatchlistForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetWatchlistConflictResponseBody builds the HTTP response body from the
+// result of the "get_watchlist" endpoint of the "watchlist" service.
+func NewGetWatchlistConflictResponseBody(res *goa.ServiceError) *GetWatchlistConflictResponseBody {
+	body := &GetWatchlistConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetWatchlistPreconditionFailedResponseBody builds the HTTP response body
+// from the result of the "get_watchlist" endpoint of the "watchlist" service.
+func NewGetWatchlistPreconditionFailedResponseBody(res *goa.ServiceError) *GetWatchlistPreconditionFailedResponseBody {
+	body := &GetWatchlistPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateWatchlistUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "update_watchlist" endpoint of the "watchlist"
+// service.
+func NewUpdateWatchlistUnauthorizedResponseBody(res *goa.ServiceError) *UpdateWatchlistUnauthorizedResponseBody {
+	body := &UpdateWatchlistUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateWatchlistNotFoundResponseBody builds the HTTP response body from
+// the result of the "update_watchlist" endpoint of the "watchlist" service.
+func NewUpdateWatchlistNotFoundResponseBody(res *goa.ServiceError) *UpdateWatchlistNotFoundResponseBody {
+	body := &UpdateWatchlistNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateWatchlistForbiddenResponseBody builds the HTTP response body from
+// the result of the "update_watchlist" endpoint of the "watchlist" service.
+func NewUpdateWatchlistForbiddenResponseBody(res *goa.ServiceError) *UpdateWatchlistForbiddenResponseBody {
+	body := &UpdateWatchlistForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateWatchlistConflictResponseBody builds the HTTP response body from
+// the result of the "update_watchlist" endpoint of the "watchlist" service.
+func NewUpdateWatchlistConflictResponseBody(res *goa.ServiceError) *UpdateWatchlistConflictResponseBody {
+	body := &UpdateWatchlistConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateWatchlistPreconditionFailedResponseBody builds the HTTP response
+// body from the result of the "update_watchlist" endpoint of the "watchlist"
+// service.
+func NewUpdateWatchlistPreconditionFailedResponseBody(res *goa.ServiceError) *UpdateWatchlistPreconditionFailedResponseBody {
+	body := &UpdateWatchlistPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteWatchlistUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "delete_watchlist" endpoint of the "watchlist"
+// service.
+func NewDeleteWatchlistUnauthorizedResponseBody(res *goa.ServiceError) *DeleteWatchlistUnauthorizedResponseBody {
+	body := &DeleteWatchlistUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteWatchlistNotFoundResponseBody builds the HTTP response body from
+// the result of the "delete_watchlist" endpoint of the "watchlist" service.
+func NewDeleteWatchlistNotFoundResponseBody(res *goa.ServiceError) *DeleteWatchlistNotFoundResponseBody {
+	body := &DeleteWatchlistNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteWatchlistForbiddenResponseBody builds the HTTP response body from
+// the result of the "delete_watchlist" endpoint of the "watchlist" service.
+func NewDeleteWatchlistForbiddenResponseBody(res *goa.ServiceError) *DeleteWatchlistForbiddenResponseBody {
+	body := &DeleteWatchlistForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteWatchlistConflictResponseBody builds the HTTP response body from
+// the result of the "delete_watchlist" endpoint of the "watchlist" service.
+func NewDeleteWatchlistConflictResponseBody(res *goa.ServiceError) *DeleteWatchlistConflictResponseBody {
+	body := &DeleteWatchlistConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteWatchlistPreconditionFailedResponseBody builds the HTTP response
+// body from the result of the "delete_watchlist" endpoint of the "watchlist"
+// service.
+func NewDeleteWatchlistPreconditionFailedResponseBody(res *goa.ServiceError) *DeleteWatchlistPreconditionFailedResponseBody {
+	body := &DeleteWatchlistPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListItemsUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "list_items" endpoint of the "watchlist" service.
+func NewListItemsUnauthorizedResponseBody(res *goa.ServiceError) *ListItemsUnauthorizedResponseBody {
+	body := &ListItemsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListItemsNotFoundResponseBody builds the HTTP response body from the
+// result of the "list_items" endpoint of the "watchlist" service.
+func NewListItemsNotFoundResponseBody(res *goa.ServiceError) *ListItemsNotFoundResponseBody {
+	body := &ListItemsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListItemsForbiddenResponseBody builds the HTTP response body from the
+// result of the "list_items" endpoint of the "watchlist" service.
+func NewListItemsForbiddenResponseBody(res *goa.ServiceError) *ListItemsForbiddenResponseBody {
+	body := &ListItemsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListItemsConflictResponseBody builds the HTTP response body from the
+// result of the "list_items" endpoint of the "watchlist" service.
+func NewListItemsConflictResponseBody(res *goa.ServiceError) *ListItemsConflictResponseBody {
+	body := &ListItemsConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListItemsPreconditionFailedResponseBody builds the HTTP response body
+// from the result of the "list_items" endpoint of the "watchlist" service.
+func NewListItemsPreconditionFailedResponseBody(res *goa.ServiceError) *ListItemsPreconditionFailedResponseBody {
+	body := &ListItemsPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddItemInvalidSymbolResponseBody builds the HTTP response body from the
+// result of the "add_item" endpoint of the "watchlist" service.
+func NewAddItemInvalidSymbolResponseBody(res *goa.ServiceError) *AddItemInvalidSymbolResponseBody {
+	body := &AddItemInvalidSymbolResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddItemUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "add_item" endpoint of the "watchlist" service.
+func NewAddItemUnauthorizedResponseBody(res *goa.ServiceError) *AddItemUnauthorizedResponseBody {
+	body := &AddItemUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddItemNotFoundResponseBody builds the HTTP response body from the result
+// of the "add_item" endpoint of the "watchlist" service.
+func NewAddItemNotFoundResponseBody(res *goa.ServiceError) *AddItemNotFoundResponseBody {
+	body := &AddItemNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddItemForbiddenResponseBody builds the HTTP response body from the
+// result of the "add_item" endpoint of the "watchlist" service.
+func NewAddItemForbiddenResponseBody(res *goa.ServiceError) *AddItemForbiddenResponseBody {
+	body := &AddItemForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddItemConflictResponseBody builds the HTTP response body from the result
+// of the "add_item" endpoint of the "watchlist" service.
+func NewAddItemConflictResponseBody(res *goa.ServiceError) *AddItemConflictResponseBody {
+	body := &AddItemConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAddItemPreconditionFailedResponseBody builds the HTTP response body from
+// the result of the "add_item" endpoint of the "watchlist" service.
+func NewAddItemPreconditionFailedResponseBody(res *goa.ServiceError) *AddItemPreconditionFailedResponseBody {
+	body := &AddItemPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateItemUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "update_item" endpoint of the "watchlist" service.
+func NewUpdateItemUnauthorizedResponseBody(res *goa.ServiceError) *UpdateItemUnauthorizedResponseBody {
+	body := &UpdateItemUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateItemNotFoundResponseBody builds the HTTP response body from the
+// result of the "update_item" endpoint of the "watchlist" service.
+func NewUpdateItemNotFoundResponseBody(res *goa.ServiceError) *UpdateItemNotFoundResponseBody {
+	body := &UpdateItemNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateItemForbiddenResponseBody builds the HTTP response body from the
+// result of the "update_item" endpoint of the "watchlist" service.
+func NewUpdateItemForbiddenResponseBody(res *goa.ServiceError) *UpdateItemForbiddenResponseBody {
+	body := &UpdateItemForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateItemConflictResponseBody builds the HTTP response body from the
+// result of the "update_item" endpoint of the "watchlist" service.
+func NewUpdateItemConflictResponseBody(res *goa.ServiceError) *UpdateItemConflictResponseBody {
+	body := &UpdateItemConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateItemPreconditionFailedResponseBody builds the HTTP response body
+// from the result of the "update_item" endpoint of the "watchlist" service.
+func NewUpdateItemPreconditionFailedResponseBody(res *goa.ServiceError) *UpdateItemPreconditionFailedResponseBody {
+	body := &UpdateItemPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRemoveItemUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "remove_item" endpoint of the "watchlist" service.
+func NewRemoveItemUnauthorizedResponseBody(res *goa.ServiceError) *RemoveItemUnauthorizedResponseBody {
+	body := &RemoveItemUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRemoveItemNotFoundResponseBody builds the HTTP response body from the
+// result of the "remove_item" endpoint of the "watchlist" service.
+func NewRemoveItemNotFoundResponseBody(res *goa.ServiceError) *RemoveItemNotFoundResponseBody {
+	body := &RemoveItemNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRemoveItemForbiddenResponseBody builds the HTTP response body from the
+// result of the "remove_item" endpoint of the "watchlist" service.
+func NewRemoveItemForbiddenResponseBody(res *goa.ServiceError) *RemoveItemForbiddenResponseBody {
+	body := &RemoveItemForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRemoveItemConflictResponseBody builds the HTTP response body from the
+// result of the "remove_item" endpoint of the "watchlist" service.
+func NewRemoveItemConflictResponseBody(res *goa.ServiceError) *RemoveItemConflictResponseBody {
+	body := &RemoveItemConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRemoveItemPreconditionFailedResponseBody builds the HTTP response body
+// from the result of the "remove_item" endpoint of the "watchlist" service.
+func NewRemoveItemPreconditionFailedResponseBody(res *goa.ServiceError) *RemoveItemPreconditionFailedResponseBody {
+	body := &RemoveItemPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSharesUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "list_shares" endpoint of the "watchlist" service.
+func NewListSharesUnauthorizedResponseBody(res *goa.ServiceError) *ListSharesUnauthorizedResponseBody {
+	body := &ListSharesUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSharesNotFoundResponseBody builds the HTTP response body from the
+// result of the "list_shares" endpoint of the "watchlist" service.
+func NewListSharesNotFoundResponseBody(res *goa.ServiceError) *ListSharesNotFoundResponseBody {
+	body := &ListSharesNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSharesForbiddenResponseBody builds the HTTP response body from the
+// result of the "list_shares" endpoint of the "watchlist" service.
+func NewListSharesForbiddenResponseBody(res *goa.ServiceError) *ListSharesForbiddenResponseBody {
+	body := &ListSharesForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSharesConflictResponseBody builds the HTTP response body from the
+// result of the "list_shares" endpoint of the "watchlist" service.
+func NewListSharesConflictResponseBody(res *goa.ServiceError) *ListSharesConflictResponseBody {
+	body := &ListSharesConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSharesPreconditionFailedResponseBody builds the HTTP response body
+// from the result of the "list_shares" endpoint of the "watchlist" service.
+func NewListSharesPreconditionFailedResponseBody(res *goa.ServiceError) *ListSharesPreconditionFailedResponseBody {
+	body := &ListSharesPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewShareWatchlistUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "share_watchlist" endpoint of the "watchlist" service.
+func NewShareWatchlistUnauthorizedResponseBody(res *goa.ServiceError) *ShareWatchlistUnauthorizedResponseBody {
+	body := &ShareWatchlistUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewShareWatchlistNotFoundResponseBody builds the HTTP response body from the
+// result of the "share_watchlist" endpoint of the "watchlist" service.
+func NewShareWatchlistNotFoundResponseBody(res *goa.ServiceError) *ShareWatchlistNotFoundResponseBody {
+	body := &ShareWatchlistNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewShareWatchlistForbiddenResponseBody builds the HTTP response body from
+// the result of the "share_watchlist" endpoint of the "watchlist" service.
+func NewShareWatchlistForbiddenResponseBody(res *goa.ServiceError) *ShareWatchlistForbiddenResponseBody {
+	body := &ShareWatchlistForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewShareWatchlistConflictResponseBody builds the HTTP response body from the
+// result of the "share_watchlist" endpoint of the "watchlist" service.
+func NewShareWatchlistConflictResponseBody(res *goa.ServiceError) *ShareWatchlistConflictResponseBody {
+	body := &ShareWatchlistConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewShareWatchlistPreconditionFailedResponseBody builds the HTTP response
+// body from the result of the "share_watchlist" endpoint of the "watchlist"
+// service.
+func NewShareWatchlistPreconditionFailedResponseBody(res *goa.ServiceError) *ShareWatchlistPreconditionFailedResponseBody {
+	body := &ShareWatchlistPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnshareWatchlistUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "unshare_watchlist" endpoint of the "watchlist"
+// service.
+func NewUnshareWatchlistUnauthorizedResponseBody(res *goa.ServiceError) *UnshareWatchlistUnauthorizedResponseBody {
+	body := &UnshareWatchlistUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnshareWatchlistNotFoundResponseBody builds the HTTP response body from
+// the result of the "unshare_watchlist" endpoint of the "watchlist" service.
+func NewUnshareWatchlistNotFoundResponseBody(res *goa.ServiceError) *UnshareWatchlistNotFoundResponseBody {
+	body := &UnshareWatchlistNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnshareWatchlistForbiddenResponseBody builds the HTTP response body from
+// the result of the "unshare_watchlist" endpoint of the "watchlist" service.
+func NewUnshareWatchlistForbiddenResponseBody(res *goa.ServiceError) *UnshareWatchlistForbiddenResponseBody {
+	body := &UnshareWatchlistForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnshareWatchlistConflictResponseBody builds the HTTP response body from
+// the result of the "unshare_watchlist" endpoint of the "watchlist" service.
+func NewUnshareWatchlistConflictResponseBody(res *goa.ServiceError) *UnshareWatchlistConflictResponseBody {
+	body := &UnshareWatchlistConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnshareWatchlistPreconditionFailedResponseBody builds the HTTP response
+// body from the result of the "unshare_watchlist" endpoint of the "watchlist"
+// service.
+func NewUnshareWatchlistPreconditionFailedResponseBody(res *goa.ServiceError) *UnshareWatchlistPreconditionFailedResponseBody {
+	body := &UnshareWatchlistPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPublishWatchlistUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "publish_watchlist" endpoint of the "watchlist"
+// service.
+func NewPublishWatchlistUnauthorizedResponseBody(res *goa.ServiceError) *PublishWatchlistUnauthorizedResponseBody {
+	body := &PublishWatchlistUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPublishWatchlistNotFoundResponseBody builds the HTTP response body from
+// the result of the "publish_watchlist" endpoint of the "watchlist" service.
+func NewPublishWatchlistNotFoundResponseBody(res *goa.ServiceError) *PublishWatchlistNotFoundResponseBody {
+	body := &PublishWatchlistNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPublishWatchlistForbiddenResponseBody builds the HTTP response body from
+// the result of the "publish_watchlist" endpoint of the "watchlist" service.
+func NewPublishWatchlistForbiddenResponseBody(res *goa.ServiceError) *PublishWatchlistForbiddenResponseBody {
+	body := &PublishWatchlistForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPublishWatchlistConflictResponseBody builds the HTTP response body from
+// the result of the "publish_watchlist" endpoint of the "watchlist" service.
+func NewPublishWatchlistConflictResponseBody(res *goa.ServiceError) *PublishWatchlistConflictResponseBody {
+	body := &PublishWatchlistConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPublishWatchlistPreconditionFailedResponseBody builds the HTTP response
+// body from the result of the "publish_watchlist" endpoint of the "watchlist"
+// service.
+func NewPublishWatchlistPreconditionFailedResponseBody(res *goa.ServiceError) *PublishWatchlistPreconditionFailedResponseBody {
+	body := &PublishWatchlistPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnpublishWatchlistUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "unpublish_watchlist" endpoint of the "watchlist"
+// service.
+func NewUnpublishWatchlistUnauthorizedResponseBody(res *goa.ServiceError) *UnpublishWatchlistUnauthorizedResponseBody {
+	body := &UnpublishWatchlistUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnpublishWatchlistNotFoundResponseBody builds the HTTP response body from
+// the result of the "unpublish_watchlist" endpoint of the "watchlist" service.
+func NewUnpublishWatchlistNotFoundResponseBody(res *goa.ServiceError) *UnpublishWatchlistNotFoundResponseBody {
+	body := &UnpublishWatchlistNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnpublishWatchlistForbiddenResponseBody builds the HTTP response body
+// from the result of the "unpublish_watchlist" endpoint of the "watchlist"
+// service.
+func NewUnpublishWatchlistForbiddenResponseBody(res *goa.ServiceError) *UnpublishWatchlistForbiddenResponseBody {
+	body := &UnpublishWatchlistForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnpublishWatchlistConflictResponseBody builds the HTTP response body from
+// the result of the "unpublish_watchlist" endpoint of the "watchlist" service.
+func NewUnpublishWatchlistConflictResponseBody(res *goa.ServiceError) *UnpublishWatchlistConflictResponseBody {
+	body := &UnpublishWatchlistConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnpublishWatchlistPreconditionFailedResponseBody builds the HTTP response
+// body from the result of the "unpublish_watchlist" endpoint of the
+// "watchlist" service.
+func NewUnpublishWatchlistPreconditionFailedResponseBody(res *goa.ServiceError) *UnpublishWatchlistPreconditionFailedResponseBody {
+	body := &UnpublishWatchlistPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetPublicWatchlistUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "get_public_watchlist" endpoint of the "watchlist"
+// service.
+func NewGetPublicWatchlistUnauthorizedResponseBody(res *goa.ServiceError) *GetPublicWatchlistUnauthorizedResponseBody {
+	body := &GetPublicWatchlistUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetPublicWatchlistNotFoundResponseBody builds the HTTP response body from
+// the result of the "get_public_watchlist" endpoint of the "watchlist" service.
+func NewGetPublicWatchlistNotFoundResponseBody(res *goa.ServiceError) *GetPublicWatchlistNotFoundResponseBody {
+	body := &GetPublicWatchlistNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetPublicWatchlistForbiddenResponseBody builds the HTTP response body
+// from the result of the "get_public_watchlist" endpoint of the "watchlist"
+// service.
+func NewGetPublicWatchlistForbiddenResponseBody(res *goa.ServiceError) *GetPublicWatchlistForbiddenResponseBody {
+	body := &GetPublicWatchlistForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetPublicWatchlistConflictResponseBody builds the HTTP response body from
+// the result of the "get_public_watchlist" endpoint of the "watchlist" service.
+func NewGetPublicWatchlistConflictResponseBody(res *goa.ServiceError) *GetPublicWatchlistConflictResponseBody {
+	body := &GetPublicWatchlistConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetPublicWatchlistPreconditionFailedResponseBody builds the HTTP response
+// body from the result of the "get_public_watchlist" endpoint of the
+// "watchlist" service.
+func NewGetPublicWatchlistPreconditionFailedResponseBody(res *goa.ServiceError) *GetPublicWatchlistPreconditionFailedResponseBody {
+	body := &GetPublicWatchlistPreconditionFailedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListPayload builds a watchlist service list endpoint payload.
+func NewListPayload(ifNoneMatch *string, token *string) *watchlist.ListPayload {
+	v := &watchlist.ListPayload{}
+	v.IfNoneMatch = ifNoneMatch
+	v.Token = token
+
+	return v
+}
+
+// NewAddPayload builds a watchlist service add endpoint payload.
+func NewAddPayload(body *AddRequestBody, ifMatch *string, token *string) *watchlist.AddPayload {
+	v := &watchlist.AddPayload{
+		Symbol:     *body.Symbol,
+		OnHand:     *body.OnHand,
+		Note:       body.Note,
+		BuyTarget:  body.BuyTarget,
+		SellTarget: body.SellTarget,
+	}
+	if body.Tags != nil {
+		v.Tags = make([]string, len(body.Tags))
+		for i, val := range body.Tags {
+			v.Tags[i] = val
+		}
+	}
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewUpdatePayload builds a watchlist service update endpoint payload.
+func NewUpdatePayload(body *UpdateRequestBody, symbol string, ifMatch *string, token *string) *watchlist.UpdatePayload {
+	v := &watchlist.UpdatePayload{
+		OnHand:     body.OnHand,
+		Note:       body.Note,
+		BuyTarget:  body.BuyTarget,
+		SellTarget: body.SellTarget,
+		Position:   body.Position,
+	}
+	if body.Tags != nil {
+		v.Tags = make([]string, len(body.Tags))
+		for i, val := range body.Tags {
+			v.Tags[i] = val
+		}
+	}
+	v.Symbol = symbol
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewRemovePayload builds a watchlist service remove endpoint payload.
+func NewRemovePayload(symbol string, ifMatch *string, token *string) *watchlist.RemovePayload {
+	v := &watchlist.RemovePayload{}
+	v.Symbol = symbol
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewListRemovedPayload builds a watchlist service list_removed endpoint
+// payload.
+func NewListRemovedPayload(watchlist2 *string, token *string) *watchlist.ListRemovedPayload {
+	v := &watchlist.ListRemovedPayload{}
+	v.Watchlist = watchlist2
+	v.Token = token
+
+	return v
+}
+
+// NewRestorePayload builds a watchlist service restore endpoint payload.
+func NewRestorePayload(symbol string, watchlist2 *string, ifMatch *string, token *string) *watchlist.RestorePayload {
+	v := &watchlist.RestorePayload{}
+	v.Symbol = symbol
+	v.Watchlist = watchlist2
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewEventsPayload builds a watchlist service events endpoint payload.
+func NewEventsPayload(watchlist2 *string, lastEventID *string, token *string) *watchlist.EventsPayload {
+	v := &watchlist.EventsPayload{}
+	v.Watchlist = watchlist2
+	v.LastEventID = lastEventID
+	v.Token = token
+
+	return v
+}
+
+// NewBulkAddPayload builds a watchlist service bulk_add endpoint payload.
+func NewBulkAddPayload(body *BulkAddRequestBody, watchlist2 *string, ifMatch *string, token *string) *watchlist.BulkAddPayload {
+	v := &watchlist.BulkAddPayload{}
+	if body.Mode != nil {
+		v.Mode = *body.Mode
+	}
+	if body.Mode == nil {
+		v.Mode = "all_or_nothing"
+	}
+	v.Items = make([]*watchlist.BulkAddItem, len(body.Items))
+	for i, val := range body.Items {
+		if val == nil {
+			v.Items[i] = nil
+			continue
+		}
+		v.Items[i] = unmarshalBulkAddItemRequestBodyToWatchlistBulkAddItem(val)
+	}
+	v.Watchlist = watchlist2
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewBulkRemovePayload builds a watchlist service bulk_remove endpoint payload.
+func NewBulkRemovePayload(body *BulkRemoveRequestBody, watchlist2 *string, ifMatch *string, token *string) *watchlist.BulkRemovePayload {
+	v := &watchlist.BulkRemovePayload{}
+	if body.Mode != nil {
+		v.Mode = *body.Mode
+	}
+	if body.Mode == nil {
+		v.Mode = "all_or_nothing"
+	}
+	v.Symbols = make([]string, len(body.Symbols))
+	for i, val := range body.Symbols {
+		v.Symbols[i] = val
+	}
+	v.Watchlist = watchlist2
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewImportPayload builds a watchlist service import endpoint payload.
+func NewImportPayload(watchlist2 *string, format *string, dryRun bool, ifMatch *string, token *string) *watchlist.ImportPayload {
+	v := &watchlist.ImportPayload{}
+	v.Watchlist = watchlist2
+	v.Format = format
+	v.DryRun = dryRun
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewExportPayload builds a watchlist service export endpoint payload.
+func NewExportPayload(watchlist2 *string, format string, token *string) *watchlist.ExportPayload {
+	v := &watchlist.ExportPayload{}
+	v.Watchlist = watchlist2
+	v.Format = format
+	v.Token = token
+
+	return v
+}
+
+// NewListWatchlistsPayload builds a watchlist service list_watchlists endpoint
+// payload.
+func NewListWatchlistsPayload(token *string) *watchlist.ListWatchlistsPayload {
+	v := &watchlist.ListWatchlistsPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewListSharedWatchlistsPayload builds a watchlist service
+// list_shared_watchlists endpoint payload.
+func NewListSharedWatchlistsPayload(token *string) *watchlist.ListSharedWatchlistsPayload {
+	v := &watchlist.ListSharedWatchlistsPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewCreateWatchlistPayload builds a watchlist service create_watchlist
+// endpoint payload.
+func NewCreateWatchlistPayload(body *CreateWatchlistRequestBody, token *string) *watchlist.CreateWatchlistPayload {
+	v := &watchlist.CreateWatchlistPayload{
+		Name: *body.Name,
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewGetWatchlistPayload builds a watchlist service get_watchlist endpoint
+// payload.
+func NewGetWatchlistPayload(id string, token *string) *watchlist.GetWatchlistPayload {
+	v := &watchlist.GetWatchlistPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewUpdateWatchlistPayload builds a watchlist service update_watchlist
+// endpoint payload.
+func NewUpdateWatchlistPayload(body *UpdateWatchlistRequestBody, id string, ifMatch *string, token *string) *watchlist.UpdateWatchlistPayload {
+	v := &watchlist.UpdateWatchlistPayload{
+		Name:     body.Name,
+		Position: body.Position,
+	}
+	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewDeleteWatchlistPayload builds a watchlist service delete_watchlist
+// endpoint payload.
+func NewDeleteWatchlistPayload(id string, ifMatch *string, token *string) *watchlist.DeleteWatchlistPayload {
+	v := &watchlist.DeleteWatchlistPayload{}
+	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewListItemsPayload builds a watchlist service list_items endpoint payload.
+func NewListItemsPayload(id string, ifNoneMatch *string, token *string) *watchlist.ListItemsPayload {
+	v := &watchlist.ListItemsPayload{}
+	v.ID = id
+	v.IfNoneMatch = ifNoneMatch
+	v.Token = token
+
+	return v
+}
+
+// NewAddItemPayload builds a watchlist service add_item endpoint payload.
+func NewAddItemPayload(body *AddItemRequestBody, id string, ifMatch *string, token *string) *watchlist.AddItemPayload {
+	v := &watchlist.AddItemPayload{
+		Symbol:     *body.Symbol,
+		OnHand:     *body.OnHand,
+		Note:       body.Note,
+		BuyTarget:  body.BuyTarget,
+		SellTarget: body.SellTarget,
+	}
+	if body.Tags != nil {
+		v.Tags = make([]string, len(body.Tags))
+		for i, val := range body.Tags {
+			v.Tags[i] = val
+		}
+	}
+	v.ID = id
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewUpdateItemPayload builds a watchlist service update_item endpoint payload.
+func NewUpdateItemPayload(body *UpdateItemRequestBody, id string, symbol string, ifMatch *string, token *string) *watchlist.UpdateItemPayload {
+	v := &watchlist.UpdateItemPayload{
+		OnHand:     body.OnHand,
+		Note:       body.Note,
+		BuyTarget:  body.BuyTarget,
+		SellTarget: body.SellTarget,
+		Position:   body.Position,
+	}
+	if body.Tags != nil {
+		v.Tags = make([]string, len(body.Tags))
+		for i, val := range body.Tags {
+			v.Tags[i] = val
+		}
+	}
+	v.ID = id
+	v.Symbol = symbol
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewRemoveItemPayload builds a watchlist service remove_item endpoint payload.
+func NewRemoveItemPayload(id string, symbol string, ifMatch *string, token *string) *watchlist.RemoveItemPayload {
+	v := &watchlist.RemoveItemPayload{}
+	v.ID = id
+	v.Symbol = symbol
+	v.IfMatch = ifMatch
+	v.Token = token
+
+	return v
+}
+
+// NewListSharesPayload builds a watchlist service list_shares endpoint payload.
+func NewListSharesPayload(id string, token *string) *watchlist.ListSharesPayload {
+	v := &watchlist.ListSharesPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewShareWatchlistPayload builds a watchlist service share_watchlist endpoint
+// payload.
+func NewShareWatchlistPayload(body *ShareWatchlistRequestBody, id string, user string, token *string) *watchlist.ShareWatchlistPayload {
+	v := &watchlist.ShareWatchlistPayload{
+		Role: *body.Role,
+	}
+	v.ID = id
+	v.User = user
+	v.Token = token
+
+	return v
+}
+
+// NewUnshareWatchlistPayload builds a watchlist service unshare_watchlist
+// endpoint payload.
+func NewUnshareWatchlistPayload(id string, user string, token *string) *watchlist.UnshareWatchlistPayload {
+	v := &watchlist.UnshareWatchlistPayload{}
+	v.ID = id
+	v.User = user
+	v.Token = token
+
+	return v
+}
+
+// NewPublishWatchlistPayload builds a watchlist service publish_watchlist
+// endpoint payload.
+func NewPublishWatchlistPayload(id string, token *string) *watchlist.PublishWatchlistPayload {
+	v := &watchlist.PublishWatchlistPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewUnpublishWatchlistPayload builds a watchlist service unpublish_watchlist
+// endpoint payload.
+func NewUnpublishWatchlistPayload(id string, token *string) *watchlist.UnpublishWatchlistPayload {
+	v := &watchlist.UnpublishWatchlistPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewGetPublicWatchlistPayload builds a watchlist service get_public_watchlist
+// endpoint payload.
+func NewGetPublicWatchlistPayload(token string) *watchlist.GetPublicWatchlistPayload {
+	v := &watchlist.GetPublicWatchlistPayload{}
+	v.Token = token
+
+	return v
+}
+
+// ValidateAddRequestBody runs the validations defined on AddRequestBody
+func ValidateAddRequestBody(body *AddRequestBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.OnHand == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("on_hand", "body"))
+	}
+	if body.Note != nil {
+		if utf8.RuneCountInString(*body.Note) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.note", *body.Note, utf8.RuneCountInString(*body.Note), 1000, false))
+		}
+	}
+	if len(body.Tags) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 20, false))
+	}
+	for _, e := range body.Tags {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.tags[*]", e, "\\S"))
+		if utf8.RuneCountInString(e) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags[*]", e, utf8.RuneCountInString(e), 50, false))
+		}
+	}
+	if body.BuyTarget != nil {
+		if *body.BuyTarget < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.buy_target", *body.BuyTarget, 0, true))
+		}
+	}
+	if body.SellTarget != nil {
+		if *body.SellTarget < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sell_target", *body.SellTarget, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateUpdateRequestBody runs the validations defined on UpdateRequestBody
+func ValidateUpdateRequestBody(body *UpdateRequestBody) (err error) {
+	if body.Note != nil {
+		if utf8.RuneCountInString(*body.Note) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.note", *body.Note, utf8.RuneCountInString(*body.Note), 1000, false))
+		}
+	}
+	if len(body.Tags) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 20, false))
+	}
+	for _, e := range body.Tags {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.tags[*]", e, "\\S"))
+		if utf8.RuneCountInString(e) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags[*]", e, utf8.RuneCountInString(e), 50, false))
+		}
+	}
+	if body.BuyTarget != nil {
+		if *body.BuyTarget < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.buy_target", *body.BuyTarget, 0, true))
+		}
+	}
+	if body.SellTarget != nil {
+		if *body.SellTarget < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sell_target", *body.SellTarget, 0, true))
+		}
+	}
+	if body.Position != nil {
+		if *body.Position < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.position", *body.Position, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateBulkAddRequestBody runs the validations defined on
+// bulk_add_request_body
+func ValidateBulkAddRequestBody(body *BulkAddRequestBody) (err error) {
+	if body.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+	}
+	if body.Mode != nil {
+		if !(*body.Mode == "all_or_nothing" || *body.Mode == "best_effort") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", *body.Mode, []any{"all_or_nothing", "best_effort"}))
+		}
+	}
+	if len(body.Items) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 1, true))
+	}
+	if len(body.Items) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 100, false))
+	}
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateBulkAddItemRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBulkRemoveRequestBody runs the validations defined on
+// bulk_remove_request_body
+func ValidateBulkRemoveRequestBody(body *BulkRemoveRequestBody) (err error) {
+	if body.Symbols == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbols", "body"))
+	}
+	if body.Mode != nil {
+		if !(*body.Mode == "all_or_nothing" || *body.Mode == "best_effort") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mode", *body.Mode, []any{"all_or_nothing", "best_effort"}))
+		}
+	}
+	if len(body.Symbols) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.symbols", body.Symbols, len(body.Symbols), 1, true))
+	}
+	if len(body.Symbols) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.symbols", body.Symbols, len(body.Symbols), 100, false))
+	}
+	return
+}
+
+// ValidateCreateWatchlistRequestBody runs the validations defined on
+// create_watchlist_request_body
+func ValidateCreateWatchlistRequestBody(body *CreateWatchlistRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Name != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.name", *body.Name, "\\S"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 100, false))
+		}
+	}
+	return
+}
+
+// ValidateUpdateWatchlistRequestBody runs the validations defined on
+// update_watchlist_request_body
+func ValidateUpdateWatchlistRequestBody(body *UpdateWatchlistRequestBody) (err error) {
+	if body.Name != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.name", *body.Name, "\\S"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 100, false))
+		}
+	}
+	if body.Position != nil {
+		if *body.Position < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.position", *body.Position, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateAddItemRequestBody runs the validations defined on
+// add_item_request_body
+func ValidateAddItemRequestBody(body *AddItemRequestBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.OnHand == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("on_hand", "body"))
+	}
+	if body.Note != nil {
+		if utf8.RuneCountInString(*body.Note) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.note", *body.Note, utf8.RuneCountInString(*body.Note), 1000, false))
+		}
+	}
+	if len(body.Tags) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 20, false))
+	}
+	for _, e := range body.Tags {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.tags[*]", e, "\\S"))
+		if utf8.RuneCountInString(e) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags[*]", e, utf8.RuneCountInString(e), 50, false))
+		}
+	}
+	if body.BuyTarget != nil {
+		if *body.BuyTarget < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.buy_target", *body.BuyTarget, 0, true))
+		}
+	}
+	if body.SellTarget != nil {
+		if *body.SellTarget < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sell_target", *body.SellTarget, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateUpdateItemRequestBody runs the validations defined on
+// update_item_request_body
+func ValidateUpdateItemRequestBody(body *UpdateItemRequestBody) (err error) {
+	if body.Note != nil {
+		if utf8.RuneCountInString(*body.Note) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.note", *body.Note, utf8.RuneCountInString(*body.Note), 1000, false))
+		}
+	}
+	if len(body.Tags) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 20, false))
+	}
+	for _, e := range body.Tags {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.tags[*]", e, "\\S"))
+		if utf8.RuneCountInString(e) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags[*]", e, utf8.RuneCountInString(e), 50, false))
+		}
+	}
+	if body.BuyTarget != nil {
+		if *body.BuyTarget < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.buy_target", *body.BuyTarget, 0, true))
+		}
+	}
+	if body.SellTarget != nil {
+		if *body.SellTarget < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sell_target", *body.SellTarget, 0, true))
+		}
+	}
+	if body.Position != nil {
+		if *body.Position < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.position", *body.Position, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateShareWatchlistRequestBody runs the validations defined on
+// share_watchlist_request_body
+func ValidateShareWatchlistRequestBody(body *ShareWatchlistRequestBody) (err error) {
+	if body.Role == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("role", "body"))
+	}
+	if body.Role != nil {
+		if !(*body.Role == "editor" || *body.Role == "viewer") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", *body.Role, []any{"editor", "viewer"}))
+		}
+	}
+	return
+}
+
+// ValidateBulkAddItemRequestBody runs the validations defined on
+// BulkAddItemRequestBody
+func ValidateBulkAddItemRequestBody(body *BulkAddItemRequestBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Note != nil {
+		if utf8.RuneCountInString(*body.Note) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.note", *body.Note, utf8.RuneCountInString(*body.Note), 1000, false))
+		}
+	}
+	if len(body.Tags) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 20, false))
+	}
+	for _, e := range body.Tags {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.tags[*]", e, "\\S"))
+		if utf8.RuneCountInString(e) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags[*]", e, utf8.RuneCountInString(e), 50, false))
+		}
+	}
+	if body.BuyTarget != nil {
+		if *body.BuyTarget < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.buy_target", *body.BuyTarget, 0, true))
+		}
+	}
+	if body.SellTarget != nil {
+		if *body.SellTarget < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sell_target", *body.SellTarget, 0, true))
+		}
 	}
 	return
 }
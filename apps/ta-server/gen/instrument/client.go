@@ -0,0 +1,136 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// instrument client
+//
+// Command:
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
+
+package instrument
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "instrument" service client.
+type Client struct {
+	ListEndpoint   goa.Endpoint
+	SearchEndpoint goa.Endpoint
+	GetEndpoint    goa.Endpoint
+	CreateEndpoint goa.Endpoint
+	UpdateEndpoint goa.Endpoint
+	DeleteEndpoint goa.Endpoint
+	ImportEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "instrument" service client given the endpoints.
+func NewClient(list, search, get, create, update, delete_, import_ goa.Endpoint) *Client {
+	return &Client{
+		ListEndpoint:   list,
+		SearchEndpoint: search,
+		GetEndpoint:    get,
+		CreateEndpoint: create,
+		UpdateEndpoint: update,
+		DeleteEndpoint: delete_,
+		ImportEndpoint: import_,
+	}
+}
+
+// List calls the "list" endpoint of the "instrument" service.
+// List may return the following errors:
+//   - "invalid_cursor" (type *goa.ServiceError): Malformed cursor, or cursor issued for another sort order
+//   - "not_found" (type *goa.ServiceError): Instrument not found
+//   - "invalid_instrument" (type *goa.ServiceError): Instrument fails validation, e.g. an unknown exchange or a bad ISIN check digit
+//   - error: internal error
+func (c *Client) List(ctx context.Context, p *ListPayload) (res *ListResult, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListResult), nil
+}
+
+// Search calls the "search" endpoint of the "instrument" service.
+// Search may return the following errors:
+//   - "not_found" (type *goa.ServiceError): Instrument not found
+//   - "invalid_instrument" (type *goa.ServiceError): Instrument fails validation, e.g. an unknown exchange or a bad ISIN check digit
+//   - error: internal error
+func (c *Client) Search(ctx context.Context, p *SearchPayload) (res []*Instrument, err error) {
+	var ires any
+	ires, err = c.SearchEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Instrument), nil
+}
+
+// Get calls the "get" endpoint of the "instrument" service.
+// Get may return the following errors:
+//   - "not_found" (type *goa.ServiceError): Instrument not found
+//   - "invalid_instrument" (type *goa.ServiceError): Instrument fails validation, e.g. an unknown exchange or a bad ISIN check digit
+//   - error: internal error
+func (c *Client) Get(ctx context.Context, p *GetPayload) (res *Instrument, err error) {
+	var ires any
+	ires, err = c.GetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Instrument), nil
+}
+
+// Create calls the "create" endpoint of the "instrument" service.
+// Create may return the following errors:
+//   - "conflict" (type *goa.ServiceError): Instrument or FIGI already exists
+//   - "not_found" (type *goa.ServiceError): Instrument not found
+//   - "invalid_instrument" (type *goa.ServiceError): Instrument fails validation, e.g. an unknown exchange or a bad ISIN check digit
+//   - error: internal error
+func (c *Client) Create(ctx context.Context, p *CreatePayload) (res *Instrument, err error) {
+	var ires any
+	ires, err = c.CreateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Instrument), nil
+}
+
+// Update calls the "update" endpoint of the "instrument" service.
+// Update may return the following errors:
+//   - "conflict" (type *goa.ServiceError): FIGI already used by another instrument
+//   - "not_found" (type *goa.ServiceError): Instrument not found
+//   - "invalid_instrument" (type *goa.ServiceError): Instrument fails validation, e.g. an unknown exchange or a bad ISIN check digit
+//   - error: internal error
+func (c *Client) Update(ctx context.Context, p *UpdatePayload) (res *Instrument, err error) {
+	var ires any
+	ires, err = c.UpdateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Instrument), nil
+}
+
+// Delete calls the "delete" endpoint of the "instrument" service.
+// Delete may return the following errors:
+//   - "not_found" (type *goa.ServiceError): Instrument not found
+//   - "invalid_instrument" (type *goa.ServiceError): Instrument fails validation, e.g. an unknown exchange or a bad ISIN check digit
+//   - error: internal error
+func (c *Client) Delete(ctx context.Context, p *DeletePayload) (err error) {
+	_, err = c.DeleteEndpoint(ctx, p)
+	return
+}
+
+// Import calls the "import" endpoint of the "instrument" service.
+// Import may return the following errors:
+//   - "not_found" (type *goa.ServiceError): Instrument not found
+//   - "invalid_instrument" (type *goa.ServiceError): Instrument fails validation, e.g. an unknown exchange or a bad ISIN check digit
+//   - error: internal error
+func (c *Client) Import(ctx context.Context, p *ImportPayload, req io.ReadCloser) (res *InstrumentImportReport, err error) {
+	var ires any
+	ires, err = c.ImportEndpoint(ctx, &ImportRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.(*InstrumentImportReport), nil
+}
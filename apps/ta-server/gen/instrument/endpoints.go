@@ -0,0 +1,122 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// instrument endpoints
+//
+// Command:
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
+
+package instrument
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "instrument" service endpoints.
+type Endpoints struct {
+	List   goa.Endpoint
+	Search goa.Endpoint
+	Get    goa.Endpoint
+	Create goa.Endpoint
+	Update goa.Endpoint
+	Delete goa.Endpoint
+	Import goa.Endpoint
+}
+
+// ImportRequestData holds both the payload and the HTTP request body reader of
+// the "import" method.
+type ImportRequestData struct {
+	// Payload is the method payload.
+	Payload *ImportPayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
+}
+
+// NewEndpoints wraps the methods of the "instrument" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		List:   NewListEndpoint(s),
+		Search: NewSearchEndpoint(s),
+		Get:    NewGetEndpoint(s),
+		Create: NewCreateEndpoint(s),
+		Update: NewUpdateEndpoint(s),
+		Delete: NewDeleteEndpoint(s),
+		Import: NewImportEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "instrument" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.List = m(e.List)
+	e.Search = m(e.Search)
+	e.Get = m(e.Get)
+	e.Create = m(e.Create)
+	e.Update = m(e.Update)
+	e.Delete = m(e.Delete)
+	e.Import = m(e.Import)
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "list" of
+// service "instrument".
+func NewListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListPayload)
+		return s.List(ctx, p)
+	}
+}
+
+// NewSearchEndpoint returns an endpoint function that calls the method
+// "search" of service "instrument".
+func NewSearchEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SearchPayload)
+		return s.Search(ctx, p)
+	}
+}
+
+// NewGetEndpoint returns an endpoint function that calls the method "get" of
+// service "instrument".
+func NewGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetPayload)
+		return s.Get(ctx, p)
+	}
+}
+
+// NewCreateEndpoint returns an endpoint function that calls the method
+// "create" of service "instrument".
+func NewCreateEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreatePayload)
+		return s.Create(ctx, p)
+	}
+}
+
+// NewUpdateEndpoint returns an endpoint function that calls the method
+// "update" of service "instrument".
+func NewUpdateEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdatePayload)
+		return s.Update(ctx, p)
+	}
+}
+
+// NewDeleteEndpoint returns an endpoint function that calls the method
+// "delete" of service "instrument".
+func NewDeleteEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeletePayload)
+		return nil, s.Delete(ctx, p)
+	}
+}
+
+// NewImportEndpoint returns an endpoint function that calls the method
+// "import" of service "instrument".
+func NewImportEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*ImportRequestData)
+		return s.Import(ctx, ep.Payload, ep.Body)
+	}
+}
@@ -0,0 +1,209 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// instrument service
+//
+// Command:
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
+
+package instrument
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Instrument master data referenced by watchlists and portfolios
+type Service interface {
+	// List instruments, optionally filtered, one page at a time
+	List(context.Context, *ListPayload) (res *ListResult, err error)
+	// Find instruments by symbol, name, ISIN or FIGI, best matches first
+	Search(context.Context, *SearchPayload) (res []*Instrument, err error)
+	// Get implements get.
+	Get(context.Context, *GetPayload) (res *Instrument, err error)
+	// Create implements create.
+	Create(context.Context, *CreatePayload) (res *Instrument, err error)
+	// Replace the attributes of an instrument; the symbol and exchange are its
+	// identity and cannot change
+	Update(context.Context, *UpdatePayload) (res *Instrument, err error)
+	// Delete implements delete.
+	Delete(context.Context, *DeletePayload) (err error)
+	// Create or update instruments from a CSV file with the columns symbol,
+	// exchange_mic, name, asset_class, currency, isin and figi. Nothing is stored
+	// unless every row is valid.
+	Import(context.Context, *ImportPayload, io.ReadCloser) (res *InstrumentImportReport, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "ta-server"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "instrument"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [7]string{"list", "search", "get", "create", "update", "delete", "import"}
+
+// CreatePayload is the payload type of the instrument service create method.
+type CreatePayload struct {
+	// Ticker symbol on the listing exchange
+	Symbol string
+	// Operating or segment MIC of the listing exchange
+	ExchangeMic string
+	// Instrument name
+	Name string
+	// Asset class
+	AssetClass string
+	// ISO 4217 trading currency
+	Currency string
+	// ISO 6166 International Securities Identification Number
+	Isin *string
+	// Financial Instrument Global Identifier of the listing
+	Figi *string
+}
+
+// DeletePayload is the payload type of the instrument service delete method.
+type DeletePayload struct {
+	// Instrument ID
+	ID string
+}
+
+// GetPayload is the payload type of the instrument service get method.
+type GetPayload struct {
+	// Instrument ID
+	ID string
+}
+
+// ImportPayload is the payload type of the instrument service import method.
+type ImportPayload struct {
+	// Validate the file and report the changes without storing them
+	DryRun bool
+}
+
+type ImportRowError struct {
+	// Line of the row in the file
+	Line int
+	// Why the row is invalid
+	Error string
+}
+
+// Instrument is the result type of the instrument service get method.
+type Instrument struct {
+	// Instrument ID, the listing exchange operating MIC and the symbol
+	ID string
+	// Ticker symbol on the listing exchange
+	Symbol string
+	// Instrument name
+	Name string
+	// Asset class
+	AssetClass string
+	// ISO 4217 trading currency
+	Currency string
+	// ISO 6166 International Securities Identification Number
+	Isin *string
+	// Financial Instrument Global Identifier of the listing
+	Figi *string
+	// Operating MIC of the listing exchange
+	ExchangeMic string
+	// Creation time
+	CreatedAt string
+	// Last update time
+	UpdatedAt string
+}
+
+// InstrumentImportReport is the result type of the instrument service import
+// method.
+type InstrumentImportReport struct {
+	// Whether the file was only validated
+	DryRun bool
+	// Whether the instruments were stored; false when any row is invalid
+	Applied bool
+	// Number of data rows in the file
+	Rows int
+	// Instruments created, or that would be
+	Created int
+	// Instruments updated, or that would be
+	Updated int
+	// Instruments already up to date
+	Unchanged int
+	// Invalid rows
+	Errors []*ImportRowError
+}
+
+// ListPayload is the payload type of the instrument service list method.
+type ListPayload struct {
+	// Operating MIC of the listing exchange
+	ExchangeMic *string
+	// Asset class
+	AssetClass *string
+	// ISO 4217 trading currency
+	Currency *string
+	// ISIN
+	Isin *string
+	// Sort order
+	Sort string
+	// Opaque cursor from the X-Next-Cursor header of the previous page
+	Cursor *string
+	// Maximum number of items to return; every remaining item when omitted
+	Limit *int
+}
+
+// ListResult is the result type of the instrument service list method.
+type ListResult struct {
+	Instruments []*Instrument
+	// Number of items matching the filters, across all pages
+	Total int
+	// Cursor of the next page, absent on the last page
+	NextCursor *string
+}
+
+// SearchPayload is the payload type of the instrument service search method.
+type SearchPayload struct {
+	// Symbol, ISIN or FIGI, or words of the name
+	Q string
+	// Maximum number of results
+	Limit int
+}
+
+// UpdatePayload is the payload type of the instrument service update method.
+type UpdatePayload struct {
+	// Instrument ID
+	ID string
+	// Instrument name
+	Name string
+	// Asset class
+	AssetClass string
+	// ISO 4217 trading currency
+	Currency string
+	// ISO 6166 International Securities Identification Number
+	Isin *string
+	// Financial Instrument Global Identifier of the listing
+	Figi *string
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// MakeInvalidInstrument builds a goa.ServiceError from an error.
+func MakeInvalidInstrument(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_instrument", false, false, false)
+}
+
+// MakeInvalidCursor builds a goa.ServiceError from an error.
+func MakeInvalidCursor(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_cursor", false, false, false)
+}
+
+// MakeConflict builds a goa.ServiceError from an error.
+func MakeConflict(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "conflict", false, false, false)
+}
@@ -1,45 +1,109 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // watchlist client
 //
 // Command:
-// $ goa gen github.com/reidlai/ta-workspace/modules/watchlist/go/design
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
 
 package watchlist
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
 
 // Client is the "watchlist" service client.
 type Client struct {
-	ListEndpoint   goa.Endpoint
-	AddEndpoint    goa.Endpoint
-	RemoveEndpoint goa.Endpoint
+	ListEndpoint                 goa.Endpoint
+	AddEndpoint                  goa.Endpoint
+	UpdateEndpoint               goa.Endpoint
+	RemoveEndpoint               goa.Endpoint
+	ListRemovedEndpoint          goa.Endpoint
+	RestoreEndpoint              goa.Endpoint
+	EventsEndpoint               goa.Endpoint
+	BulkAddEndpoint              goa.Endpoint
+	BulkRemoveEndpoint           goa.Endpoint
+	ImportEndpoint               goa.Endpoint
+	ExportEndpoint               goa.Endpoint
+	ListWatchlistsEndpoint       goa.Endpoint
+	ListSharedWatchlistsEndpoint goa.Endpoint
+	CreateWatchlistEndpoint      goa.Endpoint
+	GetWatchlistEndpoint         goa.Endpoint
+	UpdateWatchlistEndpoint      goa.Endpoint
+	DeleteWatchlistEndpoint      goa.Endpoint
+	ListItemsEndpoint            goa.Endpoint
+	AddItemEndpoint              goa.Endpoint
+	UpdateItemEndpoint           goa.Endpoint
+	RemoveItemEndpoint           goa.Endpoint
+	ListSharesEndpoint           goa.Endpoint
+	ShareWatchlistEndpoint       goa.Endpoint
+	UnshareWatchlistEndpoint     goa.Endpoint
+	PublishWatchlistEndpoint     goa.Endpoint
+	UnpublishWatchlistEndpoint   goa.Endpoint
+	GetPublicWatchlistEndpoint   goa.Endpoint
 }
 
 // NewClient initializes a "watchlist" service client given the endpoints.
-func NewClient(list, add, remove goa.Endpoint) *Client {
+func NewClient(list, add, update, remove, listRemoved, restore, events, bulkAdd, bulkRemove, import_, export, listWatchlists, listSharedWatchlists, createWatchlist, getWatchlist, updateWatchlist, deleteWatchlist, listItems, addItem, updateItem, removeItem, listShares, shareWatchlist, unshareWatchlist, publishWatchlist, unpublishWatchlist, getPublicWatchlist goa.Endpoint) *Client {
 	return &Client{
-		ListEndpoint:   list,
-		AddEndpoint:    add,
-		RemoveEndpoint: remove,
+		ListEndpoint:                 list,
+		AddEndpoint:                  add,
+		UpdateEndpoint:               update,
+		RemoveEndpoint:               remove,
+		ListRemovedEndpoint:          listRemoved,
+		RestoreEndpoint:              restore,
+		EventsEndpoint:               events,
+		BulkAddEndpoint:              bulkAdd,
+		BulkRemoveEndpoint:           bulkRemove,
+		ImportEndpoint:               import_,
+		ExportEndpoint:               export,
+		ListWatchlistsEndpoint:       listWatchlists,
+		ListSharedWatchlistsEndpoint: listSharedWatchlists,
+		CreateWatchlistEndpoint:      createWatchlist,
+		GetWatchlistEndpoint:         getWatchlist,
+		UpdateWatchlistEndpoint:      updateWatchlist,
+		DeleteWatchlistEndpoint:      deleteWatchlist,
+		ListItemsEndpoint:            listItems,
+		AddItemEndpoint:              addItem,
+		UpdateItemEndpoint:           updateItem,
+		RemoveItemEndpoint:           removeItem,
+		ListSharesEndpoint:           listShares,
+		ShareWatchlistEndpoint:       shareWatchlist,
+		UnshareWatchlistEndpoint:     unshareWatchlist,
+		PublishWatchlistEndpoint:     publishWatchlist,
+		UnpublishWatchlistEndpoint:   unpublishWatchlist,
+		GetPublicWatchlistEndpoint:   getPublicWatchlist,
 	}
 }
 
 // List calls the "list" endpoint of the "watchlist" service.
-func (c *Client) List(ctx context.Context, p *ListPayload) (res []*TickerItem, err error) {
+// List may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) List(ctx context.Context, p *ListPayload) (res *TickerItemsResult, err error) {
 	var ires any
 	ires, err = c.ListEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
-	return ires.([]*TickerItem), nil
+	return ires.(*TickerItemsResult), nil
 }
 
 // Add calls the "add" endpoint of the "watchlist" service.
+// Add may return the following errors:
+//   - "invalid_symbol" (type *goa.ServiceError): Malformed symbol, unknown exchange, or symbol missing from or ambiguous in the instrument master
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
 func (c *Client) Add(ctx context.Context, p *AddPayload) (res *TickerItem, err error) {
 	var ires any
 	ires, err = c.AddEndpoint(ctx, p)
@@ -49,8 +113,420 @@ func (c *Client) Add(ctx context.Context, p *AddPayload) (res *TickerItem, err e
 	return ires.(*TickerItem), nil
 }
 
+// Update calls the "update" endpoint of the "watchlist" service.
+// Update may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) Update(ctx context.Context, p *UpdatePayload) (res *TickerItem, err error) {
+	var ires any
+	ires, err = c.UpdateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TickerItem), nil
+}
+
 // Remove calls the "remove" endpoint of the "watchlist" service.
+// Remove may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
 func (c *Client) Remove(ctx context.Context, p *RemovePayload) (err error) {
 	_, err = c.RemoveEndpoint(ctx, p)
 	return
 }
+
+// ListRemoved calls the "list_removed" endpoint of the "watchlist" service.
+// ListRemoved may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) ListRemoved(ctx context.Context, p *ListRemovedPayload) (res []*RemovedTickerItem, err error) {
+	var ires any
+	ires, err = c.ListRemovedEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*RemovedTickerItem), nil
+}
+
+// Restore calls the "restore" endpoint of the "watchlist" service.
+// Restore may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) Restore(ctx context.Context, p *RestorePayload) (res *TickerItem, err error) {
+	var ires any
+	ires, err = c.RestoreEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TickerItem), nil
+}
+
+// Events calls the "events" endpoint of the "watchlist" service.
+// Events may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) Events(ctx context.Context, p *EventsPayload) (res EventsClientStream, err error) {
+	var ires any
+	ires, err = c.EventsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(EventsClientStream), nil
+}
+
+// BulkAdd calls the "bulk_add" endpoint of the "watchlist" service.
+// BulkAdd may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) BulkAdd(ctx context.Context, p *BulkAddPayload) (res *WatchlistBulkResult, err error) {
+	var ires any
+	ires, err = c.BulkAddEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WatchlistBulkResult), nil
+}
+
+// BulkRemove calls the "bulk_remove" endpoint of the "watchlist" service.
+// BulkRemove may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) BulkRemove(ctx context.Context, p *BulkRemovePayload) (res *WatchlistBulkResult, err error) {
+	var ires any
+	ires, err = c.BulkRemoveEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WatchlistBulkResult), nil
+}
+
+// Import calls the "import" endpoint of the "watchlist" service.
+// Import may return the following errors:
+//   - "invalid_file" (type *goa.ServiceError): Unreadable file, e.g. malformed JSON or a CSV file without a symbol column
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) Import(ctx context.Context, p *ImportPayload, req io.ReadCloser) (res *WatchlistImportReport, err error) {
+	var ires any
+	ires, err = c.ImportEndpoint(ctx, &ImportRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.(*WatchlistImportReport), nil
+}
+
+// Export calls the "export" endpoint of the "watchlist" service.
+// Export may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) Export(ctx context.Context, p *ExportPayload) (res *ExportResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.ExportEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*ExportResponseData)
+	return o.Result, o.Body, nil
+}
+
+// ListWatchlists calls the "list_watchlists" endpoint of the "watchlist"
+// service.
+// ListWatchlists may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) ListWatchlists(ctx context.Context, p *ListWatchlistsPayload) (res []*Watchlist, err error) {
+	var ires any
+	ires, err = c.ListWatchlistsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Watchlist), nil
+}
+
+// ListSharedWatchlists calls the "list_shared_watchlists" endpoint of the
+// "watchlist" service.
+// ListSharedWatchlists may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) ListSharedWatchlists(ctx context.Context, p *ListSharedWatchlistsPayload) (res []*Watchlist, err error) {
+	var ires any
+	ires, err = c.ListSharedWatchlistsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Watchlist), nil
+}
+
+// CreateWatchlist calls the "create_watchlist" endpoint of the "watchlist"
+// service.
+// CreateWatchlist may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) CreateWatchlist(ctx context.Context, p *CreateWatchlistPayload) (res *Watchlist, err error) {
+	var ires any
+	ires, err = c.CreateWatchlistEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Watchlist), nil
+}
+
+// GetWatchlist calls the "get_watchlist" endpoint of the "watchlist" service.
+// GetWatchlist may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) GetWatchlist(ctx context.Context, p *GetWatchlistPayload) (res *Watchlist, err error) {
+	var ires any
+	ires, err = c.GetWatchlistEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Watchlist), nil
+}
+
+// UpdateWatchlist calls the "update_watchlist" endpoint of the "watchlist"
+// service.
+// UpdateWatchlist may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) UpdateWatchlist(ctx context.Context, p *UpdateWatchlistPayload) (res *Watchlist, err error) {
+	var ires any
+	ires, err = c.UpdateWatchlistEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Watchlist), nil
+}
+
+// DeleteWatchlist calls the "delete_watchlist" endpoint of the "watchlist"
+// service.
+// DeleteWatchlist may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) DeleteWatchlist(ctx context.Context, p *DeleteWatchlistPayload) (err error) {
+	_, err = c.DeleteWatchlistEndpoint(ctx, p)
+	return
+}
+
+// ListItems calls the "list_items" endpoint of the "watchlist" service.
+// ListItems may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) ListItems(ctx context.Context, p *ListItemsPayload) (res *TickerItemsResult, err error) {
+	var ires any
+	ires, err = c.ListItemsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TickerItemsResult), nil
+}
+
+// AddItem calls the "add_item" endpoint of the "watchlist" service.
+// AddItem may return the following errors:
+//   - "invalid_symbol" (type *goa.ServiceError): Malformed symbol, unknown exchange, or symbol missing from or ambiguous in the instrument master
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) AddItem(ctx context.Context, p *AddItemPayload) (res *TickerItem, err error) {
+	var ires any
+	ires, err = c.AddItemEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TickerItem), nil
+}
+
+// UpdateItem calls the "update_item" endpoint of the "watchlist" service.
+// UpdateItem may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) UpdateItem(ctx context.Context, p *UpdateItemPayload) (res *TickerItem, err error) {
+	var ires any
+	ires, err = c.UpdateItemEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TickerItem), nil
+}
+
+// RemoveItem calls the "remove_item" endpoint of the "watchlist" service.
+// RemoveItem may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) RemoveItem(ctx context.Context, p *RemoveItemPayload) (err error) {
+	_, err = c.RemoveItemEndpoint(ctx, p)
+	return
+}
+
+// ListShares calls the "list_shares" endpoint of the "watchlist" service.
+// ListShares may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) ListShares(ctx context.Context, p *ListSharesPayload) (res []*WatchlistShare, err error) {
+	var ires any
+	ires, err = c.ListSharesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*WatchlistShare), nil
+}
+
+// ShareWatchlist calls the "share_watchlist" endpoint of the "watchlist"
+// service.
+// ShareWatchlist may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) ShareWatchlist(ctx context.Context, p *ShareWatchlistPayload) (res *WatchlistShare, err error) {
+	var ires any
+	ires, err = c.ShareWatchlistEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WatchlistShare), nil
+}
+
+// UnshareWatchlist calls the "unshare_watchlist" endpoint of the "watchlist"
+// service.
+// UnshareWatchlist may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) UnshareWatchlist(ctx context.Context, p *UnshareWatchlistPayload) (err error) {
+	_, err = c.UnshareWatchlistEndpoint(ctx, p)
+	return
+}
+
+// PublishWatchlist calls the "publish_watchlist" endpoint of the "watchlist"
+// service.
+// PublishWatchlist may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) PublishWatchlist(ctx context.Context, p *PublishWatchlistPayload) (res *Watchlist, err error) {
+	var ires any
+	ires, err = c.PublishWatchlistEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Watchlist), nil
+}
+
+// UnpublishWatchlist calls the "unpublish_watchlist" endpoint of the
+// "watchlist" service.
+// UnpublishWatchlist may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) UnpublishWatchlist(ctx context.Context, p *UnpublishWatchlistPayload) (err error) {
+	_, err = c.UnpublishWatchlistEndpoint(ctx, p)
+	return
+}
+
+// GetPublicWatchlist calls the "get_public_watchlist" endpoint of the
+// "watchlist" service.
+// GetPublicWatchlist may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): Missing or invalid bearer token
+//   - "not_found" (type *goa.ServiceError): Watchlist or watchlist item not found, or watchlist not shared with the user
+//   - "forbidden" (type *goa.ServiceError): Watchlist shared with the user with a role that does not allow the change
+//   - "conflict" (type *goa.ServiceError): Watchlist name already taken, the default watchlist deleted, or a watchlist shared with its owner
+//   - "precondition_failed" (type *goa.ServiceError): If-Match names no current version of the watchlist
+//   - error: internal error
+func (c *Client) GetPublicWatchlist(ctx context.Context, p *GetPublicWatchlistPayload) (res *PublicWatchlist, err error) {
+	var ires any
+	ires, err = c.GetPublicWatchlistEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PublicWatchlist), nil
+}
@@ -1,31 +1,110 @@
-// Code generated by goa v3.14.2, DO NOT EDIT.
+// Code generated by goa v3.23.4, DO NOT EDIT.
 //
 // watchlist endpoints
 //
 // Command:
-// $ goa gen github.com/reidlai/ta-workspace/modules/watchlist/go/design
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
 
 package watchlist
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 )
 
 // Endpoints wraps the "watchlist" service endpoints.
 type Endpoints struct {
-	List   goa.Endpoint
-	Add    goa.Endpoint
-	Remove goa.Endpoint
+	List                 goa.Endpoint
+	Add                  goa.Endpoint
+	Update               goa.Endpoint
+	Remove               goa.Endpoint
+	ListRemoved          goa.Endpoint
+	Restore              goa.Endpoint
+	Events               goa.Endpoint
+	BulkAdd              goa.Endpoint
+	BulkRemove           goa.Endpoint
+	Import               goa.Endpoint
+	Export               goa.Endpoint
+	ListWatchlists       goa.Endpoint
+	ListSharedWatchlists goa.Endpoint
+	CreateWatchlist      goa.Endpoint
+	GetWatchlist         goa.Endpoint
+	UpdateWatchlist      goa.Endpoint
+	DeleteWatchlist      goa.Endpoint
+	ListItems            goa.Endpoint
+	AddItem              goa.Endpoint
+	UpdateItem           goa.Endpoint
+	RemoveItem           goa.Endpoint
+	ListShares           goa.Endpoint
+	ShareWatchlist       goa.Endpoint
+	UnshareWatchlist     goa.Endpoint
+	PublishWatchlist     goa.Endpoint
+	UnpublishWatchlist   goa.Endpoint
+	GetPublicWatchlist   goa.Endpoint
+}
+
+// EventsEndpointInput holds both the payload and the server stream of the
+// "events" method.
+type EventsEndpointInput struct {
+	// Payload is the method payload.
+	Payload *EventsPayload
+	// Stream is the server stream used by the "events" method to send data.
+	Stream EventsServerStream
+}
+
+// ImportRequestData holds both the payload and the HTTP request body reader of
+// the "import" method.
+type ImportRequestData struct {
+	// Payload is the method payload.
+	Payload *ImportPayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
+}
+
+// ExportResponseData holds both the result and the HTTP response body reader
+// of the "export" method.
+type ExportResponseData struct {
+	// Result is the method result.
+	Result *ExportResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
 }
 
 // NewEndpoints wraps the methods of the "watchlist" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
 	return &Endpoints{
-		List:   NewListEndpoint(s),
-		Add:    NewAddEndpoint(s),
-		Remove: NewRemoveEndpoint(s),
+		List:                 NewListEndpoint(s, a.JWTAuth),
+		Add:                  NewAddEndpoint(s, a.JWTAuth),
+		Update:               NewUpdateEndpoint(s, a.JWTAuth),
+		Remove:               NewRemoveEndpoint(s, a.JWTAuth),
+		ListRemoved:          NewListRemovedEndpoint(s, a.JWTAuth),
+		Restore:              NewRestoreEndpoint(s, a.JWTAuth),
+		Events:               NewEventsEndpoint(s, a.JWTAuth),
+		BulkAdd:              NewBulkAddEndpoint(s, a.JWTAuth),
+		BulkRemove:           NewBulkRemoveEndpoint(s, a.JWTAuth),
+		Import:               NewImportEndpoint(s, a.JWTAuth),
+		Export:               NewExportEndpoint(s, a.JWTAuth),
+		ListWatchlists:       NewListWatchlistsEndpoint(s, a.JWTAuth),
+		ListSharedWatchlists: NewListSharedWatchlistsEndpoint(s, a.JWTAuth),
+		CreateWatchlist:      NewCreateWatchlistEndpoint(s, a.JWTAuth),
+		GetWatchlist:         NewGetWatchlistEndpoint(s, a.JWTAuth),
+		UpdateWatchlist:      NewUpdateWatchlistEndpoint(s, a.JWTAuth),
+		DeleteWatchlist:      NewDeleteWatchlistEndpoint(s, a.JWTAuth),
+		ListItems:            NewListItemsEndpoint(s, a.JWTAuth),
+		AddItem:              NewAddItemEndpoint(s, a.JWTAuth),
+		UpdateItem:           NewUpdateItemEndpoint(s, a.JWTAuth),
+		RemoveItem:           NewRemoveItemEndpoint(s, a.JWTAuth),
+		ListShares:           NewListSharesEndpoint(s, a.JWTAuth),
+		ShareWatchlist:       NewShareWatchlistEndpoint(s, a.JWTAuth),
+		UnshareWatchlist:     NewUnshareWatchlistEndpoint(s, a.JWTAuth),
+		PublishWatchlist:     NewPublishWatchlistEndpoint(s, a.JWTAuth),
+		UnpublishWatchlist:   NewUnpublishWatchlistEndpoint(s, a.JWTAuth),
+		GetPublicWatchlist:   NewGetPublicWatchlistEndpoint(s),
 	}
 }
 
@@ -33,32 +112,640 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.List = m(e.List)
 	e.Add = m(e.Add)
+	e.Update = m(e.Update)
 	e.Remove = m(e.Remove)
+	e.ListRemoved = m(e.ListRemoved)
+	e.Restore = m(e.Restore)
+	e.Events = m(e.Events)
+	e.BulkAdd = m(e.BulkAdd)
+	e.BulkRemove = m(e.BulkRemove)
+	e.Import = m(e.Import)
+	e.Export = m(e.Export)
+	e.ListWatchlists = m(e.ListWatchlists)
+	e.ListSharedWatchlists = m(e.ListSharedWatchlists)
+	e.CreateWatchlist = m(e.CreateWatchlist)
+	e.GetWatchlist = m(e.GetWatchlist)
+	e.UpdateWatchlist = m(e.UpdateWatchlist)
+	e.DeleteWatchlist = m(e.DeleteWatchlist)
+	e.ListItems = m(e.ListItems)
+	e.AddItem = m(e.AddItem)
+	e.UpdateItem = m(e.UpdateItem)
+	e.RemoveItem = m(e.RemoveItem)
+	e.ListShares = m(e.ListShares)
+	e.ShareWatchlist = m(e.ShareWatchlist)
+	e.UnshareWatchlist = m(e.UnshareWatchlist)
+	e.PublishWatchlist = m(e.PublishWatchlist)
+	e.UnpublishWatchlist = m(e.UnpublishWatchlist)
+	e.GetPublicWatchlist = m(e.GetPublicWatchlist)
 }
 
 // NewListEndpoint returns an endpoint function that calls the method "list" of
 // service "watchlist".
-func NewListEndpoint(s Service) goa.Endpoint {
+func NewListEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*ListPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		return s.List(ctx, p)
 	}
 }
 
 // NewAddEndpoint returns an endpoint function that calls the method "add" of
 // service "watchlist".
-func NewAddEndpoint(s Service) goa.Endpoint {
+func NewAddEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*AddPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		return s.Add(ctx, p)
 	}
 }
 
+// NewUpdateEndpoint returns an endpoint function that calls the method
+// "update" of service "watchlist".
+func NewUpdateEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdatePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Update(ctx, p)
+	}
+}
+
 // NewRemoveEndpoint returns an endpoint function that calls the method
 // "remove" of service "watchlist".
-func NewRemoveEndpoint(s Service) goa.Endpoint {
+func NewRemoveEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*RemovePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		return nil, s.Remove(ctx, p)
 	}
 }
+
+// NewListRemovedEndpoint returns an endpoint function that calls the method
+// "list_removed" of service "watchlist".
+func NewListRemovedEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListRemovedPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListRemoved(ctx, p)
+	}
+}
+
+// NewRestoreEndpoint returns an endpoint function that calls the method
+// "restore" of service "watchlist".
+func NewRestoreEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RestorePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Restore(ctx, p)
+	}
+}
+
+// NewEventsEndpoint returns an endpoint function that calls the method
+// "events" of service "watchlist".
+func NewEventsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*EventsEndpointInput)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if ep.Payload.Token != nil {
+			token = *ep.Payload.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.Events(ctx, ep.Payload, ep.Stream)
+	}
+}
+
+// NewBulkAddEndpoint returns an endpoint function that calls the method
+// "bulk_add" of service "watchlist".
+func NewBulkAddEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BulkAddPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.BulkAdd(ctx, p)
+	}
+}
+
+// NewBulkRemoveEndpoint returns an endpoint function that calls the method
+// "bulk_remove" of service "watchlist".
+func NewBulkRemoveEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BulkRemovePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.BulkRemove(ctx, p)
+	}
+}
+
+// NewImportEndpoint returns an endpoint function that calls the method
+// "import" of service "watchlist".
+func NewImportEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*ImportRequestData)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if ep.Payload.Token != nil {
+			token = *ep.Payload.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Import(ctx, ep.Payload, ep.Body)
+	}
+}
+
+// NewExportEndpoint returns an endpoint function that calls the method
+// "export" of service "watchlist".
+func NewExportEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, body, err := s.Export(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &ExportResponseData{Result: res, Body: body}, nil
+	}
+}
+
+// NewListWatchlistsEndpoint returns an endpoint function that calls the method
+// "list_watchlists" of service "watchlist".
+func NewListWatchlistsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListWatchlistsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListWatchlists(ctx, p)
+	}
+}
+
+// NewListSharedWatchlistsEndpoint returns an endpoint function that calls the
+// method "list_shared_watchlists" of service "watchlist".
+func NewListSharedWatchlistsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListSharedWatchlistsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListSharedWatchlists(ctx, p)
+	}
+}
+
+// NewCreateWatchlistEndpoint returns an endpoint function that calls the
+// method "create_watchlist" of service "watchlist".
+func NewCreateWatchlistEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateWatchlistPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateWatchlist(ctx, p)
+	}
+}
+
+// NewGetWatchlistEndpoint returns an endpoint function that calls the method
+// "get_watchlist" of service "watchlist".
+func NewGetWatchlistEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetWatchlistPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetWatchlist(ctx, p)
+	}
+}
+
+// NewUpdateWatchlistEndpoint returns an endpoint function that calls the
+// method "update_watchlist" of service "watchlist".
+func NewUpdateWatchlistEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateWatchlistPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateWatchlist(ctx, p)
+	}
+}
+
+// NewDeleteWatchlistEndpoint returns an endpoint function that calls the
+// method "delete_watchlist" of service "watchlist".
+func NewDeleteWatchlistEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteWatchlistPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.DeleteWatchlist(ctx, p)
+	}
+}
+
+// NewListItemsEndpoint returns an endpoint function that calls the method
+// "list_items" of service "watchlist".
+func NewListItemsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListItemsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListItems(ctx, p)
+	}
+}
+
+// NewAddItemEndpoint returns an endpoint function that calls the method
+// "add_item" of service "watchlist".
+func NewAddItemEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AddItemPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.AddItem(ctx, p)
+	}
+}
+
+// NewUpdateItemEndpoint returns an endpoint function that calls the method
+// "update_item" of service "watchlist".
+func NewUpdateItemEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateItemPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateItem(ctx, p)
+	}
+}
+
+// NewRemoveItemEndpoint returns an endpoint function that calls the method
+// "remove_item" of service "watchlist".
+func NewRemoveItemEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RemoveItemPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RemoveItem(ctx, p)
+	}
+}
+
+// NewListSharesEndpoint returns an endpoint function that calls the method
+// "list_shares" of service "watchlist".
+func NewListSharesEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListSharesPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListShares(ctx, p)
+	}
+}
+
+// NewShareWatchlistEndpoint returns an endpoint function that calls the method
+// "share_watchlist" of service "watchlist".
+func NewShareWatchlistEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ShareWatchlistPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ShareWatchlist(ctx, p)
+	}
+}
+
+// NewUnshareWatchlistEndpoint returns an endpoint function that calls the
+// method "unshare_watchlist" of service "watchlist".
+func NewUnshareWatchlistEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UnshareWatchlistPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.UnshareWatchlist(ctx, p)
+	}
+}
+
+// NewPublishWatchlistEndpoint returns an endpoint function that calls the
+// method "publish_watchlist" of service "watchlist".
+func NewPublishWatchlistEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PublishWatchlistPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.PublishWatchlist(ctx, p)
+	}
+}
+
+// NewUnpublishWatchlistEndpoint returns an endpoint function that calls the
+// method "unpublish_watchlist" of service "watchlist".
+func NewUnpublishWatchlistEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UnpublishWatchlistPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.UnpublishWatchlist(ctx, p)
+	}
+}
+
+// NewGetPublicWatchlistEndpoint returns an endpoint function that calls the
+// method "get_public_watchlist" of service "watchlist".
+func NewGetPublicWatchlistEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetPublicWatchlistPayload)
+		return s.GetPublicWatchlist(ctx, p)
+	}
+}
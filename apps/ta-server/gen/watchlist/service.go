@@ -3,26 +3,117 @@
 // watchlist service
 //
 // Command:
-// $ goa gen github.com/reidlai/ta-workspace/modules/watchlist/go/design
+// $ goa gen github.com/reidlai/ta-workspace/apps/ta-server/design
 
 package watchlist
 
 import (
 	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 )
 
-// Manage user watchlist
+// Manage user watchlists. Each user has a default watchlist, served by the
+// /watchlist routes, and may create further named watchlists, share them with
+// other users and publish them through a public link.
 type Service interface {
-	// List implements list.
-	List(context.Context, *ListPayload) (res []*TickerItem, err error)
-	// Add implements add.
+	// List the items of the default watchlist. The ETag header identifies the
+	// version of the watchlist; an If-None-Match header naming it returns 304
+	// without items.
+	List(context.Context, *ListPayload) (res *TickerItemsResult, err error)
+	// Add a ticker to the default watchlist, after its other items. The symbol is
+	// upper-cased and may name its listing exchange with a MIC suffix, as in
+	// VOD.XLON. Re-adding a ticker updates the given attributes and keeps the
+	// others.
 	Add(context.Context, *AddPayload) (res *TickerItem, err error)
-	// Remove implements remove.
+	// Update a ticker of the default watchlist in place; omitted attributes are
+	// unchanged
+	Update(context.Context, *UpdatePayload) (res *TickerItem, err error)
+	// Remove a ticker from the default watchlist. It is listed by list_removed and
+	// can be restored until it is purged at the end of the retention window.
 	Remove(context.Context, *RemovePayload) (err error)
+	// List the tickers removed from a watchlist that can still be restored, most
+	// recently removed first
+	ListRemoved(context.Context, *ListRemovedPayload) (res []*RemovedTickerItem, err error)
+	// Put a removed ticker back into a watchlist at the position it had, shifting
+	// the items after it
+	Restore(context.Context, *RestorePayload) (res *TickerItem, err error)
+	// Stream the changes of the items of the user's watchlists, or of one
+	// watchlist, possibly shared with them, as Server-Sent Events. A client
+	// reconnecting with the Last-Event-ID header receives the events it missed, or
+	// a reset event when they are no longer retained. Clients that cannot send the
+	// Authorization header, such as browser EventSource, may pass a bearer token
+	// expiring within 5 minutes as the access_token query parameter instead.
+	Events(context.Context, *EventsPayload, EventsServerStream) (err error)
+	// Add or update up to 100 tickers of a watchlist in one transaction, each as
+	// add does
+	BulkAdd(context.Context, *BulkAddPayload) (res *WatchlistBulkResult, err error)
+	// Remove up to 100 tickers from a watchlist in one transaction. Unlike remove,
+	// a ticker that is not in the watchlist is rejected.
+	BulkRemove(context.Context, *BulkRemovePayload) (res *WatchlistBulkResult, err error)
+	// Add or update the tickers of a CSV or JSON file in a watchlist, as add does.
+	// Nothing is stored unless every row is valid.
+	Import(context.Context, *ImportPayload, io.ReadCloser) (res *WatchlistImportReport, err error)
+	// Download the items of a watchlist as a CSV or JSON file that import accepts
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	Export(context.Context, *ExportPayload) (res *ExportResult, body io.ReadCloser, err error)
+	// List the user's watchlists in their order, starting with the default
+	// watchlist when it has not been moved
+	ListWatchlists(context.Context, *ListWatchlistsPayload) (res []*Watchlist, err error)
+	// List the watchlists of other users shared with the user, by name. They are
+	// read and changed through the /watchlists/{id} routes as their role allows.
+	ListSharedWatchlists(context.Context, *ListSharedWatchlistsPayload) (res []*Watchlist, err error)
+	// Create an empty watchlist after the user's other watchlists
+	CreateWatchlist(context.Context, *CreateWatchlistPayload) (res *Watchlist, err error)
+	// GetWatchlist implements get_watchlist.
+	GetWatchlist(context.Context, *GetWatchlistPayload) (res *Watchlist, err error)
+	// Rename a watchlist or move it to another position; the other watchlists
+	// shift to make room
+	UpdateWatchlist(context.Context, *UpdateWatchlistPayload) (res *Watchlist, err error)
+	// Delete a watchlist and its items, ending its shares and public link. The
+	// default watchlist cannot be deleted.
+	DeleteWatchlist(context.Context, *DeleteWatchlistPayload) (err error)
+	// List the items of a watchlist, with its ETag as list does
+	ListItems(context.Context, *ListItemsPayload) (res *TickerItemsResult, err error)
+	// Add a ticker to a watchlist, normalized as by add
+	AddItem(context.Context, *AddItemPayload) (res *TickerItem, err error)
+	// Update a ticker of a watchlist in place, as update does
+	UpdateItem(context.Context, *UpdateItemPayload) (res *TickerItem, err error)
+	// Remove a ticker from a watchlist
+	RemoveItem(context.Context, *RemoveItemPayload) (err error)
+	// List the users a watchlist is shared with, by user ID. Only its owner may
+	// list them.
+	ListShares(context.Context, *ListSharesPayload) (res []*WatchlistShare, err error)
+	// Share a watchlist with another user, or change the role it is shared with
+	// them with. Only its owner may share it.
+	ShareWatchlist(context.Context, *ShareWatchlistPayload) (res *WatchlistShare, err error)
+	// Stop sharing a watchlist with a user. Its owner may remove any share; other
+	// users only their own, to leave the watchlist.
+	UnshareWatchlist(context.Context, *UnshareWatchlistPayload) (err error)
+	// Publish a watchlist through a public link that anyone knowing it can read
+	// without authentication. Publishing a published watchlist keeps its link;
+	// unpublish it first to replace the link.
+	PublishWatchlist(context.Context, *PublishWatchlistPayload) (res *Watchlist, err error)
+	// Disable the public link of a watchlist
+	UnpublishWatchlist(context.Context, *UnpublishWatchlistPayload) (err error)
+	// Read a published watchlist through its public link. Served without
+	// authentication.
+	GetPublicWatchlist(context.Context, *GetPublicWatchlistPayload) (res *PublicWatchlist, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// JWTAuth implements the authorization logic for the JWT security scheme.
+	JWTAuth(ctx context.Context, token string, schema *security.JWTScheme) (context.Context, error)
 }
 
 // APIName is the name of the API as defined in the design.
-const APIName = "watchlist"
+const APIName = "ta-server"
 
 // APIVersion is the version of the API as defined in the design.
 const APIVersion = "0.0.1"
@@ -35,27 +126,382 @@ const ServiceName = "watchlist"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [3]string{"list", "add", "remove"}
+var MethodNames = [27]string{"list", "add", "update", "remove", "list_removed", "restore", "events", "bulk_add", "bulk_remove", "import", "export", "list_watchlists", "list_shared_watchlists", "create_watchlist", "get_watchlist", "update_watchlist", "delete_watchlist", "list_items", "add_item", "update_item", "remove_item", "list_shares", "share_watchlist", "unshare_watchlist", "publish_watchlist", "unpublish_watchlist", "get_public_watchlist"}
+
+// EventsServerStream allows streaming instances of *WatchlistEvent to the
+// client.
+type EventsServerStream interface {
+	// Send streams instances of "WatchlistEvent".
+	Send(*WatchlistEvent) error
+	// SendWithContext streams instances of "WatchlistEvent" with context.
+	SendWithContext(context.Context, *WatchlistEvent) error
+	// Close closes the stream.
+	Close() error
+}
+
+// EventsClientStream allows streaming instances of *WatchlistEvent to the
+// client.
+type EventsClientStream interface {
+	// Recv reads instances of "WatchlistEvent" from the stream.
+	Recv() (*WatchlistEvent, error)
+	// RecvWithContext reads instances of "WatchlistEvent" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*WatchlistEvent, error)
+}
+
+// AddItemPayload is the payload type of the watchlist service add_item method.
+type AddItemPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, or "default" for the default watchlist
+	ID string
+	// Ticker symbol, optionally suffixed with the MIC of its listing exchange
+	Symbol string
+	OnHand bool
+	// Free-text note; empty clears it
+	Note *string
+	// Free-form tags, replacing the current ones; empty clears them
+	Tags []string
+	// Target buy price; 0 clears it
+	BuyTarget *float64
+	// Target sell price; 0 clears it
+	SellTarget *float64
+	// ETags of the watchlist versions the change is based on, or *; an outdated
+	// version returns 412
+	IfMatch *string
+}
 
 // AddPayload is the payload type of the watchlist service add method.
 type AddPayload struct {
-	// User ID
-	UserID string
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Ticker symbol, optionally suffixed with the MIC of its listing exchange
 	Symbol string
 	OnHand bool
+	// Free-text note; empty clears it
+	Note *string
+	// Free-form tags, replacing the current ones; empty clears them
+	Tags []string
+	// Target buy price; 0 clears it
+	BuyTarget *float64
+	// Target sell price; 0 clears it
+	SellTarget *float64
+	// ETags of the watchlist versions the change is based on, or *; an outdated
+	// version returns 412
+	IfMatch *string
+}
+
+// Ticker of a bulk add
+type BulkAddItem struct {
+	// Ticker symbol, optionally suffixed with the MIC of its listing exchange
+	Symbol string
+	// Whether user holds the stock; unchanged when omitted, false for new items
+	OnHand *bool
+	// Free-text note; empty clears it
+	Note *string
+	// Free-form tags, replacing the current ones; empty clears them
+	Tags []string
+	// Target buy price; 0 clears it
+	BuyTarget *float64
+	// Target sell price; 0 clears it
+	SellTarget *float64
+}
+
+// BulkAddPayload is the payload type of the watchlist service bulk_add method.
+type BulkAddPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, the default watchlist when omitted
+	Watchlist *string
+	// all_or_nothing stores no entry unless every one is valid; best_effort stores
+	// the valid ones
+	Mode string
+	// Tickers to add
+	Items []*BulkAddItem
+	// ETags of the watchlist versions the change is based on, or *; an outdated
+	// version returns 412
+	IfMatch *string
+}
+
+type BulkItemResult struct {
+	// Symbol as given in the request
+	Symbol string
+	// added, updated or removed when stored; invalid or not_found when rejected;
+	// skipped when valid but not stored because another entry was rejected in
+	// all_or_nothing mode
+	Status string
+	// Why the entry was rejected
+	Error *string
+	// Stored item of an added or updated entry
+	Item *TickerItem
+}
+
+// BulkRemovePayload is the payload type of the watchlist service bulk_remove
+// method.
+type BulkRemovePayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, the default watchlist when omitted
+	Watchlist *string
+	// all_or_nothing stores no entry unless every one is valid; best_effort stores
+	// the valid ones
+	Mode string
+	// Tickers to remove
+	Symbols []string
+	// ETags of the watchlist versions the change is based on, or *; an outdated
+	// version returns 412
+	IfMatch *string
+}
+
+// CreateWatchlistPayload is the payload type of the watchlist service
+// create_watchlist method.
+type CreateWatchlistPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist name, unique per user regardless of case
+	Name string
+}
+
+// DeleteWatchlistPayload is the payload type of the watchlist service
+// delete_watchlist method.
+type DeleteWatchlistPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, or "default" for the default watchlist
+	ID string
+	// ETags of the watchlist versions the change is based on, or *; an outdated
+	// version returns 412
+	IfMatch *string
+}
+
+// EventsPayload is the payload type of the watchlist service events method.
+type EventsPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, all of the user's watchlists when omitted
+	Watchlist *string
+	// ID of the last event received
+	LastEventID *string
+}
+
+// ExportPayload is the payload type of the watchlist service export method.
+type ExportPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, the default watchlist when omitted
+	Watchlist *string
+	// File format
+	Format string
+}
+
+// ExportResult is the result type of the watchlist service export method.
+type ExportResult struct {
+	ContentType        string
+	ContentDisposition string
+}
+
+// GetPublicWatchlistPayload is the payload type of the watchlist service
+// get_public_watchlist method.
+type GetPublicWatchlistPayload struct {
+	// Token of the public link
+	Token string
+}
+
+// GetWatchlistPayload is the payload type of the watchlist service
+// get_watchlist method.
+type GetWatchlistPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, or "default" for the default watchlist
+	ID string
+}
+
+// ImportPayload is the payload type of the watchlist service import method.
+type ImportPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, the default watchlist when omitted
+	Watchlist *string
+	// File format, detected from the content when omitted
+	Format *string
+	// Validate the file and report the changes without storing them
+	DryRun bool
+	// ETags of the watchlist versions the change is based on, or *; an outdated
+	// version returns 412
+	IfMatch *string
+}
+
+type ImportRowError struct {
+	// Line of the row in the file
+	Line int
+	// Why the row is invalid
+	Error string
+}
+
+// ListItemsPayload is the payload type of the watchlist service list_items
+// method.
+type ListItemsPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, or "default" for the default watchlist
+	ID string
+	// ETags of watchlist versions the client has, or *
+	IfNoneMatch *string
 }
 
 // ListPayload is the payload type of the watchlist service list method.
 type ListPayload struct {
-	// User ID
-	UserID string
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// ETags of watchlist versions the client has, or *
+	IfNoneMatch *string
+}
+
+// ListRemovedPayload is the payload type of the watchlist service list_removed
+// method.
+type ListRemovedPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, the default watchlist when omitted
+	Watchlist *string
+}
+
+// ListSharedWatchlistsPayload is the payload type of the watchlist service
+// list_shared_watchlists method.
+type ListSharedWatchlistsPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+}
+
+// ListSharesPayload is the payload type of the watchlist service list_shares
+// method.
+type ListSharesPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, or "default" for the default watchlist
+	ID string
+}
+
+// ListWatchlistsPayload is the payload type of the watchlist service
+// list_watchlists method.
+type ListWatchlistsPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+}
+
+// Ticker of a public watchlist; whether the owner holds it is not disclosed
+type PublicTickerItem struct {
+	// Stock Symbol
+	Symbol string
+	// Operating MIC of the listing exchange, when known
+	ExchangeMic *string
+	// ID of the instrument in the instrument master, when listed there
+	InstrumentID *string
+	// Free-text note
+	Note *string
+	// Free-form tags
+	Tags []string
+	// Target buy price, when set
+	BuyTarget *float64
+	// Target sell price, when set
+	SellTarget *float64
+	// Zero-based position in the watchlist
+	Position int
+}
+
+// PublicWatchlist is the result type of the watchlist service
+// get_public_watchlist method.
+type PublicWatchlist struct {
+	// Watchlist name
+	Name  string
+	Items []*PublicTickerItem
+}
+
+// PublishWatchlistPayload is the payload type of the watchlist service
+// publish_watchlist method.
+type PublishWatchlistPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, or "default" for the default watchlist
+	ID string
+}
+
+// RemoveItemPayload is the payload type of the watchlist service remove_item
+// method.
+type RemoveItemPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, or "default" for the default watchlist
+	ID     string
+	Symbol string
+	// ETags of the watchlist versions the change is based on, or *; an outdated
+	// version returns 412
+	IfMatch *string
 }
 
 // RemovePayload is the payload type of the watchlist service remove method.
 type RemovePayload struct {
-	// User ID
-	UserID string
+	// JWT bearer token, whose subject is the ID of the user
+	Token  *string
+	Symbol string
+	// ETags of the watchlist versions the change is based on, or *; an outdated
+	// version returns 412
+	IfMatch *string
+}
+
+// Ticker removed from a watchlist, which can be restored until it is purged
+type RemovedTickerItem struct {
+	// Removal timestamp
+	RemovedAt string
+	// Time after which the ticker is purged and can no longer be restored
+	PurgeAt string
+	// Stock Symbol
+	Symbol string
+	// Whether user holds the stock
+	OnHand bool
+	// Creation timestamp
+	CreatedAt *string
+	// Last update timestamp
+	UpdatedAt *string
+	// Operating MIC of the listing exchange, when known
+	ExchangeMic *string
+	// ID of the instrument in the instrument master, when listed there
+	InstrumentID *string
+	// Free-text note
+	Note *string
+	// Free-form tags
+	Tags []string
+	// Target buy price, when set
+	BuyTarget *float64
+	// Target sell price, when set
+	SellTarget *float64
+	// Zero-based position in the watchlist
+	Position int
+}
+
+// RestorePayload is the payload type of the watchlist service restore method.
+type RestorePayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token  *string
 	Symbol string
+	// Watchlist ID, the default watchlist when omitted
+	Watchlist *string
+	// ETags of the watchlist versions the change is based on, or *; an outdated
+	// version returns 412
+	IfMatch *string
+}
+
+// ShareWatchlistPayload is the payload type of the watchlist service
+// share_watchlist method.
+type ShareWatchlistPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, or "default" for the default watchlist
+	ID string
+	// User ID the watchlist is shared with
+	User string
+	// editor changes the items of the watchlist; viewer only reads them
+	Role string
 }
 
 // TickerItem is the result type of the watchlist service add method.
@@ -66,4 +512,239 @@ type TickerItem struct {
 	OnHand bool
 	// Creation timestamp
 	CreatedAt *string
+	// Last update timestamp
+	UpdatedAt *string
+	// Operating MIC of the listing exchange, when known
+	ExchangeMic *string
+	// ID of the instrument in the instrument master, when listed there
+	InstrumentID *string
+	// Free-text note
+	Note *string
+	// Free-form tags
+	Tags []string
+	// Target buy price, when set
+	BuyTarget *float64
+	// Target sell price, when set
+	SellTarget *float64
+	// Zero-based position in the watchlist
+	Position int
+}
+
+// TickerItemsResult is the result type of the watchlist service list method.
+type TickerItemsResult struct {
+	// Items, omitted when not modified
+	Items []*TickerItem
+	// ETag of the watchlist version
+	Etag string
+	// not_modified when If-None-Match names the current version
+	Outcome *string
+}
+
+// UnpublishWatchlistPayload is the payload type of the watchlist service
+// unpublish_watchlist method.
+type UnpublishWatchlistPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, or "default" for the default watchlist
+	ID string
+}
+
+// UnshareWatchlistPayload is the payload type of the watchlist service
+// unshare_watchlist method.
+type UnshareWatchlistPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, or "default" for the default watchlist
+	ID string
+	// User ID the watchlist is shared with
+	User string
+}
+
+// UpdateItemPayload is the payload type of the watchlist service update_item
+// method.
+type UpdateItemPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, or "default" for the default watchlist
+	ID     string
+	Symbol string
+	OnHand *bool
+	// Free-text note; empty clears it
+	Note *string
+	// Free-form tags, replacing the current ones; empty clears them
+	Tags []string
+	// Target buy price; 0 clears it
+	BuyTarget *float64
+	// Target sell price; 0 clears it
+	SellTarget *float64
+	// New zero-based position; positions past the end move the item last
+	Position *int
+	// ETags of the watchlist versions the change is based on, or *; an outdated
+	// version returns 412
+	IfMatch *string
+}
+
+// UpdatePayload is the payload type of the watchlist service update method.
+type UpdatePayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token  *string
+	Symbol string
+	OnHand *bool
+	// Free-text note; empty clears it
+	Note *string
+	// Free-form tags, replacing the current ones; empty clears them
+	Tags []string
+	// Target buy price; 0 clears it
+	BuyTarget *float64
+	// Target sell price; 0 clears it
+	SellTarget *float64
+	// New zero-based position; positions past the end move the item last
+	Position *int
+	// ETags of the watchlist versions the change is based on, or *; an outdated
+	// version returns 412
+	IfMatch *string
+}
+
+// UpdateWatchlistPayload is the payload type of the watchlist service
+// update_watchlist method.
+type UpdateWatchlistPayload struct {
+	// JWT bearer token, whose subject is the ID of the user
+	Token *string
+	// Watchlist ID, or "default" for the default watchlist
+	ID string
+	// Watchlist name, unique per user regardless of case
+	Name *string
+	// New zero-based position; positions past the end move the watchlist last
+	Position *int
+	// ETags of the watchlist versions the change is based on, or *; an outdated
+	// version returns 412
+	IfMatch *string
+}
+
+// Watchlist is the result type of the watchlist service create_watchlist
+// method.
+type Watchlist struct {
+	// Watchlist ID
+	ID string
+	// Watchlist name
+	Name string
+	// Zero-based position among the user's watchlists
+	Position int
+	// Whether this is the default watchlist, served by the /watchlist routes
+	Default bool
+	// Number of items
+	ItemCount int
+	// Creation time
+	CreatedAt string
+	// Last rename or move
+	UpdatedAt string
+	// User ID of the owner
+	Owner string
+	// Access of the user: owner of the watchlist, or the role it is shared with
+	// them with
+	Role string
+	// Token of the public link, /public/watchlists/{public_token}, when published;
+	// shown to the owner only
+	PublicToken *string
+}
+
+// WatchlistBulkResult is the result type of the watchlist service bulk_add
+// method.
+type WatchlistBulkResult struct {
+	// Mode of the request
+	Mode string
+	// Entries stored
+	Succeeded int
+	// Entries rejected
+	Failed int
+	// Result of each entry, in request order
+	Results []*BulkItemResult
+}
+
+type WatchlistChange struct {
+	// ID of the changed watchlist
+	Watchlist string
+	// Symbol of the changed item
+	Symbol string
+	// Item after the change, omitted when removed
+	Item *TickerItem
+	// Change timestamp
+	ChangedAt string
+}
+
+// WatchlistEvent is the result type of the watchlist service events method.
+type WatchlistEvent struct {
+	// Event ID, sent back in the Last-Event-ID header to resume the stream
+	ID string
+	// added, updated, removed or restored for an item; ready once the stream is
+	// open and the missed events sent; reset instead of ready when events were
+	// missed and the watchlists must be reloaded
+	Type string
+	// Change, omitted from ready and reset events
+	Data *WatchlistChange
+}
+
+// WatchlistImportReport is the result type of the watchlist service import
+// method.
+type WatchlistImportReport struct {
+	// Whether the file was only validated
+	DryRun bool
+	// Whether the items were stored; false when any row is invalid
+	Applied bool
+	// Number of data rows in the file
+	Rows int
+	// Items added, or that would be
+	Added int
+	// Items updated, or that would be
+	Updated int
+	// Items already up to date
+	Unchanged int
+	// Invalid rows
+	Errors []*ImportRowError
+}
+
+// WatchlistShare is the result type of the watchlist service share_watchlist
+// method.
+type WatchlistShare struct {
+	// User ID the watchlist is shared with
+	UserID string
+	// editor changes the items of the watchlist; viewer only reads them
+	Role string
+	// When the watchlist was first shared with the user
+	CreatedAt string
+}
+
+// MakeUnauthorized builds a goa.ServiceError from an error.
+func MakeUnauthorized(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unauthorized", false, false, false)
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "forbidden", false, false, false)
+}
+
+// MakeConflict builds a goa.ServiceError from an error.
+func MakeConflict(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "conflict", false, false, false)
+}
+
+// MakePreconditionFailed builds a goa.ServiceError from an error.
+func MakePreconditionFailed(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "precondition_failed", false, false, false)
+}
+
+// MakeInvalidSymbol builds a goa.ServiceError from an error.
+func MakeInvalidSymbol(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_symbol", false, false, false)
+}
+
+// MakeInvalidFile builds a goa.ServiceError from an error.
+func MakeInvalidFile(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_file", false, false, false)
 }
@@ -0,0 +1,279 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/problem"
+
+	"github.com/golang-jwt/jwt/v5"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Authentication modes selectable through the "api-server.auth.mode" setting.
+const (
+	// ModeNone trusts the client: the bearer token of the watchlist methods
+	// and the X-User-ID header of the module servers are taken as the user
+	// ID. Development only.
+	ModeNone = "none"
+	// ModeLocal validates HS256 tokens signed with a local secret key.
+	ModeLocal = "local"
+	// ModeJWKS validates tokens against the keys of a JWKS file.
+	ModeJWKS = "jwks"
+	// ModeIssuer validates tokens against the JWKS published by an OpenID
+	// Connect issuer.
+	ModeIssuer = "issuer"
+)
+
+// UserIDHeader is the header the generated module decoders read the user ID
+// from. The middleware overwrites it with the authenticated token subject.
+const UserIDHeader = "X-User-ID"
+
+// MaxQueryTokenTTL is the longest remaining lifetime QueryToken accepts for
+// a token passed in the URL, which browsers and proxies may record.
+const MaxQueryTokenTTL = 5 * time.Minute
+
+// Config holds the authentication configuration. There is no default mode:
+// ModeNone must be chosen explicitly and also requires InsecureDev.
+type Config struct {
+	Mode           string
+	InsecureDev    bool // allows ModeNone
+	Issuer         string
+	Audience       string
+	JWKSFile       string
+	SigningKeyFile string
+}
+
+type (
+	ctxKey      struct{}
+	recorderKey struct{}
+)
+
+// Claims are the validated claims of the request bearer token.
+type Claims = jwt.RegisteredClaims
+
+// Authenticator validates bearer tokens and injects the caller identity into
+// requests.
+type Authenticator struct {
+	mode    string
+	keyfunc jwt.Keyfunc
+	parser  *jwt.Parser
+	logger  *slog.Logger
+}
+
+// New returns an Authenticator for the configured mode.
+func New(ctx context.Context, cfg Config, logger *slog.Logger) (*Authenticator, error) {
+	a := &Authenticator{mode: cfg.Mode, logger: logger}
+
+	opts := []jwt.ParserOption{
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30 * time.Second),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	switch cfg.Mode {
+	case "":
+		return nil, fmt.Errorf("no auth mode configured (api-server.auth.mode), choose one of %s, %s or %s", ModeLocal, ModeJWKS, ModeIssuer)
+	case ModeNone:
+		if !cfg.InsecureDev {
+			return nil, errors.New("none auth mode trusts the user ID sent by clients, set api-server.auth.insecure-dev (--insecure-dev-auth) to allow it")
+		}
+		return a, nil
+	case ModeLocal:
+		key, err := LoadSigningKey(cfg.SigningKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		a.keyfunc = func(*jwt.Token) (any, error) { return key, nil }
+		opts = append(opts, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	case ModeJWKS:
+		if cfg.JWKSFile == "" {
+			return nil, errors.New("jwks auth mode requires a JWKS file")
+		}
+		ks, err := loadKeySetFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		a.keyfunc = ks.keyfunc
+		opts = append(opts, jwt.WithValidMethods(asymmetricMethods))
+	case ModeIssuer:
+		if cfg.Issuer == "" {
+			return nil, errors.New("issuer auth mode requires an issuer URL")
+		}
+		ks, err := newRemoteKeySet(ctx, cfg.Issuer, logger)
+		if err != nil {
+			return nil, err
+		}
+		a.keyfunc = ks.keyfunc
+		opts = append(opts, jwt.WithValidMethods(asymmetricMethods))
+	default:
+		return nil, fmt.Errorf("unsupported auth mode %q", cfg.Mode)
+	}
+
+	a.parser = jwt.NewParser(opts...)
+	return a, nil
+}
+
+// Mode returns the effective authentication mode.
+func (a *Authenticator) Mode() string { return a.mode }
+
+// Middleware authenticates the request bearer token and replaces the
+// X-User-ID header with the token subject so that the generated decoders of
+// the module servers, whose designs read the user from that header, inject
+// the authenticated user into the service payloads. Requests without a valid
+// token are rejected with 401.
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	if a.mode == ModeNone {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			record(r.Context(), r.Header.Get(UserIDHeader))
+			next.ServeHTTP(w, r)
+		})
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		claims, err := a.authenticate(r)
+		if err != nil {
+			a.logger.WarnContext(ctx, "authentication failed", "error", err, "path", r.URL.Path)
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			problem.Write(w, r, problem.WithStatus(ctx, http.StatusUnauthorized, "missing or invalid bearer token"))
+			return
+		}
+
+		record(ctx, claims.Subject)
+		r = r.WithContext(ContextWithClaims(ctx, claims))
+		r.Header.Set(UserIDHeader, claims.Subject)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// QueryToken lets clients that cannot set request headers, such as browser
+// EventSource, pass a bearer token as the access_token query parameter (RFC
+// 6750, section 2.3). It moves the token into the Authorization header, for
+// the JWT security of the endpoint to authenticate, and refuses tokens that
+// are still valid for more than MaxQueryTokenTTL. It is meant for the event
+// stream only.
+func (a *Authenticator) QueryToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		token := q.Get("access_token")
+		if token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx := r.Context()
+		if r.Header.Get("Authorization") != "" {
+			problem.Write(w, r, problem.WithStatus(ctx, http.StatusBadRequest, "the bearer token is sent both in the Authorization header and as access_token"))
+			return
+		}
+		if a.mode != ModeNone {
+			claims, err := a.parse(token)
+			if err == nil && time.Until(claims.ExpiresAt.Time) > MaxQueryTokenTTL {
+				err = fmt.Errorf("access_token expires in more than %s", MaxQueryTokenTTL)
+			}
+			if err != nil {
+				a.logger.WarnContext(ctx, "authentication failed", "error", err, "path", r.URL.Path)
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				problem.Write(w, r, problem.WithStatus(ctx, http.StatusUnauthorized, fmt.Sprintf("missing or invalid access_token, which must expire within %s", MaxQueryTokenTTL)))
+				return
+			}
+		}
+
+		r = r.Clone(ctx)
+		q.Del("access_token")
+		r.URL.RawQuery = q.Encode()
+		r.Header.Set("Authorization", "Bearer "+token)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// JWTAuth implements the authorization of the JWT security scheme of the
+// Goa designs. It validates token and returns a context carrying its claims,
+// whose subject is the user the method acts for, and a request logger
+// carrying that user. Invalid tokens return an "unauthorized" error.
+func (a *Authenticator) JWTAuth(ctx context.Context, token string, _ *security.JWTScheme) (context.Context, error) {
+	var claims *Claims
+	if a.mode == ModeNone {
+		if token == "" {
+			return ctx, goa.PermanentError("unauthorized", "missing bearer token")
+		}
+		claims = &Claims{Subject: token}
+	} else {
+		var err error
+		if claims, err = a.parse(token); err != nil {
+			logctx.LoggerFromContext(ctx).WarnContext(ctx, "authentication failed", "error", err)
+			return ctx, goa.PermanentError("unauthorized", "missing or invalid bearer token")
+		}
+	}
+
+	record(ctx, claims.Subject)
+	ctx = logctx.With(ctx, slog.String("user_id", claims.Subject))
+	return ContextWithClaims(ctx, claims), nil
+}
+
+func (a *Authenticator) authenticate(r *http.Request) (*Claims, error) {
+	h := r.Header.Get("Authorization")
+	scheme, token, ok := strings.Cut(h, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return nil, errors.New("missing bearer token")
+	}
+	return a.parse(token)
+}
+
+func (a *Authenticator) parse(token string) (*Claims, error) {
+	if token == "" {
+		return nil, errors.New("missing bearer token")
+	}
+	claims := &Claims{}
+	if _, err := a.parser.ParseWithClaims(token, claims, a.keyfunc); err != nil {
+		return nil, err
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+	return claims, nil
+}
+
+// ContextWithClaims returns a copy of ctx that carries the claims of the
+// authenticated caller.
+func ContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, ctxKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims of the authenticated caller, if any.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	c, ok := ctx.Value(ctxKey{}).(*Claims)
+	return c, ok
+}
+
+// ContextWithRecorder returns a copy of ctx in which authenticating the
+// request records the user, for middleware running outside of the
+// authentication to read with RecordedUser once the request completes.
+func ContextWithRecorder(ctx context.Context) context.Context {
+	return context.WithValue(ctx, recorderKey{}, new(string))
+}
+
+// RecordedUser returns the user authenticated within ctx, if any.
+func RecordedUser(ctx context.Context) string {
+	if u, ok := ctx.Value(recorderKey{}).(*string); ok {
+		return *u
+	}
+	return ""
+}
+
+func record(ctx context.Context, user string) {
+	if u, ok := ctx.Value(recorderKey{}).(*string); ok {
+		*u = user
+	}
+}
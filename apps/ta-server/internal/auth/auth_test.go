@@ -0,0 +1,342 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	goa "goa.design/goa/v3/pkg"
+)
+
+var testLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// echoUser responds with the X-User-ID header seen by the wrapped handler.
+var echoUser = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, r.Header.Get(UserIDHeader))
+})
+
+func serve(t *testing.T, a *Authenticator, token string) *httptest.ResponseRecorder {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodGet, "/watchlist", nil)
+	r.Header.Set(UserIDHeader, "mallory")
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	a.Middleware(echoUser).ServeHTTP(w, r)
+	return w
+}
+
+func TestLocalMode(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "signing.key")
+	key := []byte("0123456789abcdef0123456789abcdef")
+	if err := os.WriteFile(keyFile, key, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := New(context.Background(), Config{Mode: ModeLocal, SigningKeyFile: keyFile, Audience: "ta-server"}, testLogger)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	valid, err := IssueToken(key, "", "ta-server", "alice", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, err := IssueToken(key, "", "ta-server", "alice", -time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrongAudience, err := IssueToken(key, "", "other", "alice", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := IssueToken([]byte("ffffffffffffffffffffffffffffffff"), "", "ta-server", "alice", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name   string
+		token  string
+		status int
+		user   string
+	}{
+		{"valid", valid, http.StatusOK, "alice"},
+		{"missing", "", http.StatusUnauthorized, ""},
+		{"expired", expired, http.StatusUnauthorized, ""},
+		{"wrong audience", wrongAudience, http.StatusUnauthorized, ""},
+		{"forged", forged, http.StatusUnauthorized, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			w := serve(t, a, c.token)
+			if w.Code != c.status {
+				t.Fatalf("status = %d, want %d", w.Code, c.status)
+			}
+			if c.status == http.StatusOK && w.Body.String() != c.user {
+				t.Errorf("X-User-ID = %q, want %q", w.Body.String(), c.user)
+			}
+		})
+	}
+}
+
+func TestJWKSMode(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks, err := json.Marshal(map[string]any{"keys": []map[string]string{{
+		"kid": "k1",
+		"kty": "RSA",
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(priv.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(priv.E)).Bytes()),
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(jwksFile, jwks, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := New(context.Background(), Config{Mode: ModeJWKS, JWKSFile: jwksFile, Issuer: "https://idp.example.com"}, testLogger)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	sign := func(kid, issuer string) string {
+		tok := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{
+			Subject:   "bob",
+			Issuer:    issuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		})
+		tok.Header["kid"] = kid
+		s, err := tok.SignedString(priv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	if w := serve(t, a, sign("k1", "https://idp.example.com")); w.Code != http.StatusOK || w.Body.String() != "bob" {
+		t.Errorf("valid token: status %d user %q", w.Code, w.Body.String())
+	}
+	if w := serve(t, a, sign("k2", "https://idp.example.com")); w.Code != http.StatusUnauthorized {
+		t.Errorf("unknown kid: status %d, want 401", w.Code)
+	}
+	if w := serve(t, a, sign("k1", "https://evil.example.com")); w.Code != http.StatusUnauthorized {
+		t.Errorf("wrong issuer: status %d, want 401", w.Code)
+	}
+}
+
+// Tokens with unknown key IDs share a single JWKS refresh per interval,
+// even when the refresh fails, so forged key IDs cannot flood the issuer.
+func TestRemoteKeySetRefreshThrottled(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks, err := json.Marshal(map[string]any{"keys": []map[string]string{{
+		"kid": "k1",
+		"kty": "RSA",
+		"n":   base64.RawURLEncoding.EncodeToString(priv.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(priv.E)).Bytes()),
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fetches atomic.Int32
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"jwks_uri": srv.URL + "/jwks"})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		if fetches.Add(1) > 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write(jwks)
+	})
+
+	rks, err := newRemoteKeySet(context.Background(), srv.URL, testLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lookup := func(kid string) error {
+		_, err := rks.keyfunc(&jwt.Token{Header: map[string]any{"kid": kid}})
+		return err
+	}
+	forgeAll := func() {
+		var wg sync.WaitGroup
+		for i := range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if lookup(fmt.Sprintf("forged-%d", i)) == nil {
+					t.Error("forged key ID accepted")
+				}
+			}()
+		}
+		wg.Wait()
+	}
+
+	forgeAll()
+	if n := fetches.Load(); n != 1 {
+		t.Errorf("JWKS fetched %d times within the refresh interval, want 1", n)
+	}
+
+	rks.mu.Lock()
+	rks.lastAttempt = time.Now().Add(-2 * minRefreshInterval)
+	rks.mu.Unlock()
+	forgeAll()
+	forgeAll()
+	if n := fetches.Load(); n != 2 {
+		t.Errorf("JWKS fetched %d times, want a single failed refresh", n)
+	}
+	if err := lookup("k1"); err != nil {
+		t.Errorf("known key after a failed refresh: %v", err)
+	}
+}
+
+func TestNoneModeTrustsHeader(t *testing.T) {
+	a, err := New(context.Background(), Config{Mode: ModeNone, InsecureDev: true}, testLogger)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if w := serve(t, a, ""); w.Code != http.StatusOK || w.Body.String() != "mallory" {
+		t.Errorf("status %d user %q", w.Code, w.Body.String())
+	}
+}
+
+func TestJWTAuth(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "signing.key")
+	key := []byte("0123456789abcdef0123456789abcdef")
+	if err := os.WriteFile(keyFile, key, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	a, err := New(context.Background(), Config{Mode: ModeLocal, SigningKeyFile: keyFile}, testLogger)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	valid, err := IssueToken(key, "", "", "alice", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := ContextWithRecorder(context.Background())
+	ctx, err = a.JWTAuth(ctx, valid, nil)
+	if err != nil {
+		t.Fatalf("valid token: %v", err)
+	}
+	if c, ok := ClaimsFromContext(ctx); !ok || c.Subject != "alice" {
+		t.Errorf("claims = %v, want subject alice", c)
+	}
+	if got := RecordedUser(ctx); got != "alice" {
+		t.Errorf("recorded user = %q, want alice", got)
+	}
+
+	for _, token := range []string{"", "alice", valid + "x"} {
+		_, err := a.JWTAuth(context.Background(), token, nil)
+		var se *goa.ServiceError
+		if !errors.As(err, &se) || se.Name != "unauthorized" {
+			t.Errorf("token %q: error %v, want unauthorized", token, err)
+		}
+	}
+}
+
+func TestQueryToken(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "signing.key")
+	key := []byte("0123456789abcdef0123456789abcdef")
+	if err := os.WriteFile(keyFile, key, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	a, err := New(context.Background(), Config{Mode: ModeLocal, SigningKeyFile: keyFile}, testLogger)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	short, err := IssueToken(key, "", "", "alice", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	long, err := IssueToken(key, "", "", "alice", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// echo responds with the Authorization header and query seen downstream.
+	h := a.QueryToken(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, r.Header.Get("Authorization")+"?"+r.URL.RawQuery)
+	}))
+
+	cases := []struct {
+		name, query, header string
+		status              int
+		want                string
+	}{
+		{"short-lived", "watchlist=w1&access_token=" + short, "", http.StatusOK, "Bearer " + short + "?watchlist=w1"},
+		{"long-lived", "access_token=" + long, "", http.StatusUnauthorized, ""},
+		{"invalid", "access_token=alice", "", http.StatusUnauthorized, ""},
+		{"both", "access_token=" + short, "Bearer " + short, http.StatusBadRequest, ""},
+		{"header only", "watchlist=w1", "Bearer " + long, http.StatusOK, "Bearer " + long + "?watchlist=w1"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/watchlist/events?"+c.query, nil)
+			if c.header != "" {
+				r.Header.Set("Authorization", c.header)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if w.Code != c.status {
+				t.Fatalf("status = %d, want %d", w.Code, c.status)
+			}
+			if c.status == http.StatusOK && w.Body.String() != c.want {
+				t.Errorf("downstream request = %q, want %q", w.Body.String(), c.want)
+			}
+		})
+	}
+}
+
+func TestNoneModeTrustsToken(t *testing.T) {
+	a, err := New(context.Background(), Config{Mode: ModeNone, InsecureDev: true}, testLogger)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	ctx, err := a.JWTAuth(context.Background(), "mallory", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c, ok := ClaimsFromContext(ctx); !ok || c.Subject != "mallory" {
+		t.Errorf("claims = %v, want subject mallory", c)
+	}
+	if _, err := a.JWTAuth(context.Background(), "", nil); err == nil {
+		t.Error("expected an error without token")
+	}
+}
+
+func TestModeRequired(t *testing.T) {
+	for _, cfg := range []Config{{}, {Mode: ModeNone}} {
+		if _, err := New(context.Background(), cfg, testLogger); err == nil {
+			t.Errorf("New(%+v): expected an error", cfg)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// asymmetricMethods are the signing algorithms accepted for JWKS keys.
+var asymmetricMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+// jwk is a single JSON Web Key (RFC 7517).
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// keySet holds the public keys of a JWKS document indexed by key ID.
+type keySet struct {
+	keys map[string]crypto.PublicKey
+}
+
+func parseKeySet(data []byte) (*keySet, error) {
+	var doc struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("decode JWKS: %w", err)
+	}
+
+	ks := &keySet{keys: make(map[string]crypto.PublicKey)}
+	for _, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("JWKS key %q: %w", k.Kid, err)
+		}
+		ks.keys[k.Kid] = pub
+	}
+	if len(ks.keys) == 0 {
+		return nil, errors.New("JWKS contains no signing keys")
+	}
+	return ks, nil
+}
+
+func loadKeySetFile(path string) (*keySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read JWKS file: %w", err)
+	}
+	return parseKeySet(data)
+}
+
+// lookup returns the key matching the token "kid" header. Tokens without a
+// key ID are accepted only when the set holds a single key.
+func (ks *keySet) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(ks.keys) == 1 {
+		for _, k := range ks.keys {
+			return k, true
+		}
+	}
+	k, ok := ks.keys[kid]
+	return k, ok
+}
+
+func (ks *keySet) keyfunc(t *jwt.Token) (any, error) {
+	kid, _ := t.Header["kid"].(string)
+	if k, ok := ks.lookup(kid); ok {
+		return k, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("modulus: %w", err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("exponent: %w", err)
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("x coordinate: %w", err)
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("y coordinate: %w", err)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("x coordinate: %w", err)
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// minRefreshInterval limits how often an unknown key ID triggers a JWKS
+// refresh from the issuer, whether or not the previous attempt succeeded.
+const minRefreshInterval = time.Minute
+
+// refreshTimeout bounds a JWKS refresh triggered by a token.
+const refreshTimeout = 5 * time.Second
+
+// remoteKeySet fetches the JWKS advertised by an OpenID Connect issuer and
+// refreshes it when a token references an unknown key (key rotation).
+type remoteKeySet struct {
+	jwksURL string
+	client  *http.Client
+	logger  *slog.Logger
+
+	mu          sync.Mutex
+	keys        *keySet
+	lastAttempt time.Time
+	// refreshing is closed when the refresh in flight completes; nil when
+	// there is none.
+	refreshing chan struct{}
+}
+
+func newRemoteKeySet(ctx context.Context, issuer string, logger *slog.Logger) (*remoteKeySet, error) {
+	rks := &remoteKeySet{
+		client: &http.Client{Timeout: 10 * time.Second},
+		logger: logger,
+	}
+
+	var discovery struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	wellKnown := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	if err := rks.getJSON(ctx, wellKnown, &discovery); err != nil {
+		return nil, fmt.Errorf("OpenID discovery: %w", err)
+	}
+	if discovery.JWKSURI == "" {
+		return nil, errors.New("OpenID discovery document has no jwks_uri")
+	}
+	rks.jwksURL = discovery.JWKSURI
+
+	if err := rks.refresh(ctx); err != nil {
+		return nil, err
+	}
+	return rks, nil
+}
+
+func (rks *remoteKeySet) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := rks.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(v)
+}
+
+// refresh reloads the key set. Callers must not hold rks.mu.
+func (rks *remoteKeySet) refresh(ctx context.Context) error {
+	rks.mu.Lock()
+	rks.lastAttempt = time.Now()
+	rks.mu.Unlock()
+
+	var raw json.RawMessage
+	if err := rks.getJSON(ctx, rks.jwksURL, &raw); err != nil {
+		return fmt.Errorf("fetch JWKS: %w", err)
+	}
+	ks, err := parseKeySet(raw)
+	if err != nil {
+		return err
+	}
+
+	rks.mu.Lock()
+	rks.keys = ks
+	rks.mu.Unlock()
+	return nil
+}
+
+// keyfunc returns the key matching the token "kid" header. An unknown key
+// ID refreshes the key set at most once per minRefreshInterval; concurrent
+// lookups wait for the refresh in flight instead of starting their own.
+func (rks *remoteKeySet) keyfunc(t *jwt.Token) (any, error) {
+	kid, _ := t.Header["kid"].(string)
+
+	rks.mu.Lock()
+	if k, ok := rks.keys.lookup(kid); ok {
+		rks.mu.Unlock()
+		return k, nil
+	}
+	done := rks.refreshing
+	if done == nil {
+		if time.Since(rks.lastAttempt) <= minRefreshInterval {
+			rks.mu.Unlock()
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+		done = make(chan struct{})
+		rks.refreshing = done
+		rks.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+		if err := rks.refresh(ctx); err != nil {
+			rks.logger.Warn("failed to refresh JWKS", "url", rks.jwksURL, "error", err)
+		}
+		cancel()
+
+		rks.mu.Lock()
+		rks.refreshing = nil
+		rks.mu.Unlock()
+		close(done)
+	} else {
+		rks.mu.Unlock()
+		<-done
+	}
+
+	rks.mu.Lock()
+	ks := rks.keys
+	rks.mu.Unlock()
+	if k, ok := ks.lookup(kid); ok {
+		return k, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
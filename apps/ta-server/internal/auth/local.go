@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minSigningKeySize is the minimum HS256 secret length in bytes.
+const minSigningKeySize = 32
+
+// LoadSigningKey reads the local HS256 secret from path.
+func LoadSigningKey(path string) ([]byte, error) {
+	if path == "" {
+		return nil, errors.New("local auth mode requires a signing key file")
+	}
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read signing key: %w", err)
+	}
+	key = bytes.TrimSpace(key)
+	if len(key) < minSigningKeySize {
+		return nil, fmt.Errorf("signing key must be at least %d bytes", minSigningKeySize)
+	}
+	return key, nil
+}
+
+// IssueToken returns an HS256 token for subject signed with the local key.
+// It is intended for development and tests where no identity provider is
+// available.
+func IssueToken(key []byte, issuer, audience, subject string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := jwt.RegisteredClaims{
+		Subject:   subject,
+		Issuer:    issuer,
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+	}
+	if audience != "" {
+		claims.Audience = jwt.ClaimStrings{audience}
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+}
@@ -1,49 +1,110 @@
 package di
 
 import (
+	"fmt"
 	"log/slog"
 
 	// Internal Modules
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/auth"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/eventbus"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/exchange"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/health"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/instrument"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/telemetry"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/watchlist"
 	portfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/pkg"
-	watchlist "github.com/reidlai/ta-workspace/modules/watchlist/go/pkg/watchlist"
 
 	// Generated Interfaces
+	exchangeGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/exchange"
+	instrumentGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/instrument"
+	watchlistGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
 	portfolioGen "github.com/reidlai/ta-workspace/modules/portfolio/go/gen/portfolio"
-	watchlistGen "github.com/reidlai/ta-workspace/modules/watchlist/go/gen/watchlist"
 
 	"goa.design/clue/debug"
 )
 
 // Services holds the initialized endpoints for the server.
 type Services struct {
-	WatchlistEndpoints *watchlistGen.Endpoints
-	PortfolioEndpoints *portfolioGen.Endpoints
+	WatchlistEndpoints  *watchlistGen.Endpoints
+	PortfolioEndpoints  *portfolioGen.Endpoints
+	ExchangeEndpoints   *exchangeGen.Endpoints
+	InstrumentEndpoints *instrumentGen.Endpoints
+	// ExchangeService is also used by the admin server to import MIC files.
+	ExchangeService *exchange.Service
+	// WatchlistService also runs the purge of removed watchlist items.
+	WatchlistService *watchlist.Service
+	// Events carries the changes published by the services to the event
+	// streams; closing it ends the streams.
+	Events *eventbus.Bus
 }
 
-// NewServices initializes the services and endpoints.
-func NewServices(logger *slog.Logger) *Services {
+// NewServices initializes the services and endpoints. The watchlist service
+// persists its data in watchlistRepo and validates symbols as configured by
+// watchlistCfg against the instrument master, the exchange service serves exchanges
+// and their trading calendars and the instrument service keeps the instrument
+// master in instrumentRepo. The watchlist service publishes the changes of
+// watchlist items to an in-process event bus and authenticates its callers
+// with authn. Services that implement health.Checker are registered in checks
+// under their service name.
+func NewServices(logger *slog.Logger, authn *auth.Authenticator, watchlistRepo watchlist.Repository, watchlistCfg watchlist.Config, instrumentRepo instrument.Repository, exchanges []*exchange.Exchange, calendars map[string]*exchange.Calendar, checks *health.Registry) (*Services, error) {
 	var (
-		watchlistSvc watchlistGen.Service
-		portfolioSvc portfolioGen.Service
+		watchlistSvc  *watchlist.Service
+		portfolioSvc  portfolioGen.Service
+		exchangeSvc   *exchange.Service
+		instrumentSvc *instrument.Service
+		events        = eventbus.New(eventbus.DefaultHistory)
 	)
 	{
-		watchlistSvc = watchlist.NewWatchlist(logger)
+		exchangeSvc = exchange.NewService(exchanges, calendars)
+		instrumentSvc = instrument.NewService(instrumentRepo, exchangeSvc)
+		symbols, err := watchlist.NewSymbolResolver(watchlistCfg, instrumentSvc, exchangeSvc)
+		if err != nil {
+			return nil, fmt.Errorf("watchlist: %w", err)
+		}
+		watchlistSvc = watchlist.NewService(watchlistRepo, symbols, watchlistCfg.Retention, events)
 		portfolioSvc = portfolio.NewPortfolio(logger)
 	}
 
+	checks.RegisterIfChecker(watchlistGen.ServiceName, watchlistSvc)
+	checks.RegisterIfChecker(portfolioGen.ServiceName, portfolioSvc)
+	checks.RegisterIfChecker(instrumentGen.ServiceName, instrumentSvc)
+	checks.RegisterIfChecker(exchangeGen.ServiceName, exchangeSvc)
+
 	var (
-		watchlistEndpoints *watchlistGen.Endpoints
-		portfolioEndpoints *portfolioGen.Endpoints
+		watchlistEndpoints  *watchlistGen.Endpoints
+		portfolioEndpoints  *portfolioGen.Endpoints
+		exchangeEndpoints   *exchangeGen.Endpoints
+		instrumentEndpoints *instrumentGen.Endpoints
 	)
 	{
-		watchlistEndpoints = watchlistGen.NewEndpoints(watchlistSvc)
+		watchlistEndpoints = watchlistGen.NewEndpoints(securedWatchlist{watchlistSvc, authn})
 		watchlistEndpoints.Use(debug.LogPayloads())
+		watchlistEndpoints.Use(telemetry.TraceEndpoint)
 		portfolioEndpoints = portfolioGen.NewEndpoints(portfolioSvc)
 		portfolioEndpoints.Use(debug.LogPayloads())
+		portfolioEndpoints.Use(telemetry.TraceEndpoint)
+		exchangeEndpoints = exchangeGen.NewEndpoints(exchangeSvc)
+		exchangeEndpoints.Use(debug.LogPayloads())
+		exchangeEndpoints.Use(telemetry.TraceEndpoint)
+		instrumentEndpoints = instrumentGen.NewEndpoints(instrumentSvc)
+		instrumentEndpoints.Use(debug.LogPayloads())
+		instrumentEndpoints.Use(telemetry.TraceEndpoint)
 	}
 
 	return &Services{
-		WatchlistEndpoints: watchlistEndpoints,
-		PortfolioEndpoints: portfolioEndpoints,
-	}
+		WatchlistEndpoints:  watchlistEndpoints,
+		PortfolioEndpoints:  portfolioEndpoints,
+		ExchangeEndpoints:   exchangeEndpoints,
+		InstrumentEndpoints: instrumentEndpoints,
+		ExchangeService:     exchangeSvc,
+		WatchlistService:    watchlistSvc,
+		Events:              events,
+	}, nil
+}
+
+// securedWatchlist is the watchlist service with the authorization of its JWT
+// security scheme, implemented by the authenticator.
+type securedWatchlist struct {
+	*watchlist.Service
+	*auth.Authenticator
 }
@@ -0,0 +1,115 @@
+package di
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	watchlistsvr "github.com/reidlai/ta-workspace/apps/ta-server/gen/http/watchlist/server"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/auth"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/health"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/instrument"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/problem"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/watchlist"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// The watchlist routes act for the subject of the bearer token and ignore
+// X-User-ID, except the public link, which is served without a token.
+func TestWatchlistSecurity(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.DiscardHandler)
+	keyFile := filepath.Join(t.TempDir(), "key")
+	if err := os.WriteFile(keyFile, []byte(strings.Repeat("k", 32)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	authn, err := auth.New(ctx, auth.Config{Mode: auth.ModeLocal, SigningKeyFile: keyFile}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := auth.LoadSigningKey(keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := func(subject string) string {
+		tok, err := auth.IssueToken(key, "", "", subject, time.Hour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tok
+	}
+
+	services, err := NewServices(logger, authn, watchlist.NewMemoryRepository(), watchlist.Config{}, instrument.NewMemoryRepository(), nil, nil, health.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := goahttp.NewMuxer()
+	watchlistsvr.Mount(mux, watchlistsvr.New(services.WatchlistEndpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, problem.ErrorHandler(logger), problem.Formatter))
+	srv := httptest.NewServer(problem.Middleware(logger)(mux))
+	defer srv.Close()
+
+	do := func(method, path, bearer, user, body string, res any) int {
+		t.Helper()
+		req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if bearer != "" {
+			req.Header.Set("Authorization", "Bearer "+bearer)
+		}
+		if user != "" {
+			req.Header.Set(auth.UserIDHeader, user)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if res != nil && resp.StatusCode < http.StatusBadRequest {
+			if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+				t.Fatal(err)
+			}
+		} else {
+			io.Copy(io.Discard, resp.Body)
+		}
+		return resp.StatusCode
+	}
+
+	var list struct {
+		ID          string `json:"id"`
+		PublicToken string `json:"public_token"`
+	}
+	if code := do("POST", "/watchlists", token("alice"), "", `{"name":"Tech"}`, &list); code != http.StatusCreated {
+		t.Fatalf("create = %d, want 201", code)
+	}
+	if code := do("PUT", "/watchlists/"+list.ID+"/public", token("alice"), "", "", &list); code != http.StatusOK || list.PublicToken == "" {
+		t.Fatalf("publish = %d with token %q, want 200 and a token", code, list.PublicToken)
+	}
+
+	for _, tc := range []struct {
+		name, method, path, bearer, user string
+		want                             int
+	}{
+		{"public link without token", "GET", "/public/watchlists/" + list.PublicToken, "", "", http.StatusOK},
+		{"owner", "GET", "/watchlists/" + list.ID, token("alice"), "", http.StatusOK},
+		{"no token", "GET", "/watchlists/" + list.ID, "", "", http.StatusUnauthorized},
+		{"user header only", "GET", "/watchlists/" + list.ID, "", "alice", http.StatusUnauthorized},
+		{"invalid token", "GET", "/watchlists/" + list.ID, "not-a-jwt", "", http.StatusUnauthorized},
+		{"other user claiming the owner", "GET", "/watchlists/" + list.ID, token("bob"), "alice", http.StatusNotFound},
+		{"publish without token", "PUT", "/watchlists/" + list.ID + "/public", "", "alice", http.StatusUnauthorized},
+	} {
+		if code := do(tc.method, tc.path, tc.bearer, tc.user, "", nil); code != tc.want {
+			t.Errorf("%s: %s %s = %d, want %d", tc.name, tc.method, tc.path, code, tc.want)
+		}
+	}
+}
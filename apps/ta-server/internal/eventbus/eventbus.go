@@ -0,0 +1,242 @@
+// Package eventbus implements an in-process publish/subscribe hub whose
+// subscribers can resume after reconnecting.
+package eventbus
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultHistory is the number of events retained per topic when New is
+// given none.
+const DefaultHistory = 100
+
+// ResumeWindow is how long the events of a topic without subscribers are
+// retained after the last one was published. Subscribers reconnecting later
+// may have to start over.
+const ResumeWindow = 10 * time.Minute
+
+// subscriberBuffer is how many events a subscriber may lag behind before it
+// is dropped.
+const subscriberBuffer = 64
+
+// Event is a message published to a topic.
+type Event struct {
+	// ID increases with every event published to the bus, from 1.
+	ID    uint64
+	Topic string
+	Type  string
+	Data  any
+}
+
+// Bus delivers the events published to a topic to its subscribers. It
+// retains the last events of every topic, so that a subscriber that
+// reconnects receives those it missed, and evicts the topics without
+// subscribers whose last event is older than the resume window. A Bus is
+// safe for concurrent use.
+type Bus struct {
+	mu      sync.Mutex
+	epoch   string
+	seq     uint64
+	history int
+	window  time.Duration
+	now     func() time.Time
+	// topics are the retained events of the topics published to.
+	topics map[string]*topic
+	// subs are the subscriptions of each topic with at least one.
+	subs map[string]map[*Subscription]struct{}
+	// evicted is the ID of the last event of the topics evicted so far.
+	evicted   uint64
+	lastSweep time.Time
+	closed    bool
+}
+
+type topic struct {
+	// events are the last events of the topic, oldest first.
+	events []Event
+	// dropped is the ID of the last event no longer retained.
+	dropped uint64
+	// published is when the last event was published.
+	published time.Time
+}
+
+// Subscription receives the events published to a topic.
+type Subscription struct {
+	bus   *Bus
+	topic string
+	start uint64
+	ch    chan Event
+}
+
+// New returns a bus retaining the last history events of each topic,
+// DefaultHistory when not positive.
+func New(history int) *Bus {
+	if history <= 0 {
+		history = DefaultHistory
+	}
+	now := time.Now()
+	return &Bus{
+		epoch:     strconv.FormatInt(now.UnixNano(), 36),
+		history:   history,
+		window:    ResumeWindow,
+		now:       time.Now,
+		topics:    make(map[string]*topic),
+		subs:      make(map[string]map[*Subscription]struct{}),
+		lastSweep: now,
+	}
+}
+
+// Publish delivers an event to the subscribers of the topic and returns it.
+// Subscribers that have fallen behind are dropped: their channel is closed,
+// so that they can subscribe again and resume from the retained events.
+func (b *Bus) Publish(topicName, typ string, data any) Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if now.Sub(b.lastSweep) >= b.window {
+		b.sweep(now)
+	}
+
+	b.seq++
+	e := Event{ID: b.seq, Topic: topicName, Type: typ, Data: data}
+	t, ok := b.topics[topicName]
+	if !ok {
+		// The topic may have been evicted with events published after
+		// those its subscribers resume from.
+		t = &topic{dropped: b.evicted}
+		b.topics[topicName] = t
+	}
+	if len(t.events) == b.history {
+		t.dropped = t.events[0].ID
+		t.events = append(t.events[:0], t.events[1:]...)
+	}
+	t.events = append(t.events, e)
+	t.published = now
+	for s := range b.subs[topicName] {
+		select {
+		case s.ch <- e:
+		default:
+			b.unsubscribe(s)
+		}
+	}
+	return e
+}
+
+// Subscribe subscribes to the topic. after is the ID of the last event the
+// subscriber received, 0 for none; the retained events of the topic
+// published since are returned for replay. ok is false, and nothing is
+// replayed, when events published after it may have been missed because
+// they are no longer retained or after is unknown to the bus.
+func (b *Bus) Subscribe(topicName string, after uint64) (sub *Subscription, replay []Event, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub = &Subscription{bus: b, topic: topicName, start: b.seq, ch: make(chan Event, subscriberBuffer)}
+	if b.closed {
+		close(sub.ch)
+		return sub, nil, true
+	}
+	subs, ok := b.subs[topicName]
+	if !ok {
+		subs = make(map[*Subscription]struct{})
+		b.subs[topicName] = subs
+	}
+	subs[sub] = struct{}{}
+
+	// A topic without retained events is not created: its subscribers are
+	// tracked apart, and it may have been evicted.
+	t, ok := b.topics[topicName]
+	if !ok {
+		t = &topic{dropped: b.evicted}
+	}
+	if after > b.seq || after < t.dropped {
+		return sub, nil, false
+	}
+	for _, e := range t.events {
+		if e.ID > after {
+			replay = append(replay, e)
+		}
+	}
+	return sub, replay, true
+}
+
+// Close ends all subscriptions, and those made later, e.g. so that
+// long-lived streams finish when the server shuts down.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for _, subs := range b.subs {
+		for s := range subs {
+			b.unsubscribe(s)
+		}
+	}
+}
+
+// FormatID returns the external form of an event ID. It names the bus
+// instance, so that IDs issued before a restart are not mistaken for
+// current ones.
+func (b *Bus) FormatID(id uint64) string {
+	return b.epoch + "-" + strconv.FormatUint(id, 10)
+}
+
+// ParseID parses an ID formatted by FormatID. It reports false when s is
+// malformed or was issued by another bus instance.
+func (b *Bus) ParseID(s string) (uint64, bool) {
+	epoch, seq, found := strings.Cut(s, "-")
+	if !found || epoch != b.epoch {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(seq, 10, 64)
+	return id, err == nil
+}
+
+// sweep evicts the topics without subscribers whose last event was
+// published more than the resume window before now. The caller must hold
+// the lock.
+func (b *Bus) sweep(now time.Time) {
+	for name, t := range b.topics {
+		if _, ok := b.subs[name]; ok || now.Sub(t.published) < b.window {
+			continue
+		}
+		b.evicted = max(b.evicted, t.events[len(t.events)-1].ID)
+		delete(b.topics, name)
+	}
+	b.lastSweep = now
+}
+
+// unsubscribe ends the subscription s if it is still active. The caller must
+// hold the lock.
+func (b *Bus) unsubscribe(s *Subscription) {
+	subs := b.subs[s.topic]
+	if _, ok := subs[s]; !ok {
+		return
+	}
+	delete(subs, s)
+	if len(subs) == 0 {
+		delete(b.subs, s.topic)
+	}
+	close(s.ch)
+}
+
+// Events returns the channel of the events published after Subscribe
+// returned. It is closed when the subscription is closed or dropped, or
+// when the bus is closed.
+func (s *Subscription) Events() <-chan Event { return s.ch }
+
+// Start returns the ID of the last event published to the bus before the
+// subscription, from which a subscriber that missed events may resume
+// after reloading.
+func (s *Subscription) Start() uint64 { return s.start }
+
+// Close ends the subscription.
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	s.bus.unsubscribe(s)
+}
@@ -0,0 +1,156 @@
+package eventbus
+
+import (
+	"testing"
+	"time"
+)
+
+func ids(events []Event) []uint64 {
+	res := make([]uint64, len(events))
+	for i, e := range events {
+		res[i] = e.ID
+	}
+	return res
+}
+
+func TestBus(t *testing.T) {
+	b := New(3)
+	alice, replay, ok := b.Subscribe("alice", 0)
+	if !ok || len(replay) != 0 || alice.Start() != 0 {
+		t.Fatalf("Subscribe = %v, %v, start %d", replay, ok, alice.Start())
+	}
+	b.Publish("alice", "added", "AAPL")
+	b.Publish("bob", "added", "MSFT")
+	b.Publish("alice", "removed", "AAPL")
+
+	for _, want := range []uint64{1, 3} {
+		if e := <-alice.Events(); e.ID != want || e.Topic != "alice" {
+			t.Errorf("event = %+v, want ID %d of alice", e, want)
+		}
+	}
+	select {
+	case e := <-alice.Events():
+		t.Errorf("unexpected event %+v", e)
+	default:
+	}
+
+	// Resuming replays the events of the topic published since.
+	sub, replay, ok := b.Subscribe("alice", 1)
+	if !ok || len(replay) != 1 || replay[0].ID != 3 || sub.Start() != 3 {
+		t.Errorf("Subscribe after 1 = %v, %v, start %d, want event 3", ids(replay), ok, sub.Start())
+	}
+	sub.Close()
+	sub.Close()
+	if _, ok := <-sub.Events(); ok {
+		t.Error("Events of a closed subscription is open")
+	}
+
+	// Only the last 3 events of a topic are retained.
+	for range 3 {
+		b.Publish("alice", "updated", "AAPL")
+	}
+	if _, replay, ok := b.Subscribe("alice", 1); ok || replay != nil {
+		t.Errorf("Subscribe after a dropped event = %v, %v, want not ok", ids(replay), ok)
+	}
+	if _, replay, ok := b.Subscribe("alice", 3); !ok || len(replay) != 3 {
+		t.Errorf("Subscribe after 3 = %v, %v, want 3 events", ids(replay), ok)
+	}
+	if _, _, ok := b.Subscribe("alice", 99); ok {
+		t.Error("Subscribe after an unknown event is ok")
+	}
+
+	// IDs name the bus instance.
+	id := b.FormatID(42)
+	if got, ok := b.ParseID(id); !ok || got != 42 {
+		t.Errorf("ParseID(%q) = %d, %v", id, got, ok)
+	}
+	for _, s := range []string{"42", "x-42", b.FormatID(42) + "x", New(0).FormatID(42)} {
+		if _, ok := b.ParseID(s); ok {
+			t.Errorf("ParseID(%q) is ok", s)
+		}
+	}
+
+	b.Close()
+	if _, ok := <-alice.Events(); !ok {
+		// alice still has the 3 updates buffered.
+		t.Fatal("buffered events lost on Close")
+	}
+	for range alice.Events() {
+	}
+	late, _, _ := b.Subscribe("alice", 0)
+	if _, ok := <-late.Events(); ok {
+		t.Error("Subscribe after Close is open")
+	}
+}
+
+func TestBusDropsSlowSubscribers(t *testing.T) {
+	b := New(0)
+	slow, _, _ := b.Subscribe("alice", 0)
+	for range subscriberBuffer + 1 {
+		b.Publish("alice", "updated", nil)
+	}
+	n := 0
+	for range slow.Events() {
+		n++
+	}
+	if n != subscriberBuffer {
+		t.Errorf("slow subscriber received %d events, want %d before being dropped", n, subscriberBuffer)
+	}
+	slow.Close()
+
+	// It resumes from the retained events.
+	_, replay, ok := b.Subscribe("alice", uint64(n))
+	if !ok || len(replay) != 1 || replay[0].ID != uint64(n+1) {
+		t.Errorf("resume = %v, %v", ids(replay), ok)
+	}
+}
+
+func TestBusEvictsIdleTopics(t *testing.T) {
+	b := New(3)
+	now := time.Now()
+	b.now = func() time.Time { return now }
+
+	// Subscribing creates no topic.
+	bob, _, _ := b.Subscribe("bob", 0)
+	if len(b.topics) != 0 {
+		t.Errorf("topics after Subscribe = %d, want 0", len(b.topics))
+	}
+	b.Publish("alice", "added", "AAPL")
+	b.Publish("bob", "added", "MSFT")
+	bob.Close()
+	if len(b.subs) != 0 {
+		t.Errorf("subscriptions after Close = %d, want 0", len(b.subs))
+	}
+	carol, _, _ := b.Subscribe("carol", 0)
+	b.Publish("carol", "added", "NVDA")
+
+	// Within the resume window the topics are kept.
+	now = now.Add(ResumeWindow / 2)
+	b.Publish("dave", "added", "TSLA")
+	if len(b.topics) != 4 {
+		t.Errorf("topics within the resume window = %d, want 4", len(b.topics))
+	}
+
+	// Past it, those without subscribers are evicted.
+	now = now.Add(ResumeWindow)
+	b.Publish("erin", "added", "AMZN")
+	if _, ok := b.topics["carol"]; !ok || len(b.topics) != 2 {
+		t.Errorf("topics after the resume window = %v, want carol and erin", b.topics)
+	}
+	if e := <-carol.Events(); e.ID != 3 {
+		t.Errorf("carol event = %+v, want ID 3", e)
+	}
+
+	// Resuming an evicted topic starts over, unless no event could be
+	// missed.
+	if _, replay, ok := b.Subscribe("alice", 1); ok || replay != nil {
+		t.Errorf("Subscribe to an evicted topic = %v, %v, want not ok", ids(replay), ok)
+	}
+	if _, replay, ok := b.Subscribe("alice", 5); !ok || len(replay) != 0 {
+		t.Errorf("Subscribe after the evicted events = %v, %v, want ok", ids(replay), ok)
+	}
+	b.Publish("alice", "removed", "AAPL")
+	if _, _, ok := b.Subscribe("alice", 1); ok {
+		t.Error("Subscribe to a recreated topic after evicted events is ok")
+	}
+}
@@ -0,0 +1,354 @@
+package exchange
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	// Embed the IANA time zone database so that calendars load on hosts
+	// (and distroless images) without /usr/share/zoneinfo.
+	_ "time/tzdata"
+)
+
+// calendarsJSON holds the trading calendars of the supported markets. Each
+// calendar lists its holidays for the years from first_year to last_year,
+// the only years it answers for; days of those years outside the listed
+// holidays are regular trading days. Add the holidays of a new year and
+// extend last_year by editing the file.
+//
+//go:embed data/calendars.json
+var calendarsJSON []byte
+
+// Session kinds.
+const (
+	SessionPre     = "pre"
+	SessionRegular = "regular"
+	SessionPost    = "post"
+)
+
+// Market states returned by Calendar.Status. The state is unknown outside
+// the years the calendar covers.
+const (
+	StatusOpen    = "open"
+	StatusClosed  = "closed"
+	StatusPre     = "pre"
+	StatusPost    = "post"
+	StatusUnknown = "unknown"
+)
+
+// Day states returned by Calendar.Day.
+const (
+	DayOpen    = "open"
+	DayHalfDay = "half_day"
+	DayClosed  = "closed"
+)
+
+// dateLayout is the layout of calendar dates.
+const dateLayout = time.DateOnly
+
+// Calendar is the trading calendar of an operating MIC. Its holidays are
+// only known from FirstYear through LastYear.
+type Calendar struct {
+	OperatingMIC string
+	Location     *time.Location
+	FirstYear    int
+	LastYear     int
+	pre          []clockRange
+	regular      []clockRange
+	post         []clockRange
+	weekend      map[time.Weekday]bool
+	holidays     map[string]string
+	halfDays     map[string]clock
+}
+
+// Session is a trading session of a given day.
+type Session struct {
+	Kind  string
+	Open  time.Time
+	Close time.Time
+}
+
+// TradingDay describes a calendar day of a market.
+type TradingDay struct {
+	Date     time.Time
+	Status   string
+	Holiday  string
+	Sessions []Session
+}
+
+// clock is a wall clock time, in minutes after midnight.
+type clock int
+
+func parseClock(s string) (clock, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, want HH:MM", s)
+	}
+	return clock(t.Hour()*60 + t.Minute()), nil
+}
+
+// on returns the wall clock time c on day, which is correct across DST
+// transitions unlike adding a duration to midnight.
+func (c clock) on(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), int(c)/60, int(c)%60, 0, 0, day.Location())
+}
+
+type clockRange struct {
+	open, close clock
+}
+
+// calendarFile is the JSON representation of a calendar.
+type calendarFile struct {
+	OperatingMIC string `json:"operating_mic"`
+	Timezone     string `json:"timezone"`
+	FirstYear    int    `json:"first_year"`
+	LastYear     int    `json:"last_year"`
+	Sessions     struct {
+		Pre     []sessionFile `json:"pre"`
+		Regular []sessionFile `json:"regular"`
+		Post    []sessionFile `json:"post"`
+	} `json:"sessions"`
+	Weekend  []string `json:"weekend"`
+	Holidays []struct {
+		Date string `json:"date"`
+		Name string `json:"name"`
+	} `json:"holidays"`
+	HalfDays []struct {
+		Date  string `json:"date"`
+		Close string `json:"close"`
+	} `json:"half_days"`
+}
+
+type sessionFile struct {
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+// LoadEmbeddedCalendars parses the trading calendars bundled with the binary.
+func LoadEmbeddedCalendars() (map[string]*Calendar, error) {
+	return LoadCalendars(bytes.NewReader(calendarsJSON))
+}
+
+// LoadCalendars parses trading calendars keyed by operating MIC. Any invalid
+// entry is an error.
+func LoadCalendars(r io.Reader) (map[string]*Calendar, error) {
+	var files []calendarFile
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&files); err != nil {
+		return nil, fmt.Errorf("decode calendars: %w", err)
+	}
+	calendars := make(map[string]*Calendar, len(files))
+	for _, f := range files {
+		c, err := f.calendar()
+		if err != nil {
+			return nil, fmt.Errorf("calendar %s: %w", f.OperatingMIC, err)
+		}
+		if _, dup := calendars[c.OperatingMIC]; dup {
+			return nil, fmt.Errorf("calendar %s: defined twice", c.OperatingMIC)
+		}
+		calendars[c.OperatingMIC] = c
+	}
+	return calendars, nil
+}
+
+func (f *calendarFile) calendar() (*Calendar, error) {
+	if len(f.OperatingMIC) != 4 {
+		return nil, errors.New("invalid operating MIC")
+	}
+	loc, err := time.LoadLocation(f.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	if f.FirstYear == 0 || f.LastYear < f.FirstYear {
+		return nil, fmt.Errorf("invalid covered years %d-%d", f.FirstYear, f.LastYear)
+	}
+	c := &Calendar{
+		OperatingMIC: f.OperatingMIC,
+		Location:     loc,
+		FirstYear:    f.FirstYear,
+		LastYear:     f.LastYear,
+		weekend:      map[time.Weekday]bool{time.Saturday: true, time.Sunday: true},
+		holidays:     make(map[string]string, len(f.Holidays)),
+		halfDays:     make(map[string]clock, len(f.HalfDays)),
+	}
+	if c.pre, err = parseRanges(f.Sessions.Pre); err != nil {
+		return nil, fmt.Errorf("pre session: %w", err)
+	}
+	if c.regular, err = parseRanges(f.Sessions.Regular); err != nil {
+		return nil, fmt.Errorf("regular session: %w", err)
+	}
+	if len(c.regular) == 0 {
+		return nil, errors.New("no regular session")
+	}
+	if c.post, err = parseRanges(f.Sessions.Post); err != nil {
+		return nil, fmt.Errorf("post session: %w", err)
+	}
+
+	if len(f.Weekend) > 0 {
+		c.weekend = make(map[time.Weekday]bool, len(f.Weekend))
+		for _, name := range f.Weekend {
+			d, ok := weekdays[name]
+			if !ok {
+				return nil, fmt.Errorf("invalid weekend day %q", name)
+			}
+			c.weekend[d] = true
+		}
+	}
+	for _, h := range f.Holidays {
+		d, err := time.ParseInLocation(dateLayout, h.Date, loc)
+		if err != nil {
+			return nil, fmt.Errorf("holiday %q: %w", h.Name, err)
+		}
+		if !c.Covers(d) {
+			return nil, fmt.Errorf("holiday %s is outside the covered years", h.Date)
+		}
+		c.holidays[h.Date] = h.Name
+	}
+	for _, h := range f.HalfDays {
+		d, err := time.ParseInLocation(dateLayout, h.Date, loc)
+		if err != nil {
+			return nil, fmt.Errorf("half day: %w", err)
+		}
+		if !c.Covers(d) {
+			return nil, fmt.Errorf("half day %s is outside the covered years", h.Date)
+		}
+		closing, err := parseClock(h.Close)
+		if err != nil {
+			return nil, fmt.Errorf("half day %s: %w", h.Date, err)
+		}
+		c.halfDays[h.Date] = closing
+	}
+	return c, nil
+}
+
+var weekdays = map[string]time.Weekday{
+	"Sunday": time.Sunday, "Monday": time.Monday, "Tuesday": time.Tuesday, "Wednesday": time.Wednesday,
+	"Thursday": time.Thursday, "Friday": time.Friday, "Saturday": time.Saturday,
+}
+
+func parseRanges(sessions []sessionFile) ([]clockRange, error) {
+	ranges := make([]clockRange, len(sessions))
+	for i, s := range sessions {
+		open, err := parseClock(s.Open)
+		if err != nil {
+			return nil, err
+		}
+		closing, err := parseClock(s.Close)
+		if err != nil {
+			return nil, err
+		}
+		if closing <= open || (i > 0 && open < ranges[i-1].close) {
+			return nil, fmt.Errorf("invalid session %s-%s", s.Open, s.Close)
+		}
+		ranges[i] = clockRange{open: open, close: closing}
+	}
+	return ranges, nil
+}
+
+// Covers reports whether the holidays of the calendar day containing t are
+// known, in the market's time zone.
+func (c *Calendar) Covers(t time.Time) bool {
+	y := t.In(c.Location).Year()
+	return y >= c.FirstYear && y <= c.LastYear
+}
+
+// Day returns the sessions of the calendar day containing t, in the market's
+// time zone. On half days the regular session closes early and there is no
+// post-market session.
+func (c *Calendar) Day(t time.Time) TradingDay {
+	t = t.In(c.Location)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, c.Location)
+	key := day.Format(dateLayout)
+	td := TradingDay{Date: day, Status: DayOpen}
+
+	if name, ok := c.holidays[key]; ok {
+		td.Status, td.Holiday = DayClosed, name
+		return td
+	}
+	if c.weekend[day.Weekday()] {
+		td.Status = DayClosed
+		return td
+	}
+
+	add := func(kind string, ranges []clockRange, limit clock) {
+		for _, r := range ranges {
+			if r.open >= limit {
+				break
+			}
+			td.Sessions = append(td.Sessions, Session{Kind: kind, Open: r.open.on(day), Close: min(r.close, limit).on(day)})
+		}
+	}
+	const endOfDay = clock(24 * 60)
+	halfClose, half := c.halfDays[key]
+	add(SessionPre, c.pre, endOfDay)
+	if half {
+		td.Status = DayHalfDay
+		add(SessionRegular, c.regular, halfClose)
+		return td
+	}
+	add(SessionRegular, c.regular, endOfDay)
+	add(SessionPost, c.post, endOfDay)
+	return td
+}
+
+// Days returns the trading days from the day containing from through the
+// day containing to, inclusive.
+func (c *Calendar) Days(from, to time.Time) []TradingDay {
+	var days []TradingDay
+	for d := c.Day(from).Date; !d.After(to.In(c.Location)); d = d.AddDate(0, 0, 1) {
+		days = append(days, c.Day(d))
+	}
+	return days
+}
+
+// maxStatusLookahead bounds the search for the next open or close, which
+// must cover the longest closure in the calendar (e.g. Golden Week).
+const maxStatusLookahead = 31
+
+// Status returns the state of the market at t and the next time the regular
+// session opens and closes after t. A lunch break is reported as closed.
+// next_open or next_close is zero if there is none within a month, or
+// before the end of the covered years. Outside the covered years the state
+// is unknown.
+func (c *Calendar) Status(t time.Time) (status string, nextOpen, nextClose time.Time) {
+	if !c.Covers(t) {
+		return StatusUnknown, time.Time{}, time.Time{}
+	}
+	status = StatusClosed
+	var sessions []Session
+	for _, d := range c.Days(t.AddDate(0, 0, -1), t.AddDate(0, 0, maxStatusLookahead)) {
+		if c.Covers(d.Date) {
+			sessions = append(sessions, d.Sessions...)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].Open.Before(sessions[j].Open) })
+
+	for _, s := range sessions {
+		if !t.Before(s.Open) && t.Before(s.Close) {
+			switch s.Kind {
+			case SessionRegular:
+				status = StatusOpen
+			case SessionPre:
+				status = StatusPre
+			case SessionPost:
+				status = StatusPost
+			}
+		}
+		if s.Kind != SessionRegular {
+			continue
+		}
+		if nextOpen.IsZero() && s.Open.After(t) {
+			nextOpen = s.Open
+		}
+		if nextClose.IsZero() && s.Close.After(t) {
+			nextClose = s.Close
+		}
+	}
+	return status, nextOpen, nextClose
+}
@@ -0,0 +1,227 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	exchangeGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/exchange"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+const testCalendars = `[
+  {
+    "operating_mic": "XNYS",
+    "timezone": "America/New_York",
+    "first_year": 2026,
+    "last_year": 2027,
+    "sessions": {
+      "pre": [{"open": "04:00", "close": "09:30"}],
+      "regular": [{"open": "09:30", "close": "16:00"}],
+      "post": [{"open": "16:00", "close": "20:00"}]
+    },
+    "holidays": [{"date": "2026-11-26", "name": "Thanksgiving Day"}],
+    "half_days": [{"date": "2026-11-27", "close": "13:00"}]
+  },
+  {
+    "operating_mic": "BATS",
+    "timezone": "Asia/Tokyo",
+    "first_year": 2026,
+    "last_year": 2026,
+    "sessions": {
+      "regular": [{"open": "09:00", "close": "11:30"}, {"open": "12:30", "close": "15:30"}]
+    },
+    "weekend": ["Friday", "Saturday"]
+  }
+]`
+
+func loadTestCalendars(t *testing.T) map[string]*Calendar {
+	t.Helper()
+	calendars, err := LoadCalendars(strings.NewReader(testCalendars))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return calendars
+}
+
+func at(t *testing.T, c *Calendar, s string) time.Time {
+	t.Helper()
+	ts, err := time.ParseInLocation("2006-01-02 15:04", s, c.Location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ts
+}
+
+func TestCalendarDay(t *testing.T) {
+	calendars := loadTestCalendars(t)
+	nyse := calendars["XNYS"]
+
+	for date, want := range map[string]string{
+		"2026-11-25 12:00": DayOpen,
+		"2026-11-26 12:00": DayClosed,
+		"2026-11-27 12:00": DayHalfDay,
+		"2026-11-28 12:00": DayClosed,
+	} {
+		if got := nyse.Day(at(t, nyse, date)).Status; got != want {
+			t.Errorf("%s: status %s, want %s", date, got, want)
+		}
+	}
+
+	half := nyse.Day(at(t, nyse, "2026-11-27 12:00"))
+	if len(half.Sessions) != 2 || half.Sessions[1].Close.Hour() != 13 {
+		t.Errorf("half day sessions = %+v, want pre and regular until 13:00", half.Sessions)
+	}
+
+	// Sessions keep their wall clock times on DST transition days.
+	dst := nyse.Day(at(t, nyse, "2026-03-09 00:00"))
+	if open := dst.Sessions[1].Open; open.Hour() != 9 || open.Minute() != 30 {
+		t.Errorf("regular open on DST day = %s", open)
+	}
+
+	tse := calendars["BATS"]
+	if tse.Day(at(t, tse, "2026-10-16 12:00")).Status != DayClosed {
+		t.Error("Friday should be a weekend day")
+	}
+	if tse.Day(at(t, tse, "2026-10-18 12:00")).Status != DayOpen {
+		t.Error("Sunday should be a trading day")
+	}
+}
+
+func TestCalendarStatus(t *testing.T) {
+	calendars := loadTestCalendars(t)
+	nyse := calendars["XNYS"]
+	tse := calendars["BATS"]
+
+	for _, tc := range []struct {
+		cal                 *Calendar
+		now                 string
+		status              string
+		nextOpen, nextClose string
+	}{
+		{nyse, "2026-11-25 03:00", StatusClosed, "2026-11-25 09:30", "2026-11-25 16:00"},
+		{nyse, "2026-11-25 08:00", StatusPre, "2026-11-25 09:30", "2026-11-25 16:00"},
+		{nyse, "2026-11-25 10:00", StatusOpen, "2026-11-27 09:30", "2026-11-25 16:00"},
+		{nyse, "2026-11-25 17:00", StatusPost, "2026-11-27 09:30", "2026-11-27 13:00"},
+		{nyse, "2026-11-27 14:00", StatusClosed, "2026-11-30 09:30", "2026-11-30 16:00"},
+		{tse, "2026-10-19 12:00", StatusClosed, "2026-10-19 12:30", "2026-10-19 15:30"},
+	} {
+		status, nextOpen, nextClose := tc.cal.Status(at(t, tc.cal, tc.now))
+		if status != tc.status || !nextOpen.Equal(at(t, tc.cal, tc.nextOpen)) || !nextClose.Equal(at(t, tc.cal, tc.nextClose)) {
+			t.Errorf("%s %s: got %s, %s, %s; want %s, %s, %s", tc.cal.OperatingMIC, tc.now,
+				status, nextOpen, nextClose, tc.status, tc.nextOpen, tc.nextClose)
+		}
+	}
+}
+
+func TestCalendarCoverage(t *testing.T) {
+	tse := loadTestCalendars(t)["BATS"]
+
+	if status, nextOpen, nextClose := tse.Status(at(t, tse, "2027-01-04 10:00")); status != StatusUnknown || !nextOpen.IsZero() || !nextClose.IsZero() {
+		t.Errorf("status after the covered years = %s, %s, %s; want unknown", status, nextOpen, nextClose)
+	}
+	// The next open would fall in 2027, whose holidays are not known.
+	if status, nextOpen, _ := tse.Status(at(t, tse, "2026-12-31 16:00")); status != StatusClosed || !nextOpen.IsZero() {
+		t.Errorf("status at the end of the covered years = %s, next open %s; want closed without next open", status, nextOpen)
+	}
+}
+
+func TestLoadCalendarsInvalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"unknown zone":  strings.Replace(testCalendars, "America/New_York", "America/Gotham", 1),
+		"bad clock":     strings.Replace(testCalendars, `"09:30", "close": "16:00"`, `"9.30", "close": "16:00"`, 1),
+		"overlapping":   strings.Replace(testCalendars, `"12:30", "close": "15:30"`, `"11:00", "close": "15:30"`, 1),
+		"bad date":      strings.Replace(testCalendars, "2026-11-26", "26/11/2026", 1),
+		"unknown field": strings.Replace(testCalendars, `"weekend"`, `"weekends"`, 1),
+		"no years":      strings.Replace(testCalendars, `"first_year": 2026,`, ``, 1),
+		"reversed":      strings.Replace(testCalendars, `"last_year": 2027`, `"last_year": 2025`, 1),
+		"not covered":   strings.Replace(testCalendars, "2026-11-26", "2028-11-23", 1),
+	} {
+		if _, err := LoadCalendars(strings.NewReader(data)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestLoadEmbeddedCalendars(t *testing.T) {
+	calendars, err := LoadEmbeddedCalendars()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchanges, err := LoadEmbedded()
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(exchanges, calendars)
+	// Calendars must be extended a year before they run out, as exchanges
+	// publish their holidays for the next year.
+	deadline := time.Now().AddDate(1, 0, 0)
+	for mic, c := range calendars {
+		if _, err := svc.lookup(mic); err != nil {
+			t.Errorf("calendar %s has no active operating MIC", mic)
+		}
+		if end := time.Date(c.LastYear, time.December, 31, 0, 0, 0, 0, c.Location); end.Before(deadline) {
+			t.Errorf("calendar %s ends on %s, within a year: add the holidays of the following years", mic, end.Format(dateLayout))
+		}
+	}
+}
+
+func TestServiceCalendar(t *testing.T) {
+	exchanges, err := Load(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(exchanges, loadTestCalendars(t))
+	svc.now = func() time.Time { return time.Date(2026, 11, 25, 15, 0, 0, 0, time.UTC) }
+	ctx := context.Background()
+
+	res, err := svc.Calendar(ctx, &exchangeGen.CalendarPayload{OperatingMic: "XNYS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Days) != defaultCalendarDays || res.Days[0].Date != "2026-11-25" || res.Days[1].Holiday == nil {
+		t.Errorf("unexpected default calendar: %d days from %s", len(res.Days), res.Days[0].Date)
+	}
+
+	st, err := svc.Status(ctx, &exchangeGen.StatusPayload{OperatingMic: "xnys"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Status != StatusOpen || st.NextClose == nil || *st.NextClose != "2026-11-25T16:00:00-05:00" {
+		t.Errorf("unexpected status %+v", st)
+	}
+
+	// The default range stops at the end of the covered years, and the
+	// status is unknown past them.
+	svc.now = func() time.Time { return time.Date(2027, 12, 20, 15, 0, 0, 0, time.UTC) }
+	res, err = svc.Calendar(ctx, &exchangeGen.CalendarPayload{OperatingMic: "XNYS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last := res.Days[len(res.Days)-1].Date; last != "2027-12-31" {
+		t.Errorf("default calendar ends on %s, want 2027-12-31", last)
+	}
+	svc.now = func() time.Time { return time.Date(2028, 1, 3, 15, 0, 0, 0, time.UTC) }
+	if st, err = svc.Status(ctx, &exchangeGen.StatusPayload{OperatingMic: "XNYS"}); err != nil || st.Status != StatusUnknown {
+		t.Errorf("status past the covered years = %+v, %v; want unknown", st, err)
+	}
+
+	for name, tc := range map[string]struct {
+		mic, from, to, errName string
+	}{
+		"reversed":    {"XNYS", "2026-12-01", "2026-11-01", "invalid_range"},
+		"too long":    {"XNYS", "2026-01-01", "2027-12-31", "invalid_range"},
+		"no calendar": {"XBER", "2026-01-01", "2026-01-31", "not_found"},
+		"not covered": {"XNYS", "2027-12-01", "2028-01-31", "invalid_range"},
+		"too early":   {"XNYS", "2025-12-01", "2026-01-31", "invalid_range"},
+	} {
+		_, err := svc.Calendar(ctx, &exchangeGen.CalendarPayload{OperatingMic: tc.mic, From: &tc.from, To: &tc.to})
+		var serr *goa.ServiceError
+		if !errors.As(err, &serr) || serr.Name != tc.errName {
+			t.Errorf("%s: error = %v, want %s", name, err, tc.errName)
+		}
+	}
+}
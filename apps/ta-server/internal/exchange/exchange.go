@@ -0,0 +1,250 @@
+// Package exchange serves the ISO 10383 Market Identifier Code (MIC) list of
+// active operating exchanges.
+package exchange
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// micCSV is a curated subset of the ISO 10383 MIC list published by ISO 20022
+// (https://www.iso20022.org/market-identifier-codes): about 80 operating and
+// segment MICs of the major markets, in the columns and ISO-8859-1 encoding of
+// the published ISO10383_MIC.csv. The full list is meant to be bundled
+// unchanged: `moon run ta-server:mic-data` downloads the published file over
+// this one. A running server can also import it (exchanges import).
+//
+//go:embed data/ISO10383_MIC.csv
+var micCSV []byte
+
+// Column headers of the ISO 10383 CSV used by the loader.
+const (
+	colMIC          = "MIC"
+	colOperatingMIC = "OPERATING MIC"
+	colType         = "OPRT/SGMT"
+	colName         = "MARKET NAME-INSTITUTION DESCRIPTION"
+	colCategory     = "MARKET CATEGORY CODE"
+	colAcronym      = "ACRONYM"
+	colCountry      = "ISO COUNTRY CODE (ISO 3166)"
+	colCity         = "CITY"
+	colWebsite      = "WEBSITE"
+	colStatus       = "STATUS"
+	colCreated      = "CREATION DATE"
+	colModified     = "LAST UPDATE DATE"
+)
+
+// requiredColumns lists the columns the loader reads.
+var requiredColumns = []string{colMIC, colOperatingMIC, colType, colName, colCategory, colAcronym, colCountry, colCity, colWebsite, colStatus, colCreated, colModified}
+
+// micDateLayout is the layout of the dates in the ISO 10383 CSV.
+const micDateLayout = "20060102"
+
+// Exchange is an active operating market from the ISO 10383 MIC list.
+type Exchange struct {
+	OperatingMIC   string
+	Name           string
+	DisplayName    string
+	Country        string
+	City           string
+	Acronym        string
+	MarketCategory string
+	Status         string
+	Website        string
+	Created        time.Time
+	LastModified   time.Time
+	// Segments lists the active segment MICs of the market, sorted by MIC.
+	Segments []*Segment
+}
+
+// Segment is an active segment MIC: a section of an operating market, such
+// as a trading platform dedicated to a specific asset class.
+type Segment struct {
+	MIC            string
+	OperatingMIC   string
+	Name           string
+	City           string
+	Acronym        string
+	MarketCategory string
+	Status         string
+	Website        string
+	Created        time.Time
+	LastModified   time.Time
+}
+
+// displayName formats the label used by exchange select lists:
+// "ACRONYM - NAME (CC)", or "NAME (CC)" when the exchange has no acronym.
+func displayName(acronym, name, country string) string {
+	if acronym == "" {
+		return fmt.Sprintf("%s (%s)", name, country)
+	}
+	return fmt.Sprintf("%s - %s (%s)", acronym, name, country)
+}
+
+// excelEpoch is day zero of the serial dates of spreadsheet cells.
+var excelEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// parseMICDate parses an ISO 10383 date in micDateLayout or, as read from a
+// workbook cell formatted as a date, a serial day number. An empty value is
+// the zero time.
+func parseMICDate(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	if len(v) < len(micDateLayout) {
+		if days, err := strconv.Atoi(v); err == nil && days > 0 {
+			return excelEpoch.AddDate(0, 0, days), nil
+		}
+	}
+	return time.Parse(micDateLayout, v)
+}
+
+// LoadEmbedded parses the MIC list bundled with the binary.
+func LoadEmbedded() ([]*Exchange, error) {
+	return Load(bytes.NewReader(micCSV))
+}
+
+// Load parses an ISO-8859-1 encoded ISO 10383 CSV and returns the active
+// operating MICs sorted by country and name, each carrying its active
+// segment MICs. Inactive entries and segments of inactive markets are
+// skipped. A malformed file is an error, so that the server refuses to start
+// with a partial list.
+func Load(r io.Reader) ([]*Exchange, error) {
+	cr := csv.NewReader(charmap.ISO8859_1.NewDecoder().Reader(r))
+	return parse("line", func() ([]string, int, error) {
+		rec, err := cr.Read()
+		if err != nil {
+			return nil, 0, err
+		}
+		line, _ := cr.FieldPos(0)
+		return rec, line, nil
+	})
+}
+
+// parse builds the exchange list from the records returned by next, the
+// first of which is the header, until next returns io.EOF. Errors locate
+// records by the position returned by next, a pos number.
+func parse(pos string, next func() (rec []string, n int, err error)) ([]*Exchange, error) {
+	header, _, err := next()
+	if err != nil {
+		return nil, fmt.Errorf("read MIC header: %w", err)
+	}
+	col := make(map[string]int, len(header))
+	for i, h := range header {
+		col[strings.TrimSpace(h)] = i
+	}
+	for _, name := range requiredColumns {
+		if _, ok := col[name]; !ok {
+			return nil, fmt.Errorf("MIC file is missing column %q", name)
+		}
+	}
+	field := func(rec []string, name string) string {
+		// Spreadsheet rows omit trailing empty cells.
+		if i := col[name]; i < len(rec) {
+			return strings.TrimSpace(rec[i])
+		}
+		return ""
+	}
+
+	date := func(rec []string, name string) (time.Time, error) {
+		return parseMICDate(field(rec, name))
+	}
+
+	var (
+		exchanges []*Exchange
+		segments  []*Segment
+	)
+	for {
+		rec, line, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read MIC file: %w", err)
+		}
+		if field(rec, colStatus) != "ACTIVE" {
+			continue
+		}
+		created, err := date(rec, colCreated)
+		if err != nil {
+			return nil, fmt.Errorf("MIC file %s %d: invalid creation date: %w", pos, line, err)
+		}
+		modified, err := date(rec, colModified)
+		if err != nil {
+			return nil, fmt.Errorf("MIC file %s %d: invalid last update date: %w", pos, line, err)
+		}
+
+		switch kind := field(rec, colType); kind {
+		case "OPRT":
+			e := &Exchange{
+				OperatingMIC:   field(rec, colOperatingMIC),
+				Name:           field(rec, colName),
+				Country:        field(rec, colCountry),
+				City:           field(rec, colCity),
+				Acronym:        field(rec, colAcronym),
+				MarketCategory: field(rec, colCategory),
+				Status:         field(rec, colStatus),
+				Website:        field(rec, colWebsite),
+				Created:        created,
+				LastModified:   modified,
+			}
+			if len(e.OperatingMIC) != 4 || e.Name == "" || len(e.Country) != 2 {
+				return nil, fmt.Errorf("MIC file %s %d: invalid operating MIC entry %q", pos, line, e.OperatingMIC)
+			}
+			e.DisplayName = displayName(e.Acronym, e.Name, e.Country)
+			exchanges = append(exchanges, e)
+		case "SGMT":
+			sg := &Segment{
+				MIC:            field(rec, colMIC),
+				OperatingMIC:   field(rec, colOperatingMIC),
+				Name:           field(rec, colName),
+				City:           field(rec, colCity),
+				Acronym:        field(rec, colAcronym),
+				MarketCategory: field(rec, colCategory),
+				Status:         field(rec, colStatus),
+				Website:        field(rec, colWebsite),
+				Created:        created,
+				LastModified:   modified,
+			}
+			if len(sg.MIC) != 4 || len(sg.OperatingMIC) != 4 || sg.Name == "" {
+				return nil, fmt.Errorf("MIC file %s %d: invalid segment MIC entry %q", pos, line, sg.MIC)
+			}
+			segments = append(segments, sg)
+		default:
+			return nil, fmt.Errorf("MIC file %s %d: unknown MIC type %q", pos, line, kind)
+		}
+	}
+	if len(exchanges) == 0 {
+		return nil, errors.New("MIC file contains no active operating MICs")
+	}
+
+	// Segments may precede their operating MIC in the file, attach them once
+	// all markets are known.
+	byMIC := make(map[string]*Exchange, len(exchanges))
+	for _, e := range exchanges {
+		byMIC[e.OperatingMIC] = e
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i].MIC < segments[j].MIC })
+	for _, sg := range segments {
+		if e, ok := byMIC[sg.OperatingMIC]; ok {
+			e.Segments = append(e.Segments, sg)
+		}
+	}
+
+	sort.Slice(exchanges, func(i, j int) bool {
+		if exchanges[i].Country != exchanges[j].Country {
+			return exchanges[i].Country < exchanges[j].Country
+		}
+		return exchanges[i].Name < exchanges[j].Name
+	})
+	return exchanges, nil
+}
@@ -0,0 +1,229 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	exchangeGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/exchange"
+	exchangesvr "github.com/reidlai/ta-workspace/apps/ta-server/gen/http/exchange/server"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+const testCSV = `"MIC","OPERATING MIC","OPRT/SGMT","MARKET NAME-INSTITUTION DESCRIPTION","MARKET CATEGORY CODE","ACRONYM","ISO COUNTRY CODE (ISO 3166)","CITY","WEBSITE","STATUS","CREATION DATE","LAST UPDATE DATE"
+"XASE","XNYS","SGMT","NYSE AMERICAN","RMKT","NYSE","US","NEW YORK","WWW.NYSE.COM","ACTIVE","20050530","20200525"
+"XNYS","XNYS","OPRT","NEW YORK STOCK EXCHANGE, INC.","RMKT","NYSE","US","NEW YORK","WWW.NYSE.COM","ACTIVE","20050530","20200525"
+"ARCX","XNYS","SGMT","NYSE ARCA","RMKT","NYSE","US","NEW YORK","WWW.NYSE.COM","ACTIVE","20060403",""
+"XOLD","XNYS","SGMT","NYSE RETIRED SEGMENT","RMKT","","US","NEW YORK","","EXPIRED","20050530","20100101"
+"XDUB","XDUB","OPRT","IRISH STOCK EXCHANGE - ALL MARKET","NSPD","ISE","IE","DUBLIN","WWW.ISE.IE","EXPIRED","20050530","20180924"
+"XEYE","XDUB","SGMT","IRISH STOCK EXCHANGE - MAIN","RMKT","","IE","DUBLIN","","ACTIVE","20050530",""
+"XBER","XBER","OPRT","B` + "\xd6" + `RSE BERLIN","RMKT","","DE","BERLIN","","ACTIVE","",""
+"BATS","BATS","OPRT","CBOE BZX U.S. EQUITIES EXCHANGE","RMKT","CBOE","US","CHICAGO","WWW.CBOE.COM","ACTIVE","20081027","20210927"
+`
+
+func TestLoad(t *testing.T) {
+	exchanges, err := Load(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, e := range exchanges {
+		got = append(got, e.DisplayName)
+	}
+	want := []string{
+		"BÖRSE BERLIN (DE)",
+		"CBOE - CBOE BZX U.S. EQUITIES EXCHANGE (US)",
+		"NYSE - NEW YORK STOCK EXCHANGE, INC. (US)",
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestLoadFailsFast(t *testing.T) {
+	for name, data := range map[string]string{
+		"missing column": "\"MIC\",\"STATUS\"\n\"XNYS\",\"ACTIVE\"\n",
+		"invalid mic":    strings.Replace(testCSV, `"BATS","BATS"`, `"BATS","BAT"`, 1),
+		"invalid date":   strings.Replace(testCSV, `"20081027"`, `"2008-10-27"`, 1),
+		"unknown type":   strings.Replace(testCSV, `"BATS","OPRT"`, `"BATS","OPER"`, 1),
+		"no exchanges":   strings.SplitAfter(testCSV, "\n")[0],
+		"bad quoting":    testCSV + "\"XLON,\"XLON\"\n",
+	} {
+		if _, err := Load(strings.NewReader(data)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestLoadEmbedded(t *testing.T) {
+	exchanges, err := LoadEmbedded()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range exchanges {
+		if e.OperatingMIC == "ARCX" || e.OperatingMIC == "XDUB" {
+			t.Errorf("%s should have been filtered out", e.OperatingMIC)
+		}
+		for _, sg := range e.Segments {
+			if sg.OperatingMIC != e.OperatingMIC {
+				t.Errorf("segment %s attached to %s", sg.MIC, e.OperatingMIC)
+			}
+		}
+	}
+}
+
+func TestServiceListAndGet(t *testing.T) {
+	exchanges, err := Load(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(exchanges, nil)
+	ctx := context.Background()
+
+	for query, want := range map[string]int{"": 3, "us": 2, "exchange": 2, "berlin": 1, "nasdaq": 0} {
+		q := query
+		res, err := svc.List(ctx, &exchangeGen.ListPayload{Query: &q})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Exchanges) != want || res.Total != want {
+			t.Errorf("List(%q) returned %d of %d exchanges, want %d", query, len(res.Exchanges), res.Total, want)
+		}
+	}
+
+	e, err := svc.Get(ctx, &exchangeGen.GetPayload{OperatingMic: "xnys"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Acronym == nil || *e.Acronym != "NYSE" {
+		t.Errorf("unexpected exchange %+v", e)
+	}
+
+	if e.CreationDate == nil || *e.CreationDate != "2005-05-30" || e.MarketCategory != "RMKT" || e.Status != "ACTIVE" {
+		t.Errorf("unexpected exchange attributes %+v", e)
+	}
+
+	tree, err := svc.Segments(ctx, &exchangeGen.SegmentsPayload{OperatingMic: "XNYS"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mics []string
+	for _, sg := range tree.Segments {
+		mics = append(mics, sg.Mic)
+	}
+	if strings.Join(mics, ",") != "ARCX,XASE" {
+		t.Errorf("XNYS segments = %v, want [ARCX XASE]", mics)
+	}
+
+	for mic, want := range map[string]string{"xnys": "XNYS", "ARCX": "XNYS", "XOLD": "", "XDUB": ""} {
+		if got, _ := svc.ResolveMIC(mic); got != want {
+			t.Errorf("ResolveMIC(%s) = %q, want %q", mic, got, want)
+		}
+	}
+
+	_, err = svc.Get(ctx, &exchangeGen.GetPayload{OperatingMic: "ARCX"})
+	var serr *goa.ServiceError
+	if !errors.As(err, &serr) || serr.Name != "not_found" {
+		t.Errorf("Get(ARCX) error = %v, want not_found", err)
+	}
+}
+
+func TestServiceCheck(t *testing.T) {
+	exchanges, err := Load(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(exchanges, nil)
+	if err := svc.Check(context.Background()); err != nil {
+		t.Errorf("Check = %v, want ready", err)
+	}
+	if err := NewService(nil, nil).Check(context.Background()); err == nil {
+		t.Error("Check without exchanges = nil, want an error")
+	}
+}
+
+// A list request without a limit gets every exchange, which the UI select
+// of exchanges relies on.
+func TestListWithoutLimit(t *testing.T) {
+	exchanges, err := Load(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(exchanges, nil)
+	decode := exchangesvr.DecodeListRequest(goahttp.NewMuxer(), goahttp.RequestDecoder)
+	p, err := decode(httptest.NewRequest(http.MethodGet, "/exchanges", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := svc.List(context.Background(), p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Exchanges) != res.Total || res.NextCursor != nil {
+		t.Errorf("List without limit returned %d of %d exchanges, next cursor %v", len(res.Exchanges), res.Total, res.NextCursor)
+	}
+}
+
+func TestServiceListPages(t *testing.T) {
+	exchanges, err := Load(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(exchanges, nil)
+	ctx := context.Background()
+
+	for sort, want := range map[string]string{
+		"name":    "XBER,BATS,XNYS",
+		"country": "XBER,BATS,XNYS",
+		"mic":     "BATS,XBER,XNYS",
+	} {
+		var mics []string
+		limit := 2
+		p := &exchangeGen.ListPayload{Sort: &sort, Limit: &limit}
+		for {
+			res, err := svc.List(ctx, p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Total != 3 || len(res.Exchanges) > limit {
+				t.Fatalf("sort %s: page of %d, total %d", sort, len(res.Exchanges), res.Total)
+			}
+			for _, e := range res.Exchanges {
+				mics = append(mics, e.OperatingMic)
+			}
+			if res.NextCursor == nil {
+				break
+			}
+			p.Cursor = res.NextCursor
+		}
+		if got := strings.Join(mics, ","); got != want {
+			t.Errorf("sort %s: got %s, want %s", sort, got, want)
+		}
+	}
+
+	byMIC, byName := "mic", "name"
+	country, city, acronym := "us", "Chicago", "cboe"
+	res, err := svc.List(ctx, &exchangeGen.ListPayload{Sort: &byMIC, Country: &country, City: &city, Acronym: &acronym})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Total != 1 || res.Exchanges[0].OperatingMic != "BATS" || res.NextCursor != nil {
+		t.Errorf("filtered list = %+v", res)
+	}
+
+	limit := 1
+	first, err := svc.List(ctx, &exchangeGen.ListPayload{Sort: &byMIC, Limit: &limit})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = svc.List(ctx, &exchangeGen.ListPayload{Sort: &byName, Cursor: first.NextCursor})
+	var serr *goa.ServiceError
+	if !errors.As(err, &serr) || serr.Name != "invalid_cursor" {
+		t.Errorf("cursor reused across sorts: error = %v, want invalid_cursor", err)
+	}
+}
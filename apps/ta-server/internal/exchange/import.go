@@ -0,0 +1,234 @@
+package exchange
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/problem"
+)
+
+// MaxImportSize is the largest MIC file accepted by the import endpoint.
+const MaxImportSize = 32 << 20
+
+// zipMagic starts every XLSX workbook.
+var zipMagic = []byte("PK\x03\x04")
+
+// LoadFile parses the ISO 10383 CSV or XLSX file at path.
+func LoadFile(path string) ([]*Exchange, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Decode(data)
+}
+
+// Decode parses an ISO 10383 MIC file, either the ISO-8859-1 CSV or the XLSX
+// workbook published by ISO 20022, told apart by their content.
+func Decode(data []byte) ([]*Exchange, error) {
+	if bytes.HasPrefix(data, zipMagic) {
+		return LoadXLSX(bytes.NewReader(data))
+	}
+	return Load(bytes.NewReader(data))
+}
+
+// LoadXLSX parses an ISO 10383 workbook like Load parses the CSV. The MIC
+// list is read from the first sheet whose first row holds the MIC columns.
+func LoadXLSX(r io.Reader) ([]*Exchange, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("open MIC workbook: %w", err)
+	}
+	defer f.Close()
+
+	for _, sheet := range f.GetSheetList() {
+		// Raw values keep dates as serial numbers rather than in the
+		// display format of the workbook.
+		rows, err := f.GetRows(sheet, excelize.Options{RawCellValue: true})
+		if err != nil {
+			return nil, fmt.Errorf("read MIC workbook sheet %q: %w", sheet, err)
+		}
+		if len(rows) == 0 || !hasColumns(rows[0]) {
+			continue
+		}
+		i := 0
+		return parse("row", func() ([]string, int, error) {
+			if i == len(rows) {
+				return nil, 0, io.EOF
+			}
+			i++
+			return rows[i-1], i, nil
+		})
+	}
+	return nil, errors.New("MIC workbook has no sheet with the MIC columns")
+}
+
+func hasColumns(header []string) bool {
+	for _, name := range requiredColumns {
+		if !slices.Contains(header, name) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff reports the differences between two exchange lists, covering
+// operating and segment MICs. Deactivated MICs are those no longer active,
+// whether expired or removed from the file.
+type Diff struct {
+	Added       []string `json:"added"`
+	Modified    []Change `json:"modified"`
+	Deactivated []string `json:"deactivated"`
+}
+
+// Change lists the attributes of a MIC that differ between two lists.
+type Change struct {
+	MIC    string   `json:"mic"`
+	Fields []string `json:"fields"`
+}
+
+// micAttributes are the compared attributes of an operating or segment MIC,
+// named as in the API.
+type micAttributes [9]string
+
+var micAttributeNames = micAttributes{"operating_mic", "name", "acronym", "country", "city", "market_category", "website", "creation_date", "last_modified_date"}
+
+func flatten(exchanges []*Exchange) map[string]micAttributes {
+	res := make(map[string]micAttributes)
+	for _, e := range exchanges {
+		res[e.OperatingMIC] = micAttributes{e.OperatingMIC, e.Name, e.Acronym, e.Country, e.City, e.MarketCategory, e.Website, dateString(e.Created), dateString(e.LastModified)}
+		for _, sg := range e.Segments {
+			res[sg.MIC] = micAttributes{sg.OperatingMIC, sg.Name, sg.Acronym, e.Country, sg.City, sg.MarketCategory, sg.Website, dateString(sg.Created), dateString(sg.LastModified)}
+		}
+	}
+	return res
+}
+
+func dateString(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+// Compare returns the MICs added to, modified in and deactivated from prev
+// in next, each sorted.
+func Compare(prev, next []*Exchange) *Diff {
+	before, after := flatten(prev), flatten(next)
+	d := &Diff{Added: []string{}, Modified: []Change{}, Deactivated: []string{}}
+	for mic, attrs := range after {
+		old, ok := before[mic]
+		if !ok {
+			d.Added = append(d.Added, mic)
+			continue
+		}
+		var fields []string
+		for i := range attrs {
+			if attrs[i] != old[i] {
+				fields = append(fields, micAttributeNames[i])
+			}
+		}
+		if fields != nil {
+			d.Modified = append(d.Modified, Change{MIC: mic, Fields: fields})
+		}
+	}
+	for mic := range before {
+		if _, ok := after[mic]; !ok {
+			d.Deactivated = append(d.Deactivated, mic)
+		}
+	}
+	slices.Sort(d.Added)
+	slices.Sort(d.Deactivated)
+	slices.SortFunc(d.Modified, func(a, b Change) int { return cmp.Compare(a.MIC, b.MIC) })
+	return d
+}
+
+// ImportReport is the response of the import endpoint.
+type ImportReport struct {
+	// DryRun is set when the list was validated but not replaced.
+	DryRun bool `json:"dry_run"`
+	// Exchanges is the number of active operating MICs in the file.
+	Exchanges int `json:"exchanges"`
+	*Diff
+}
+
+// ImportHandler returns the admin handler replacing the served exchanges
+// with the ISO 10383 CSV or XLSX file in the request body and responding
+// with an ImportReport. With ?dry_run=true the file is only validated and
+// compared. When file is set, an accepted file is first saved there, so that
+// the server loads it again on restart.
+func (s *Service) ImportHandler(file string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		dryRun, err := strconv.ParseBool(r.URL.Query().Get("dry_run"))
+		if err != nil && r.URL.Query().Has("dry_run") {
+			problem.Write(w, r, problem.WithStatus(ctx, http.StatusBadRequest, "dry_run must be a boolean"))
+			return
+		}
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxImportSize))
+		if err != nil {
+			problem.Write(w, r, problem.WithStatus(ctx, http.StatusRequestEntityTooLarge, err.Error()))
+			return
+		}
+		exchanges, err := Decode(data)
+		if err != nil {
+			problem.Write(w, r, problem.WithStatus(ctx, http.StatusUnprocessableEntity, err.Error()))
+			return
+		}
+
+		report := &ImportReport{DryRun: dryRun, Exchanges: len(exchanges)}
+		if dryRun {
+			report.Diff = s.Compare(exchanges)
+		} else {
+			if file != "" {
+				if err := writeFileAtomic(file, data); err != nil {
+					logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to save MIC file", "file", file, "error", err)
+					problem.Write(w, r, problem.WithStatus(ctx, http.StatusInternalServerError, "failed to save the MIC file"))
+					return
+				}
+			}
+			report.Diff = s.Replace(exchanges)
+			logctx.LoggerFromContext(ctx).InfoContext(ctx, "Exchanges imported",
+				"count", len(exchanges),
+				"added", len(report.Added),
+				"modified", len(report.Modified),
+				"deactivated", len(report.Deactivated))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
+
+// writeFileAtomic replaces path with data so that readers never see a
+// partially written file.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,163 @@
+package exchange
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	exchangeGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/exchange"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// updatedCSV is testCSV a release later: BATS renamed, XBER expired, XASE
+// retired and XLON and its segment listed.
+var updatedCSV = strings.NewReplacer(
+	`"BATS","BATS","OPRT","CBOE BZX U.S. EQUITIES EXCHANGE"`, `"BATS","BATS","OPRT","CBOE BZX EXCHANGE"`,
+	`"XBER","XBER","OPRT","B`+"\xd6"+`RSE BERLIN","RMKT","","DE","BERLIN","","ACTIVE"`, `"XBER","XBER","OPRT","B`+"\xd6"+`RSE BERLIN","RMKT","","DE","BERLIN","","EXPIRED"`,
+	`"XASE","XNYS","SGMT","NYSE AMERICAN","RMKT","NYSE","US","NEW YORK","WWW.NYSE.COM","ACTIVE"`, `"XASE","XNYS","SGMT","NYSE AMERICAN","RMKT","NYSE","US","NEW YORK","WWW.NYSE.COM","EXPIRED"`,
+).Replace(testCSV) + `"XLON","XLON","OPRT","LONDON STOCK EXCHANGE","RMKT","LSE","GB","LONDON","WWW.LSEG.COM","ACTIVE","20050530",""
+"XLOM","XLON","SGMT","LONDON STOCK EXCHANGE - MAIN MARKET","RMKT","LSE","GB","LONDON","","ACTIVE","20050530",""
+`
+
+func TestCompare(t *testing.T) {
+	prev, err := Load(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := Load(strings.NewReader(updatedCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := Compare(prev, next)
+	if got := strings.Join(d.Added, ","); got != "XLOM,XLON" {
+		t.Errorf("added = %s", got)
+	}
+	if got := strings.Join(d.Deactivated, ","); got != "XASE,XBER" {
+		t.Errorf("deactivated = %s", got)
+	}
+	if len(d.Modified) != 1 || d.Modified[0].MIC != "BATS" || strings.Join(d.Modified[0].Fields, ",") != "name" {
+		t.Errorf("modified = %+v", d.Modified)
+	}
+	if d := Compare(next, next); len(d.Added)+len(d.Modified)+len(d.Deactivated) != 0 {
+		t.Errorf("Compare(next, next) = %+v, want no differences", d)
+	}
+}
+
+// testXLSX converts testCSV into a workbook whose MIC list is on the second
+// sheet, with the creation dates stored as date cells.
+func testXLSX(t *testing.T) []byte {
+	t.Helper()
+	records, err := csv.NewReader(strings.NewReader(testCSV)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := excelize.NewFile()
+	defer f.Close()
+	if _, err := f.NewSheet("MICs List by CC"); err != nil {
+		t.Fatal(err)
+	}
+	for i, rec := range records {
+		row := make([]any, len(rec))
+		for j, v := range rec {
+			row[j] = strings.ToValidUTF8(v, "Ö")
+			if i > 0 && j == 10 && v != "" {
+				d, err := parseMICDate(v)
+				if err != nil {
+					t.Fatal(err)
+				}
+				row[j] = d
+			}
+		}
+		cell, _ := excelize.CoordinatesToCellName(1, i+1)
+		if err := f.SetSheetRow("MICs List by CC", cell, &row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var buf bytes.Buffer
+	if err := f.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecodeXLSX(t *testing.T) {
+	fromCSV, err := Load(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromXLSX, err := Decode(testXLSX(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := Compare(fromCSV, fromXLSX); len(d.Added)+len(d.Modified)+len(d.Deactivated) != 0 {
+		t.Errorf("workbook differs from CSV: %+v", d)
+	}
+}
+
+func TestImportHandler(t *testing.T) {
+	exchanges, err := Load(strings.NewReader(testCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(exchanges, nil)
+	file := filepath.Join(t.TempDir(), "mic.csv")
+	h := svc.ImportHandler(file)
+
+	post := func(query, body string) (*httptest.ResponseRecorder, *ImportReport) {
+		t.Helper()
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/exchanges/import"+query, strings.NewReader(body)))
+		var r ImportReport
+		if w.Code == http.StatusOK {
+			if err := json.NewDecoder(w.Body).Decode(&r); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return w, &r
+	}
+	count := func() int {
+		res, err := svc.List(context.Background(), &exchangeGen.ListPayload{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Total
+	}
+
+	if w, _ := post("", "\"MIC\"\n\"XNYS\"\n"); w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("invalid file: status %d, want 422", w.Code)
+	}
+
+	w, r := post("?dry_run=true", updatedCSV)
+	if w.Code != http.StatusOK || !r.DryRun || len(r.Added) != 2 {
+		t.Fatalf("dry run: status %d, report %+v", w.Code, r)
+	}
+	if n := count(); n != 3 {
+		t.Errorf("dry run replaced the list: %d exchanges", n)
+	}
+	if _, err := os.Stat(file); !os.IsNotExist(err) {
+		t.Errorf("dry run saved the file: %v", err)
+	}
+
+	w, r = post("", updatedCSV)
+	if w.Code != http.StatusOK || r.DryRun || r.Exchanges != 3 || len(r.Deactivated) != 2 {
+		t.Fatalf("import: status %d, report %+v", w.Code, r)
+	}
+	if _, err := svc.Get(context.Background(), &exchangeGen.GetPayload{OperatingMic: "XLON"}); err != nil {
+		t.Errorf("imported exchange not served: %v", err)
+	}
+	saved, err := LoadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := svc.Compare(saved); len(d.Added)+len(d.Modified)+len(d.Deactivated) != 0 {
+		t.Errorf("saved file differs from the served list: %+v", d)
+	}
+}
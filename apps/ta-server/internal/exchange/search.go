@@ -0,0 +1,267 @@
+package exchange
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Fields of an exchange indexed for search, in decreasing weight.
+const (
+	fieldMIC = iota
+	fieldAcronym
+	fieldName
+	fieldCity
+	fieldCountry
+)
+
+// fieldWeights ranks a match on an exchange code above one on its name, and
+// one on its name above one on where it is.
+var fieldWeights = [...]int{
+	fieldMIC:     5,
+	fieldAcronym: 4,
+	fieldName:    3,
+	fieldCity:    2,
+	fieldCountry: 2,
+}
+
+// Scores of the ways a query token can match an indexed token, multiplied
+// by the field weight.
+const (
+	matchExact  = 4
+	matchPrefix = 2
+	matchFuzzy  = 1
+)
+
+// fuzzyMinLen is the length from which a query token matches indexed tokens
+// within one edit, and fuzzyMinLen2 within two edits. Shorter tokens only
+// match exactly or as a prefix.
+const (
+	fuzzyMinLen  = 4
+	fuzzyMinLen2 = 8
+)
+
+type posting struct {
+	doc   int32
+	field int8
+}
+
+// searchIndex is an inverted index over the MIC, acronym, name, city and
+// country of the exchanges, built once and read concurrently.
+type searchIndex struct {
+	exchanges []*Exchange
+	postings  map[string][]posting
+	// vocab holds the keys of postings sorted, for prefix lookups.
+	vocab []string
+	// deletes maps the strings obtained by deleting up to two characters
+	// from the tokens of vocab to their positions in vocab. Two tokens within
+	// n edits share such a string with at most n deletions from each, which
+	// narrows fuzzy matching to a few candidates instead of the vocabulary.
+	deletes map[string][]int32
+}
+
+// hit is an exchange matching a search query with its relevance score.
+type hit struct {
+	exchange *Exchange
+	score    int
+}
+
+func newSearchIndex(exchanges []*Exchange) *searchIndex {
+	x := &searchIndex{exchanges: exchanges, postings: make(map[string][]posting)}
+	for i, e := range exchanges {
+		for field, text := range [...]string{
+			fieldMIC:     e.OperatingMIC,
+			fieldAcronym: e.Acronym,
+			fieldName:    e.Name,
+			fieldCity:    e.City,
+			fieldCountry: e.Country,
+		} {
+			for _, tok := range tokenize(text) {
+				ps := x.postings[tok]
+				if n := len(ps); n > 0 && ps[n-1].doc == int32(i) && ps[n-1].field == int8(field) {
+					continue
+				}
+				x.postings[tok] = append(ps, posting{doc: int32(i), field: int8(field)})
+			}
+		}
+	}
+	x.vocab = make([]string, 0, len(x.postings))
+	for tok := range x.postings {
+		x.vocab = append(x.vocab, tok)
+	}
+	sort.Strings(x.vocab)
+
+	x.deletes = make(map[string][]int32)
+	for i, tok := range x.vocab {
+		for del := range deletions(tok, maxEdits(len(tok)+2)) {
+			x.deletes[del] = append(x.deletes[del], int32(i))
+		}
+	}
+	return x
+}
+
+// search returns the exchanges matching every token of query, by decreasing
+// score and then by name and MIC. A query token matches an indexed token
+// that equals it, starts with it or, for longer tokens, is within one or two
+// edits of it; the best match on each exchange counts, weighted by field.
+func (x *searchIndex) search(query string) []hit {
+	tokens := tokenize(query)
+	if len(tokens) == 0 {
+		return nil
+	}
+	total := make([]int, len(x.exchanges))
+	matched := make([]int, len(x.exchanges))
+	best := make([]int, len(x.exchanges))
+	for _, qt := range tokens {
+		clear(best)
+		x.match(qt, func(tok string, kind int) {
+			for _, p := range x.postings[tok] {
+				if s := kind * fieldWeights[p.field]; s > best[p.doc] {
+					best[p.doc] = s
+				}
+			}
+		})
+		for doc, s := range best {
+			if s > 0 {
+				total[doc] += s
+				matched[doc]++
+			}
+		}
+	}
+
+	var hits []hit
+	for doc, n := range matched {
+		if n == len(tokens) {
+			hits = append(hits, hit{exchange: x.exchanges[doc], score: total[doc]})
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		a, b := hits[i], hits[j]
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		if a.exchange.Name != b.exchange.Name {
+			return a.exchange.Name < b.exchange.Name
+		}
+		return a.exchange.OperatingMIC < b.exchange.OperatingMIC
+	})
+	return hits
+}
+
+// match calls fn with every indexed token matching the query token qt and
+// the kind of match.
+func (x *searchIndex) match(qt string, fn func(tok string, kind int)) {
+	lo := sort.SearchStrings(x.vocab, qt)
+	hi := lo
+	for ; hi < len(x.vocab) && strings.HasPrefix(x.vocab[hi], qt); hi++ {
+		if x.vocab[hi] == qt {
+			fn(qt, matchExact)
+		} else {
+			fn(x.vocab[hi], matchPrefix)
+		}
+	}
+
+	maxDist := maxEdits(len(qt))
+	if maxDist == 0 {
+		return
+	}
+	seen := make(map[int32]bool)
+	for del := range deletions(qt, maxDist) {
+		for _, i := range x.deletes[del] {
+			if seen[i] || (int(i) >= lo && int(i) < hi) {
+				continue
+			}
+			seen[i] = true
+			if editDistance(qt, x.vocab[i], maxDist) <= maxDist {
+				fn(x.vocab[i], matchFuzzy)
+			}
+		}
+	}
+}
+
+// maxEdits returns the number of edits a query token of n bytes tolerates.
+func maxEdits(n int) int {
+	switch {
+	case n >= fuzzyMinLen2:
+		return 2
+	case n >= fuzzyMinLen:
+		return 1
+	}
+	return 0
+}
+
+// deletions returns s and every distinct string obtained by deleting up to
+// n of its characters.
+func deletions(s string, n int) map[string]struct{} {
+	res := map[string]struct{}{s: {}}
+	level := []string{s}
+	for ; n > 0; n-- {
+		var next []string
+		for _, w := range level {
+			r := []rune(w)
+			for i := range r {
+				del := string(r[:i]) + string(r[i+1:])
+				if _, ok := res[del]; !ok {
+					res[del] = struct{}{}
+					next = append(next, del)
+				}
+			}
+		}
+		level = next
+	}
+	return res
+}
+
+// tokenize splits s into alphanumeric tokens, lowercased and stripped of
+// diacritics so that "Börse" matches "borse".
+func tokenize(s string) []string {
+	fold := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), runes.Map(unicode.ToLower), norm.NFC)
+	folded, _, err := transform.String(fold, s)
+	if err != nil {
+		folded = strings.ToLower(s)
+	}
+	return strings.FieldsFunc(folded, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// editDistance returns the optimal string alignment distance between a and
+// b, counting an adjacent transposition as one edit, or limit+1 as soon as
+// it exceeds limit.
+func editDistance(a, b string, limit int) int {
+	if d := len(a) - len(b); d > limit || -d > limit {
+		return limit + 1
+	}
+	ra, rb := []rune(a), []rune(b)
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d := min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d = min(d, prev2[j-2]+1)
+			}
+			cur[j] = d
+			rowMin = min(rowMin, d)
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return min(prev[len(rb)], limit+1)
+}
@@ -0,0 +1,167 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	exchangeGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/exchange"
+)
+
+func TestSearchRanksBestMatchFirst(t *testing.T) {
+	exchanges, err := LoadEmbedded()
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := newSearchIndex(exchanges)
+
+	for query, want := range map[string]string{
+		"Nasdq":                "XNAS", // typo
+		"lse":                  "XLON", // acronym
+		"xnys":                 "XNYS", // MIC
+		"borse berlin":         "XBER", // diacritics and several tokens
+		"lond":                 "XLON", // prefix
+		"londn stock exhcange": "XLON", // typos and transposition
+	} {
+		hits := x.search(query)
+		if len(hits) == 0 {
+			t.Errorf("search(%q) found nothing, want %s", query, want)
+			continue
+		}
+		if got := hits[0].exchange.OperatingMIC; got != want {
+			t.Errorf("search(%q) ranked %s first, want %s", query, got, want)
+		}
+		for i := 1; i < len(hits); i++ {
+			if hits[i].score > hits[i-1].score {
+				t.Errorf("search(%q) not sorted by score at %d", query, i)
+			}
+		}
+	}
+
+	if hits := x.search("zzqx"); len(hits) != 0 {
+		t.Errorf("search(zzqx) = %d hits, want none", len(hits))
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	for _, tc := range []struct {
+		a, b  string
+		limit int
+		want  int
+	}{
+		{"nasdq", "nasdaq", 1, 1},
+		{"exhcange", "exchange", 2, 1},
+		{"berlin", "berlin", 1, 0},
+		{"milan", "madrid", 2, 3},
+		{"oslo", "osaka", 1, 2},
+	} {
+		if got := editDistance(tc.a, tc.b, tc.limit); got != tc.want {
+			t.Errorf("editDistance(%q, %q, %d) = %d, want %d", tc.a, tc.b, tc.limit, got, tc.want)
+		}
+	}
+}
+
+func TestServiceListByRelevance(t *testing.T) {
+	exchanges, err := LoadEmbedded()
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(exchanges, nil)
+	ctx := context.Background()
+
+	query, limit := "stock exchange", 3
+	all, err := svc.List(ctx, &exchangeGen.ListPayload{Query: &query})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paged []*exchangeGen.Exchange
+	p := &exchangeGen.ListPayload{Query: &query, Limit: &limit}
+	for {
+		res, err := svc.List(ctx, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		paged = append(paged, res.Exchanges...)
+		if res.NextCursor == nil {
+			break
+		}
+		p.Cursor = res.NextCursor
+	}
+	if len(paged) != all.Total || len(paged) != len(all.Exchanges) {
+		t.Fatalf("paged %d exchanges, want %d", len(paged), all.Total)
+	}
+	for i := range paged {
+		if paged[i].OperatingMic != all.Exchanges[i].OperatingMic {
+			t.Fatalf("page order differs at %d: %s != %s", i, paged[i].OperatingMic, all.Exchanges[i].OperatingMic)
+		}
+	}
+}
+
+// syntheticExchanges returns n exchanges with made-up names, cities and
+// countries, so that the index vocabulary is as large as that of the full
+// ISO 10383 list (about 2,500 active operating MICs) rather than of the
+// curated subset.
+func syntheticExchanges(n int) []*Exchange {
+	rng := rand.New(rand.NewPCG(1, 2))
+	word := func() string {
+		const letters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+		b := make([]byte, 4+rng.IntN(7))
+		for i := range b {
+			b[i] = letters[rng.IntN(len(letters))]
+		}
+		return string(b)
+	}
+	words := make([]string, 4000)
+	for i := range words {
+		words[i] = word()
+	}
+	suffixes := []string{"EXCHANGE", "STOCK EXCHANGE", "MARKET", "MTF", "SECURITIES EXCHANGE", "BOERSE"}
+
+	exchanges := make([]*Exchange, n)
+	for i := range exchanges {
+		name := make([]string, 1+rng.IntN(3))
+		for j := range name {
+			name[j] = words[rng.IntN(len(words))]
+		}
+		exchanges[i] = &Exchange{
+			OperatingMIC: fmt.Sprintf("Y%03d", i),
+			Name:         strings.Join(name, " ") + " " + suffixes[rng.IntN(len(suffixes))],
+			Acronym:      strings.ToUpper(words[rng.IntN(len(words))][:3]),
+			City:         words[rng.IntN(300)],
+			Country:      words[rng.IntN(150)][:2],
+		}
+	}
+	return exchanges
+}
+
+// BenchmarkSearch measures queries over the embedded list; every case should
+// stay well under a millisecond per op. While the embedded file is still the
+// curated subset, it is padded with synthetic exchanges to the size of the
+// full ISO 10383 list; the full list is benchmarked as is.
+func BenchmarkSearch(b *testing.B) {
+	exchanges, err := LoadEmbedded()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if len(exchanges) < 500 {
+		exchanges = append(syntheticExchanges(2500), exchanges...)
+	}
+	x := newSearchIndex(exchanges)
+	b.Logf("%d exchanges, %d indexed tokens", len(x.exchanges), len(x.vocab))
+
+	for name, query := range map[string]string{
+		"mic":    "XNYS",
+		"prefix": "lon",
+		"typo":   "Nasdq",
+		"tokens": "new york stock exchange",
+		"long":   "securities exhcange",
+	} {
+		b.Run(name, func(b *testing.B) {
+			for b.Loop() {
+				x.search(query)
+			}
+		})
+	}
+}
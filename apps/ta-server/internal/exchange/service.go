@@ -0,0 +1,415 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	exchangeGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/exchange"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/paging"
+)
+
+// Service implements the generated exchange service over an in-memory list,
+// which Replace swaps atomically, and trading calendars loaded once at
+// startup.
+type Service struct {
+	data      atomic.Pointer[dataset]
+	replaceMu sync.Mutex
+	calendars map[string]*Calendar
+	now       func() time.Time
+}
+
+// dataset is an immutable exchange list with the lookups derived from it.
+type dataset struct {
+	exchanges []*Exchange
+	byMIC     map[string]*Exchange
+	// bySegment maps segment MICs to the exchange operating them.
+	bySegment map[string]*Exchange
+	sorted    map[string][]*Exchange
+	index     *searchIndex
+}
+
+var _ exchangeGen.Service = (*Service)(nil)
+
+// Sort orders of the list method.
+const (
+	sortRelevance = "relevance"
+	sortName      = "name"
+	sortCountry   = "country"
+	sortMIC       = "mic"
+)
+
+// Sort keys of the list method, each ending with the unique operating MIC
+// as required by the paging package. The relevance key depends on the query
+// and is built by relevanceKey.
+var sortKeys = map[string]func(*Exchange) []string{
+	sortName:    func(e *Exchange) []string { return []string{e.Name, e.OperatingMIC} },
+	sortCountry: func(e *Exchange) []string { return []string{e.Country, e.Name, e.OperatingMIC} },
+	sortMIC:     func(e *Exchange) []string { return []string{e.OperatingMIC} },
+}
+
+// NewService returns the exchange service serving exchanges and the trading
+// calendars keyed by operating MIC.
+func NewService(exchanges []*Exchange, calendars map[string]*Calendar) *Service {
+	s := &Service{calendars: calendars, now: time.Now}
+	s.data.Store(newDataset(exchanges))
+	return s
+}
+
+func newDataset(exchanges []*Exchange) *dataset {
+	byMIC := make(map[string]*Exchange, len(exchanges))
+	bySegment := make(map[string]*Exchange)
+	for _, e := range exchanges {
+		byMIC[e.OperatingMIC] = e
+		for _, sg := range e.Segments {
+			bySegment[sg.MIC] = e
+		}
+	}
+	sorted := make(map[string][]*Exchange, len(sortKeys))
+	for name, key := range sortKeys {
+		s := slices.Clone(exchanges)
+		paging.Sort(s, key)
+		sorted[name] = s
+	}
+	return &dataset{
+		exchanges: exchanges,
+		byMIC:     byMIC,
+		bySegment: bySegment,
+		sorted:    sorted,
+		index:     newSearchIndex(exchanges),
+	}
+}
+
+// ResolveMIC returns the operating MIC of the exchange identified by mic,
+// an active operating or segment MIC, ignoring case.
+func (s *Service) ResolveMIC(mic string) (string, bool) {
+	data := s.data.Load()
+	mic = strings.ToUpper(mic)
+	if e, ok := data.byMIC[mic]; ok {
+		return e.OperatingMIC, true
+	}
+	if e, ok := data.bySegment[mic]; ok {
+		return e.OperatingMIC, true
+	}
+	return "", false
+}
+
+// Compare returns the differences between the served exchanges and
+// exchanges.
+func (s *Service) Compare(exchanges []*Exchange) *Diff {
+	return Compare(s.data.Load().exchanges, exchanges)
+}
+
+// Replace atomically replaces the served exchanges with exchanges and
+// returns the differences from the previous list. Requests in flight
+// complete against the list they started with.
+func (s *Service) Replace(exchanges []*Exchange) *Diff {
+	// Build the indexes before taking the lock, serialize the swaps so that
+	// each diff is relative to the list it replaces.
+	next := newDataset(exchanges)
+	s.replaceMu.Lock()
+	defer s.replaceMu.Unlock()
+	prev := s.data.Swap(next)
+	return Compare(prev.exchanges, exchanges)
+}
+
+// Check implements health.Checker. The service is ready when its exchange
+// list is loaded and not empty.
+func (s *Service) Check(ctx context.Context) error {
+	if data := s.data.Load(); data == nil || len(data.exchanges) == 0 {
+		return errors.New("no exchanges loaded")
+	}
+	return nil
+}
+
+// List returns a page of the exchanges matching the query and the filters in
+// the requested order. The query is looked up in the search index, which
+// tolerates typos and ranks the results; the country, city and acronym
+// filters match exact values, ignoring case. Results are ordered by
+// relevance when there is a query and by country otherwise, unless a sort
+// order is requested.
+func (s *Service) List(ctx context.Context, p *exchangeGen.ListPayload) (*exchangeGen.ListResult, error) {
+	var query string
+	if p.Query != nil {
+		query = strings.TrimSpace(*p.Query)
+	}
+	sortBy := sortCountry
+	if query != "" {
+		sortBy = sortRelevance
+	}
+	if p.Sort != nil && (*p.Sort == sortRelevance || sortKeys[*p.Sort] != nil) {
+		sortBy = *p.Sort
+	}
+	var cursor string
+	if p.Cursor != nil {
+		cursor = *p.Cursor
+	}
+	after, err := paging.Decode(cursor, sortBy)
+	if err != nil {
+		return nil, exchangeGen.MakeInvalidCursor(err)
+	}
+
+	data := s.data.Load()
+	var scores map[*Exchange]int
+	candidates, key := data.sorted[sortBy], sortKeys[sortBy]
+	if query != "" {
+		hits := data.index.search(query)
+		scores = make(map[*Exchange]int, len(hits))
+		for _, h := range hits {
+			scores[h.exchange] = h.score
+		}
+		if sortBy == sortRelevance {
+			candidates = make([]*Exchange, len(hits))
+			for i, h := range hits {
+				candidates[i] = h.exchange
+			}
+		}
+	}
+	if sortBy == sortRelevance {
+		if query == "" {
+			// Every exchange is equally relevant, leaving the name order.
+			candidates = data.sorted[sortName]
+		}
+		key = relevanceKey(scores)
+	}
+
+	matched := make([]*Exchange, 0, len(candidates))
+	for _, e := range candidates {
+		if scores != nil && scores[e] == 0 {
+			continue
+		}
+		if !equalFold(p.Country, e.Country) || !equalFold(p.City, e.City) || !equalFold(p.Acronym, e.Acronym) {
+			continue
+		}
+		matched = append(matched, e)
+	}
+
+	var limit int
+	if p.Limit != nil {
+		limit = *p.Limit
+	}
+	page, next := paging.Page(matched, key, after, limit)
+	res := &exchangeGen.ListResult{
+		Exchanges: make([]*exchangeGen.Exchange, len(page)),
+		Total:     len(matched),
+	}
+	for i, e := range page {
+		res.Exchanges[i] = toExchange(e)
+	}
+	if next != nil {
+		res.NextCursor = optional(paging.Encode(sortBy, next))
+	}
+	logctx.LoggerFromContext(ctx).DebugContext(ctx, "exchanges listed", "query", query, "sort", sortBy, "total", res.Total, "count", len(page))
+	return res, nil
+}
+
+// relevanceKey returns the sort key ordering exchanges by decreasing score,
+// then by name, as returned by searchIndex.search.
+func relevanceKey(scores map[*Exchange]int) func(*Exchange) []string {
+	return func(e *Exchange) []string {
+		return []string{fmt.Sprintf("%010d", math.MaxInt32-scores[e]), e.Name, e.OperatingMIC}
+	}
+}
+
+// Get returns the exchange with the given operating MIC.
+func (s *Service) Get(ctx context.Context, p *exchangeGen.GetPayload) (*exchangeGen.Exchange, error) {
+	e, err := s.lookup(p.OperatingMic)
+	if err != nil {
+		return nil, err
+	}
+	return toExchange(e), nil
+}
+
+// Segments returns the segment MICs operated by the exchange with the given
+// operating MIC.
+func (s *Service) Segments(ctx context.Context, p *exchangeGen.SegmentsPayload) (*exchangeGen.SegmentTree, error) {
+	e, err := s.lookup(p.OperatingMic)
+	if err != nil {
+		return nil, err
+	}
+	return &exchangeGen.SegmentTree{
+		OperatingMic: e.OperatingMIC,
+		ExchangeName: e.Name,
+		Segments:     toSegments(e.Segments),
+	}, nil
+}
+
+// Default and maximum number of days returned by Calendar.
+const (
+	defaultCalendarDays = 30
+	maxCalendarDays     = 366
+)
+
+// Calendar returns the trading days of the exchange between the from and to
+// dates, inclusive. Without from the range starts today and without to it
+// spans defaultCalendarDays, up to the end of the covered years. Dates
+// outside the covered years are an invalid range, since their holidays are
+// not known.
+func (s *Service) Calendar(ctx context.Context, p *exchangeGen.CalendarPayload) (*exchangeGen.TradingCalendar, error) {
+	c, err := s.calendar(p.OperatingMic)
+	if err != nil {
+		return nil, err
+	}
+	from := s.now().In(c.Location)
+	if p.From != nil {
+		if from, err = time.ParseInLocation(dateLayout, *p.From, c.Location); err != nil {
+			return nil, exchangeGen.MakeInvalidRange(err)
+		}
+	}
+	from = c.Day(from).Date
+	to := from.AddDate(0, 0, defaultCalendarDays-1)
+	if p.To != nil {
+		if to, err = time.ParseInLocation(dateLayout, *p.To, c.Location); err != nil {
+			return nil, exchangeGen.MakeInvalidRange(err)
+		}
+	} else if !c.Covers(to) {
+		// The default range stops at the end of the covered years.
+		to = time.Date(c.LastYear, time.December, 31, 0, 0, 0, 0, c.Location)
+	}
+	if !c.Covers(from) || !c.Covers(to) {
+		return nil, exchangeGen.MakeInvalidRange(fmt.Errorf("the holidays of %s are only known from %d to %d", c.OperatingMIC, c.FirstYear, c.LastYear))
+	}
+	if to.Before(from) {
+		return nil, exchangeGen.MakeInvalidRange(fmt.Errorf("to (%s) is before from (%s)", to.Format(dateLayout), from.Format(dateLayout)))
+	}
+	if to.After(from.AddDate(0, 0, maxCalendarDays-1)) {
+		return nil, exchangeGen.MakeInvalidRange(fmt.Errorf("date range exceeds %d days", maxCalendarDays))
+	}
+
+	days := c.Days(from, to)
+	res := &exchangeGen.TradingCalendar{
+		OperatingMic: c.OperatingMIC,
+		Timezone:     c.Location.String(),
+		Days:         make([]*exchangeGen.TradingDay, len(days)),
+	}
+	for i, d := range days {
+		res.Days[i] = toTradingDay(d)
+	}
+	return res, nil
+}
+
+// Status returns whether the exchange is open now and when its regular
+// session next opens and closes.
+func (s *Service) Status(ctx context.Context, p *exchangeGen.StatusPayload) (*exchangeGen.MarketStatus, error) {
+	c, err := s.calendar(p.OperatingMic)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now().In(c.Location)
+	status, nextOpen, nextClose := c.Status(now)
+	return &exchangeGen.MarketStatus{
+		OperatingMic: c.OperatingMIC,
+		Timezone:     c.Location.String(),
+		Status:       status,
+		AsOf:         now.Format(time.RFC3339),
+		NextOpen:     optionalTime(nextOpen),
+		NextClose:    optionalTime(nextClose),
+	}, nil
+}
+
+func (s *Service) calendar(mic string) (*Calendar, error) {
+	e, err := s.lookup(mic)
+	if err != nil {
+		return nil, err
+	}
+	c, ok := s.calendars[e.OperatingMIC]
+	if !ok {
+		return nil, exchangeGen.MakeNotFound(fmt.Errorf("no trading calendar for exchange %q", e.OperatingMIC))
+	}
+	return c, nil
+}
+
+func (s *Service) lookup(mic string) (*Exchange, error) {
+	e, ok := s.data.Load().byMIC[strings.ToUpper(mic)]
+	if !ok {
+		return nil, exchangeGen.MakeNotFound(fmt.Errorf("exchange %q not found", mic))
+	}
+	return e, nil
+}
+
+func toExchange(e *Exchange) *exchangeGen.Exchange {
+	return &exchangeGen.Exchange{
+		OperatingMic:     e.OperatingMIC,
+		ExchangeName:     e.Name,
+		DisplayName:      e.DisplayName,
+		Country:          e.Country,
+		City:             e.City,
+		Acronym:          optional(e.Acronym),
+		MarketCategory:   e.MarketCategory,
+		Status:           e.Status,
+		Website:          optional(e.Website),
+		CreationDate:     optionalDate(e.Created),
+		LastModifiedDate: optionalDate(e.LastModified),
+		Segments:         toSegments(e.Segments),
+	}
+}
+
+func toSegments(segments []*Segment) []*exchangeGen.Segment {
+	res := make([]*exchangeGen.Segment, len(segments))
+	for i, sg := range segments {
+		res[i] = &exchangeGen.Segment{
+			Mic:              sg.MIC,
+			OperatingMic:     sg.OperatingMIC,
+			Name:             sg.Name,
+			City:             sg.City,
+			Acronym:          optional(sg.Acronym),
+			MarketCategory:   sg.MarketCategory,
+			Status:           sg.Status,
+			Website:          optional(sg.Website),
+			CreationDate:     optionalDate(sg.Created),
+			LastModifiedDate: optionalDate(sg.LastModified),
+		}
+	}
+	return res
+}
+
+func toTradingDay(d TradingDay) *exchangeGen.TradingDay {
+	res := &exchangeGen.TradingDay{
+		Date:     d.Date.Format(dateLayout),
+		Status:   d.Status,
+		Holiday:  optional(d.Holiday),
+		Sessions: make([]*exchangeGen.TradingSession, len(d.Sessions)),
+	}
+	for i, sess := range d.Sessions {
+		res.Sessions[i] = &exchangeGen.TradingSession{
+			Kind:  sess.Kind,
+			Open:  sess.Open.Format(time.RFC3339),
+			Close: sess.Close.Format(time.RFC3339),
+		}
+	}
+	return res
+}
+
+// equalFold reports whether the optional filter is unset or equal to v,
+// ignoring case.
+func equalFold(filter *string, v string) bool {
+	return filter == nil || strings.EqualFold(strings.TrimSpace(*filter), v)
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func optionalTime(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	return optional(t.Format(time.RFC3339))
+}
+
+func optionalDate(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	return optional(t.Format(time.DateOnly))
+}
@@ -0,0 +1,156 @@
+// Package health implements the liveness and readiness probes of ta-server.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+)
+
+// checkTimeout bounds the time a single readiness check may take.
+const checkTimeout = 5 * time.Second
+
+// Checker is implemented by services and storage backends that can report
+// whether they are ready to serve requests.
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckFunc adapts a function to the Checker interface.
+type CheckFunc func(ctx context.Context) error
+
+// Check implements Checker.
+func (f CheckFunc) Check(ctx context.Context) error { return f(ctx) }
+
+// Registry holds the readiness checks of the server.
+type Registry struct {
+	mu       sync.RWMutex
+	names    []string
+	checks   map[string]Checker
+	draining atomic.Bool
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{checks: make(map[string]Checker)}
+}
+
+// Register adds a named readiness check. Registering the same name twice
+// replaces the previous check.
+func (r *Registry) Register(name string, c Checker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.checks[name]; !ok {
+		r.names = append(r.names, name)
+	}
+	r.checks[name] = c
+}
+
+// RegisterIfChecker registers v under name when it implements Checker. It
+// lets modules opt into readiness reporting without the server depending on
+// their concrete types.
+func (r *Registry) RegisterIfChecker(name string, v any) {
+	if c, ok := v.(Checker); ok {
+		r.Register(name, c)
+	}
+}
+
+// SetDraining marks the server as shutting down. From then on the readiness
+// probe fails so that load balancers stop routing new traffic while
+// in-flight requests complete.
+func (r *Registry) SetDraining() {
+	r.draining.Store(true)
+}
+
+// Results of a single check in a Report.
+const (
+	checkPass = "pass"
+	checkFail = "fail"
+)
+
+// Report is the JSON body of the health endpoints. It tells only whether each
+// check passed: the probes are unauthenticated, so the reasons of failures
+// are logged instead.
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Check runs every registered check concurrently and returns the report and
+// whether the server is ready. Failed checks are logged with their error.
+func (r *Registry) Check(ctx context.Context) (*Report, bool) {
+	r.mu.RLock()
+	names := append([]string(nil), r.names...)
+	checks := make([]Checker, len(names))
+	for i, n := range names {
+		checks[i] = r.checks[n]
+	}
+	r.mu.RUnlock()
+
+	results := make([]error, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+			results[i] = c.Check(ctx)
+		}()
+	}
+	wg.Wait()
+
+	rep := &Report{Status: "ok", Checks: make(map[string]string, len(names))}
+	ready := true
+	for i, n := range names {
+		if results[i] != nil {
+			logctx.LoggerFromContext(ctx).WarnContext(ctx, "readiness check failed", "check", n, "error", results[i])
+			rep.Checks[n] = checkFail
+			ready = false
+		} else {
+			rep.Checks[n] = checkPass
+		}
+	}
+	if r.draining.Load() {
+		rep.Checks["server"] = checkFail
+		ready = false
+	}
+	if !ready {
+		rep.Status = "unavailable"
+	}
+	return rep, ready
+}
+
+// LivenessHandler serves /healthz. It reports whether the process is able to
+// serve HTTP requests and never runs dependency checks, so that a slow
+// dependency does not get the process restarted.
+func (r *Registry) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		writeReport(w, http.StatusOK, &Report{Status: "ok"})
+	})
+}
+
+// ReadinessHandler serves /readyz. It responds 200 when every registered
+// check passes and 503 otherwise, including during graceful shutdown.
+func (r *Registry) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		rep, ready := r.Check(req.Context())
+		status := http.StatusOK
+		if !ready {
+			status = http.StatusServiceUnavailable
+		}
+		writeReport(w, status, rep)
+	})
+}
+
+func writeReport(w http.ResponseWriter, status int, rep *Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(rep)
+}
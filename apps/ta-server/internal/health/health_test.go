@@ -0,0 +1,65 @@
+package health
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func probe(t *testing.T, h http.Handler) (int, *Report) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	var rep Report
+	if err := json.NewDecoder(w.Body).Decode(&rep); err != nil {
+		t.Fatalf("decode report: %v", err)
+	}
+	return w.Code, &rep
+}
+
+type notChecker struct{}
+
+func TestReadiness(t *testing.T) {
+	var logs bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	reg := NewRegistry()
+	storageErr := error(nil)
+	reg.Register("storage", CheckFunc(func(context.Context) error { return storageErr }))
+	reg.RegisterIfChecker("watchlist", CheckFunc(func(context.Context) error { return nil }))
+	reg.RegisterIfChecker("portfolio", notChecker{})
+
+	code, rep := probe(t, reg.ReadinessHandler())
+	if code != http.StatusOK || rep.Status != "ok" {
+		t.Fatalf("ready probe = %d %+v, want 200 ok", code, rep)
+	}
+	if len(rep.Checks) != 2 {
+		t.Errorf("checks = %v, want storage and watchlist only", rep.Checks)
+	}
+
+	storageErr = errors.New("database is locked")
+	code, rep = probe(t, reg.ReadinessHandler())
+	if code != http.StatusServiceUnavailable || rep.Checks["storage"] != "fail" || rep.Checks["watchlist"] != "pass" {
+		t.Fatalf("failing probe = %d %+v, want 503 with storage failed", code, rep)
+	}
+	if !strings.Contains(logs.String(), "database is locked") {
+		t.Errorf("log = %q, want the storage error", logs.String())
+	}
+
+	storageErr = nil
+	reg.SetDraining()
+	if code, _ := probe(t, reg.ReadinessHandler()); code != http.StatusServiceUnavailable {
+		t.Errorf("draining probe = %d, want 503", code)
+	}
+	if code, _ := probe(t, reg.LivenessHandler()); code != http.StatusOK {
+		t.Errorf("liveness while draining = %d, want 200", code)
+	}
+}
@@ -0,0 +1,177 @@
+package instrument
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	instrumentGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/instrument"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+)
+
+// MaxImportSize is the largest CSV file accepted by Import.
+const MaxImportSize = 16 << 20
+
+// requiredColumns are the columns every import file must have, besides the
+// optional isin and figi.
+var requiredColumns = []string{"symbol", "exchange_mic", "name", "asset_class", "currency"}
+
+// row is a parsed data row of an import file.
+type row struct {
+	line int
+	inst *Instrument
+}
+
+// Import creates or updates the instruments of a CSV file, the first row of
+// which names the columns in any order. Every row is validated like a create
+// payload and nothing is stored unless all are valid. With dry_run the
+// report only tells what would change.
+func (s *Service) Import(ctx context.Context, p *instrumentGen.ImportPayload, body io.ReadCloser) (*instrumentGen.InstrumentImportReport, error) {
+	defer body.Close()
+
+	rows, err := readCSV(io.LimitReader(body, MaxImportSize+1))
+	if err != nil {
+		return nil, instrumentGen.MakeInvalidInstrument(err)
+	}
+
+	report := &instrumentGen.InstrumentImportReport{DryRun: p.DryRun, Rows: len(rows), Errors: []*instrumentGen.ImportRowError{}}
+	fail := func(line int, err error) {
+		report.Errors = append(report.Errors, &instrumentGen.ImportRowError{Line: line, Error: err.Error()})
+	}
+	now := s.now()
+	var (
+		changed []*Instrument
+		ids     = make(map[string]int)
+		figis   = make(map[string]int)
+	)
+	for _, r := range rows {
+		inst := r.inst
+		if err := validateFormat(inst); err != nil {
+			fail(r.line, err)
+			continue
+		}
+		if err := s.normalize(inst); err != nil {
+			fail(r.line, err)
+			continue
+		}
+		if prev, ok := ids[inst.ID()]; ok {
+			fail(r.line, fmt.Errorf("instrument %s is already on line %d", inst.ID(), prev))
+			continue
+		}
+		ids[inst.ID()] = r.line
+		if inst.FIGI != "" {
+			if prev, ok := figis[inst.FIGI]; ok {
+				fail(r.line, fmt.Errorf("FIGI %s is already on line %d", inst.FIGI, prev))
+				continue
+			}
+			figis[inst.FIGI] = r.line
+		}
+
+		existing, err := s.repo.Get(ctx, inst.ExchangeMIC, inst.Symbol)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			report.Created++
+			inst.CreatedAt = now
+		case err != nil:
+			logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to get instrument", "id", inst.ID(), "error", err)
+			return nil, err
+		case sameAttributes(existing, inst):
+			report.Unchanged++
+			continue
+		default:
+			report.Updated++
+			inst.CreatedAt = existing.CreatedAt
+		}
+		inst.UpdatedAt = now
+		changed = append(changed, inst)
+	}
+
+	if p.DryRun || len(report.Errors) > 0 {
+		return report, nil
+	}
+	if err := s.repo.Upsert(ctx, changed); err != nil {
+		if errors.Is(err, ErrConflict) {
+			return nil, instrumentGen.MakeInvalidInstrument(errors.New("a FIGI of the file is used by another instrument"))
+		}
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to import instruments", "error", err)
+		return nil, err
+	}
+	report.Applied = true
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "instruments imported",
+		"rows", report.Rows,
+		"created", report.Created,
+		"updated", report.Updated,
+		"unchanged", report.Unchanged)
+	return report, nil
+}
+
+// readCSV parses the rows of an import file, leaving their validation to
+// the caller.
+func readCSV(r io.Reader) ([]row, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("empty file")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, ok := index[name]; ok {
+			return nil, fmt.Errorf("duplicate column %q", name)
+		}
+		index[name] = i
+	}
+	for _, name := range requiredColumns {
+		if _, ok := index[name]; !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+	field := func(rec []string, name string) string {
+		i, ok := index[name]
+		if !ok || i >= len(rec) {
+			return ""
+		}
+		return strings.TrimSpace(rec[i])
+	}
+
+	var rows []row
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if cr.InputOffset() > MaxImportSize {
+			return nil, fmt.Errorf("file exceeds %d bytes", MaxImportSize)
+		}
+		line, _ := cr.FieldPos(0)
+		rows = append(rows, row{line: line, inst: &Instrument{
+			Symbol:      field(rec, "symbol"),
+			ExchangeMIC: field(rec, "exchange_mic"),
+			Name:        field(rec, "name"),
+			AssetClass:  strings.ToLower(field(rec, "asset_class")),
+			Currency:    strings.ToUpper(field(rec, "currency")),
+			ISIN:        strings.ToUpper(field(rec, "isin")),
+			FIGI:        strings.ToUpper(field(rec, "figi")),
+		}})
+	}
+	return rows, nil
+}
+
+// sameAttributes reports whether a and b, the same instrument, have the same
+// attributes.
+func sameAttributes(a, b *Instrument) bool {
+	return a.Name == b.Name && a.AssetClass == b.AssetClass && a.Currency == b.Currency &&
+		a.ISIN == b.ISIN && a.FIGI == b.FIGI
+}
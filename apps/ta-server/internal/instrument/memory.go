@@ -0,0 +1,179 @@
+package instrument
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/paging"
+)
+
+// MemoryRepository keeps the instrument master in process memory. Its
+// contents are lost when the server restarts.
+type MemoryRepository struct {
+	mu    sync.RWMutex
+	items map[string]*Instrument
+}
+
+// NewMemoryRepository returns an empty in-memory repository.
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{items: make(map[string]*Instrument)}
+}
+
+// List implements Repository.
+func (r *MemoryRepository) List(ctx context.Context, f Filter, sort string, after []string, limit int) ([]*Instrument, int, error) {
+	r.mu.RLock()
+	matched := make([]*Instrument, 0, len(r.items))
+	for _, it := range r.items {
+		if f.match(it) {
+			cp := *it
+			matched = append(matched, &cp)
+		}
+	}
+	r.mu.RUnlock()
+
+	key := func(i *Instrument) []string { return SortKey(sort, i) }
+	paging.Sort(matched, key)
+	page, _ := paging.Page(matched, key, after, limit)
+	return page, len(matched), nil
+}
+
+// Search implements Repository.
+func (r *MemoryRepository) Search(ctx context.Context, query string, limit int) ([]*Instrument, error) {
+	q := normalizeQuery(query)
+	type hit struct {
+		inst *Instrument
+		rank int
+	}
+	var hits []hit
+	r.mu.RLock()
+	for _, it := range r.items {
+		if rk := rank(it, q); rk != noMatch {
+			cp := *it
+			hits = append(hits, hit{&cp, rk})
+		}
+	}
+	r.mu.RUnlock()
+
+	slices.SortFunc(hits, func(a, b hit) int {
+		return cmp.Or(cmp.Compare(a.rank, b.rank),
+			cmp.Compare(a.inst.Symbol, b.inst.Symbol),
+			cmp.Compare(a.inst.ExchangeMIC, b.inst.ExchangeMIC))
+	})
+	res := make([]*Instrument, 0, min(len(hits), limit))
+	for _, h := range hits[:min(len(hits), limit)] {
+		res = append(res, h.inst)
+	}
+	return res, nil
+}
+
+// Get implements Repository.
+func (r *MemoryRepository) Get(ctx context.Context, mic, symbol string) (*Instrument, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	it, ok := r.items[FormatID(mic, symbol)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	cp := *it
+	return &cp, nil
+}
+
+// Create implements Repository.
+func (r *MemoryRepository) Create(ctx context.Context, inst *Instrument) (*Instrument, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.items[inst.ID()]; ok || r.figiTaken(inst) {
+		return nil, ErrConflict
+	}
+	cp := *inst
+	r.items[inst.ID()] = &cp
+	res := cp
+	return &res, nil
+}
+
+// Update implements Repository.
+func (r *MemoryRepository) Update(ctx context.Context, inst *Instrument) (*Instrument, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.items[inst.ID()]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if r.figiTaken(inst) {
+		return nil, ErrConflict
+	}
+	cp := *inst
+	cp.CreatedAt = existing.CreatedAt
+	r.items[inst.ID()] = &cp
+	res := cp
+	return &res, nil
+}
+
+// Delete implements Repository.
+func (r *MemoryRepository) Delete(ctx context.Context, mic, symbol string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := FormatID(mic, symbol)
+	if _, ok := r.items[id]; !ok {
+		return ErrNotFound
+	}
+	delete(r.items, id)
+	return nil
+}
+
+// Upsert implements Repository.
+func (r *MemoryRepository) Upsert(ctx context.Context, insts []*Instrument) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Check every FIGI against the instruments as they will be once all
+	// are stored, so that a failure leaves the repository untouched.
+	next := make(map[string]*Instrument, len(r.items)+len(insts))
+	for id, it := range r.items {
+		next[id] = it
+	}
+	for _, inst := range insts {
+		cp := *inst
+		if existing, ok := next[inst.ID()]; ok {
+			cp.CreatedAt = existing.CreatedAt
+		}
+		next[inst.ID()] = &cp
+	}
+	figis := make(map[string]bool, len(next))
+	for _, it := range next {
+		if it.FIGI == "" {
+			continue
+		}
+		if figis[it.FIGI] {
+			return ErrConflict
+		}
+		figis[it.FIGI] = true
+	}
+	r.items = next
+	return nil
+}
+
+// Ping implements Repository.
+func (r *MemoryRepository) Ping(ctx context.Context) error { return nil }
+
+// Close implements Repository.
+func (r *MemoryRepository) Close() error { return nil }
+
+// figiTaken reports whether another instrument has the FIGI of inst.
+func (r *MemoryRepository) figiTaken(inst *Instrument) bool {
+	if inst.FIGI == "" {
+		return false
+	}
+	for id, it := range r.items {
+		if it.FIGI == inst.FIGI && id != inst.ID() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,18 @@
+CREATE TABLE instruments (
+    symbol       TEXT NOT NULL,
+    exchange_mic TEXT NOT NULL,
+    name         TEXT NOT NULL,
+    asset_class  TEXT NOT NULL,
+    currency     TEXT NOT NULL,
+    isin         TEXT NOT NULL DEFAULT '',
+    figi         TEXT NOT NULL DEFAULT '',
+    created_at   TEXT NOT NULL,
+    updated_at   TEXT NOT NULL,
+    PRIMARY KEY (symbol, exchange_mic)
+);
+
+-- A FIGI identifies a single listing.
+CREATE UNIQUE INDEX idx_instruments_figi ON instruments (figi) WHERE figi <> '';
+
+CREATE INDEX idx_instruments_isin ON instruments (isin) WHERE isin <> '';
+CREATE INDEX idx_instruments_name ON instruments (name, symbol, exchange_mic);
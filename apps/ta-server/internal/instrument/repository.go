@@ -0,0 +1,138 @@
+// Package instrument keeps the instrument master: the canonical listing of a
+// security on an exchange, which watchlist and portfolio entries reference
+// by ID.
+//
+// An instrument is identified by the operating MIC of its listing exchange
+// and its ticker symbol there, written "XNAS:AAPL". Symbols are stored in
+// upper case, so IDs are compared exactly.
+package instrument
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/sqlstore"
+)
+
+var (
+	// ErrNotFound is returned by a Repository when the requested instrument
+	// does not exist.
+	ErrNotFound = errors.New("instrument not found")
+	// ErrConflict is returned by a Repository when an instrument with the
+	// same ID, or another instrument with the same FIGI, already exists.
+	ErrConflict = errors.New("instrument already exists")
+)
+
+// Instrument is a security listed on an exchange.
+type Instrument struct {
+	Symbol      string
+	ExchangeMIC string
+	Name        string
+	AssetClass  string
+	Currency    string
+	// ISIN and FIGI are empty when unknown.
+	ISIN      string
+	FIGI      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ID returns the instrument ID, "<operating MIC>:<symbol>".
+func (i *Instrument) ID() string {
+	return FormatID(i.ExchangeMIC, i.Symbol)
+}
+
+// FormatID returns the ID of the instrument listed on mic as symbol.
+func FormatID(mic, symbol string) string {
+	return mic + ":" + symbol
+}
+
+// ParseID splits an instrument ID into its exchange MIC and symbol, both
+// upper-cased.
+func ParseID(id string) (mic, symbol string, ok bool) {
+	mic, symbol, ok = strings.Cut(strings.ToUpper(id), ":")
+	if !ok || mic == "" || symbol == "" {
+		return "", "", false
+	}
+	return mic, symbol, true
+}
+
+// Filter selects instruments by exact attribute values; empty fields match
+// any value.
+type Filter struct {
+	Symbol      string
+	ExchangeMIC string
+	AssetClass  string
+	Currency    string
+	ISIN        string
+}
+
+func (f Filter) match(i *Instrument) bool {
+	return (f.Symbol == "" || i.Symbol == f.Symbol) &&
+		(f.ExchangeMIC == "" || i.ExchangeMIC == f.ExchangeMIC) &&
+		(f.AssetClass == "" || i.AssetClass == f.AssetClass) &&
+		(f.Currency == "" || i.Currency == f.Currency) &&
+		(f.ISIN == "" || i.ISIN == f.ISIN)
+}
+
+// Sort orders of List.
+const (
+	SortSymbol = "symbol"
+	SortName   = "name"
+)
+
+// SortKey returns the paging key of i in the given sort order, ending with
+// the exchange MIC so that it is unique.
+func SortKey(sort string, i *Instrument) []string {
+	if sort == SortName {
+		return []string{i.Name, i.Symbol, i.ExchangeMIC}
+	}
+	return []string{i.Symbol, i.ExchangeMIC}
+}
+
+// Repository persists the instrument master.
+type Repository interface {
+	// List returns up to limit instruments matching f (all when limit is 0)
+	// ordered by SortKey, starting after the key after (from the start when
+	// nil), and the number of instruments matching f.
+	List(ctx context.Context, f Filter, sort string, after []string, limit int) ([]*Instrument, int, error)
+	// Search returns up to limit instruments matching query, best matches
+	// first, as ranked by rank.
+	Search(ctx context.Context, query string, limit int) ([]*Instrument, error)
+	// Get returns the instrument listed on mic as symbol, or ErrNotFound.
+	Get(ctx context.Context, mic, symbol string) (*Instrument, error)
+	// Create stores a new instrument. It returns ErrConflict when the ID or
+	// the FIGI is taken.
+	Create(ctx context.Context, inst *Instrument) (*Instrument, error)
+	// Update replaces the attributes of an existing instrument, keeping its
+	// creation time. It returns ErrNotFound when it does not exist and
+	// ErrConflict when its new FIGI is taken.
+	Update(ctx context.Context, inst *Instrument) (*Instrument, error)
+	// Delete removes the instrument listed on mic as symbol, or returns
+	// ErrNotFound.
+	Delete(ctx context.Context, mic, symbol string) error
+	// Upsert creates or updates every instrument in a single transaction, so
+	// that either all or none are stored. Existing instruments keep their
+	// creation time.
+	Upsert(ctx context.Context, insts []*Instrument) error
+	// Ping reports whether the storage backend is reachable.
+	Ping(ctx context.Context) error
+	// Close releases any resources held by the repository.
+	Close() error
+}
+
+// OpenRepository opens the repository for the given storage backend. The dsn
+// is the database file path and is ignored by the in-memory backend.
+func OpenRepository(ctx context.Context, storage string, dsn string) (Repository, error) {
+	switch storage {
+	case "", sqlstore.StorageMemory:
+		return NewMemoryRepository(), nil
+	case sqlstore.StorageSQLite:
+		return NewSQLiteRepository(ctx, dsn)
+	default:
+		return nil, fmt.Errorf("unsupported storage backend %q", storage)
+	}
+}
@@ -0,0 +1,167 @@
+package instrument
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var created = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func testInstruments() []*Instrument {
+	return []*Instrument{
+		{Symbol: "AAPL", ExchangeMIC: "XNAS", Name: "Apple Inc.", AssetClass: "equity", Currency: "USD", ISIN: "US0378331005", FIGI: "BBG000B9XRY4"},
+		{Symbol: "MSFT", ExchangeMIC: "XNAS", Name: "Microsoft Corporation", AssetClass: "equity", Currency: "USD", ISIN: "US5949181045"},
+		{Symbol: "SPY", ExchangeMIC: "ARCX", Name: "SPDR S&P 500 ETF Trust", AssetClass: "etf", Currency: "USD"},
+		{Symbol: "APP", ExchangeMIC: "XNAS", Name: "AppLovin Corporation", AssetClass: "equity", Currency: "USD"},
+		{Symbol: "AAPL", ExchangeMIC: "XLON", Name: "Apple Inc. (London)", AssetClass: "equity", Currency: "GBP"},
+	}
+}
+
+func ids(insts []*Instrument) string {
+	res := make([]string, len(insts))
+	for i, inst := range insts {
+		res[i] = inst.ID()
+	}
+	return strings.Join(res, ",")
+}
+
+// testRepository runs the Repository contract against repo, which must be
+// empty.
+func testRepository(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	for _, inst := range testInstruments() {
+		inst.CreatedAt, inst.UpdatedAt = created, created
+		if _, err := repo.Create(ctx, inst); err != nil {
+			t.Fatalf("Create %s: %v", inst.ID(), err)
+		}
+	}
+	dup := testInstruments()[0]
+	if _, err := repo.Create(ctx, dup); !errors.Is(err, ErrConflict) {
+		t.Errorf("Create duplicate = %v, want ErrConflict", err)
+	}
+	dup.ExchangeMIC = "XNYS"
+	if _, err := repo.Create(ctx, dup); !errors.Is(err, ErrConflict) {
+		t.Errorf("Create duplicate FIGI = %v, want ErrConflict", err)
+	}
+
+	t.Run("List", func(t *testing.T) {
+		all, total, err := repo.List(ctx, Filter{}, SortSymbol, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ids(all); total != 5 || got != "XLON:AAPL,XNAS:AAPL,XNAS:APP,XNAS:MSFT,ARCX:SPY" {
+			t.Errorf("List = %s (%d)", got, total)
+		}
+		page, total, err := repo.List(ctx, Filter{ExchangeMIC: "XNAS"}, SortName, []string{"AppLovin Corporation", "APP", "XNAS"}, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ids(page); total != 3 || got != "XNAS:AAPL" {
+			t.Errorf("List XNAS by name after APP = %s (%d)", got, total)
+		}
+		page, total, _ = repo.List(ctx, Filter{AssetClass: "etf", Currency: "USD"}, SortSymbol, nil, 0)
+		if got := ids(page); total != 1 || got != "ARCX:SPY" {
+			t.Errorf("List etf = %s (%d)", got, total)
+		}
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		for query, want := range map[string]string{
+			"aapl":         "XLON:AAPL,XNAS:AAPL",          // exact symbol
+			"US5949181045": "XNAS:MSFT",                    // ISIN
+			"ap":           "XNAS:APP,XLON:AAPL,XNAS:AAPL", // symbol prefix, then name prefix
+			"app":          "XNAS:APP,XLON:AAPL,XNAS:AAPL", // exact, then name prefix
+			"corp":         "XNAS:APP,XNAS:MSFT",           // word of the name
+			"s&p":          "ARCX:SPY",
+			"100%":         "",
+		} {
+			res, err := repo.Search(ctx, query, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ids(res); got != want {
+				t.Errorf("Search(%q) = %s, want %s", query, got, want)
+			}
+		}
+		if res, _ := repo.Search(ctx, "a", 2); len(res) != 2 {
+			t.Errorf("Search limit 2 = %d results", len(res))
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		upd := testInstruments()[1]
+		upd.Name, upd.UpdatedAt = "Microsoft Corp.", created.Add(time.Hour)
+		res, err := repo.Update(ctx, upd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Name != "Microsoft Corp." || !res.CreatedAt.Equal(created) || !res.UpdatedAt.Equal(upd.UpdatedAt) {
+			t.Errorf("Update = %+v", res)
+		}
+		upd.FIGI = "BBG000B9XRY4"
+		if _, err := repo.Update(ctx, upd); !errors.Is(err, ErrConflict) {
+			t.Errorf("Update to a taken FIGI = %v, want ErrConflict", err)
+		}
+		upd.Symbol = "NONE"
+		if _, err := repo.Update(ctx, upd); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Update missing = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("Upsert", func(t *testing.T) {
+		later := created.Add(2 * time.Hour)
+		spy := testInstruments()[2]
+		spy.Name, spy.CreatedAt, spy.UpdatedAt = "SPDR S&P 500", later, later
+		qqq := &Instrument{Symbol: "QQQ", ExchangeMIC: "XNAS", Name: "Invesco QQQ", AssetClass: "etf", Currency: "USD", FIGI: "BBG000B9XRY4", CreatedAt: later, UpdatedAt: later}
+		if err := repo.Upsert(ctx, []*Instrument{spy, qqq}); !errors.Is(err, ErrConflict) {
+			t.Fatalf("Upsert with a taken FIGI = %v, want ErrConflict", err)
+		}
+		if got, _ := repo.Get(ctx, "ARCX", "SPY"); got.Name != "SPDR S&P 500 ETF Trust" {
+			t.Errorf("failed Upsert stored %+v", got)
+		}
+
+		qqq.FIGI = ""
+		if err := repo.Upsert(ctx, []*Instrument{spy, qqq}); err != nil {
+			t.Fatal(err)
+		}
+		got, err := repo.Get(ctx, "ARCX", "SPY")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Name != "SPDR S&P 500" || !got.CreatedAt.Equal(created) || !got.UpdatedAt.Equal(later) {
+			t.Errorf("upserted SPY = %+v", got)
+		}
+		if _, err := repo.Get(ctx, "XNAS", "QQQ"); err != nil {
+			t.Errorf("upserted QQQ: %v", err)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		if err := repo.Delete(ctx, "XLON", "AAPL"); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.Delete(ctx, "XLON", "AAPL"); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Delete missing = %v, want ErrNotFound", err)
+		}
+		if _, err := repo.Get(ctx, "XLON", "AAPL"); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Get deleted = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func TestMemoryRepository(t *testing.T) {
+	testRepository(t, NewMemoryRepository())
+}
+
+func TestSQLiteRepository(t *testing.T) {
+	repo, err := NewSQLiteRepository(context.Background(), filepath.Join(t.TempDir(), "ta.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteRepository: %v", err)
+	}
+	t.Cleanup(func() { repo.Close() })
+	testRepository(t, repo)
+}
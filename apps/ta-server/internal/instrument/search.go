@@ -0,0 +1,49 @@
+package instrument
+
+import "strings"
+
+// Search ranks, best first. A query matches an instrument when it equals its
+// symbol, ISIN or FIGI, starts its symbol, or occurs in its name, ignoring
+// the case of ASCII letters as SQLite LIKE does.
+const (
+	rankExact = iota
+	rankSymbolPrefix
+	rankNamePrefix
+	rankNameWord
+	rankNameContains
+	noMatch = -1
+)
+
+// rank returns how well i matches the upper-cased query q, or noMatch.
+func rank(i *Instrument, q string) int {
+	switch {
+	case i.Symbol == q || i.ISIN == q || i.FIGI == q:
+		return rankExact
+	case strings.HasPrefix(i.Symbol, q):
+		return rankSymbolPrefix
+	}
+	name := upperASCII(i.Name)
+	switch {
+	case strings.HasPrefix(name, q):
+		return rankNamePrefix
+	case strings.Contains(name, " "+q):
+		return rankNameWord
+	case strings.Contains(name, q):
+		return rankNameContains
+	}
+	return noMatch
+}
+
+// normalizeQuery trims and upper-cases a search query.
+func normalizeQuery(q string) string {
+	return upperASCII(strings.TrimSpace(q))
+}
+
+func upperASCII(s string) string {
+	return strings.Map(func(r rune) rune {
+		if 'a' <= r && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		return r
+	}, s)
+}
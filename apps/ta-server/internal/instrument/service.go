@@ -0,0 +1,268 @@
+package instrument
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	instrumentGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/instrument"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/paging"
+)
+
+// Service implements the generated instrument service on top of a
+// Repository, resolving listing exchanges through exchanges.
+type Service struct {
+	repo      Repository
+	exchanges MICResolver
+	now       func() time.Time
+}
+
+var _ instrumentGen.Service = (*Service)(nil)
+
+// NewService returns the instrument service implementation backed by repo.
+// Instruments may only be listed on exchanges known to exchanges.
+func NewService(repo Repository, exchanges MICResolver) *Service {
+	return &Service{repo: repo, exchanges: exchanges, now: time.Now}
+}
+
+// List returns a page of the instruments matching the filters.
+func (s *Service) List(ctx context.Context, p *instrumentGen.ListPayload) (*instrumentGen.ListResult, error) {
+	var cursor string
+	if p.Cursor != nil {
+		cursor = *p.Cursor
+	}
+	after, err := paging.Decode(cursor, p.Sort)
+	if err != nil {
+		return nil, instrumentGen.MakeInvalidCursor(err)
+	}
+	limit := 0
+	if p.Limit != nil {
+		limit = *p.Limit
+	}
+	f := Filter{
+		ExchangeMIC: upper(p.ExchangeMic),
+		AssetClass:  value(p.AssetClass),
+		Currency:    upper(p.Currency),
+		ISIN:        upper(p.Isin),
+	}
+	if mic, ok := s.exchanges.ResolveMIC(f.ExchangeMIC); ok {
+		f.ExchangeMIC = mic
+	}
+
+	// Fetch one more than requested to tell whether another page follows.
+	fetch := limit
+	if limit > 0 {
+		fetch++
+	}
+	insts, total, err := s.repo.List(ctx, f, p.Sort, after, fetch)
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to list instruments", "error", err)
+		return nil, err
+	}
+	res := &instrumentGen.ListResult{Total: total}
+	if limit > 0 && len(insts) > limit {
+		insts = insts[:limit]
+		next := paging.Encode(p.Sort, SortKey(p.Sort, insts[limit-1]))
+		res.NextCursor = &next
+	}
+	res.Instruments = make([]*instrumentGen.Instrument, len(insts))
+	for i, inst := range insts {
+		res.Instruments[i] = toGen(inst)
+	}
+	return res, nil
+}
+
+// Search returns the instruments best matching the query.
+func (s *Service) Search(ctx context.Context, p *instrumentGen.SearchPayload) ([]*instrumentGen.Instrument, error) {
+	insts, err := s.repo.Search(ctx, p.Q, p.Limit)
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to search instruments", "query", p.Q, "error", err)
+		return nil, err
+	}
+	res := make([]*instrumentGen.Instrument, len(insts))
+	for i, inst := range insts {
+		res[i] = toGen(inst)
+	}
+	return res, nil
+}
+
+// Get returns the instrument with the given ID.
+func (s *Service) Get(ctx context.Context, p *instrumentGen.GetPayload) (*instrumentGen.Instrument, error) {
+	inst, err := s.Lookup(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	return toGen(inst), nil
+}
+
+// Lookup returns the instrument with the given ID, for the services whose
+// entries reference instruments. It returns a not_found error when there is
+// no such instrument.
+func (s *Service) Lookup(ctx context.Context, id string) (*Instrument, error) {
+	mic, symbol, ok := ParseID(id)
+	if !ok {
+		return nil, instrumentGen.MakeNotFound(fmt.Errorf("instrument %q not found", id))
+	}
+	inst, err := s.repo.Get(ctx, mic, symbol)
+	if errors.Is(err, ErrNotFound) {
+		return nil, instrumentGen.MakeNotFound(fmt.Errorf("instrument %q not found", id))
+	}
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to get instrument", "id", id, "error", err)
+		return nil, err
+	}
+	return inst, nil
+}
+
+// Listings returns the operating MICs of the exchanges listing symbol,
+// sorted, for the services validating symbols against the master.
+func (s *Service) Listings(ctx context.Context, symbol string) ([]string, error) {
+	insts, _, err := s.repo.List(ctx, Filter{Symbol: upperASCII(symbol)}, SortSymbol, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	mics := make([]string, len(insts))
+	for i, inst := range insts {
+		mics[i] = inst.ExchangeMIC
+	}
+	return mics, nil
+}
+
+// Create adds an instrument listed on the exchange of the payload MIC.
+func (s *Service) Create(ctx context.Context, p *instrumentGen.CreatePayload) (*instrumentGen.Instrument, error) {
+	now := s.now()
+	inst := &Instrument{
+		Symbol:      p.Symbol,
+		ExchangeMIC: p.ExchangeMic,
+		Name:        p.Name,
+		AssetClass:  p.AssetClass,
+		Currency:    p.Currency,
+		ISIN:        value(p.Isin),
+		FIGI:        value(p.Figi),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.normalize(inst); err != nil {
+		return nil, instrumentGen.MakeInvalidInstrument(err)
+	}
+	res, err := s.repo.Create(ctx, inst)
+	if errors.Is(err, ErrConflict) {
+		if inst.FIGI == "" {
+			return nil, instrumentGen.MakeConflict(fmt.Errorf("instrument %s already exists", inst.ID()))
+		}
+		return nil, instrumentGen.MakeConflict(fmt.Errorf("instrument %s or FIGI %s already exists", inst.ID(), inst.FIGI))
+	}
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to create instrument", "id", inst.ID(), "error", err)
+		return nil, err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "instrument created", "id", res.ID())
+	return toGen(res), nil
+}
+
+// Update replaces the attributes of an instrument.
+func (s *Service) Update(ctx context.Context, p *instrumentGen.UpdatePayload) (*instrumentGen.Instrument, error) {
+	mic, symbol, ok := ParseID(p.ID)
+	if !ok {
+		return nil, instrumentGen.MakeNotFound(fmt.Errorf("instrument %q not found", p.ID))
+	}
+	inst := &Instrument{
+		Symbol:      symbol,
+		ExchangeMIC: mic,
+		Name:        p.Name,
+		AssetClass:  p.AssetClass,
+		Currency:    p.Currency,
+		ISIN:        value(p.Isin),
+		FIGI:        value(p.Figi),
+		UpdatedAt:   s.now(),
+	}
+	if err := validateCheckDigits(inst); err != nil {
+		return nil, instrumentGen.MakeInvalidInstrument(err)
+	}
+	res, err := s.repo.Update(ctx, inst)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, instrumentGen.MakeNotFound(fmt.Errorf("instrument %q not found", p.ID))
+	case errors.Is(err, ErrConflict):
+		return nil, instrumentGen.MakeConflict(fmt.Errorf("FIGI %s is used by another instrument", inst.FIGI))
+	case err != nil:
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to update instrument", "id", inst.ID(), "error", err)
+		return nil, err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "instrument updated", "id", res.ID())
+	return toGen(res), nil
+}
+
+// Delete removes an instrument.
+func (s *Service) Delete(ctx context.Context, p *instrumentGen.DeletePayload) error {
+	mic, symbol, ok := ParseID(p.ID)
+	if !ok {
+		return instrumentGen.MakeNotFound(fmt.Errorf("instrument %q not found", p.ID))
+	}
+	err := s.repo.Delete(ctx, mic, symbol)
+	if errors.Is(err, ErrNotFound) {
+		return instrumentGen.MakeNotFound(fmt.Errorf("instrument %q not found", p.ID))
+	}
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to delete instrument", "id", p.ID, "error", err)
+		return err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "instrument deleted", "id", FormatID(mic, symbol))
+	return nil
+}
+
+// Check implements health.Checker. The service is ready when its storage
+// is reachable.
+func (s *Service) Check(ctx context.Context) error {
+	if err := s.repo.Ping(ctx); err != nil {
+		return fmt.Errorf("storage unavailable: %w", err)
+	}
+	return nil
+}
+
+// normalize upper-cases the symbol of a new instrument, replaces its
+// exchange MIC with the operating MIC and verifies its check digits.
+func (s *Service) normalize(inst *Instrument) error {
+	inst.Symbol = upperASCII(inst.Symbol)
+	mic, ok := s.exchanges.ResolveMIC(inst.ExchangeMIC)
+	if !ok {
+		return fmt.Errorf("unknown exchange MIC %q", inst.ExchangeMIC)
+	}
+	inst.ExchangeMIC = mic
+	return validateCheckDigits(inst)
+}
+
+func toGen(inst *Instrument) *instrumentGen.Instrument {
+	return &instrumentGen.Instrument{
+		ID:          inst.ID(),
+		Symbol:      inst.Symbol,
+		Name:        inst.Name,
+		AssetClass:  inst.AssetClass,
+		Currency:    inst.Currency,
+		Isin:        optional(inst.ISIN),
+		Figi:        optional(inst.FIGI),
+		ExchangeMic: inst.ExchangeMIC,
+		CreatedAt:   inst.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:   inst.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func value(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
+
+func upper(p *string) string {
+	return upperASCII(value(p))
+}
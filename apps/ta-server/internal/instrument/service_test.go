@@ -0,0 +1,162 @@
+package instrument
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	instrumentGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/instrument"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// resolver maps operating and segment MICs to operating MICs.
+type resolver map[string]string
+
+func (r resolver) ResolveMIC(mic string) (string, bool) {
+	op, ok := r[strings.ToUpper(mic)]
+	return op, ok
+}
+
+var exchanges = resolver{"XNAS": "XNAS", "XNYS": "XNYS", "ARCX": "XNYS", "XLON": "XLON"}
+
+func errorName(err error) string {
+	var se *goa.ServiceError
+	if errors.As(err, &se) {
+		return se.Name
+	}
+	return ""
+}
+
+func TestCheckDigits(t *testing.T) {
+	for _, isin := range []string{"US0378331005", "GB0002634946", "DE0007164600"} {
+		if !validISIN(isin) {
+			t.Errorf("validISIN(%s) = false", isin)
+		}
+	}
+	if validISIN("US0378331006") {
+		t.Error("validISIN(US0378331006) = true")
+	}
+	for _, figi := range []string{"BBG000B9XRY4", "BBG000BPH459", "BBG000BLNNH6"} {
+		if !validFIGI(figi) {
+			t.Errorf("validFIGI(%s) = false", figi)
+		}
+	}
+	if validFIGI("BBG000B9XRY5") {
+		t.Error("validFIGI(BBG000B9XRY5) = true")
+	}
+}
+
+func TestServiceCreate(t *testing.T) {
+	ctx := context.Background()
+	svc := NewService(NewMemoryRepository(), exchanges)
+
+	isin := "US78462F1030"
+	res, err := svc.Create(ctx, &instrumentGen.CreatePayload{
+		Symbol: "spy", ExchangeMic: "arcx", Name: "SPDR S&P 500 ETF Trust", AssetClass: "etf", Currency: "USD", Isin: &isin,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ID != "XNYS:SPY" || res.Symbol != "SPY" || res.ExchangeMic != "XNYS" {
+		t.Errorf("Create = %+v, want XNYS:SPY", res)
+	}
+	if _, err := svc.Get(ctx, &instrumentGen.GetPayload{ID: "xnys:spy"}); err != nil {
+		t.Errorf("Get xnys:spy: %v", err)
+	}
+	if mics, err := svc.Listings(ctx, "spy"); err != nil || strings.Join(mics, ",") != "XNYS" {
+		t.Errorf("Listings(spy) = %v, %v, want [XNYS]", mics, err)
+	}
+
+	bad := "US78462F1031"
+	for name, p := range map[string]*instrumentGen.CreatePayload{
+		"conflict":           {Symbol: "SPY", ExchangeMic: "XNYS", Name: "SPY", AssetClass: "etf", Currency: "USD"},
+		"invalid_instrument": {Symbol: "SPY", ExchangeMic: "XXXX", Name: "SPY", AssetClass: "etf", Currency: "USD"},
+		"":                   {Symbol: "IVV", ExchangeMic: "ARCX", Name: "iShares Core S&P 500 ETF", AssetClass: "etf", Currency: "USD"},
+	} {
+		if _, err := svc.Create(ctx, p); errorName(err) != name {
+			t.Errorf("Create %s/%s = %v, want %q", p.ExchangeMic, p.Symbol, err, name)
+		}
+	}
+	if _, err := svc.Create(ctx, &instrumentGen.CreatePayload{
+		Symbol: "VOO", ExchangeMic: "ARCX", Name: "Vanguard S&P 500 ETF", AssetClass: "etf", Currency: "USD", Isin: &bad,
+	}); errorName(err) != "invalid_instrument" {
+		t.Errorf("Create with a bad ISIN = %v, want invalid_instrument", err)
+	}
+
+	for _, id := range []string{"XNYS:VOO", "SPY"} {
+		if err := svc.Delete(ctx, &instrumentGen.DeletePayload{ID: id}); errorName(err) != "not_found" {
+			t.Errorf("Delete %s = %v, want not_found", id, err)
+		}
+	}
+}
+
+const importCSV = `symbol,exchange_mic,name,asset_class,currency,isin,figi
+AAPL,XNAS,Apple Inc.,equity,USD,US0378331005,BBG000B9XRY4
+msft,xnas,Microsoft Corporation,Equity,usd,US5949181045,
+SPY,ARCX,SPDR S&P 500 ETF Trust,etf,USD,,
+`
+
+func TestServiceImport(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRepository()
+	svc := NewService(repo, exchanges)
+	imp := func(dryRun bool, file string) *instrumentGen.InstrumentImportReport {
+		t.Helper()
+		r, err := svc.Import(ctx, &instrumentGen.ImportPayload{DryRun: dryRun}, io.NopCloser(strings.NewReader(file)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	count := func() int {
+		_, n, _ := repo.List(ctx, Filter{}, SortSymbol, nil, 0)
+		return n
+	}
+
+	r := imp(true, importCSV)
+	if !r.DryRun || r.Applied || r.Rows != 3 || r.Created != 3 || len(r.Errors) != 0 {
+		t.Errorf("dry run = %+v", r)
+	}
+	if n := count(); n != 0 {
+		t.Errorf("dry run stored %d instruments", n)
+	}
+
+	r = imp(false, importCSV)
+	if !r.Applied || r.Created != 3 {
+		t.Errorf("import = %+v", r)
+	}
+	if inst, err := repo.Get(ctx, "XNYS", "SPY"); err != nil || inst.AssetClass != "etf" {
+		t.Errorf("imported SPY = %+v, %v", inst, err)
+	}
+
+	updated := strings.Replace(importCSV, "Microsoft Corporation", "Microsoft Corp.", 1)
+	r = imp(false, updated)
+	if !r.Applied || r.Created != 0 || r.Updated != 1 || r.Unchanged != 2 {
+		t.Errorf("re-import = %+v", r)
+	}
+
+	invalid := updated + `AAPL,XNAS,Apple again,equity,USD,,
+QQQ,XXXX,Invesco QQQ,etf,USD,,
+VOO,ARCX,Vanguard S&P 500 ETF,etf,USD,US9229083632,BBG000B9XRY4
+IVV,ARCX,iShares Core S&P 500 ETF,etf,USD,US4642872001,
+`
+	r = imp(false, invalid)
+	if r.Applied || len(r.Errors) != 4 {
+		t.Fatalf("invalid import = %+v", r)
+	}
+	for i, line := range []int{5, 6, 7, 8} {
+		if r.Errors[i].Line != line {
+			t.Errorf("error %d on line %d, want %d: %s", i, r.Errors[i].Line, line, r.Errors[i].Error)
+		}
+	}
+	if n := count(); n != 3 {
+		t.Errorf("invalid import stored %d instruments, want 3", n)
+	}
+
+	if _, err := svc.Import(ctx, &instrumentGen.ImportPayload{}, io.NopCloser(strings.NewReader("symbol,name\nAAPL,Apple\n"))); errorName(err) != "invalid_instrument" {
+		t.Errorf("import without the required columns = %v, want invalid_instrument", err)
+	}
+}
@@ -0,0 +1,269 @@
+package instrument
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/sqlstore"
+)
+
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+
+// migrationsTable records the applied instrument migrations.
+const migrationsTable = "instrument_schema_migrations"
+
+// timeLayout is the format used to store timestamps in SQLite TEXT columns.
+const timeLayout = time.RFC3339Nano
+
+const columns = `symbol, exchange_mic, name, asset_class, currency, isin, figi, created_at, updated_at`
+
+// sortColumns are the ORDER BY columns of each sort, matching SortKey.
+var sortColumns = map[string][]string{
+	SortSymbol: {"symbol", "exchange_mic"},
+	SortName:   {"name", "symbol", "exchange_mic"},
+}
+
+// SQLiteRepository stores the instrument master in an embedded SQLite
+// database file, which may be shared with other repositories.
+type SQLiteRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteRepository opens (or creates) the SQLite database at path and runs
+// any pending instrument schema migrations.
+func NewSQLiteRepository(ctx context.Context, path string) (*SQLiteRepository, error) {
+	db, err := sqlstore.Open(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlstore.Migrate(ctx, db, migrationFS, migrationsTable); err != nil {
+		db.Close() //nolint:errcheck
+		return nil, err
+	}
+	return &SQLiteRepository{db: db}, nil
+}
+
+// List implements Repository.
+func (r *SQLiteRepository) List(ctx context.Context, f Filter, sort string, after []string, limit int) ([]*Instrument, int, error) {
+	var (
+		where []string
+		args  []any
+	)
+	for _, c := range []struct{ column, value string }{
+		{"symbol", f.Symbol},
+		{"exchange_mic", f.ExchangeMIC},
+		{"asset_class", f.AssetClass},
+		{"currency", f.Currency},
+		{"isin", f.ISIN},
+	} {
+		if c.value != "" {
+			where = append(where, c.column+" = ?")
+			args = append(args, c.value)
+		}
+	}
+	cond := "TRUE"
+	if len(where) > 0 {
+		cond = strings.Join(where, " AND ")
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM instruments WHERE `+cond, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	order, ok := sortColumns[sort]
+	if !ok {
+		order = sortColumns[SortSymbol]
+	}
+	if after != nil {
+		if len(after) != len(order) {
+			return nil, 0, fmt.Errorf("cursor key has %d columns, want %d", len(after), len(order))
+		}
+		// Row values compare like slices.Compare under the default BINARY
+		// collation, so pages resume where paging.Page would.
+		cond += " AND (" + strings.Join(order, ", ") + ") > (" + strings.Repeat("?, ", len(order)-1) + "?)"
+		for _, v := range after {
+			args = append(args, v)
+		}
+	}
+	query := `SELECT ` + columns + ` FROM instruments WHERE ` + cond + ` ORDER BY ` + strings.Join(order, ", ")
+	if limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, limit)
+	}
+	res, err := r.query(ctx, query, args...)
+	return res, total, err
+}
+
+// Search implements Repository.
+func (r *SQLiteRepository) Search(ctx context.Context, query string, limit int) ([]*Instrument, error) {
+	q := normalizeQuery(query)
+	like := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(q)
+	// The CASE mirrors rank.
+	return r.query(ctx, `SELECT `+columns+` FROM (
+		SELECT *, CASE
+			WHEN symbol = :q OR isin = :q OR figi = :q THEN 0
+			WHEN symbol LIKE :like || '%' ESCAPE '\' THEN 1
+			WHEN name LIKE :like || '%' ESCAPE '\' THEN 2
+			WHEN name LIKE '% ' || :like || '%' ESCAPE '\' THEN 3
+			WHEN name LIKE '%' || :like || '%' ESCAPE '\' THEN 4
+		END AS rank
+		FROM instruments)
+		WHERE rank IS NOT NULL
+		ORDER BY rank, symbol, exchange_mic
+		LIMIT :limit`,
+		sql.Named("q", q), sql.Named("like", like), sql.Named("limit", limit))
+}
+
+// Get implements Repository.
+func (r *SQLiteRepository) Get(ctx context.Context, mic, symbol string) (*Instrument, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT `+columns+` FROM instruments WHERE symbol = ? AND exchange_mic = ?`, symbol, mic)
+	inst, err := scanInstrument(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return inst, err
+}
+
+// Create implements Repository.
+func (r *SQLiteRepository) Create(ctx context.Context, inst *Instrument) (*Instrument, error) {
+	row := r.db.QueryRowContext(ctx,
+		`INSERT INTO instruments (`+columns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		 RETURNING `+columns, values(inst)...)
+	res, err := scanInstrument(row)
+	if sqlstore.IsUniqueViolation(err) {
+		return nil, ErrConflict
+	}
+	return res, err
+}
+
+// Update implements Repository.
+func (r *SQLiteRepository) Update(ctx context.Context, inst *Instrument) (*Instrument, error) {
+	row := r.db.QueryRowContext(ctx,
+		`UPDATE instruments SET name = ?, asset_class = ?, currency = ?, isin = ?, figi = ?, updated_at = ?
+		 WHERE symbol = ? AND exchange_mic = ?
+		 RETURNING `+columns,
+		inst.Name, inst.AssetClass, inst.Currency, inst.ISIN, inst.FIGI, inst.UpdatedAt.UTC().Format(timeLayout),
+		inst.Symbol, inst.ExchangeMIC)
+	res, err := scanInstrument(row)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, ErrNotFound
+	case sqlstore.IsUniqueViolation(err):
+		return nil, ErrConflict
+	}
+	return res, err
+}
+
+// Delete implements Repository.
+func (r *SQLiteRepository) Delete(ctx context.Context, mic, symbol string) error {
+	res, err := r.db.ExecContext(ctx,
+		`DELETE FROM instruments WHERE symbol = ? AND exchange_mic = ?`, symbol, mic)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Upsert implements Repository.
+func (r *SQLiteRepository) Upsert(ctx context.Context, insts []*Instrument) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	stmt, err := tx.PrepareContext(ctx,
+		`INSERT INTO instruments (`+columns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT (symbol, exchange_mic) DO UPDATE SET
+		   name = excluded.name, asset_class = excluded.asset_class, currency = excluded.currency,
+		   isin = excluded.isin, figi = excluded.figi, updated_at = excluded.updated_at`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, inst := range insts {
+		if _, err := stmt.ExecContext(ctx, values(inst)...); err != nil {
+			if sqlstore.IsUniqueViolation(err) {
+				return ErrConflict
+			}
+			return fmt.Errorf("upsert %s: %w", inst.ID(), err)
+		}
+	}
+	return tx.Commit()
+}
+
+// Ping implements Repository.
+func (r *SQLiteRepository) Ping(ctx context.Context) error {
+	return r.db.PingContext(ctx)
+}
+
+// Close implements Repository.
+func (r *SQLiteRepository) Close() error {
+	return r.db.Close()
+}
+
+func (r *SQLiteRepository) query(ctx context.Context, query string, args ...any) ([]*Instrument, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*Instrument{}
+	for rows.Next() {
+		inst, err := scanInstrument(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, inst)
+	}
+	return res, rows.Err()
+}
+
+// values returns the column values of inst in the order of columns.
+func values(inst *Instrument) []any {
+	return []any{
+		inst.Symbol, inst.ExchangeMIC, inst.Name, inst.AssetClass, inst.Currency, inst.ISIN, inst.FIGI,
+		inst.CreatedAt.UTC().Format(timeLayout), inst.UpdatedAt.UTC().Format(timeLayout),
+	}
+}
+
+// scanner is implemented by *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanInstrument(s scanner) (*Instrument, error) {
+	var (
+		inst                 Instrument
+		createdAt, updatedAt string
+	)
+	if err := s.Scan(&inst.Symbol, &inst.ExchangeMIC, &inst.Name, &inst.AssetClass, &inst.Currency,
+		&inst.ISIN, &inst.FIGI, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	var err error
+	if inst.CreatedAt, err = time.Parse(timeLayout, createdAt); err != nil {
+		return nil, fmt.Errorf("parse created_at %q: %w", createdAt, err)
+	}
+	if inst.UpdatedAt, err = time.Parse(timeLayout, updatedAt); err != nil {
+		return nil, fmt.Errorf("parse updated_at %q: %w", updatedAt, err)
+	}
+	return &inst, nil
+}
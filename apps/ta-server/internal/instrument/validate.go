@@ -0,0 +1,111 @@
+package instrument
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"unicode/utf8"
+)
+
+// AssetClasses are the asset classes an instrument may have, as enumerated
+// in the design.
+var AssetClasses = []string{"equity", "etf", "fund", "bond", "index", "fx", "crypto", "derivative", "other"}
+
+// Attribute formats, as declared in the design. Imported rows are checked
+// against them since they bypass the generated validation.
+var (
+	symbolPattern   = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9.\-]{0,19}$`)
+	micPattern      = regexp.MustCompile(`^[A-Za-z0-9]{4}$`)
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	isinPattern     = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{9}[0-9]$`)
+	figiPattern     = regexp.MustCompile(`^[B-DF-HJ-NP-TV-Z0-9]{2}G[B-DF-HJ-NP-TV-Z0-9]{8}[0-9]$`)
+)
+
+// MICResolver maps an operating or segment MIC to the operating MIC of the
+// exchange, as exchange.Service does.
+type MICResolver interface {
+	ResolveMIC(mic string) (string, bool)
+}
+
+// validateFormat checks the attributes of inst against the formats of the
+// design.
+func validateFormat(inst *Instrument) error {
+	switch {
+	case !symbolPattern.MatchString(inst.Symbol):
+		return fmt.Errorf("invalid symbol %q", inst.Symbol)
+	case !micPattern.MatchString(inst.ExchangeMIC):
+		return fmt.Errorf("invalid exchange MIC %q", inst.ExchangeMIC)
+	case inst.Name == "" || utf8.RuneCountInString(inst.Name) > 200:
+		return errors.New("name must have 1 to 200 characters")
+	case !slices.Contains(AssetClasses, inst.AssetClass):
+		return fmt.Errorf("invalid asset class %q", inst.AssetClass)
+	case !currencyPattern.MatchString(inst.Currency):
+		return fmt.Errorf("invalid currency %q", inst.Currency)
+	case inst.ISIN != "" && !isinPattern.MatchString(inst.ISIN):
+		return fmt.Errorf("invalid ISIN %q", inst.ISIN)
+	case inst.FIGI != "" && !figiPattern.MatchString(inst.FIGI):
+		return fmt.Errorf("invalid FIGI %q", inst.FIGI)
+	}
+	return nil
+}
+
+// validateCheckDigits verifies the check digits of the ISIN and FIGI of
+// inst, which must match their formats.
+func validateCheckDigits(inst *Instrument) error {
+	if inst.ISIN != "" && !validISIN(inst.ISIN) {
+		return fmt.Errorf("ISIN %s has a wrong check digit", inst.ISIN)
+	}
+	if inst.FIGI != "" && !validFIGI(inst.FIGI) {
+		return fmt.Errorf("FIGI %s has a wrong check digit", inst.FIGI)
+	}
+	return nil
+}
+
+// charValue returns the value of an ISIN or FIGI character: its value for
+// a digit and 10 to 35 for the letters A to Z.
+func charValue(c byte) int {
+	if c >= '0' && c <= '9' {
+		return int(c - '0')
+	}
+	return int(c-'A') + 10
+}
+
+// validISIN verifies the ISO 6166 check digit: the Luhn checksum of the
+// digits obtained by replacing each letter with its two-digit value.
+func validISIN(isin string) bool {
+	var digits []int
+	for i := 0; i < len(isin); i++ {
+		v := charValue(isin[i])
+		if v >= 10 {
+			digits = append(digits, v/10)
+		}
+		digits = append(digits, v%10)
+	}
+	sum := 0
+	for i := range digits {
+		d := digits[len(digits)-1-i]
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// validFIGI verifies the FIGI check digit: the modified Luhn checksum of the
+// values of the first 11 characters, doubling every second one.
+func validFIGI(figi string) bool {
+	sum := 0
+	for i := 0; i < 11; i++ {
+		v := charValue(figi[i])
+		if i%2 == 1 {
+			v *= 2
+		}
+		sum += v/10 + v%10
+	}
+	return charValue(figi[11]) == (10-sum%10)%10
+}
@@ -0,0 +1,34 @@
+// Package logctx carries the request-scoped *slog.Logger in a context.
+//
+// The HTTP middleware stores a logger enriched with the request ID, trace
+// and user attributes; services retrieve it with LoggerFromContext so their
+// log lines carry those attributes without a constructor-injected logger.
+// It is a leaf package so that services can use it without importing the
+// server package that wires them.
+package logctx
+
+import (
+	"context"
+	"log/slog"
+)
+
+type ctxKey struct{}
+
+// ContextWithLogger returns a copy of ctx that carries logger.
+func ContextWithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, logger)
+}
+
+// LoggerFromContext returns the logger stored in ctx, or slog.Default() if
+// there is none.
+func LoggerFromContext(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(ctxKey{}).(*slog.Logger); ok && l != nil {
+		return l
+	}
+	return slog.Default()
+}
+
+// With returns a copy of ctx whose logger has the given attributes added.
+func With(ctx context.Context, args ...any) context.Context {
+	return ContextWithLogger(ctx, LoggerFromContext(ctx).With(args...))
+}
@@ -0,0 +1,28 @@
+package logctx
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLoggerFromContext(t *testing.T) {
+	if LoggerFromContext(context.Background()) != slog.Default() {
+		t.Error("expected slog.Default() without a stored logger")
+	}
+
+	var buf bytes.Buffer
+	ctx := ContextWithLogger(context.Background(), slog.New(slog.NewTextHandler(&buf, nil)))
+	ctx = With(ctx, "request_id", "r-1")
+	ctx = With(ctx, "user_id", "alice")
+	LoggerFromContext(ctx).InfoContext(ctx, "hello")
+
+	out := buf.String()
+	for _, want := range []string{"msg=hello", "request_id=r-1", "user_id=alice"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log line %q missing %q", out, want)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Package metrics exposes Prometheus metrics for the Goa services mounted in
+// ta-server.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes every ta-server metric.
+const namespace = "ta_server"
+
+// unknownMethod labels requests whose route is not a registered mount.
+const unknownMethod = "unknown"
+
+// Registry holds the Prometheus collectors of the API server.
+type Registry struct {
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	inflight *prometheus.GaugeVec
+
+	mu     sync.RWMutex
+	routes map[string]string // "<service> <verb> <pattern>" -> method
+}
+
+// New returns a registry with the HTTP request metrics and the Go runtime
+// and process collectors registered.
+func New() *Registry {
+	r := &Registry{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Total number of HTTP requests by Goa service, method and status code.",
+		}, []string{"service", "method", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "HTTP request latency by Goa service and method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"service", "method"}),
+		inflight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "requests_in_flight",
+			Help:      "Number of HTTP requests currently being served by Goa service and method.",
+		}, []string{"service", "method"}),
+		routes: make(map[string]string),
+	}
+	r.registry.MustRegister(
+		r.requests,
+		r.duration,
+		r.inflight,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return r
+}
+
+// AddRoute registers a mounted Goa method so that requests matching verb and
+// pattern are labelled with service and method. The gauges and histograms
+// of registered methods are exported with zero values until first use.
+func (r *Registry) AddRoute(service, method, verb, pattern string) {
+	r.mu.Lock()
+	r.routes[routeKey(service, verb, pattern)] = method
+	r.mu.Unlock()
+
+	r.inflight.WithLabelValues(service, method)
+	r.duration.WithLabelValues(service, method)
+}
+
+// Middleware instruments the handlers of a generated Goa server, e.g.
+// watchlistServer.Use(reg.Middleware(watchlistServer.Service())). It relies
+// on the chi route pattern to resolve the method, so it must be applied to
+// handlers mounted on the Goa muxer.
+func (r *Registry) Middleware(service string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			method := r.method(service, req)
+			inflight := r.inflight.WithLabelValues(service, method)
+			inflight.Inc()
+			defer inflight.Dec()
+
+			sw := &statusWriter{ResponseWriter: w}
+			start := time.Now()
+			next.ServeHTTP(sw, req)
+
+			r.duration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+			r.requests.WithLabelValues(service, method, strconv.Itoa(sw.code())).Inc()
+		})
+	}
+}
+
+// Handler returns the /metrics handler.
+func (r *Registry) Handler() http.Handler {
+	return promhttp.HandlerFor(r.registry, promhttp.HandlerOpts{Registry: r.registry})
+}
+
+func (r *Registry) method(service string, req *http.Request) string {
+	rctx := chi.RouteContext(req.Context())
+	if rctx == nil {
+		return unknownMethod
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if m, ok := r.routes[routeKey(service, req.Method, rctx.RoutePattern())]; ok {
+		return m
+	}
+	return unknownMethod
+}
+
+func routeKey(service, verb, pattern string) string {
+	return service + " " + verb + " " + pattern
+}
+
+// statusWriter records the response status code.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *statusWriter) code() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+// Flush implements http.Flusher.
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying writer for http.ResponseController.
+func (w *statusWriter) Unwrap() http.ResponseWriter { return w.ResponseWriter }
@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMiddlewareLabelsByGoaMethod(t *testing.T) {
+	reg := New()
+	reg.AddRoute("watchlist", "List", "GET", "/watchlist")
+	reg.AddRoute("watchlist", "Remove", "DELETE", "/watchlist/{symbol}")
+
+	mw := reg.Middleware("watchlist")
+	r := chi.NewRouter()
+	r.Method("GET", "/watchlist", mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("[]"))
+	})))
+	r.Method("DELETE", "/watchlist/{symbol}", mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})))
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest("GET", "/watchlist", nil),
+		httptest.NewRequest("GET", "/watchlist", nil),
+		httptest.NewRequest("DELETE", "/watchlist/AAPL", nil),
+	} {
+		r.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	if got := testutil.ToFloat64(reg.requests.WithLabelValues("watchlist", "List", "200")); got != 2 {
+		t.Errorf("List requests = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(reg.requests.WithLabelValues("watchlist", "Remove", "204")); got != 1 {
+		t.Errorf("Remove requests = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(reg.inflight.WithLabelValues("watchlist", "List")); got != 0 {
+		t.Errorf("List in flight = %v, want 0", got)
+	}
+	if got := testutil.CollectAndCount(reg.duration); got != 2 {
+		t.Errorf("duration series = %d, want 2", got)
+	}
+}
+
+func TestHandlerExposesRuntimeMetrics(t *testing.T) {
+	w := httptest.NewRecorder()
+	New().Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	if body := w.Body.String(); !containsAll(body, "go_goroutines", "process_cpu_seconds_total") {
+		t.Error("runtime and process metrics missing from /metrics output")
+	}
+}
+
+func containsAll(s string, subs ...string) bool {
+	for _, sub := range subs {
+		if !strings.Contains(s, sub) {
+			return false
+		}
+	}
+	return true
+}
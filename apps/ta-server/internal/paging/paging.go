@@ -0,0 +1,86 @@
+// Package paging implements the cursor pagination contract shared by the
+// list methods of the ta-server services.
+//
+// A list method sorts its items by a key made of one or more strings, the
+// last of which must be unique (an ID or a MIC) so that the order is total.
+// A page holds up to limit items and, when more follow, an opaque cursor
+// encoding the sort and the key of its last item. Passing that cursor back
+// resumes after that item, so inserts and deletes between requests neither
+// skip nor repeat items. The total number of matching items is reported
+// alongside every page, in the X-Total-Count header over HTTP.
+package paging
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// MaxLimit is the largest page size accepted by list methods.
+const MaxLimit = 1000
+
+// ErrInvalidCursor is returned by Decode for malformed cursors and cursors
+// issued for a different sort order.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+type cursor struct {
+	Sort  string   `json:"s"`
+	After []string `json:"k"`
+}
+
+// Encode returns the opaque cursor resuming a listing sorted by sort after
+// the item with the given key.
+func Encode(sort string, after []string) string {
+	b, _ := json.Marshal(cursor{Sort: sort, After: after})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Decode returns the key encoded in the cursor s, which must have been
+// issued by Encode for the same sort. An empty cursor decodes to a nil key,
+// the start of the listing.
+func Decode(s, sort string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil || len(c.After) == 0 {
+		return nil, fmt.Errorf("%w: malformed", ErrInvalidCursor)
+	}
+	if c.Sort != sort {
+		return nil, fmt.Errorf("%w: issued for sort %q, not %q", ErrInvalidCursor, c.Sort, sort)
+	}
+	return c.After, nil
+}
+
+// Page returns up to limit items following the item with key after, and the
+// key of the last returned item if more items follow. items must be sorted
+// by ascending key. A nil after starts at the first item and a limit of zero
+// or less returns every remaining item.
+func Page[T any](items []T, key func(T) []string, after []string, limit int) (page []T, next []string) {
+	start := 0
+	if after != nil {
+		start = sort.Search(len(items), func(i int) bool {
+			return slices.Compare(key(items[i]), after) > 0
+		})
+	}
+	items = items[start:]
+	if limit <= 0 || len(items) <= limit {
+		return items, nil
+	}
+	page = items[:limit]
+	return page, key(page[limit-1])
+}
+
+// Sort sorts items in place by ascending key.
+func Sort[T any](items []T, key func(T) []string) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		return slices.Compare(key(a), key(b))
+	})
+}
@@ -0,0 +1,57 @@
+package paging
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPageWalksEveryItemOnce(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+	key := func(s string) []string { return []string{s} }
+
+	var got []string
+	var after []string
+	for i := 0; ; i++ {
+		if i > len(items) {
+			t.Fatal("pagination did not terminate")
+		}
+		page, next := Page(items, key, after, 2)
+		got = append(got, page...)
+		if next == nil {
+			break
+		}
+		// Round trip through the opaque cursor as a client would.
+		var err error
+		if after, err = Decode(Encode("name", next), "name"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if strings.Join(got, "") != "abcde" {
+		t.Errorf("got %q, want every item once", got)
+	}
+
+	if page, next := Page(items, key, nil, 0); len(page) != 5 || next != nil {
+		t.Errorf("Page without limit = %q, %q", page, next)
+	}
+	// A deleted item does not break resumption.
+	if page, _ := Page([]string{"a", "d", "e"}, key, []string{"b"}, 2); strings.Join(page, "") != "de" {
+		t.Errorf("Page after deleted item = %q, want [d e]", page)
+	}
+}
+
+func TestDecodeRejectsForeignCursors(t *testing.T) {
+	for name, c := range map[string]string{
+		"not base64":   "!!!",
+		"not json":     Encode("name", nil)[:3],
+		"empty key":    Encode("name", nil),
+		"another sort": Encode("mic", []string{"XNYS"}),
+	} {
+		if _, err := Decode(c, "name"); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("%s: error = %v, want ErrInvalidCursor", name, err)
+		}
+	}
+	if after, err := Decode("", "name"); err != nil || after != nil {
+		t.Errorf("Decode(\"\") = %q, %v", after, err)
+	}
+}
@@ -0,0 +1,159 @@
+package problem
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"runtime/debug"
+)
+
+type stateKey struct{}
+
+// state tracks the response of a single request so that errors raised after
+// the handler called WriteHeader can still be reported as problem details.
+type state struct {
+	w    *deferredWriter
+	path string
+}
+
+// deferredWriter delays WriteHeader until the first body byte is written.
+// Generated Goa encoders call WriteHeader before encoding the body; when the
+// encoding then fails nothing has reached the client yet and the status can
+// still be replaced by an error response.
+type deferredWriter struct {
+	http.ResponseWriter
+	status    int
+	committed bool
+	// problem is the error response rendered by Formatter, if any. Its
+	// status follows the one written by the encoder.
+	problem *Problem
+}
+
+func (w *deferredWriter) WriteHeader(code int) {
+	if w.committed {
+		return
+	}
+	if code >= 100 && code < 200 {
+		// Informational responses are sent immediately and do not commit.
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+	w.status = code
+	if w.problem != nil {
+		w.problem.encodedAs(code)
+		if code == http.StatusUnauthorized {
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		}
+	}
+}
+
+func (w *deferredWriter) Write(b []byte) (int, error) {
+	w.commit()
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *deferredWriter) commit() {
+	if w.committed {
+		return
+	}
+	w.committed = true
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+}
+
+// reset discards a pending status so that an error response can be written.
+// It reports false when the response was already committed.
+func (w *deferredWriter) reset() bool {
+	if w.committed {
+		return false
+	}
+	w.status = 0
+	w.problem = nil
+	return true
+}
+
+// Flush implements http.Flusher.
+func (w *deferredWriter) Flush() {
+	w.commit()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker.
+func (w *deferredWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	w.committed = true
+	return h.Hijack()
+}
+
+// Unwrap returns the underlying writer for http.ResponseController.
+func (w *deferredWriter) Unwrap() http.ResponseWriter { return w.ResponseWriter }
+
+// Middleware prepares requests for problem details error reporting and
+// converts panics into 500 responses. It must run inside the chi RequestID
+// middleware so that the correlation ID is available.
+func Middleware(logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			dw := &deferredWriter{ResponseWriter: w}
+			ctx := context.WithValue(r.Context(), stateKey{}, &state{w: dw, path: r.URL.Path})
+			r = r.WithContext(ctx)
+
+			defer func() {
+				if rec := recover(); rec != nil {
+					if rec == http.ErrAbortHandler {
+						panic(rec)
+					}
+					logger.ErrorContext(ctx, "panic recovered",
+						"panic", fmt.Sprint(rec),
+						"stack", string(debug.Stack()),
+					)
+					if dw.reset() {
+						Write(dw, r, WithStatus(ctx, http.StatusInternalServerError, ""))
+					}
+				}
+				dw.commit()
+			}()
+
+			next.ServeHTTP(dw, r)
+		})
+	}
+}
+
+// ErrorHandler returns the Goa error handler invoked when a response or error
+// fails to encode. It logs the error with its correlation ID and, unless the
+// response body was already started, replaces the response with a 500
+// problem details document.
+func ErrorHandler(logger *slog.Logger) func(context.Context, http.ResponseWriter, error) {
+	return func(ctx context.Context, w http.ResponseWriter, err error) {
+		p := New(ctx, err)
+		logger.ErrorContext(ctx, "HTTP Error",
+			"error", err,
+			"status", p.Status,
+			"correlation_id", p.CorrelationID,
+		)
+
+		s, ok := ctx.Value(stateKey{}).(*state)
+		if !ok {
+			Write(w, nil, p)
+			return
+		}
+		if !s.w.reset() {
+			// Part of the body already reached the client.
+			return
+		}
+		p = WithStatus(ctx, http.StatusInternalServerError, "")
+		p.Instance = s.path
+		Write(s.w, nil, p)
+	}
+}
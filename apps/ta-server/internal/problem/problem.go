@@ -0,0 +1,176 @@
+// Package problem writes RFC 9457 "application/problem+json" error responses
+// shared by every module server mounted in ta-server.
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// ContentType is the media type of problem details responses.
+const ContentType = "application/problem+json"
+
+// Problem is an RFC 9457 problem details object.
+type Problem struct {
+	// Type is a URI reference identifying the problem type.
+	Type string `json:"type"`
+	// Title is a short, human-readable summary of the problem type.
+	Title string `json:"title"`
+	// Status is the HTTP status code.
+	Status int `json:"status"`
+	// Detail is a human-readable explanation of this occurrence.
+	Detail string `json:"detail,omitempty"`
+	// Instance is the request path that produced the problem.
+	Instance string `json:"instance,omitempty"`
+	// Name is the Goa error name (e.g. "missing_field").
+	Name string `json:"name,omitempty"`
+	// ErrorID is the unique Goa error ID, if any.
+	ErrorID string `json:"error_id,omitempty"`
+	// CorrelationID is the chi request ID, also logged with the error.
+	CorrelationID string `json:"correlation_id,omitempty"`
+	// Errors lists individual validation failures when several were merged.
+	Errors []*FieldError `json:"errors,omitempty"`
+
+	// message is the message of a service error reported as an internal
+	// error, disclosed if its design maps it to a client error.
+	message string
+}
+
+// FieldError is a single validation failure.
+type FieldError struct {
+	Name   string `json:"name"`
+	Detail string `json:"detail"`
+}
+
+// StatusCode implements goahttp.Statuser.
+func (p *Problem) StatusCode() int { return p.Status }
+
+// validationErrors are the Goa error names produced by generated decoders
+// and validators.
+var validationErrors = map[string]bool{
+	goa.InvalidFieldType:     true,
+	goa.MissingField:         true,
+	goa.InvalidEnumValue:     true,
+	goa.InvalidFormat:        true,
+	goa.InvalidPattern:       true,
+	goa.InvalidRange:         true,
+	goa.InvalidLength:        true,
+	goa.DecodePayload:        true,
+	goa.MissingPayload:       true,
+	goa.UnsupportedMediaType: true,
+}
+
+// namedStatus maps conventional service error names to status codes. Other
+// names are internal errors unless the design of the method maps them to a
+// status, which the generated encoder then writes (see Formatter).
+var namedStatus = map[string]int{
+	"bad_request":         http.StatusBadRequest,
+	"unauthorized":        http.StatusUnauthorized,
+	"forbidden":           http.StatusForbidden,
+	"not_found":           http.StatusNotFound,
+	"conflict":            http.StatusConflict,
+	"precondition_failed": http.StatusPreconditionFailed,
+	"too_many_requests":   http.StatusTooManyRequests,
+	"unavailable":         http.StatusServiceUnavailable,
+}
+
+// New builds the problem details for err. Errors that are not Goa service
+// errors are reported as internal errors without exposing their message.
+func New(ctx context.Context, err error) *Problem {
+	p := &Problem{CorrelationID: chimiddleware.GetReqID(ctx)}
+
+	var serr *goa.ServiceError
+	if !errors.As(err, &serr) {
+		return p.with(http.StatusInternalServerError, "")
+	}
+
+	p.Name = serr.Name
+	p.ErrorID = serr.ID
+	switch {
+	case serr.Name == goa.UnsupportedMediaType:
+		p.with(http.StatusUnsupportedMediaType, serr.Message)
+	case validationErrors[serr.Name]:
+		p.with(http.StatusBadRequest, serr.Message)
+		if h := serr.History(); len(h) > 1 {
+			for _, e := range h {
+				p.Errors = append(p.Errors, &FieldError{Name: e.Name, Detail: e.Message})
+			}
+		}
+	case namedStatus[serr.Name] != 0:
+		p.with(namedStatus[serr.Name], serr.Message)
+	case serr.Timeout:
+		p.with(http.StatusGatewayTimeout, serr.Message)
+	case serr.Temporary:
+		p.with(http.StatusServiceUnavailable, serr.Message)
+	case serr.Fault:
+		// Faults may carry internal details, keep them in the logs only.
+		p.with(http.StatusInternalServerError, "")
+	default:
+		p.with(http.StatusInternalServerError, "")
+		p.message = serr.Message
+	}
+	return p
+}
+
+// WithStatus builds a problem for status with the given detail message.
+func WithStatus(ctx context.Context, status int, detail string) *Problem {
+	p := &Problem{CorrelationID: chimiddleware.GetReqID(ctx)}
+	return p.with(status, detail)
+}
+
+// encodedAs sets the status of p to the one the generated encoder writes for
+// its error, as mapped by the design.
+func (p *Problem) encodedAs(status int) {
+	if status < http.StatusBadRequest || status == p.Status {
+		return
+	}
+	detail := p.Detail
+	if detail == "" && status < http.StatusInternalServerError {
+		detail = p.message
+	}
+	p.with(status, detail)
+}
+
+func (p *Problem) with(status int, detail string) *Problem {
+	p.Type = "about:blank"
+	p.Title = http.StatusText(status)
+	p.Status = status
+	p.Detail = detail
+	return p
+}
+
+// Write writes p to w as an application/problem+json response.
+func Write(w http.ResponseWriter, r *http.Request, p *Problem) {
+	if p.Instance == "" && r != nil {
+		p.Instance = r.URL.Path
+	}
+	h := w.Header()
+	h.Del("Content-Length")
+	h.Set("Content-Type", ContentType)
+	h.Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// Formatter is the Goa error formatter used by the generated servers. It
+// renders service and validation errors as problem details. When the request
+// went through Middleware, the response content type is switched to
+// application/problem+json and the problem takes the status the generated
+// encoder writes, so that errors declared in the design get the status it
+// maps them to; 401 responses also carry the bearer token challenge (RFC
+// 6750).
+func Formatter(ctx context.Context, err error) goahttp.Statuser {
+	p := New(ctx, err)
+	if s, ok := ctx.Value(stateKey{}).(*state); ok {
+		s.w.Header().Set("Content-Type", ContentType)
+		p.Instance = s.path
+		s.w.problem = p
+	}
+	return p
+}
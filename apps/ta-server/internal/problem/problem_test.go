@@ -0,0 +1,145 @@
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	goa "goa.design/goa/v3/pkg"
+)
+
+var testLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func TestNew(t *testing.T) {
+	merged := goa.MergeErrors(goa.MissingFieldError("symbol", "body"), goa.MissingFieldError("on_hand", "body"))
+	cases := []struct {
+		name   string
+		err    error
+		status int
+		errors int
+	}{
+		{"validation", goa.MissingFieldError("symbol", "body"), http.StatusBadRequest, 0},
+		{"merged validation", merged, http.StatusBadRequest, 2},
+		{"not found", goa.PermanentError("not_found", "no such symbol"), http.StatusNotFound, 0},
+		{"temporary", goa.TemporaryError("busy", "try again"), http.StatusServiceUnavailable, 0},
+		{"fault", goa.Fault("boom"), http.StatusInternalServerError, 0},
+		{"unknown name", goa.PermanentError("quota_exceeded", "internal detail"), http.StatusInternalServerError, 0},
+		{"plain error", errors.New("db password leaked"), http.StatusInternalServerError, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p := New(context.Background(), c.err)
+			if p.Status != c.status {
+				t.Errorf("status = %d, want %d", p.Status, c.status)
+			}
+			if len(p.Errors) != c.errors {
+				t.Errorf("errors = %d, want %d", len(p.Errors), c.errors)
+			}
+			if p.Status == http.StatusInternalServerError && p.Detail != "" {
+				t.Errorf("internal error detail leaked: %q", p.Detail)
+			}
+		})
+	}
+}
+
+func serve(h http.Handler) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	chimiddleware.RequestID(Middleware(testLogger)(h)).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/watchlist", nil))
+	return w
+}
+
+func decode(t *testing.T, w *httptest.ResponseRecorder) *Problem {
+	t.Helper()
+	if ct := w.Header().Get("Content-Type"); ct != ContentType {
+		t.Fatalf("Content-Type = %q, want %q", ct, ContentType)
+	}
+	var p Problem
+	if err := json.NewDecoder(w.Body).Decode(&p); err != nil {
+		t.Fatalf("decode problem: %v", err)
+	}
+	if p.CorrelationID == "" {
+		t.Error("missing correlation_id")
+	}
+	if p.Instance != "/watchlist" {
+		t.Errorf("instance = %q, want /watchlist", p.Instance)
+	}
+	return &p
+}
+
+func TestErrorHandlerReplacesUncommittedResponse(t *testing.T) {
+	eh := ErrorHandler(testLogger)
+	w := serve(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Mimics a generated encoder failing after WriteHeader.
+		w.WriteHeader(http.StatusOK)
+		eh(r.Context(), w, errors.New("json: unsupported value"))
+	}))
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want 500", w.Code)
+	}
+	decode(t, w)
+}
+
+func TestMiddlewareRecoversPanics(t *testing.T) {
+	w := serve(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want 500", w.Code)
+	}
+	decode(t, w)
+}
+
+func TestFormatterSetsContentType(t *testing.T) {
+	w := serve(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		p := Formatter(r.Context(), goa.MissingFieldError("symbol", "body"))
+		w.WriteHeader(p.StatusCode())
+		json.NewEncoder(w).Encode(p)
+	}))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400", w.Code)
+	}
+	if p := decode(t, w); p.Name != goa.MissingField {
+		t.Errorf("name = %q, want %q", p.Name, goa.MissingField)
+	}
+}
+
+func TestFormatterTakesEncodedStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+		detail string
+	}{
+		// As declared in a design: the encoder writes the mapped status.
+		{"unknown name mapped", goa.PermanentError("quota_exceeded", "quota exceeded"), http.StatusTooManyRequests, "quota exceeded"},
+		{"named", goa.PermanentError("not_found", "no such symbol"), http.StatusNotFound, "no such symbol"},
+		{"unauthorized", goa.PermanentError("unauthorized", "missing token"), http.StatusUnauthorized, "missing token"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			w := serve(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// Mimics a generated encoder for an error declared in the design.
+				body := Formatter(r.Context(), c.err)
+				w.WriteHeader(c.status)
+				json.NewEncoder(w).Encode(body)
+			}))
+			if w.Code != c.status {
+				t.Fatalf("status = %d, want %d", w.Code, c.status)
+			}
+			p := decode(t, w)
+			if p.Status != c.status || p.Detail != c.detail {
+				t.Errorf("problem status %d detail %q, want %d %q", p.Status, p.Detail, c.status, c.detail)
+			}
+			if got := w.Header().Get("WWW-Authenticate"); (c.status == http.StatusUnauthorized) != (got != "") {
+				t.Errorf("WWW-Authenticate = %q", got)
+			}
+		})
+	}
+}
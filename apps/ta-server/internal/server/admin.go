@@ -0,0 +1,90 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/metrics"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/problem"
+)
+
+// minAdminTokenSize is the minimum length of the admin token, so that it
+// cannot be guessed.
+const minAdminTokenSize = 32
+
+// HandleAdminServer starts the admin HTTP server on addr. It serves
+// operational endpoints (Prometheus /metrics and the exchange list import)
+// on a port separate from the public API so that they can be firewalled
+// independently. The exchange import changes what the API serves, so it
+// requires the admin token as bearer token, and is not served at all when
+// token is empty.
+func HandleAdminServer(ctx context.Context, addr string, reg *metrics.Registry, exchangeImport http.Handler, token []byte, wg *sync.WaitGroup, errc chan error, logger *slog.Logger) {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", reg.Handler())
+	if len(token) > 0 {
+		mux.Handle("POST /exchanges/import", requireAdminToken(token, exchangeImport))
+	} else {
+		logger.InfoContext(ctx, "Exchange import disabled, no admin token configured")
+	}
+
+	srv := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: time.Second * 60}
+
+	(*wg).Add(1)
+	go func() {
+		defer (*wg).Done()
+
+		go func() {
+			logger.InfoContext(ctx, "Admin server listening", "host", addr)
+			errc <- srv.ListenAndServe()
+		}()
+
+		<-ctx.Done()
+		logger.InfoContext(ctx, "shutting down admin server", "host", addr)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if err := srv.Shutdown(ctx); err != nil {
+			logger.ErrorContext(ctx, "failed to shutdown admin server", "error", err)
+		}
+	}()
+}
+
+// loadAdminToken reads the admin token from the file at path, nil when path
+// is empty.
+func loadAdminToken(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	token, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read admin token: %w", err)
+	}
+	token = bytes.TrimSpace(token)
+	if len(token) < minAdminTokenSize {
+		return nil, fmt.Errorf("admin token must be at least %d bytes", minAdminTokenSize)
+	}
+	return token, nil
+}
+
+// requireAdminToken serves h only to requests sending token as their bearer
+// token, and responds 401 to every other request.
+func requireAdminToken(token []byte, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(got), token) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+			problem.Write(w, r, problem.WithStatus(r.Context(), http.StatusUnauthorized, "missing or invalid admin token"))
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
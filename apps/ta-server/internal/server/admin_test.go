@@ -0,0 +1,62 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRequireAdminToken(t *testing.T) {
+	token := []byte(strings.Repeat("s", minAdminTokenSize))
+	h := requireAdminToken(token, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	for _, tc := range []struct {
+		name, auth string
+		want       int
+	}{
+		{"missing", "", http.StatusUnauthorized},
+		{"wrong", "Bearer " + strings.Repeat("x", minAdminTokenSize), http.StatusUnauthorized},
+		{"not bearer", "Basic " + string(token), http.StatusUnauthorized},
+		{"valid", "Bearer " + string(token), http.StatusOK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/exchanges/import", nil)
+			if tc.auth != "" {
+				r.Header.Set("Authorization", tc.auth)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if w.Code != tc.want {
+				t.Fatalf("status = %d, want %d", w.Code, tc.want)
+			}
+			if tc.want == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") == "" {
+				t.Error("401 without a WWW-Authenticate challenge")
+			}
+		})
+	}
+}
+
+func TestLoadAdminToken(t *testing.T) {
+	if token, err := loadAdminToken(""); err != nil || token != nil {
+		t.Fatalf("no file = %q, %v, want no token", token, err)
+	}
+	dir := t.TempDir()
+	short := filepath.Join(dir, "short")
+	if err := os.WriteFile(short, []byte("secret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadAdminToken(short); err == nil {
+		t.Error("short token accepted")
+	}
+	valid := filepath.Join(dir, "valid")
+	want := strings.Repeat("s", minAdminTokenSize)
+	if err := os.WriteFile(valid, []byte(want+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if token, err := loadAdminToken(valid); err != nil || string(token) != want {
+		t.Errorf("token = %q, %v, want %q", token, err, want)
+	}
+}
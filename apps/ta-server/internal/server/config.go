@@ -1,11 +1,37 @@
 package server
 
+import (
+	"time"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/auth"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/telemetry"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/watchlist"
+)
+
+// DefaultDrainDelay is how long the server keeps serving with a failing
+// readiness probe on shutdown when the configuration does not say otherwise.
+const DefaultDrainDelay = 5 * time.Second
+
 // Config holds the server configuration.
 type Config struct {
-	Host      string
-	Port      int
-	Debug     bool
-	LogLevel  string
-	LogFormat string
-	Secure    bool
+	Host           string
+	Port           int
+	Debug          bool
+	LogLevel       string
+	LogFormat      string
+	Secure         bool
+	TLSCert        string
+	TLSKey         string
+	TLSClientCA    string
+	AdminHost      string
+	AdminPort      int
+	AdminTokenFile string
+	DrainDelay     time.Duration
+	Storage        string
+	StoragePath    string
+	ExchangesFile  string
+	AccessLog      AccessLogConfig
+	Auth           auth.Config
+	Telemetry      telemetry.Config
+	Watchlist      watchlist.Config
 }
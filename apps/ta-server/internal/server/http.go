@@ -2,26 +2,35 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"log/slog"
 	"net/http"
 	"net/url"
 	"sync"
 	"time"
 
+	exchange "github.com/reidlai/ta-workspace/apps/ta-server/gen/exchange"
+	exchangesvr "github.com/reidlai/ta-workspace/apps/ta-server/gen/http/exchange/server"
+	instrumentsvr "github.com/reidlai/ta-workspace/apps/ta-server/gen/http/instrument/server"
+	watchlistsvr "github.com/reidlai/ta-workspace/apps/ta-server/gen/http/watchlist/server"
+	instrument "github.com/reidlai/ta-workspace/apps/ta-server/gen/instrument"
+	watchlist "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/auth"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/health"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/metrics"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/problem"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/telemetry"
 	portfoliosvr "github.com/reidlai/ta-workspace/modules/portfolio/go/gen/http/portfolio/server"
 	portfolio "github.com/reidlai/ta-workspace/modules/portfolio/go/gen/portfolio"
-	watchlistsvr "github.com/reidlai/ta-workspace/modules/watchlist/go/gen/http/watchlist/server"
-	watchlist "github.com/reidlai/ta-workspace/modules/watchlist/go/gen/watchlist"
 
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
-	"go.opentelemetry.io/otel/trace"
 	"goa.design/clue/debug"
 	goahttp "goa.design/goa/v3/http"
 )
 
 // HandleHTTPServer starts configures and starts a HTTP server on the given
 // URL. It shuts down the server if any error is received in the error channel.
-func HandleHTTPServer(ctx context.Context, u *url.URL, watchlistEndpoints *watchlist.Endpoints, portfolioEndpoints *portfolio.Endpoints, wg *sync.WaitGroup, errc chan error, logger *slog.Logger, dbg bool) {
+func HandleHTTPServer(ctx context.Context, u *url.URL, watchlistEndpoints *watchlist.Endpoints, portfolioEndpoints *portfolio.Endpoints, exchangeEndpoints *exchange.Endpoints, instrumentEndpoints *instrument.Endpoints, authn *auth.Authenticator, reg *metrics.Registry, checks *health.Registry, tlsCfg *tls.Config, accessLog AccessLogConfig, drainDelay time.Duration, wg *sync.WaitGroup, errc chan error, logger *slog.Logger, dbg bool) {
 
 	// Provide the transport specific request decoder and response encoder.
 	// The goa http package has built-in support for JSON, XML and gob.
@@ -49,27 +58,72 @@ func HandleHTTPServer(ctx context.Context, u *url.URL, watchlistEndpoints *watch
 	// the service input and output data structures to HTTP requests and
 	// responses.
 	var (
-		watchlistServer *watchlistsvr.Server
-		portfolioServer *portfoliosvr.Server
+		watchlistServer  *watchlistsvr.Server
+		portfolioServer  *portfoliosvr.Server
+		exchangeServer   *exchangesvr.Server
+		instrumentServer *instrumentsvr.Server
 	)
 	{
-		eh := errorHandler(ctx, logger)
-		watchlistServer = watchlistsvr.New(watchlistEndpoints, mux, dec, enc, eh, nil)
-		portfolioServer = portfoliosvr.New(portfolioEndpoints, mux, dec, enc, eh, nil)
+		// Every module server shares the same problem+json (RFC 9457) error
+		// handler and formatter.
+		eh := problem.ErrorHandler(logger)
+		watchlistServer = watchlistsvr.New(watchlistEndpoints, mux, dec, enc, eh, problem.Formatter)
+		portfolioServer = portfoliosvr.New(portfolioEndpoints, mux, dec, enc, eh, problem.Formatter)
+		exchangeServer = exchangesvr.New(exchangeEndpoints, mux, dec, enc, eh, problem.Formatter)
+		instrumentServer = instrumentsvr.New(instrumentEndpoints, mux, dec, enc, eh, problem.Formatter)
 	}
 
+	// The watchlist endpoints authenticate their callers through the JWT
+	// security scheme of the design. Authenticate every other module
+	// handler: the authenticator replaces the X-User-ID header with the
+	// bearer token subject before the generated decoders build the service
+	// payloads; userLogger, applied first so that it runs inside the
+	// authenticator, adds that user to the request logger.
+	portfolioServer.Use(userLogger)
+	exchangeServer.Use(userLogger)
+	instrumentServer.Use(userLogger)
+	portfolioServer.Use(authn.Middleware)
+	exchangeServer.Use(authn.Middleware)
+	instrumentServer.Use(authn.Middleware)
+
+	// Browsers' EventSource cannot send the Authorization header: the event
+	// stream also accepts a short-lived token as the access_token parameter.
+	watchlistServer.Events = authn.QueryToken(watchlistServer.Events)
+
+	// Record Prometheus request metrics labelled by Goa service and method.
+	// Applied last so that rejected (unauthenticated) requests are counted.
+	watchlistServer.Use(reg.Middleware(watchlistServer.Service()))
+	portfolioServer.Use(reg.Middleware(portfolioServer.Service()))
+	exchangeServer.Use(reg.Middleware(exchangeServer.Service()))
+	instrumentServer.Use(reg.Middleware(instrumentServer.Service()))
+
 	// Configure the mux.
 	watchlistsvr.Mount(mux, watchlistServer)
 	portfoliosvr.Mount(mux, portfolioServer)
+	exchangesvr.Mount(mux, exchangeServer)
+	instrumentsvr.Mount(mux, instrumentServer)
+
+	// Liveness and readiness probes, served without authentication.
+	mux.Handle("GET", "/healthz", checks.LivenessHandler().ServeHTTP)
+	mux.Handle("GET", "/readyz", checks.ReadinessHandler().ServeHTTP)
 
 	var handler http.Handler = mux
+	// Convert errors and panics into problem details. Applied before
+	// RequestID so that it runs inside it and sees the correlation ID.
+	handler = problem.Middleware(logger)(handler)
+
+	// Inject Slog Logger with Trace Context and log each completed request.
+	// Applied inside RequestID and RealIP so the log line carries both.
+	routes := newRouteTable()
+	handler = SlogMiddleware(logger, accessLog, routes)(handler)
+
 	// Apply Chi middleware for performance and resilience
 	handler = chimiddleware.RequestID(handler)
 	handler = chimiddleware.RealIP(handler)
-	handler = chimiddleware.Recoverer(handler)
 
-	// Inject Slog Logger with Trace Context
-	handler = SlogMiddleware(logger)(handler)
+	// Start the OTel server span (W3C propagation) before any middleware
+	// that reads the span context.
+	handler = telemetry.HTTP(handler)
 
 	if dbg {
 		// Log query and response bodies if debug logs are enabled.
@@ -78,11 +132,25 @@ func HandleHTTPServer(ctx context.Context, u *url.URL, watchlistEndpoints *watch
 
 	// Start HTTP server using default configuration, change the code to
 	// configure the server as required by your service.
-	srv := &http.Server{Addr: u.Host, Handler: handler, ReadHeaderTimeout: time.Second * 60}
+	srv := &http.Server{Addr: u.Host, Handler: handler, ReadHeaderTimeout: time.Second * 60, TLSConfig: tlsCfg}
 	for _, m := range watchlistServer.Mounts {
+		reg.AddRoute(watchlistServer.Service(), m.Method, m.Verb, m.Pattern)
+		routes.add(watchlistServer.Service(), m.Method, m.Verb, m.Pattern)
 		logger.InfoContext(ctx, "HTTP handler mounted", "method", m.Method, "verb", m.Verb, "pattern", m.Pattern)
 	}
 	for _, m := range portfolioServer.Mounts {
+		reg.AddRoute(portfolioServer.Service(), m.Method, m.Verb, m.Pattern)
+		routes.add(portfolioServer.Service(), m.Method, m.Verb, m.Pattern)
+		logger.InfoContext(ctx, "HTTP handler mounted", "method", m.Method, "verb", m.Verb, "pattern", m.Pattern)
+	}
+	for _, m := range exchangeServer.Mounts {
+		reg.AddRoute(exchangeServer.Service(), m.Method, m.Verb, m.Pattern)
+		routes.add(exchangeServer.Service(), m.Method, m.Verb, m.Pattern)
+		logger.InfoContext(ctx, "HTTP handler mounted", "method", m.Method, "verb", m.Verb, "pattern", m.Pattern)
+	}
+	for _, m := range instrumentServer.Mounts {
+		reg.AddRoute(instrumentServer.Service(), m.Method, m.Verb, m.Pattern)
+		routes.add(instrumentServer.Service(), m.Method, m.Verb, m.Pattern)
 		logger.InfoContext(ctx, "HTTP handler mounted", "method", m.Method, "verb", m.Verb, "pattern", m.Pattern)
 	}
 
@@ -92,71 +160,32 @@ func HandleHTTPServer(ctx context.Context, u *url.URL, watchlistEndpoints *watch
 
 		// Start HTTP server in a separate goroutine.
 		go func() {
-			logger.InfoContext(ctx, "HTTP server listening", "host", u.Host)
+			logger.InfoContext(ctx, "HTTP server listening", "host", u.Host, "scheme", u.Scheme)
+			if tlsCfg != nil {
+				// Certificates are provided by tlsCfg.
+				errc <- srv.ListenAndServeTLS("", "")
+				return
+			}
 			errc <- srv.ListenAndServe()
 		}()
 
 		<-ctx.Done()
-		logger.InfoContext(ctx, "shutting down HTTP server", "host", u.Host)
-
-		// Shutdown gracefully with a 30s timeout.
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
-
-		err := srv.Shutdown(ctx)
-		if err != nil {
-			logger.ErrorContext(ctx, "failed to shutdown", "error", err)
-		}
+		logger.InfoContext(ctx, "shutting down HTTP server", "host", u.Host, "drain_delay", drainDelay)
+		drain(srv, checks, drainDelay, logger)
 	}()
 }
 
-// errorHandler returns a function that writes and logs the given error.
-// The function also writes and logs the error unique ID so that it's possible
-// to correlate.
-func errorHandler(logCtx context.Context, logger *slog.Logger) func(context.Context, http.ResponseWriter, error) {
-	return func(ctx context.Context, w http.ResponseWriter, err error) {
-		logger.ErrorContext(ctx, "HTTP Error", "error", err)
-	}
-}
+// drain fails readiness, keeps serving requests for delay so that load
+// balancers notice and stop routing new traffic to the server, then shuts
+// srv down gracefully with a 30s timeout.
+func drain(srv *http.Server, checks *health.Registry, delay time.Duration, logger *slog.Logger) {
+	checks.SetDraining()
+	time.Sleep(delay)
 
-// SlogMiddleware extracts OTel trace IDs and injects a logger into the context.
-func SlogMiddleware(logger *slog.Logger) func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			ctx := r.Context()
-			span := trace.SpanFromContext(ctx)
-
-			// Inject trace_id and span_id if available (and valid) across all environments
-			reqLogger := logger
-			if span.SpanContext().IsValid() {
-				// We attach the trace info to the logger's attributes.
-				// For the JSON/GCP handler (Phase 3), the ReplaceAttr function handles mapping these keys
-				// to logging.googleapis.com/trace, etc.
-				// For Text/Dev handler (Phase 4), these just appear as normal attributes.
-				traceID := span.SpanContext().TraceID().String()
-				spanID := span.SpanContext().SpanID().String()
-
-				reqLogger = logger.With(
-					slog.String("trace_id", traceID),
-					slog.String("span_id", spanID),
-				)
-			}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
 
-			// Log request start
-			reqLogger.InfoContext(ctx, "request started",
-				"method", r.Method,
-				"path", r.URL.Path,
-				"remote_addr", r.RemoteAddr,
-			)
-
-			// Update context with logger
-			// NOTE: We rely on standard context behavior. Services should use slog.Default() or
-			// take explicit logger. If services need to retrieve this logger from context,
-			// we would need a custom context key. For now, we assume simple usage or
-			// explicit passing. Services are refactored in Phase 5 to take *slog.Logger.
-			// Ideally, we'd have a ContextWithLogger helper if deep context extraction is needed.
-
-			next.ServeHTTP(w, r)
-		})
+	if err := srv.Shutdown(ctx); err != nil {
+		logger.ErrorContext(ctx, "failed to shutdown", "error", err)
 	}
 }
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/health"
+)
+
+func TestDrainKeepsServingWhileNotReady(t *testing.T) {
+	checks := health.NewRegistry()
+	mux := http.NewServeMux()
+	mux.Handle("GET /readyz", checks.ReadinessHandler())
+	mux.HandleFunc("GET /work", func(w http.ResponseWriter, r *http.Request) {})
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &http.Server{Handler: mux}
+	go srv.Serve(ln)
+	base := "http://" + ln.Addr().String()
+	get := func(path string) (int, error) {
+		res, err := http.Get(base + path)
+		if err != nil {
+			return 0, err
+		}
+		io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+		return res.StatusCode, nil
+	}
+
+	if code, err := get("/readyz"); err != nil || code != http.StatusOK {
+		t.Fatalf("readyz before shutdown = %d, %v, want 200", code, err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		drain(srv, checks, 500*time.Millisecond, slog.New(slog.DiscardHandler))
+		close(done)
+	}()
+
+	deadline := time.Now().Add(200 * time.Millisecond)
+	for {
+		code, err := get("/readyz")
+		if err != nil {
+			t.Fatalf("readyz while draining: %v", err)
+		}
+		if code == http.StatusServiceUnavailable {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("readyz while draining = %d, want 503", code)
+		}
+	}
+	if code, err := get("/work"); err != nil || code != http.StatusOK {
+		t.Fatalf("request while draining = %d, %v, want 200", code, err)
+	}
+
+	<-done
+	if _, err := get("/work"); err == nil {
+		t.Error("request after shutdown succeeded, want the listener closed")
+	}
+}
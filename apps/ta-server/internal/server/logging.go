@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/auth"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/telemetry"
+)
+
+// AccessLogConfig controls the completion log line SlogMiddleware writes for
+// every request.
+type AccessLogConfig struct {
+	// SampleRatio is the fraction of successful (< 400) requests that are
+	// logged. Failed requests are always logged. Values outside (0, 1) log
+	// every request.
+	SampleRatio float64
+	// ExcludePaths lists path.Match patterns (e.g. "/healthz", "/static/*")
+	// for which no completion line is written.
+	ExcludePaths []string
+}
+
+func (c AccessLogConfig) excluded(p string) bool {
+	for _, pattern := range c.ExcludePaths {
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// secretParams are the route parameters that carry credentials, such as the
+// token of a public watchlist link.
+var secretParams = []string{"{token}"}
+
+// redactPath returns the path to log and trace for a request matched by the
+// route pattern: the pattern itself when it has a secret parameter, so that
+// the credential never reaches the logs or traces, else the request path.
+func redactPath(path, pattern string) string {
+	for _, param := range secretParams {
+		if strings.Contains(pattern, param) {
+			return pattern
+		}
+	}
+	return path
+}
+
+func (c AccessLogConfig) sampled(status int) bool {
+	if status >= http.StatusBadRequest || c.SampleRatio <= 0 || c.SampleRatio >= 1 {
+		return true
+	}
+	return rand.Float64() < c.SampleRatio
+}
+
+// SlogMiddleware stores a request logger carrying the OTel trace IDs and the
+// request ID in the context and writes one completion log line per request
+// with the status, response size, duration, Goa service and method and the
+// authenticated user. It also records the matched route on the server span.
+// Paths with secret route parameters are replaced with their route pattern in
+// both.
+func SlogMiddleware(logger *slog.Logger, cfg AccessLogConfig, routes *routeTable) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			span := trace.SpanFromContext(ctx)
+
+			// Inject trace_id and span_id if available (and valid) across all environments
+			reqLogger := logger
+			if span.SpanContext().IsValid() {
+				// We attach the trace info to the logger's attributes.
+				// For the JSON/GCP handler (Phase 3), the ReplaceAttr function handles mapping these keys
+				// to logging.googleapis.com/trace, etc.
+				// For Text/Dev handler (Phase 4), these just appear as normal attributes.
+				traceID := span.SpanContext().TraceID().String()
+				spanID := span.SpanContext().SpanID().String()
+
+				reqLogger = logger.With(
+					slog.String("trace_id", traceID),
+					slog.String("span_id", spanID),
+				)
+			}
+
+			if id := chimiddleware.GetReqID(ctx); id != "" {
+				reqLogger = reqLogger.With(slog.String("request_id", id))
+			}
+
+			// Services retrieve the request logger with LoggerFromContext.
+			ctx = ContextWithLogger(ctx, reqLogger)
+			// The authentication, in the middleware or the JWT security of
+			// the endpoints, records the user for the completion line.
+			ctx = auth.ContextWithRecorder(ctx)
+
+			// Install the chi routing context up front: the Goa mux reuses
+			// a context found on the request, which leaves the matched route
+			// pattern readable here once the handler returns.
+			rctx := chi.NewRouteContext()
+			r = r.WithContext(context.WithValue(ctx, chi.RouteCtxKey, rctx))
+
+			lw := &accessLogWriter{ResponseWriter: w}
+			start := time.Now()
+			next.ServeHTTP(lw, r)
+			duration := time.Since(start)
+
+			route := rctx.RoutePattern()
+			loggedPath := redactPath(r.URL.Path, route)
+			telemetry.RecordRoute(ctx, route, loggedPath)
+
+			status := lw.code()
+			if cfg.excluded(r.URL.Path) || !cfg.sampled(status) {
+				return
+			}
+
+			level := slog.LevelInfo
+			switch {
+			case status >= http.StatusInternalServerError:
+				level = slog.LevelError
+			case status >= http.StatusBadRequest:
+				level = slog.LevelWarn
+			}
+			service, method := routes.lookup(r.Method, route)
+			reqLogger.LogAttrs(ctx, level, "request completed",
+				slog.String("http_method", r.Method),
+				slog.String("path", loggedPath),
+				slog.Int("status", status),
+				slog.Int64("bytes", lw.bytes),
+				slog.Duration("duration", duration),
+				slog.String("service", service),
+				slog.String("method", method),
+				slog.String("user_id", auth.RecordedUser(ctx)),
+				slog.String("remote_addr", r.RemoteAddr),
+			)
+		})
+	}
+}
+
+// ContextWithLogger returns a copy of ctx that carries logger. Services
+// import internal/logctx directly, which this wraps, to avoid an import cycle
+// with the server package that wires them.
+func ContextWithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return logctx.ContextWithLogger(ctx, logger)
+}
+
+// LoggerFromContext returns the request logger stored in ctx, or
+// slog.Default() outside of a request.
+func LoggerFromContext(ctx context.Context) *slog.Logger {
+	return logctx.LoggerFromContext(ctx)
+}
+
+// userLogger adds the user ID to the request logger. It must run after the
+// authenticator, which sets the header from the bearer token.
+func userLogger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user := r.Header.Get(auth.UserIDHeader); user != "" {
+			r = r.WithContext(logctx.With(r.Context(), slog.String("user_id", user)))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// routeTable maps mounted Goa routes back to their service and method.
+type routeTable struct {
+	mu     sync.RWMutex
+	routes map[string][2]string // "<verb> <pattern>" -> {service, method}
+}
+
+func newRouteTable() *routeTable {
+	return &routeTable{routes: make(map[string][2]string)}
+}
+
+func (t *routeTable) add(service, method, verb, pattern string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.routes[verb+" "+pattern] = [2]string{service, method}
+}
+
+func (t *routeTable) lookup(verb, pattern string) (service, method string) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	r := t.routes[verb+" "+pattern]
+	return r[0], r[1]
+}
+
+// accessLogWriter records the status code and number of bytes written.
+type accessLogWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (w *accessLogWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *accessLogWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += int64(n)
+	return n, err
+}
+
+func (w *accessLogWriter) code() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+// Flush implements http.Flusher.
+func (w *accessLogWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying writer for http.ResponseController.
+func (w *accessLogWriter) Unwrap() http.ResponseWriter { return w.ResponseWriter }
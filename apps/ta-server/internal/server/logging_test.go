@@ -0,0 +1,129 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	goahttp "goa.design/goa/v3/http"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/auth"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/telemetry"
+)
+
+func newAccessLogHandler(t *testing.T, cfg AccessLogConfig) (http.Handler, *bytes.Buffer) {
+	t.Helper()
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	mux := goahttp.NewMuxer()
+	authn, err := auth.New(context.Background(), auth.Config{Mode: auth.ModeNone, InsecureDev: true}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux.Handle("GET", "/watchlist/{symbol}", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := authn.JWTAuth(r.Context(), "alice", nil); err != nil {
+			t.Error(err)
+		}
+		w.Write([]byte("hello")) //nolint:errcheck
+	})
+	mux.Handle("GET", "/public/watchlists/{token}", func(w http.ResponseWriter, r *http.Request) {})
+	mux.Handle("GET", "/healthz", func(w http.ResponseWriter, r *http.Request) {})
+	routes := newRouteTable()
+	routes.add("watchlist", "get", "GET", "/watchlist/{symbol}")
+	routes.add("watchlist", "get_public_watchlist", "GET", "/public/watchlists/{token}")
+
+	return chimiddleware.RequestID(SlogMiddleware(logger, cfg, routes)(mux)), &buf
+}
+
+func TestSlogMiddlewareCompletionLine(t *testing.T) {
+	h, buf := newAccessLogHandler(t, AccessLogConfig{})
+	r := httptest.NewRequest("GET", "/watchlist/AAPL", nil)
+	// Only the authenticated user is logged, not a header sent by the client.
+	r.Header.Set(auth.UserIDHeader, "mallory")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("want exactly one JSON log line, got %q: %v", buf.String(), err)
+	}
+	want := map[string]any{
+		"msg":     "request completed",
+		"status":  float64(200),
+		"bytes":   float64(5),
+		"service": "watchlist",
+		"method":  "get",
+		"user_id": "alice",
+	}
+	for k, v := range want {
+		if entry[k] != v {
+			t.Errorf("%s = %v, want %v", k, entry[k], v)
+		}
+	}
+	if entry["request_id"] == "" || entry["duration"] == nil {
+		t.Errorf("missing request_id or duration: %v", entry)
+	}
+}
+
+func TestSlogMiddlewareExcludeAndSample(t *testing.T) {
+	h, buf := newAccessLogHandler(t, AccessLogConfig{ExcludePaths: []string{"/healthz"}, SampleRatio: 0.000001})
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/healthz", nil))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/watchlist/AAPL", nil))
+	if buf.Len() != 0 {
+		t.Fatalf("expected no log lines, got %q", buf.String())
+	}
+
+	// Failures bypass sampling.
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/missing", nil))
+	if !bytes.Contains(buf.Bytes(), []byte(`"status":404`)) {
+		t.Fatalf("expected 404 to be logged, got %q", buf.String())
+	}
+}
+
+// The token of a public watchlist link is a credential: it must reach
+// neither the access log nor the server span.
+func TestSlogMiddlewareRedactsPublicToken(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() { tp.Shutdown(context.Background()) })
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	h, buf := newAccessLogHandler(t, AccessLogConfig{})
+	const token = "k3Vq9xZ2mPb7LwT4"
+	telemetry.HTTP(h).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/public/watchlists/"+token, nil))
+
+	if strings.Contains(buf.String(), token) {
+		t.Errorf("access log contains the token: %s", buf.String())
+	}
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("want exactly one JSON log line, got %q: %v", buf.String(), err)
+	}
+	if entry["path"] != "/public/watchlists/{token}" || entry["method"] != "get_public_watchlist" {
+		t.Errorf("path = %v, method = %v, want the route pattern and get_public_watchlist", entry["path"], entry["method"])
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("recorded %d spans, want 1", len(spans))
+	}
+	if strings.Contains(spans[0].Name(), token) {
+		t.Errorf("span name %q contains the token", spans[0].Name())
+	}
+	for _, kv := range spans[0].Attributes() {
+		if strings.Contains(kv.Value.Emit(), token) {
+			t.Errorf("span attribute %s = %q contains the token", kv.Key, kv.Value.Emit())
+		}
+	}
+}
@@ -2,7 +2,9 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"log/slog"
 	"net"
 	"net/url"
@@ -10,8 +12,16 @@ import (
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/auth"
 	"github.com/reidlai/ta-workspace/apps/ta-server/internal/di"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/exchange"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/health"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/instrument"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/metrics"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/telemetry"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/watchlist"
 )
 
 // Run initializes and starts the API server.
@@ -68,10 +78,79 @@ func Run(ctx context.Context, cfg Config) error {
 		"format", cfg.LogFormat,
 	)
 
+	// Setup OpenTelemetry tracing and metrics
+	shutdownTelemetry, err := telemetry.Setup(ctx, cfg.Telemetry)
+	if err != nil {
+		return fmt.Errorf("failed to initialize OpenTelemetry: %w", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTelemetry(ctx); err != nil {
+			logger.ErrorContext(ctx, "failed to shutdown OpenTelemetry", "error", err)
+		}
+	}()
+	logger.InfoContext(ctx, "OpenTelemetry initialized", "exporter", cfg.Telemetry.Exporter)
+
+	// Open watchlist storage (runs schema migrations for persistent backends)
+	watchlistRepo, err := watchlist.OpenRepository(ctx, cfg.Storage, cfg.StoragePath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s storage: %w", cfg.Storage, err)
+	}
+	defer func() {
+		if err := watchlistRepo.Close(); err != nil {
+			logger.ErrorContext(ctx, "failed to close storage", "error", err)
+		}
+	}()
+	// The instrument master shares the storage backend and database file,
+	// with its own schema migrations
+	instrumentRepo, err := instrument.OpenRepository(ctx, cfg.Storage, cfg.StoragePath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s instrument storage: %w", cfg.Storage, err)
+	}
+	defer func() {
+		if err := instrumentRepo.Close(); err != nil {
+			logger.ErrorContext(ctx, "failed to close instrument storage", "error", err)
+		}
+	}()
+	logger.InfoContext(ctx, "Storage initialized", "backend", cfg.Storage)
+
+	// Load the ISO 10383 exchange list (fail fast on a corrupt data file),
+	// from the last imported file if any
+	exchanges, err := loadExchanges(cfg.ExchangesFile)
+	if err != nil {
+		return fmt.Errorf("failed to load exchanges: %w", err)
+	}
+	calendars, err := exchange.LoadEmbeddedCalendars()
+	if err != nil {
+		return fmt.Errorf("failed to load trading calendars: %w", err)
+	}
+	logger.InfoContext(ctx, "Exchanges loaded", "count", len(exchanges), "calendars", len(calendars), "file", cfg.ExchangesFile)
+
+	// Readiness checks, populated by the storage backend and the modules
+	checks := health.NewRegistry()
+	checks.Register("storage", health.CheckFunc(watchlistRepo.Ping))
+
+	// Setup authentication
+	authn, err := auth.New(ctx, cfg.Auth, logger)
+	if err != nil {
+		return fmt.Errorf("failed to initialize authentication: %w", err)
+	}
+	if authn.Mode() == auth.ModeNone {
+		logger.WarnContext(ctx, "Authentication disabled for development, trusting the user ID sent by clients")
+	} else {
+		logger.InfoContext(ctx, "Authentication initialized", "mode", authn.Mode())
+	}
+
 	// Initialize services via DI container
-	services := di.NewServices(logger)
+	services, err := di.NewServices(logger, authn, watchlistRepo, cfg.Watchlist, instrumentRepo, exchanges, calendars, checks)
+	if err != nil {
+		return fmt.Errorf("failed to initialize services: %w", err)
+	}
 	watchlistEndpoints := services.WatchlistEndpoints
 	portfolioEndpoints := services.PortfolioEndpoints
+	exchangeEndpoints := services.ExchangeEndpoints
+	instrumentEndpoints := services.InstrumentEndpoints
 
 	// Create channel for signal handling
 	errc := make(chan error)
@@ -88,9 +167,15 @@ func Run(ctx context.Context, cfg Config) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	// Setup TLS
+	tlsCfg, err := buildTLSConfig(ctx, cfg, logger)
+	if err != nil {
+		return fmt.Errorf("failed to configure TLS: %w", err)
+	}
+
 	// Build URL
 	scheme := "http"
-	if cfg.Secure {
+	if tlsCfg != nil {
 		scheme = "https"
 	}
 	addr := fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(cfg.Host, fmt.Sprintf("%d", cfg.Port)))
@@ -99,16 +184,49 @@ func Run(ctx context.Context, cfg Config) error {
 		return fmt.Errorf("invalid URL %s: %w", addr, err)
 	}
 
+	// The admin token guards the exchange import of the admin server
+	adminToken, err := loadAdminToken(cfg.AdminTokenFile)
+	if err != nil {
+		return fmt.Errorf("failed to load admin token: %w", err)
+	}
+
+	// Purge removed watchlist items past the retention window
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		services.WatchlistService.RunPurge(ctx, watchlist.PurgeInterval)
+	}()
+
 	// Start HTTP server
-	HandleHTTPServer(ctx, u, watchlistEndpoints, portfolioEndpoints, &wg, errc, logger, cfg.Debug)
+	reg := metrics.New()
+	HandleHTTPServer(ctx, u, watchlistEndpoints, portfolioEndpoints, exchangeEndpoints, instrumentEndpoints, authn, reg, checks, tlsCfg, cfg.AccessLog, cfg.DrainDelay, &wg, errc, logger, cfg.Debug)
+
+	// Start admin server
+	if cfg.AdminPort != 0 {
+		HandleAdminServer(ctx, net.JoinHostPort(cfg.AdminHost, fmt.Sprintf("%d", cfg.AdminPort)), reg, services.ExchangeService.ImportHandler(cfg.ExchangesFile), adminToken, &wg, errc, logger)
+	}
 
 	// Wait for signal
 	logger.InfoContext(ctx, "exiting", "signal", <-errc)
 
-	// Send cancellation signal
+	// Send cancellation signal, and end the event streams so that the HTTP
+	// server can shut down
 	cancel()
+	services.Events.Close()
 
 	wg.Wait()
 	logger.InfoContext(ctx, "exited")
 	return nil
 }
+
+// loadExchanges loads the MIC file at path, or the embedded list when path is
+// empty or does not exist yet.
+func loadExchanges(path string) ([]*exchange.Exchange, error) {
+	if path != "" {
+		exchanges, err := exchange.LoadFile(path)
+		if !errors.Is(err, fs.ErrNotExist) {
+			return exchanges, err
+		}
+	}
+	return exchange.LoadEmbedded()
+}
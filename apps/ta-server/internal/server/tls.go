@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// selfSignedValidity is the lifetime of the generated development certificate.
+const selfSignedValidity = 30 * 24 * time.Hour
+
+// buildTLSConfig returns the TLS configuration for the API server, or nil
+// when TLS is disabled. With --secure but no certificate files, a self-signed
+// certificate for host is generated (development only). Certificate files are
+// watched and reloaded when they change, so rotated certificates are picked
+// up without a restart.
+func buildTLSConfig(ctx context.Context, cfg Config, logger *slog.Logger) (*tls.Config, error) {
+	if cfg.TLSCert == "" && cfg.TLSKey == "" && !cfg.Secure {
+		return nil, nil
+	}
+
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	switch {
+	case cfg.TLSCert != "" && cfg.TLSKey != "":
+		r, err := newCertReloader(cfg.TLSCert, cfg.TLSKey, logger)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.watch(ctx); err != nil {
+			return nil, err
+		}
+		tlsCfg.GetCertificate = r.GetCertificate
+	case cfg.TLSCert != "" || cfg.TLSKey != "":
+		return nil, errors.New("--tls-cert and --tls-key must be set together")
+	default:
+		cert, err := selfSignedCertificate(cfg.Host)
+		if err != nil {
+			return nil, fmt.Errorf("generate self-signed certificate: %w", err)
+		}
+		logger.WarnContext(ctx, "Serving HTTPS with a self-signed development certificate", "host", cfg.Host, "valid_for", selfSignedValidity.String())
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	if cfg.TLSClientCA != "" {
+		pem, err := os.ReadFile(cfg.TLSClientCA)
+		if err != nil {
+			return nil, fmt.Errorf("read client CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("client CA bundle %s contains no certificates", cfg.TLSClientCA)
+		}
+		tlsCfg.ClientCAs = pool
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsCfg, nil
+}
+
+// certReloader serves the key pair loaded from certFile and keyFile and
+// reloads it whenever either file changes.
+type certReloader struct {
+	certFile string
+	keyFile  string
+	logger   *slog.Logger
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+}
+
+func newCertReloader(certFile, keyFile string, logger *slog.Logger) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile, logger: logger}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *certReloader) reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("load TLS key pair: %w", err)
+	}
+	r.mu.Lock()
+	r.cert = &cert
+	r.mu.Unlock()
+	return nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// watch reloads the key pair on changes until ctx is cancelled. The parent
+// directories are watched rather than the files themselves so that atomic
+// renames and Kubernetes secret symlink swaps are detected.
+func (r *certReloader) watch(ctx context.Context) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("watch TLS certificate: %w", err)
+	}
+	dirs := map[string]bool{filepath.Dir(r.certFile): true, filepath.Dir(r.keyFile): true}
+	for d := range dirs {
+		if err := w.Add(d); err != nil {
+			w.Close() //nolint:errcheck
+			return fmt.Errorf("watch %s: %w", d, err)
+		}
+	}
+
+	go func() {
+		defer w.Close() //nolint:errcheck
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok := <-w.Events:
+				if !ok {
+					return
+				}
+				if !ev.Has(fsnotify.Write) && !ev.Has(fsnotify.Create) && !ev.Has(fsnotify.Rename) {
+					continue
+				}
+				if err := r.reload(); err != nil {
+					// Keep serving the previous certificate; the next event
+					// (e.g. the key file being written) may complete the pair.
+					r.logger.WarnContext(ctx, "failed to reload TLS certificate", "error", err)
+					continue
+				}
+				r.logger.InfoContext(ctx, "TLS certificate reloaded", "cert", r.certFile)
+			case err, ok := <-w.Errors:
+				if !ok {
+					return
+				}
+				r.logger.ErrorContext(ctx, "TLS certificate watcher error", "error", err)
+			}
+		}
+	}()
+	return nil
+}
+
+// selfSignedCertificate generates an ECDSA P-256 certificate valid for host,
+// localhost and the loopback addresses.
+func selfSignedCertificate(host string) (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "ta-server development", Organization: []string{"ta-server"}},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(selfSignedValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+	} else if host != "" && host != "localhost" {
+		tmpl.DNSNames = append(tmpl.DNSNames, host)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
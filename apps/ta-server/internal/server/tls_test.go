@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeKeyPair(t *testing.T, dir, host string) (certFile, keyFile string, leaf *x509.Certificate) {
+	t.Helper()
+	cert, err := selfSignedCertificate(host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile = filepath.Join(dir, "tls.crt")
+	keyFile = filepath.Join(dir, "tls.key")
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile, leaf
+}
+
+func TestBuildTLSConfigDisabled(t *testing.T) {
+	cfg, err := buildTLSConfig(context.Background(), Config{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil || cfg != nil {
+		t.Fatalf("got %v, %v; want nil, nil", cfg, err)
+	}
+}
+
+func TestBuildTLSConfigSelfSigned(t *testing.T) {
+	cfg, err := buildTLSConfig(context.Background(), Config{Host: "example.test", Secure: true}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(cfg.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := leaf.VerifyHostname("example.test"); err != nil {
+		t.Error(err)
+	}
+	if err := leaf.VerifyHostname("127.0.0.1"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBuildTLSConfigRequiresPair(t *testing.T) {
+	_, err := buildTLSConfig(context.Background(), Config{TLSCert: "tls.crt"}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err == nil {
+		t.Fatal("expected error when only --tls-cert is set")
+	}
+}
+
+func TestBuildTLSConfigClientCA(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile, _ := writeKeyPair(t, dir, "localhost")
+	cfg, err := buildTLSConfig(context.Background(), Config{TLSCert: certFile, TLSKey: keyFile, TLSClientCA: certFile}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.ClientAuth != tls.RequireAndVerifyClientCert || cfg.ClientCAs == nil {
+		t.Errorf("client auth = %v, want RequireAndVerifyClientCert with CA pool", cfg.ClientAuth)
+	}
+}
+
+func TestCertReloaderReloadsOnChange(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	certFile, keyFile, first := writeKeyPair(t, dir, "localhost")
+	r, err := newCertReloader(certFile, keyFile, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.watch(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, second := writeKeyPair(t, dir, "localhost")
+	if first.SerialNumber.Cmp(second.SerialNumber) == 0 {
+		t.Fatal("test certificates share a serial number")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		cert, _ := r.GetCertificate(nil)
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if leaf.SerialNumber.Cmp(second.SerialNumber) == 0 {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatal("certificate was not reloaded")
+}
@@ -0,0 +1,78 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// HTTP returns the otelhttp server instrumentation. It extracts the W3C
+// trace context of incoming requests and starts the server span, so it must
+// wrap every middleware that reads the span (e.g. request logging).
+func HTTP(h http.Handler) http.Handler {
+	return otelhttp.NewHandler(h, "http.server",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return "HTTP " + r.Method
+		}),
+	)
+}
+
+// RecordRoute sets the http.route attribute of the server span in ctx to the
+// matched route pattern and its url.path attribute to path, which lets the
+// caller redact path segments the span must not record.
+func RecordRoute(ctx context.Context, route, path string) {
+	span := trace.SpanFromContext(ctx)
+	if route != "" {
+		span.SetAttributes(attribute.String("http.route", route))
+	}
+	span.SetAttributes(attribute.String("url.path", path))
+}
+
+// TraceEndpoint is a Goa endpoint middleware that records a span named
+// "<service>.<method>" around the service method call. Server faults set the
+// span status to Error; all service errors are named in "goa.error".
+func TraceEndpoint(e goa.Endpoint) goa.Endpoint {
+	tracer := otel.Tracer(instrumentationName)
+	return func(ctx context.Context, req any) (any, error) {
+		svc, _ := ctx.Value(goa.ServiceKey).(string)
+		method, _ := ctx.Value(goa.MethodKey).(string)
+
+		ctx, span := tracer.Start(ctx, fmt.Sprintf("%s.%s", svc, method),
+			trace.WithSpanKind(trace.SpanKindInternal),
+			trace.WithAttributes(
+				attribute.String("goa.service", svc),
+				attribute.String("goa.method", method),
+			),
+		)
+		defer span.End()
+
+		res, err := e(ctx, req)
+		if err != nil {
+			var serr *goa.ServiceError
+			if errors.As(err, &serr) {
+				span.SetAttributes(attribute.String("goa.error", serr.Name))
+			}
+			if serverFault(serr) {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+		}
+		return res, err
+	}
+}
+
+// serverFault reports whether an endpoint error is answered with a 5xx
+// status: it is not a Goa service error, or one marked as a fault, timeout
+// or temporary error. Other service errors are the client's (4xx) and leave
+// the span status unset.
+func serverFault(serr *goa.ServiceError) bool {
+	return serr == nil || serr.Fault || serr.Timeout || serr.Temporary
+}
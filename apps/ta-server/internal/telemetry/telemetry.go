@@ -0,0 +1,117 @@
+// Package telemetry bootstraps OpenTelemetry tracing and metrics for
+// ta-server.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+// Exporters selectable through the "api-server.otel.exporter" setting.
+const (
+	// ExporterNone records spans so that trace IDs are available to logs
+	// and downstream services, but exports nothing.
+	ExporterNone = "none"
+	// ExporterStdout writes spans and metrics to stdout. Development only.
+	ExporterStdout = "stdout"
+	// ExporterOTLP sends spans and metrics over OTLP/HTTP. The collector
+	// endpoint defaults to the standard OTEL_EXPORTER_OTLP_* variables.
+	ExporterOTLP = "otlp"
+)
+
+// instrumentationName identifies the ta-server tracer and meter.
+const instrumentationName = "github.com/reidlai/ta-workspace/apps/ta-server"
+
+// Config holds the OpenTelemetry configuration.
+type Config struct {
+	Exporter       string
+	Endpoint       string
+	SampleRatio    float64
+	ServiceName    string
+	ServiceVersion string
+}
+
+// Setup installs the global tracer provider, meter provider and W3C trace
+// context propagator. The returned function flushes and stops the providers
+// and must be called on shutdown.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+		semconv.ServiceVersion(cfg.ServiceVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("build resource: %w", err)
+	}
+
+	traceOpts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}
+	meterOpts := []sdkmetric.Option{
+		sdkmetric.WithResource(res),
+	}
+
+	switch cfg.Exporter {
+	case "", ExporterNone:
+	case ExporterStdout:
+		se, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("create stdout span exporter: %w", err)
+		}
+		me, err := stdoutmetric.New(stdoutmetric.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("create stdout metric exporter: %w", err)
+		}
+		traceOpts = append(traceOpts, sdktrace.WithBatcher(se))
+		meterOpts = append(meterOpts, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(me)))
+	case ExporterOTLP:
+		var (
+			traceClientOpts  []otlptracehttp.Option
+			metricClientOpts []otlpmetrichttp.Option
+		)
+		if cfg.Endpoint != "" {
+			traceClientOpts = append(traceClientOpts, otlptracehttp.WithEndpointURL(cfg.Endpoint+"/v1/traces"))
+			metricClientOpts = append(metricClientOpts, otlpmetrichttp.WithEndpointURL(cfg.Endpoint+"/v1/metrics"))
+		}
+		se, err := otlptracehttp.New(ctx, traceClientOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("create OTLP span exporter: %w", err)
+		}
+		me, err := otlpmetrichttp.New(ctx, metricClientOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("create OTLP metric exporter: %w", err)
+		}
+		traceOpts = append(traceOpts, sdktrace.WithBatcher(se))
+		meterOpts = append(meterOpts, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(me)))
+	default:
+		return nil, fmt.Errorf("unsupported OpenTelemetry exporter %q", cfg.Exporter)
+	}
+
+	tp := sdktrace.NewTracerProvider(traceOpts...)
+	mp := sdkmetric.NewMeterProvider(meterOpts...)
+
+	otel.SetTracerProvider(tp)
+	otel.SetMeterProvider(mp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return func(ctx context.Context) error {
+		return errors.Join(tp.Shutdown(ctx), mp.Shutdown(ctx))
+	}, nil
+}
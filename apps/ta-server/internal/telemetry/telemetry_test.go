@@ -0,0 +1,120 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	goa "goa.design/goa/v3/pkg"
+)
+
+func TestHTTPAndEndpointSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() { tp.Shutdown(context.Background()) })
+
+	prevTP, prevProp := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevProp)
+	})
+
+	var handlerSpan trace.SpanContext
+	endpoint := TraceEndpoint(func(ctx context.Context, req any) (any, error) {
+		handlerSpan = trace.SpanContextFromContext(ctx)
+		return nil, fmt.Errorf("remove: %w", goa.PermanentError("not_found", "no such symbol"))
+	})
+	h := HTTP(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Mimics the context set up by a generated Goa handler.
+		ctx := context.WithValue(r.Context(), goa.ServiceKey, "watchlist")
+		ctx = context.WithValue(ctx, goa.MethodKey, "remove")
+		endpoint(ctx, nil)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	r := httptest.NewRequest(http.MethodDelete, "/watchlist/AAPL", nil)
+	r.Header.Set("traceparent", traceparent)
+	h.ServeHTTP(httptest.NewRecorder(), r)
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("recorded %d spans, want 2", len(spans))
+	}
+	endpointSpan, serverSpan := spans[0], spans[1]
+
+	if got := serverSpan.Name(); got != "HTTP DELETE" {
+		t.Errorf("server span name = %q, want %q", got, "HTTP DELETE")
+	}
+	if got := serverSpan.SpanKind(); got != trace.SpanKindServer {
+		t.Errorf("server span kind = %v, want server", got)
+	}
+	if got := serverSpan.Parent().TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("server span did not continue the incoming trace, trace ID = %s", got)
+	}
+
+	if got := endpointSpan.Name(); got != "watchlist.remove" {
+		t.Errorf("endpoint span name = %q, want %q", got, "watchlist.remove")
+	}
+	if endpointSpan.Parent().SpanID() != serverSpan.SpanContext().SpanID() {
+		t.Error("endpoint span is not a child of the server span")
+	}
+	if got := endpointSpan.Status().Code.String(); got != "Unset" {
+		t.Errorf("endpoint span status = %s, want Unset for a client error", got)
+	}
+	var goaError string
+	for _, kv := range endpointSpan.Attributes() {
+		if kv.Key == "goa.error" {
+			goaError = kv.Value.AsString()
+		}
+	}
+	if goaError != "not_found" {
+		t.Errorf("endpoint span goa.error = %q, want not_found", goaError)
+	}
+	if !handlerSpan.IsValid() || handlerSpan.SpanID() != endpointSpan.SpanContext().SpanID() {
+		t.Error("service method did not run inside the endpoint span")
+	}
+}
+
+func TestEndpointSpanStatus(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() { tp.Shutdown(context.Background()) })
+	prevTP := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prevTP) })
+
+	cases := []struct {
+		err  error
+		want string
+	}{
+		{goa.PermanentError("invalid_symbol", "unknown exchange"), "Unset"},
+		{goa.Fault("storage unavailable"), "Error"},
+		{goa.TemporaryError("unavailable", "try again"), "Error"},
+		{errors.New("boom"), "Error"},
+	}
+	for _, c := range cases {
+		endpoint := TraceEndpoint(func(context.Context, any) (any, error) { return nil, c.err })
+		endpoint(context.Background(), nil)
+		spans := recorder.Ended()
+		if got := spans[len(spans)-1].Status().Code.String(); got != c.want {
+			t.Errorf("span status for %v = %s, want %s", c.err, got, c.want)
+		}
+	}
+}
+
+func TestSetupRejectsUnknownExporter(t *testing.T) {
+	if _, err := Setup(context.Background(), Config{Exporter: "zipkin", SampleRatio: 1}); err == nil {
+		t.Fatal("expected error for unknown exporter")
+	}
+}
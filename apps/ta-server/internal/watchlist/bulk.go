@@ -0,0 +1,212 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	watchlistGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+)
+
+// Modes of the bulk methods.
+const (
+	BulkAllOrNothing = "all_or_nothing"
+	BulkBestEffort   = "best_effort"
+)
+
+// Statuses of the entries of a bulk result.
+const (
+	bulkAdded    = "added"
+	bulkUpdated  = "updated"
+	bulkRemoved  = "removed"
+	bulkInvalid  = "invalid"
+	bulkNotFound = "not_found"
+	bulkSkipped  = "skipped"
+)
+
+// BulkAdd adds or updates many tickers of a watchlist, each as Add does, in
+// a single transaction. In all_or_nothing mode nothing is stored unless
+// every ticker is valid; in best_effort mode the valid ones are stored.
+func (s *Service) BulkAdd(ctx context.Context, p *watchlistGen.BulkAddPayload) (*watchlistGen.WatchlistBulkResult, error) {
+	l, err := s.listOrDefault(ctx, callerID(ctx), p.Watchlist, RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	version, err := ifMatch(l, p.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	res := newBulkResult(p.Mode, len(p.Items))
+	now := s.now()
+	var (
+		items []*Item
+		// index holds the position in res.Results of each of items.
+		index []int
+		seen  = make(map[string]bool, len(p.Items))
+	)
+	for i, in := range p.Items {
+		r := res.Results[i]
+		r.Symbol = in.Symbol
+		it, existing, err := s.prepare(ctx, l, in.Symbol, itemChanges{
+			OnHand:     in.OnHand,
+			Note:       in.Note,
+			Tags:       in.Tags,
+			BuyTarget:  in.BuyTarget,
+			SellTarget: in.SellTarget,
+		}, now)
+		if errors.Is(err, ErrInvalidSymbol) {
+			reject(r, bulkInvalid, err)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if seen[it.Symbol] {
+			reject(r, bulkInvalid, fmt.Errorf("duplicate symbol in request: %s", it.Symbol))
+			continue
+		}
+		seen[it.Symbol] = true
+
+		r.Status = bulkAdded
+		if existing != nil {
+			r.Status = bulkUpdated
+		}
+		items = append(items, it)
+		index = append(index, i)
+	}
+	if !finish(res) {
+		return res, nil
+	}
+
+	stored, err := s.repo.AddItems(ctx, l.ID, version, items)
+	switch {
+	case errors.Is(err, ErrListNotFound):
+		return nil, watchlistGen.MakeNotFound(fmt.Errorf("watchlist %q not found", l.ID))
+	case errors.Is(err, ErrVersionMismatch):
+		return nil, preconditionFailed(l)
+	case err != nil:
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to add watchlist items", "watchlist", l.ID, "error", err)
+		return nil, err
+	}
+	for i, it := range stored {
+		r := res.Results[index[i]]
+		r.Item = toTickerItem(it)
+		if r.Status == bulkAdded {
+			s.publish(l, eventAdded, it.Symbol, it, it.UpdatedAt)
+		} else {
+			s.publish(l, eventUpdated, it.Symbol, it, it.UpdatedAt)
+		}
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist items added",
+		"watchlist", l.ID,
+		"mode", res.Mode,
+		"succeeded", res.Succeeded,
+		"failed", res.Failed)
+	return res, nil
+}
+
+// BulkRemove removes many tickers from a watchlist in a single transaction.
+// Unlike Remove, a ticker that is not in the watchlist is rejected, so that
+// all_or_nothing mode removes nothing unless every ticker is listed.
+func (s *Service) BulkRemove(ctx context.Context, p *watchlistGen.BulkRemovePayload) (*watchlistGen.WatchlistBulkResult, error) {
+	l, err := s.listOrDefault(ctx, callerID(ctx), p.Watchlist, RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	version, err := ifMatch(l, p.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.repo.Items(ctx, l.ID)
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to list watchlist", "watchlist", l.ID, "error", err)
+		return nil, err
+	}
+	listed := make(map[string]bool, len(items))
+	for _, it := range items {
+		listed[it.Symbol] = true
+	}
+
+	res := newBulkResult(p.Mode, len(p.Symbols))
+	var (
+		symbols []string
+		seen    = make(map[string]bool, len(p.Symbols))
+	)
+	for i, symbol := range p.Symbols {
+		r := res.Results[i]
+		r.Symbol = symbol
+		key := s.symbols.Key(symbol)
+		switch {
+		case seen[key]:
+			reject(r, bulkInvalid, fmt.Errorf("duplicate symbol in request: %s", key))
+		case !listed[key]:
+			reject(r, bulkNotFound, fmt.Errorf("%s is not in the watchlist", key))
+		default:
+			r.Status = bulkRemoved
+			symbols = append(symbols, key)
+		}
+		seen[key] = true
+	}
+	if !finish(res) {
+		return res, nil
+	}
+
+	now := s.now()
+	err = s.repo.RemoveItems(ctx, l.ID, version, symbols, now)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		// Another request removed an item since it was listed above.
+		return nil, watchlistGen.MakeConflict(errors.New("the watchlist changed during the request"))
+	case errors.Is(err, ErrVersionMismatch):
+		return nil, preconditionFailed(l)
+	case err != nil:
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to remove watchlist items", "watchlist", l.ID, "error", err)
+		return nil, err
+	}
+	for _, symbol := range symbols {
+		s.publish(l, eventRemoved, symbol, nil, now)
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist items removed",
+		"watchlist", l.ID,
+		"mode", res.Mode,
+		"succeeded", res.Succeeded,
+		"failed", res.Failed)
+	return res, nil
+}
+
+func newBulkResult(mode string, n int) *watchlistGen.WatchlistBulkResult {
+	res := &watchlistGen.WatchlistBulkResult{Mode: mode, Results: make([]*watchlistGen.BulkItemResult, n)}
+	for i := range res.Results {
+		res.Results[i] = &watchlistGen.BulkItemResult{}
+	}
+	return res
+}
+
+func reject(r *watchlistGen.BulkItemResult, status string, err error) {
+	msg := err.Error()
+	r.Status = status
+	r.Error = &msg
+}
+
+// finish counts the entries of res and reports whether the valid ones are
+// to be stored. In all_or_nothing mode a rejected entry marks the others
+// skipped.
+func finish(res *watchlistGen.WatchlistBulkResult) bool {
+	for _, r := range res.Results {
+		if r.Error != nil {
+			res.Failed++
+		}
+	}
+	if res.Failed > 0 && res.Mode != BulkBestEffort {
+		for _, r := range res.Results {
+			if r.Error == nil {
+				r.Status = bulkSkipped
+			}
+		}
+		return false
+	}
+	res.Succeeded = len(res.Results) - res.Failed
+	return res.Succeeded > 0
+}
@@ -0,0 +1,114 @@
+package watchlist
+
+import (
+	"context"
+	"time"
+
+	watchlistGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/eventbus"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+)
+
+// Types of the watchlist events. Item changes are published to the event
+// bus under the ID of the owner of the watchlist; ready and reset only open
+// streams.
+const (
+	eventAdded    = "added"
+	eventUpdated  = "updated"
+	eventRemoved  = "removed"
+	eventRestored = "restored"
+	eventReady    = "ready"
+	eventReset    = "reset"
+)
+
+// Events streams the changes of the items of the user's watchlists, or of
+// one watchlist, possibly shared with them, until the client disconnects,
+// falls behind, or the event bus is closed. A client resuming with the ID
+// of the last event it received first gets the events it missed and a
+// ready event, or a reset event when they are no longer retained.
+func (s *Service) Events(ctx context.Context, p *watchlistGen.EventsPayload, stream watchlistGen.EventsServerStream) error {
+	user := callerID(ctx)
+	var (
+		listID string
+		owner  = user
+	)
+	if p.Watchlist != nil {
+		l, err := s.list(ctx, user, *p.Watchlist, RoleViewer)
+		if err != nil {
+			return err
+		}
+		listID, owner = l.ID, l.UserID
+	}
+	var (
+		after  uint64
+		known  = true
+		resume = p.LastEventID != nil && *p.LastEventID != ""
+	)
+	if resume {
+		after, known = s.events.ParseID(*p.LastEventID)
+	}
+	sub, replay, complete := s.events.Subscribe(owner, after)
+	defer sub.Close()
+	if !resume {
+		// A new stream starts with the next change.
+		replay, complete = nil, true
+	}
+
+	send := func(e eventbus.Event) error {
+		change, _ := e.Data.(*watchlistGen.WatchlistChange)
+		if listID != "" && change != nil && change.Watchlist != listID {
+			return nil
+		}
+		return stream.SendWithContext(ctx, &watchlistGen.WatchlistEvent{ID: s.events.FormatID(e.ID), Type: e.Type, Data: change})
+	}
+	open := eventReady
+	if !known || !complete {
+		// The client reloads its watchlists instead.
+		open, replay = eventReset, nil
+	}
+	for _, e := range replay {
+		if err := send(e); err != nil {
+			return err
+		}
+	}
+	if err := send(eventbus.Event{ID: sub.Start(), Type: open}); err != nil {
+		return err
+	}
+	logctx.LoggerFromContext(ctx).DebugContext(ctx, "watchlist event stream opened", "replayed", len(replay), "outcome", open)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-sub.Events():
+			if !ok {
+				// Dropped for falling behind, or shutting down; the client
+				// reconnects and resumes.
+				return nil
+			}
+			if owner != user {
+				// Stop once the watchlist is no longer shared with the user.
+				if _, err := s.repo.GetSharedList(ctx, user, listID); err != nil {
+					return nil
+				}
+			}
+			if err := send(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// publish announces a change of the item of l with the given symbol to the
+// user's event streams. it is the item after the change, nil when removed.
+func (s *Service) publish(l *List, typ, symbol string, it *Item, at time.Time) {
+	change := &watchlistGen.WatchlistChange{
+		Watchlist: l.ID,
+		Symbol:    symbol,
+		ChangedAt: at.UTC().Format(time.RFC3339),
+	}
+	if it != nil {
+		change.Item = toTickerItem(it)
+	}
+	s.events.Publish(l.UserID, typ, change)
+}
@@ -0,0 +1,134 @@
+package watchlist
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	watchlistGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+)
+
+// csvColumns are the columns of an exported CSV file. Import reads the
+// first seven.
+var csvColumns = []string{
+	"symbol", "exchange_mic", "on_hand", "note", "tags", "buy_target", "sell_target",
+	"instrument_id", "position", "created_at", "updated_at",
+}
+
+// Export returns the items of a watchlist as a CSV or JSON file, in their
+// order, that Import accepts.
+func (s *Service) Export(ctx context.Context, p *watchlistGen.ExportPayload) (*watchlistGen.ExportResult, io.ReadCloser, error) {
+	l, err := s.listOrDefault(ctx, callerID(ctx), p.Watchlist, RoleViewer)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, err := s.repo.Items(ctx, l.ID)
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to list watchlist", "watchlist", l.ID, "error", err)
+		return nil, nil, err
+	}
+
+	var (
+		buf         bytes.Buffer
+		contentType string
+	)
+	if p.Format == FormatJSON {
+		contentType = "application/json"
+		err = writeJSON(&buf, items)
+	} else {
+		contentType = "text/csv; charset=utf-8"
+		err = writeCSV(&buf, items)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	res := &watchlistGen.ExportResult{
+		ContentType:        contentType,
+		ContentDisposition: `attachment; filename="` + fileName(l.Name) + "." + p.Format + `"`,
+	}
+	return res, io.NopCloser(&buf), nil
+}
+
+func writeCSV(w io.Writer, items []*Item) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return err
+	}
+	for _, it := range items {
+		if err := cw.Write([]string{
+			it.Symbol,
+			it.ExchangeMIC,
+			strconv.FormatBool(it.OnHand),
+			it.Note,
+			strings.Join(it.Tags, tagSeparator),
+			formatPrice(it.BuyTarget),
+			formatPrice(it.SellTarget),
+			it.InstrumentID,
+			strconv.Itoa(it.Position),
+			it.CreatedAt.UTC().Format(time.RFC3339),
+			it.UpdatedAt.UTC().Format(time.RFC3339),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeJSON(w io.Writer, items []*Item) error {
+	res := make([]fileItem, len(items))
+	for i, it := range items {
+		res[i] = fileItem{
+			Symbol:       it.Symbol,
+			ExchangeMIC:  it.ExchangeMIC,
+			InstrumentID: it.InstrumentID,
+			OnHand:       &it.OnHand,
+			Note:         optional(it.Note),
+			Tags:         it.Tags,
+			Position:     &it.Position,
+			CreatedAt:    it.CreatedAt.UTC().Format(time.RFC3339),
+			UpdatedAt:    it.UpdatedAt.UTC().Format(time.RFC3339),
+		}
+		if it.BuyTarget != 0 {
+			res[i].BuyTarget = &it.BuyTarget
+		}
+		if it.SellTarget != 0 {
+			res[i].SellTarget = &it.SellTarget
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(res)
+}
+
+// formatPrice formats a target price for a CSV cell, empty when unset.
+func formatPrice(p float64) string {
+	if p == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(p, 'f', -1, 64)
+}
+
+// fileName returns the name of an export file of the watchlist named name,
+// keeping only the characters that are safe in any file system.
+func fileName(name string) string {
+	res := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		case r == ' ' || r == '.':
+			return '-'
+		}
+		return -1
+	}, name)
+	if res == "" {
+		return "watchlist"
+	}
+	return res
+}
@@ -0,0 +1,374 @@
+package watchlist
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	watchlistGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+)
+
+// MaxImportSize is the largest file accepted by Import.
+const MaxImportSize = 4 << 20
+
+// Limits of the item attributes, as declared by the add payloads.
+const (
+	maxNoteLength = 1000
+	maxTags       = 20
+	maxTagLength  = 50
+)
+
+// File formats of Import and Export.
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// tagSeparator separates the tags of an item in a CSV cell.
+const tagSeparator = ";"
+
+// fileItem is an item of a JSON import or export file. Import reads the
+// attributes an add payload takes and ignores the others; omitted
+// attributes are unchanged.
+type fileItem struct {
+	Symbol       string   `json:"symbol"`
+	ExchangeMIC  string   `json:"exchange_mic,omitempty"`
+	InstrumentID string   `json:"instrument_id,omitempty"`
+	OnHand       *bool    `json:"on_hand,omitempty"`
+	Note         *string  `json:"note,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	BuyTarget    *float64 `json:"buy_target,omitempty"`
+	SellTarget   *float64 `json:"sell_target,omitempty"`
+	Position     *int     `json:"position,omitempty"`
+	CreatedAt    string   `json:"created_at,omitempty"`
+	UpdatedAt    string   `json:"updated_at,omitempty"`
+}
+
+// importRow is a parsed data row of an import file.
+type importRow struct {
+	line int
+	// symbol is prefixed with the exchange MIC of the row, if any, as an
+	// instrument ID.
+	symbol string
+	c      itemChanges
+	// err is set when the row cannot be parsed.
+	err error
+}
+
+// Import adds or updates the items of a CSV or JSON file in a watchlist.
+// Every row is validated like an add payload and nothing is stored unless
+// all are valid. With dry_run the report only tells what would change.
+func (s *Service) Import(ctx context.Context, p *watchlistGen.ImportPayload, body io.ReadCloser) (*watchlistGen.WatchlistImportReport, error) {
+	defer body.Close()
+
+	l, err := s.listOrDefault(ctx, callerID(ctx), p.Watchlist, RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	version, err := ifMatch(l, p.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(io.LimitReader(body, MaxImportSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxImportSize {
+		return nil, watchlistGen.MakeInvalidFile(fmt.Errorf("file exceeds %d bytes", MaxImportSize))
+	}
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	format := value(p.Format)
+	if format == "" {
+		format = detectFormat(data)
+	}
+	var rows []importRow
+	if format == FormatJSON {
+		rows, err = readJSON(data)
+	} else {
+		rows, err = readCSV(data)
+	}
+	if err != nil {
+		return nil, watchlistGen.MakeInvalidFile(err)
+	}
+
+	report := &watchlistGen.WatchlistImportReport{DryRun: p.DryRun, Rows: len(rows), Errors: []*watchlistGen.ImportRowError{}}
+	fail := func(line int, err error) {
+		report.Errors = append(report.Errors, &watchlistGen.ImportRowError{Line: line, Error: err.Error()})
+	}
+	now := s.now()
+	var (
+		changed []*Item
+		added   = make(map[string]bool)
+		lines   = make(map[string]int)
+	)
+	for _, r := range rows {
+		if r.err != nil {
+			fail(r.line, r.err)
+			continue
+		}
+		if err := r.c.validate(); err != nil {
+			fail(r.line, err)
+			continue
+		}
+		it, existing, err := s.prepare(ctx, l, r.symbol, r.c, now)
+		if errors.Is(err, ErrInvalidSymbol) {
+			fail(r.line, err)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if prev, ok := lines[it.Symbol]; ok {
+			fail(r.line, fmt.Errorf("%s is already on line %d", it.Symbol, prev))
+			continue
+		}
+		lines[it.Symbol] = r.line
+
+		switch {
+		case existing == nil:
+			report.Added++
+			added[it.Symbol] = true
+		case sameItem(existing, it):
+			report.Unchanged++
+			continue
+		default:
+			report.Updated++
+		}
+		changed = append(changed, it)
+	}
+
+	if p.DryRun || len(report.Errors) > 0 {
+		return report, nil
+	}
+	stored, err := s.repo.AddItems(ctx, l.ID, version, changed)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrListNotFound):
+			return nil, watchlistGen.MakeNotFound(fmt.Errorf("watchlist %q not found", l.ID))
+		case errors.Is(err, ErrVersionMismatch):
+			return nil, preconditionFailed(l)
+		}
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to import watchlist items", "watchlist", l.ID, "error", err)
+		return nil, err
+	}
+	report.Applied = true
+	for _, it := range stored {
+		if added[it.Symbol] {
+			s.publish(l, eventAdded, it.Symbol, it, it.UpdatedAt)
+		} else {
+			s.publish(l, eventUpdated, it.Symbol, it, it.UpdatedAt)
+		}
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist items imported",
+		"watchlist", l.ID,
+		"rows", report.Rows,
+		"added", report.Added,
+		"updated", report.Updated,
+		"unchanged", report.Unchanged)
+	return report, nil
+}
+
+// detectFormat tells a JSON file, which starts with an array, from a CSV
+// file.
+func detectFormat(data []byte) string {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		return FormatJSON
+	}
+	return FormatCSV
+}
+
+// readCSV parses the rows of a CSV import file, the first row of which names
+// the columns in any order. Only the symbol column is required; columns
+// that are not item attributes are ignored, so that files exported by other
+// tools can be imported as is.
+func readCSV(data []byte) ([]importRow, error) {
+	cr := csv.NewReader(bytes.NewReader(data))
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("empty file")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := index[name]; ok {
+			return nil, fmt.Errorf("duplicate column %q", name)
+		}
+		index[name] = i
+	}
+	if _, ok := index["symbol"]; !ok {
+		return nil, errors.New(`missing column "symbol"`)
+	}
+	// cell returns the value of the named column, nil when the file has no
+	// such column.
+	cell := func(rec []string, name string) *string {
+		i, ok := index[name]
+		if !ok {
+			return nil
+		}
+		v := ""
+		if i < len(rec) {
+			v = strings.TrimSpace(rec[i])
+		}
+		return &v
+	}
+
+	var rows []importRow
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		r := importRow{line: line, symbol: withMIC(*cell(rec, "symbol"), value(cell(rec, "exchange_mic")))}
+		r.c.Note = cell(rec, "note")
+		if v := cell(rec, "tags"); v != nil {
+			r.c.Tags = []string{}
+			if *v != "" {
+				r.c.Tags = strings.Split(*v, tagSeparator)
+			}
+		}
+		r.c.OnHand, r.err = parseBool(cell(rec, "on_hand"))
+		if r.err == nil {
+			r.c.BuyTarget, r.err = parsePrice("buy_target", cell(rec, "buy_target"))
+		}
+		if r.err == nil {
+			r.c.SellTarget, r.err = parsePrice("sell_target", cell(rec, "sell_target"))
+		}
+		rows = append(rows, r)
+	}
+	return rows, nil
+}
+
+// readJSON parses the items of a JSON import file, an array of objects with
+// the attributes of fileItem.
+func readJSON(data []byte) ([]importRow, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return nil, errors.New("a JSON file must hold an array of items")
+	}
+	var rows []importRow
+	for dec.More() {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, err
+		}
+		// The decoder stops after the item, so its start is the end
+		// offset less its length.
+		r := importRow{line: lineAt(data, int(dec.InputOffset())-len(raw))}
+		var fi fileItem
+		if err := json.Unmarshal(raw, &fi); err != nil {
+			r.err = err
+		} else {
+			r.symbol = withMIC(strings.TrimSpace(fi.Symbol), strings.TrimSpace(fi.ExchangeMIC))
+			r.c = itemChanges{OnHand: fi.OnHand, Note: fi.Note, Tags: fi.Tags, BuyTarget: fi.BuyTarget, SellTarget: fi.SellTarget}
+		}
+		rows = append(rows, r)
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// lineAt returns the line of the byte at offset in data.
+func lineAt(data []byte, offset int) int {
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+// withMIC returns symbol as an instrument ID on mic, or symbol alone when
+// mic is empty.
+func withMIC(symbol, mic string) string {
+	if mic == "" || symbol == "" {
+		return symbol
+	}
+	return mic + ":" + symbol
+}
+
+// parseBool parses an on_hand cell, accepting yes and no besides the values
+// of strconv.ParseBool. An empty cell is false.
+func parseBool(v *string) (*bool, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var b bool
+	switch strings.ToLower(*v) {
+	case "", "no", "n":
+	case "yes", "y":
+		b = true
+	default:
+		var err error
+		if b, err = strconv.ParseBool(*v); err != nil {
+			return nil, fmt.Errorf("on_hand: %q is not a boolean", *v)
+		}
+	}
+	return &b, nil
+}
+
+// parsePrice parses a target price cell. An empty cell is 0, which clears
+// the target.
+func parsePrice(column string, v *string) (*float64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var f float64
+	if *v != "" {
+		var err error
+		if f, err = strconv.ParseFloat(*v, 64); err != nil {
+			return nil, fmt.Errorf("%s: %q is not a number", column, *v)
+		}
+	}
+	return &f, nil
+}
+
+// validate checks c against the limits the add payloads declare.
+func (c itemChanges) validate() error {
+	if c.Note != nil && utf8.RuneCountInString(*c.Note) > maxNoteLength {
+		return fmt.Errorf("note is longer than %d characters", maxNoteLength)
+	}
+	if len(c.Tags) > maxTags {
+		return fmt.Errorf("more than %d tags", maxTags)
+	}
+	for _, tag := range c.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return errors.New("empty tag")
+		}
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return fmt.Errorf("tag %q is longer than %d characters", tag, maxTagLength)
+		}
+	}
+	for _, t := range []struct {
+		name  string
+		price *float64
+	}{{"buy_target", c.BuyTarget}, {"sell_target", c.SellTarget}} {
+		if t.price != nil && *t.price < 0 {
+			return fmt.Errorf("%s must not be negative", t.name)
+		}
+	}
+	return nil
+}
+
+// sameItem reports whether a and b, the same item, have the same attributes.
+func sameItem(a, b *Item) bool {
+	return a.OnHand == b.OnHand && a.Note == b.Note && slices.Equal(a.Tags, b.Tags) &&
+		a.BuyTarget == b.BuyTarget && a.SellTarget == b.SellTarget &&
+		a.ExchangeMIC == b.ExchangeMIC && a.InstrumentID == b.InstrumentID
+}
@@ -0,0 +1,531 @@
+package watchlist
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryRepository keeps watchlists in process memory. Its contents are lost
+// when the server restarts.
+type MemoryRepository struct {
+	mu    sync.RWMutex
+	lists map[string]*List
+	items map[string]map[string]*Item
+	// removed holds the removed items of each watchlist by symbol.
+	removed map[string]map[string]*Item
+	// shares holds the shares of each watchlist by user ID.
+	shares map[string]map[string]*Share
+}
+
+// NewMemoryRepository returns an empty in-memory repository.
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{
+		lists:   make(map[string]*List),
+		items:   make(map[string]map[string]*Item),
+		removed: make(map[string]map[string]*Item),
+		shares:  make(map[string]map[string]*Share),
+	}
+}
+
+// Lists implements Repository.
+func (r *MemoryRepository) Lists(ctx context.Context, userID string) ([]*List, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	owned := r.owned(userID)
+	res := make([]*List, len(owned))
+	for i, l := range owned {
+		res[i] = r.copyList(l)
+	}
+	return res, nil
+}
+
+// GetList implements Repository.
+func (r *MemoryRepository) GetList(ctx context.Context, userID, id string) (*List, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	l, ok := r.lists[id]
+	if !ok || l.UserID != userID {
+		return nil, ErrListNotFound
+	}
+	return r.copyList(l), nil
+}
+
+// DefaultList implements Repository.
+func (r *MemoryRepository) DefaultList(ctx context.Context, def *List) (*List, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, l := range r.owned(def.UserID) {
+		if l.Default {
+			return r.copyList(l), nil
+		}
+	}
+	cp := *def
+	cp.Default = true
+	cp.Version = 1
+	return r.insert(&cp)
+}
+
+// CreateList implements Repository.
+func (r *MemoryRepository) CreateList(ctx context.Context, list *List) (*List, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cp := *list
+	cp.Default = false
+	cp.Version = 1
+	return r.insert(&cp)
+}
+
+// UpdateList implements Repository.
+func (r *MemoryRepository) UpdateList(ctx context.Context, list *List) (*List, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, ok := r.lists[list.ID]
+	if !ok || l.UserID != list.UserID {
+		return nil, ErrListNotFound
+	}
+	if !matches(l, list.Version) {
+		return nil, ErrVersionMismatch
+	}
+	owned := r.owned(list.UserID)
+	for _, o := range owned {
+		if o != l && strings.EqualFold(o.Name, list.Name) {
+			return nil, ErrConflict
+		}
+	}
+	l.Name = list.Name
+	l.UpdatedAt = list.UpdatedAt
+	l.Version++
+
+	owned = append(owned[:l.Position], owned[l.Position+1:]...)
+	pos := min(max(list.Position, 0), len(owned))
+	owned = append(owned[:pos], append([]*List{l}, owned[pos:]...)...)
+	renumber(owned)
+	return r.copyList(l), nil
+}
+
+// DeleteList implements Repository.
+func (r *MemoryRepository) DeleteList(ctx context.Context, userID, id string, version int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, ok := r.lists[id]
+	if !ok || l.UserID != userID {
+		return ErrListNotFound
+	}
+	if !matches(l, version) {
+		return ErrVersionMismatch
+	}
+	delete(r.lists, id)
+	delete(r.items, id)
+	delete(r.removed, id)
+	delete(r.shares, id)
+	renumber(r.owned(userID))
+	return nil
+}
+
+// SharedLists implements Repository.
+func (r *MemoryRepository) SharedLists(ctx context.Context, userID string) ([]*SharedList, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := []*SharedList{}
+	for id, shares := range r.shares {
+		if sh, ok := shares[userID]; ok {
+			res = append(res, &SharedList{List: r.copyList(r.lists[id]), Role: sh.Role})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		a, b := strings.ToLower(res[i].Name), strings.ToLower(res[j].Name)
+		if a != b {
+			return a < b
+		}
+		return res[i].ID < res[j].ID
+	})
+	return res, nil
+}
+
+// GetSharedList implements Repository.
+func (r *MemoryRepository) GetSharedList(ctx context.Context, userID, id string) (*SharedList, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sh, ok := r.shares[id][userID]
+	if !ok {
+		return nil, ErrListNotFound
+	}
+	return &SharedList{List: r.copyList(r.lists[id]), Role: sh.Role}, nil
+}
+
+// Shares implements Repository.
+func (r *MemoryRepository) Shares(ctx context.Context, listID string) ([]*Share, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*Share, 0, len(r.shares[listID]))
+	for _, sh := range r.shares[listID] {
+		cp := *sh
+		res = append(res, &cp)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].UserID < res[j].UserID })
+	return res, nil
+}
+
+// PutShare implements Repository.
+func (r *MemoryRepository) PutShare(ctx context.Context, share *Share) (*Share, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.lists[share.ListID]; !ok {
+		return nil, ErrListNotFound
+	}
+	shares, ok := r.shares[share.ListID]
+	if !ok {
+		shares = make(map[string]*Share)
+		r.shares[share.ListID] = shares
+	}
+	cp := *share
+	if existing, ok := shares[share.UserID]; ok {
+		cp.CreatedAt = existing.CreatedAt
+	}
+	shares[share.UserID] = &cp
+	res := cp
+	return &res, nil
+}
+
+// DeleteShare implements Repository.
+func (r *MemoryRepository) DeleteShare(ctx context.Context, listID, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.shares[listID][userID]; !ok {
+		return ErrShareNotFound
+	}
+	delete(r.shares[listID], userID)
+	return nil
+}
+
+// SetPublicToken implements Repository.
+func (r *MemoryRepository) SetPublicToken(ctx context.Context, listID, token string) (*List, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, ok := r.lists[listID]
+	if !ok {
+		return nil, ErrListNotFound
+	}
+	l.PublicToken = token
+	return r.copyList(l), nil
+}
+
+// PublicList implements Repository.
+func (r *MemoryRepository) PublicList(ctx context.Context, token string) (*List, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, l := range r.lists {
+		if token != "" && l.PublicToken == token {
+			return r.copyList(l), nil
+		}
+	}
+	return nil, ErrListNotFound
+}
+
+// Items implements Repository.
+func (r *MemoryRepository) Items(ctx context.Context, listID string) ([]*Item, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ordered := r.ordered(listID)
+	res := make([]*Item, len(ordered))
+	for i, it := range ordered {
+		res[i] = copyItem(it)
+	}
+	return res, nil
+}
+
+// Get implements Repository.
+func (r *MemoryRepository) Get(ctx context.Context, listID, symbol string) (*Item, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	it, ok := r.items[listID][symbol]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return copyItem(it), nil
+}
+
+// Add implements Repository.
+func (r *MemoryRepository) Add(ctx context.Context, listID string, version int64, item *Item) (*Item, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, err := r.check(listID, version)
+	if err != nil {
+		return nil, err
+	}
+	l.Version++
+	return r.add(listID, item), nil
+}
+
+// AddItems implements Repository.
+func (r *MemoryRepository) AddItems(ctx context.Context, listID string, version int64, items []*Item) ([]*Item, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, err := r.check(listID, version)
+	if err != nil {
+		return nil, err
+	}
+	l.Version++
+	res := make([]*Item, len(items))
+	for i, item := range items {
+		res[i] = r.add(listID, item)
+	}
+	return res, nil
+}
+
+// Update implements Repository.
+func (r *MemoryRepository) Update(ctx context.Context, listID string, version int64, item *Item) (*Item, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, err := r.check(listID, version)
+	if err != nil {
+		return nil, err
+	}
+	existing, ok := r.items[listID][item.Symbol]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	l.Version++
+	ordered := r.ordered(listID)
+	ordered = append(ordered[:existing.Position], ordered[existing.Position+1:]...)
+
+	cp := copyItem(item)
+	cp.CreatedAt = existing.CreatedAt
+	r.items[listID][item.Symbol] = cp
+	pos := min(max(item.Position, 0), len(ordered))
+	ordered = append(ordered[:pos], append([]*Item{cp}, ordered[pos:]...)...)
+	for i, it := range ordered {
+		it.Position = i
+	}
+	return copyItem(cp), nil
+}
+
+// Remove implements Repository.
+func (r *MemoryRepository) Remove(ctx context.Context, listID string, version int64, symbol string, removedAt time.Time) error {
+	return r.RemoveItems(ctx, listID, version, []string{symbol}, removedAt)
+}
+
+// RemoveItems implements Repository.
+func (r *MemoryRepository) RemoveItems(ctx context.Context, listID string, version int64, symbols []string, removedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, err := r.check(listID, version)
+	if err != nil {
+		return err
+	}
+	for _, symbol := range symbols {
+		if _, ok := r.items[listID][symbol]; !ok {
+			return ErrNotFound
+		}
+	}
+	l.Version++
+	removed, ok := r.removed[listID]
+	if !ok {
+		removed = make(map[string]*Item)
+		r.removed[listID] = removed
+	}
+	for _, symbol := range symbols {
+		it := r.items[listID][symbol]
+		it.RemovedAt = removedAt
+		removed[symbol] = it
+		delete(r.items[listID], symbol)
+	}
+	for i, it := range r.ordered(listID) {
+		it.Position = i
+	}
+	return nil
+}
+
+// Removed implements Repository.
+func (r *MemoryRepository) Removed(ctx context.Context, listID string) ([]*Item, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*Item, 0, len(r.removed[listID]))
+	for _, it := range r.removed[listID] {
+		res = append(res, copyItem(it))
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].RemovedAt.Equal(res[j].RemovedAt) {
+			return res[i].RemovedAt.After(res[j].RemovedAt)
+		}
+		return res[i].Symbol < res[j].Symbol
+	})
+	return res, nil
+}
+
+// Restore implements Repository.
+func (r *MemoryRepository) Restore(ctx context.Context, listID string, version int64, symbol string, restoredAt time.Time) (*Item, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l, err := r.check(listID, version)
+	if err != nil {
+		return nil, err
+	}
+	it, ok := r.removed[listID][symbol]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	l.Version++
+	delete(r.removed[listID], symbol)
+	it.RemovedAt = time.Time{}
+	it.UpdatedAt = restoredAt
+
+	ordered := r.ordered(listID)
+	pos := min(it.Position, len(ordered))
+	ordered = append(ordered[:pos], append([]*Item{it}, ordered[pos:]...)...)
+	for i, it := range ordered {
+		it.Position = i
+	}
+	if _, ok := r.items[listID]; !ok {
+		r.items[listID] = make(map[string]*Item)
+	}
+	r.items[listID][symbol] = it
+	return copyItem(it), nil
+}
+
+// Purge implements Repository.
+func (r *MemoryRepository) Purge(ctx context.Context, before time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := 0
+	for _, removed := range r.removed {
+		for symbol, it := range removed {
+			if it.RemovedAt.Before(before) {
+				delete(removed, symbol)
+				n++
+			}
+		}
+	}
+	return n, nil
+}
+
+// Ping implements Repository.
+func (r *MemoryRepository) Ping(ctx context.Context) error { return nil }
+
+// Close implements Repository.
+func (r *MemoryRepository) Close() error { return nil }
+
+// owned returns the watchlists of the user ordered by position. The caller
+// must hold the lock.
+func (r *MemoryRepository) owned(userID string) []*List {
+	var res []*List
+	for _, l := range r.lists {
+		if l.UserID == userID {
+			res = append(res, l)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Position < res[j].Position })
+	return res
+}
+
+// check returns the watchlist to be changed, or ErrListNotFound or
+// ErrVersionMismatch. The caller must hold the lock.
+func (r *MemoryRepository) check(listID string, version int64) (*List, error) {
+	l, ok := r.lists[listID]
+	if !ok {
+		return nil, ErrListNotFound
+	}
+	if !matches(l, version) {
+		return nil, ErrVersionMismatch
+	}
+	return l, nil
+}
+
+// add stores item in the existing watchlist. The caller must hold the
+// write lock.
+func (r *MemoryRepository) add(listID string, item *Item) *Item {
+	list, ok := r.items[listID]
+	if !ok {
+		list = make(map[string]*Item)
+		r.items[listID] = list
+	}
+	delete(r.removed[listID], item.Symbol)
+	cp := copyItem(item)
+	if existing, ok := list[item.Symbol]; ok {
+		cp.CreatedAt = existing.CreatedAt
+		cp.Position = existing.Position
+	} else {
+		cp.Position = len(list)
+	}
+	list[item.Symbol] = cp
+	return copyItem(cp)
+}
+
+// ordered returns the items of the watchlist ordered by position. The
+// caller must hold the lock.
+func (r *MemoryRepository) ordered(listID string) []*Item {
+	res := make([]*Item, 0, len(r.items[listID]))
+	for _, it := range r.items[listID] {
+		res = append(res, it)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Position < res[j].Position })
+	return res
+}
+
+// insert stores l after the user's other watchlists. The caller must hold
+// the write lock.
+func (r *MemoryRepository) insert(l *List) (*List, error) {
+	owned := r.owned(l.UserID)
+	for _, o := range owned {
+		if strings.EqualFold(o.Name, l.Name) {
+			return nil, ErrConflict
+		}
+	}
+	l.Position = len(owned)
+	r.lists[l.ID] = l
+	return r.copyList(l), nil
+}
+
+// copyList returns a copy of l with its item count. The caller must hold the
+// lock.
+func (r *MemoryRepository) copyList(l *List) *List {
+	cp := *l
+	cp.ItemCount = len(r.items[l.ID])
+	return &cp
+}
+
+// copyItem returns a copy of it that shares no memory with it.
+func copyItem(it *Item) *Item {
+	cp := *it
+	cp.Tags = slices.Clone(it.Tags)
+	return &cp
+}
+
+// matches reports whether version is the current version of l or
+// AnyVersion.
+func matches(l *List, version int64) bool {
+	return version == AnyVersion || version == l.Version
+}
+
+// renumber assigns consecutive positions to lists in their order.
+func renumber(lists []*List) {
+	for i, l := range lists {
+		l.Position = i
+	}
+}
@@ -0,0 +1,26 @@
+package watchlist
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/sqlstore"
+)
+
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+
+// migrationsTable records the applied watchlist migrations. It predates the
+// other repositories and keeps its original name.
+const migrationsTable = "schema_migrations"
+
+func loadMigrations() ([]sqlstore.Migration, error) {
+	return sqlstore.LoadMigrations(migrationFS)
+}
+
+// migrate applies every embedded migration newer than the recorded schema
+// version.
+func migrate(ctx context.Context, db *sql.DB) error {
+	return sqlstore.Migrate(ctx, db, migrationFS, migrationsTable)
+}
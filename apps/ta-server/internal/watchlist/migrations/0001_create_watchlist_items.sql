@@ -0,0 +1,9 @@
+CREATE TABLE watchlist_items (
+    user_id    TEXT    NOT NULL,
+    symbol     TEXT    NOT NULL,
+    on_hand    INTEGER NOT NULL DEFAULT 0,
+    created_at TEXT    NOT NULL,
+    PRIMARY KEY (user_id, symbol)
+);
+
+CREATE INDEX idx_watchlist_items_user_created ON watchlist_items (user_id, created_at);
@@ -0,0 +1,19 @@
+ALTER TABLE watchlist_items ADD COLUMN exchange_mic TEXT NOT NULL DEFAULT '';
+ALTER TABLE watchlist_items ADD COLUMN instrument_id TEXT NOT NULL DEFAULT '';
+
+-- Symbols are stored upper-cased from now on. Items the same user added in
+-- several cases are merged into one, on hand if any of them was and created
+-- when the first of them was.
+UPDATE watchlist_items SET
+    on_hand = (
+        SELECT max(o.on_hand) FROM watchlist_items o
+        WHERE o.user_id = watchlist_items.user_id AND upper(o.symbol) = upper(watchlist_items.symbol)
+    ),
+    created_at = (
+        SELECT min(o.created_at) FROM watchlist_items o
+        WHERE o.user_id = watchlist_items.user_id AND upper(o.symbol) = upper(watchlist_items.symbol)
+    );
+DELETE FROM watchlist_items WHERE rowid NOT IN (
+    SELECT min(rowid) FROM watchlist_items GROUP BY user_id, upper(symbol)
+);
+UPDATE watchlist_items SET symbol = upper(symbol);
@@ -0,0 +1,38 @@
+CREATE TABLE watchlists (
+    id         TEXT    NOT NULL PRIMARY KEY,
+    user_id    TEXT    NOT NULL,
+    name       TEXT    NOT NULL,
+    position   INTEGER NOT NULL,
+    is_default INTEGER NOT NULL DEFAULT 0,
+    created_at TEXT    NOT NULL,
+    updated_at TEXT    NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_watchlists_user_name ON watchlists (user_id, name COLLATE NOCASE);
+CREATE UNIQUE INDEX idx_watchlists_user_default ON watchlists (user_id) WHERE is_default;
+
+-- The single list of each user becomes their default watchlist.
+INSERT INTO watchlists (id, user_id, name, position, is_default, created_at, updated_at)
+SELECT lower(hex(randomblob(8))), user_id, 'Default', 0, 1, min(created_at), min(created_at)
+FROM watchlist_items
+GROUP BY user_id;
+
+CREATE TABLE watchlist_items_new (
+    watchlist_id  TEXT    NOT NULL REFERENCES watchlists (id) ON DELETE CASCADE,
+    symbol        TEXT    NOT NULL,
+    on_hand       INTEGER NOT NULL DEFAULT 0,
+    created_at    TEXT    NOT NULL,
+    exchange_mic  TEXT    NOT NULL DEFAULT '',
+    instrument_id TEXT    NOT NULL DEFAULT '',
+    PRIMARY KEY (watchlist_id, symbol)
+);
+
+INSERT INTO watchlist_items_new (watchlist_id, symbol, on_hand, created_at, exchange_mic, instrument_id)
+SELECT w.id, i.symbol, i.on_hand, i.created_at, i.exchange_mic, i.instrument_id
+FROM watchlist_items i
+JOIN watchlists w ON w.user_id = i.user_id;
+
+DROP TABLE watchlist_items;
+ALTER TABLE watchlist_items_new RENAME TO watchlist_items;
+
+CREATE INDEX idx_watchlist_items_list_created ON watchlist_items (watchlist_id, created_at);
@@ -0,0 +1,19 @@
+ALTER TABLE watchlist_items ADD COLUMN note TEXT NOT NULL DEFAULT '';
+ALTER TABLE watchlist_items ADD COLUMN tags TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE watchlist_items ADD COLUMN buy_target REAL;
+ALTER TABLE watchlist_items ADD COLUMN sell_target REAL;
+ALTER TABLE watchlist_items ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE watchlist_items ADD COLUMN updated_at TEXT NOT NULL DEFAULT '';
+
+-- Items keep the order they were listed in, by creation time.
+UPDATE watchlist_items SET
+    position = (
+        SELECT COUNT(*) FROM watchlist_items o
+        WHERE o.watchlist_id = watchlist_items.watchlist_id
+          AND (o.created_at < watchlist_items.created_at
+               OR (o.created_at = watchlist_items.created_at AND o.symbol < watchlist_items.symbol))
+    ),
+    updated_at = created_at;
+
+DROP INDEX idx_watchlist_items_list_created;
+CREATE INDEX idx_watchlist_items_list_position ON watchlist_items (watchlist_id, position);
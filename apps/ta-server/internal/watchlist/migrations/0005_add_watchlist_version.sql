@@ -0,0 +1,3 @@
+-- version counts the changes of a watchlist and its items; it backs the
+-- ETag of the watchlist.
+ALTER TABLE watchlists ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
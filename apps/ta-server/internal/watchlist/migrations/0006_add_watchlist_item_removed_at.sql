@@ -0,0 +1,6 @@
+-- removed_at marks the items removed from a watchlist, kept until they are
+-- restored or purged. Removed items keep their last position, which live
+-- items may reuse.
+ALTER TABLE watchlist_items ADD COLUMN removed_at TEXT;
+
+CREATE INDEX idx_watchlist_items_removed ON watchlist_items (removed_at) WHERE removed_at IS NOT NULL;
@@ -0,0 +1,16 @@
+-- public_token is the token of the public link of a published watchlist.
+ALTER TABLE watchlists ADD COLUMN public_token TEXT;
+
+CREATE UNIQUE INDEX idx_watchlists_public_token ON watchlists (public_token) WHERE public_token IS NOT NULL;
+
+-- Each share grants a user other than the owner access to a watchlist as
+-- an editor or a viewer.
+CREATE TABLE watchlist_shares (
+    watchlist_id TEXT NOT NULL REFERENCES watchlists (id) ON DELETE CASCADE,
+    user_id      TEXT NOT NULL,
+    role         TEXT NOT NULL,
+    created_at   TEXT NOT NULL,
+    PRIMARY KEY (watchlist_id, user_id)
+);
+
+CREATE INDEX idx_watchlist_shares_user ON watchlist_shares (user_id);
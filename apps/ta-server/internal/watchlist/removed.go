@@ -0,0 +1,140 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	watchlistGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+)
+
+// DefaultRetention is how long removed items can be restored when the
+// configuration does not say otherwise.
+const DefaultRetention = 30 * 24 * time.Hour
+
+// PurgeInterval is how often RunPurge deletes the removed items past the
+// retention window.
+const PurgeInterval = time.Hour
+
+// ListRemoved returns the tickers removed from a watchlist that can still be
+// restored, most recently removed first.
+func (s *Service) ListRemoved(ctx context.Context, p *watchlistGen.ListRemovedPayload) ([]*watchlistGen.RemovedTickerItem, error) {
+	l, err := s.listOrDefault(ctx, callerID(ctx), p.Watchlist, RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	removed, err := s.removed(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*watchlistGen.RemovedTickerItem, len(removed))
+	for i, it := range removed {
+		res[i] = s.toRemovedTickerItem(it)
+	}
+	return res, nil
+}
+
+// Restore puts a removed ticker back into a watchlist at the position it
+// had, as long as it is within the retention window.
+func (s *Service) Restore(ctx context.Context, p *watchlistGen.RestorePayload) (*watchlistGen.TickerItem, error) {
+	l, err := s.listOrDefault(ctx, callerID(ctx), p.Watchlist, RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	version, err := ifMatch(l, p.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+	symbol := s.symbols.Key(p.Symbol)
+	notFound := watchlistGen.MakeNotFound(fmt.Errorf("%s is not among the removed items of watchlist %q", symbol, l.ID))
+
+	// Items past the retention window wait for the next purge but can no
+	// longer be restored.
+	removed, err := s.removed(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(removed, func(it *Item) bool { return it.Symbol == symbol }) {
+		return nil, notFound
+	}
+
+	it, err := s.repo.Restore(ctx, l.ID, version, symbol, s.now())
+	switch {
+	case errors.Is(err, ErrNotFound), errors.Is(err, ErrListNotFound):
+		return nil, notFound
+	case errors.Is(err, ErrVersionMismatch):
+		return nil, preconditionFailed(l)
+	case err != nil:
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to restore watchlist item", "watchlist", l.ID, "symbol", symbol, "error", err)
+		return nil, err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist item restored", "watchlist", l.ID, "symbol", symbol)
+	s.publish(l, eventRestored, symbol, it, it.UpdatedAt)
+	return toTickerItem(it), nil
+}
+
+// Purge deletes the items removed before the retention window and returns
+// how many were deleted.
+func (s *Service) Purge(ctx context.Context) (int, error) {
+	return s.repo.Purge(ctx, s.now().Add(-s.retention))
+}
+
+// RunPurge purges removed items right away and then every interval, until
+// ctx is cancelled. Failures are logged and retried at the next interval.
+func (s *Service) RunPurge(ctx context.Context, interval time.Duration) {
+	logger := logctx.LoggerFromContext(ctx)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		n, err := s.Purge(ctx)
+		switch {
+		case err != nil && ctx.Err() == nil:
+			logger.ErrorContext(ctx, "failed to purge removed watchlist items", "error", err)
+		case n > 0:
+			logger.InfoContext(ctx, "removed watchlist items purged", "count", n, "retention", s.retention.String())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// removed returns the removed items of l within the retention window.
+func (s *Service) removed(ctx context.Context, l *List) ([]*Item, error) {
+	items, err := s.repo.Removed(ctx, l.ID)
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to list removed watchlist items", "watchlist", l.ID, "error", err)
+		return nil, err
+	}
+	cutoff := s.now().Add(-s.retention)
+	for i, it := range items {
+		if it.RemovedAt.Before(cutoff) {
+			return items[:i], nil
+		}
+	}
+	return items, nil
+}
+
+func (s *Service) toRemovedTickerItem(it *Item) *watchlistGen.RemovedTickerItem {
+	t := toTickerItem(it)
+	return &watchlistGen.RemovedTickerItem{
+		Symbol:       t.Symbol,
+		OnHand:       t.OnHand,
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+		ExchangeMic:  t.ExchangeMic,
+		InstrumentID: t.InstrumentID,
+		Note:         t.Note,
+		Tags:         t.Tags,
+		BuyTarget:    t.BuyTarget,
+		SellTarget:   t.SellTarget,
+		Position:     t.Position,
+		RemovedAt:    it.RemovedAt.UTC().Format(time.RFC3339),
+		PurgeAt:      it.RemovedAt.Add(s.retention).UTC().Format(time.RFC3339),
+	}
+}
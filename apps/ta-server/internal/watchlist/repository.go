@@ -0,0 +1,227 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/sqlstore"
+)
+
+// Storage backends selectable through the "api-server.storage" setting.
+const (
+	StorageMemory = sqlstore.StorageMemory
+	StorageSQLite = sqlstore.StorageSQLite
+)
+
+var (
+	// ErrNotFound is returned by a Repository when the requested item does
+	// not exist.
+	ErrNotFound = errors.New("watchlist item not found")
+	// ErrListNotFound is returned by a Repository when the requested
+	// watchlist does not exist or belongs to another user.
+	ErrListNotFound = errors.New("watchlist not found")
+	// ErrConflict is returned by a Repository when the user already has a
+	// watchlist with the same name, compared case-insensitively.
+	ErrConflict = errors.New("watchlist name already taken")
+	// ErrVersionMismatch is returned by a Repository when a change expects
+	// another version of the watchlist than the current one.
+	ErrVersionMismatch = errors.New("watchlist version mismatch")
+	// ErrShareNotFound is returned by a Repository when the watchlist is
+	// not shared with the user.
+	ErrShareNotFound = errors.New("watchlist share not found")
+)
+
+// AnyVersion makes a change apply to the current version of the watchlist,
+// whichever it is.
+const AnyVersion int64 = 0
+
+// List is a named watchlist of a user.
+type List struct {
+	ID     string
+	UserID string
+	Name   string
+	// Position orders the watchlists of a user, from 0 without gaps.
+	Position int
+	// Default marks the list served by the /watchlist routes. Every user
+	// has exactly one, created on first use.
+	Default bool
+	// Version starts at 1 and is incremented by every change of the
+	// watchlist or its items.
+	Version   int64
+	ItemCount int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// PublicToken is the token of the public link of the watchlist, empty
+	// when it is not published.
+	PublicToken string
+}
+
+// Role is the access a user has to a watchlist.
+type Role string
+
+// Roles, each allowing what the next one does.
+const (
+	// RoleOwner also renames, moves, deletes, shares and publishes the
+	// watchlist.
+	RoleOwner Role = "owner"
+	// RoleEditor also changes the items of the watchlist.
+	RoleEditor Role = "editor"
+	// RoleViewer reads the watchlist and its items.
+	RoleViewer Role = "viewer"
+)
+
+// roleRanks orders the roles from the least access; unknown roles have
+// none.
+var roleRanks = map[Role]int{RoleViewer: 1, RoleEditor: 2, RoleOwner: 3}
+
+// allows reports whether r allows what other does.
+func (r Role) allows(other Role) bool {
+	return roleRanks[r] >= roleRanks[other]
+}
+
+// Share grants a user other than its owner access to a watchlist.
+type Share struct {
+	ListID string
+	UserID string
+	// Role is RoleEditor or RoleViewer.
+	Role      Role
+	CreatedAt time.Time
+}
+
+// SharedList is a watchlist of another user shared with a user.
+type SharedList struct {
+	*List
+	Role Role
+}
+
+// Item is a single ticker stored in a user's watchlist.
+type Item struct {
+	Symbol string
+	OnHand bool
+	Note   string
+	Tags   []string
+	// BuyTarget and SellTarget are target prices, 0 when unset.
+	BuyTarget  float64
+	SellTarget float64
+	// Position orders the items of a watchlist, from 0 without gaps.
+	Position  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// ExchangeMIC and InstrumentID locate the symbol, see Listing; they are
+	// empty when unknown.
+	ExchangeMIC  string
+	InstrumentID string
+	// RemovedAt is when the item was removed from the watchlist, zero while
+	// it is listed. Removed items keep the position they had.
+	RemovedAt time.Time
+}
+
+// Repository persists the watchlists of users and their items.
+//
+// The methods changing a watchlist or its items take the version of the
+// watchlist the change was based on, or AnyVersion. They return
+// ErrVersionMismatch and change nothing when that version is no longer
+// current, and otherwise increment it.
+type Repository interface {
+	// Lists returns the user's watchlists ordered by position.
+	Lists(ctx context.Context, userID string) ([]*List, error)
+	// GetList returns the user's watchlist with the given ID, or
+	// ErrListNotFound.
+	GetList(ctx context.Context, userID, id string) (*List, error)
+	// DefaultList returns the user's default watchlist, storing def as the
+	// default after the user's other watchlists when there is none yet.
+	DefaultList(ctx context.Context, def *List) (*List, error)
+	// CreateList stores a new watchlist after the user's other watchlists.
+	// It returns ErrConflict when the name is taken.
+	CreateList(ctx context.Context, list *List) (*List, error)
+	// UpdateList renames the watchlist and moves it to its position, which
+	// is clamped to the last one, shifting the watchlists in between. Its
+	// Version is the version the change is based on. It returns
+	// ErrListNotFound when it does not exist and ErrConflict when its new
+	// name is taken.
+	UpdateList(ctx context.Context, list *List) (*List, error)
+	// DeleteList removes the watchlist, its items and its shares, closing
+	// the gap in positions, or returns ErrListNotFound.
+	DeleteList(ctx context.Context, userID, id string, version int64) error
+	// SharedLists returns the watchlists shared with the user ordered by
+	// name, regardless of case.
+	SharedLists(ctx context.Context, userID string) ([]*SharedList, error)
+	// GetSharedList returns the watchlist with the given ID shared with the
+	// user, or ErrListNotFound.
+	GetSharedList(ctx context.Context, userID, id string) (*SharedList, error)
+	// Shares returns the shares of the watchlist ordered by user ID.
+	Shares(ctx context.Context, listID string) ([]*Share, error)
+	// PutShare stores a new share, or changes the role of the share of the
+	// watchlist with the same user, keeping its creation time. It returns
+	// ErrListNotFound when the watchlist does not exist.
+	PutShare(ctx context.Context, share *Share) (*Share, error)
+	// DeleteShare removes the share of the watchlist with the user, or
+	// returns ErrShareNotFound.
+	DeleteShare(ctx context.Context, listID, userID string) error
+	// SetPublicToken sets the token of the public link of the watchlist,
+	// empty to unpublish it. It returns ErrListNotFound when the
+	// watchlist does not exist.
+	SetPublicToken(ctx context.Context, listID, token string) (*List, error)
+	// PublicList returns the watchlist published with the given token, or
+	// ErrListNotFound.
+	PublicList(ctx context.Context, token string) (*List, error)
+	// Items returns the items of the watchlist ordered by position,
+	// leaving out removed items.
+	Items(ctx context.Context, listID string) ([]*Item, error)
+	// Get returns the item of the watchlist with the given symbol, or
+	// ErrNotFound.
+	Get(ctx context.Context, listID, symbol string) (*Item, error)
+	// Add stores a new item after the other items of the watchlist, or
+	// replaces the attributes of the item with the same symbol, keeping its
+	// creation time and position. A removed item with the same symbol is
+	// discarded. It returns ErrListNotFound when the watchlist does not
+	// exist.
+	Add(ctx context.Context, listID string, version int64, item *Item) (*Item, error)
+	// AddItems stores the items as Add does, in order and in a single
+	// transaction, so that either all or none are stored.
+	AddItems(ctx context.Context, listID string, version int64, items []*Item) ([]*Item, error)
+	// Update replaces the attributes of an existing item, keeping its
+	// creation time, and moves it to its position, which is clamped to the
+	// last one, shifting the items in between. It returns ErrNotFound when
+	// the watchlist has no such item.
+	Update(ctx context.Context, listID string, version int64, item *Item) (*Item, error)
+	// Remove takes the item with the given symbol out of the watchlist,
+	// closing the gap in positions, and keeps it as removed at removedAt
+	// until it is restored or purged. It returns ErrNotFound when the
+	// watchlist has no such item.
+	Remove(ctx context.Context, listID string, version int64, symbol string, removedAt time.Time) error
+	// RemoveItems removes the items with the given distinct symbols in a
+	// single transaction, as Remove does. It returns ErrNotFound and
+	// removes none when the watchlist lacks any of them.
+	RemoveItems(ctx context.Context, listID string, version int64, symbols []string, removedAt time.Time) error
+	// Removed returns the removed items of the watchlist, most recently
+	// removed first.
+	Removed(ctx context.Context, listID string) ([]*Item, error)
+	// Restore puts the removed item with the given symbol back at the
+	// position it had, clamped to the last one, shifting the items after
+	// it. It returns ErrNotFound when the watchlist has no such removed
+	// item.
+	Restore(ctx context.Context, listID string, version int64, symbol string, restoredAt time.Time) (*Item, error)
+	// Purge deletes the items of all watchlists removed before the given
+	// time and returns how many were deleted.
+	Purge(ctx context.Context, before time.Time) (int, error)
+	// Ping reports whether the storage backend is reachable.
+	Ping(ctx context.Context) error
+	// Close releases any resources held by the repository.
+	Close() error
+}
+
+// OpenRepository opens the repository for the given storage backend. The dsn
+// is the database file path and is ignored by the in-memory backend.
+func OpenRepository(ctx context.Context, storage string, dsn string) (Repository, error) {
+	switch storage {
+	case "", StorageMemory:
+		return NewMemoryRepository(), nil
+	case StorageSQLite:
+		return NewSQLiteRepository(ctx, dsn)
+	default:
+		return nil, fmt.Errorf("unsupported storage backend %q", storage)
+	}
+}
@@ -0,0 +1,430 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var created = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func names(lists []*List) string {
+	res := make([]string, len(lists))
+	for i, l := range lists {
+		res[i] = l.Name
+	}
+	return strings.Join(res, ",")
+}
+
+func symbols(items []*Item) string {
+	res := make([]string, len(items))
+	for i, it := range items {
+		res[i] = it.Symbol
+	}
+	return strings.Join(res, ",")
+}
+
+// testRepository runs the Repository contract against repo, which must be
+// empty.
+func testRepository(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	def, err := repo.DefaultList(ctx, &List{ID: "d1", UserID: "alice", Name: "Default", CreatedAt: created, UpdatedAt: created})
+	if err != nil {
+		t.Fatalf("DefaultList: %v", err)
+	}
+	if !def.Default || def.Position != 0 {
+		t.Errorf("DefaultList = %+v, want the default at position 0", def)
+	}
+	if again, err := repo.DefaultList(ctx, &List{ID: "d2", UserID: "alice", Name: "Other"}); err != nil || again.ID != "d1" {
+		t.Errorf("DefaultList again = %+v, %v, want d1", again, err)
+	}
+	for _, l := range []*List{
+		{ID: "l1", UserID: "alice", Name: "Tech"},
+		{ID: "l2", UserID: "alice", Name: "Energy"},
+		{ID: "l3", UserID: "alice", Name: "Banks"},
+		{ID: "b1", UserID: "bob", Name: "Tech"},
+	} {
+		l.CreatedAt, l.UpdatedAt = created, created
+		if _, err := repo.CreateList(ctx, l); err != nil {
+			t.Fatalf("CreateList %s: %v", l.ID, err)
+		}
+	}
+	if _, err := repo.CreateList(ctx, &List{ID: "l4", UserID: "alice", Name: "TECH", CreatedAt: created, UpdatedAt: created}); !errors.Is(err, ErrConflict) {
+		t.Errorf("CreateList duplicate name = %v, want ErrConflict", err)
+	}
+
+	t.Run("Lists", func(t *testing.T) {
+		lists, err := repo.Lists(ctx, "alice")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := names(lists); got != "Default,Tech,Energy,Banks" {
+			t.Errorf("Lists = %s", got)
+		}
+		for i, l := range lists {
+			if l.Position != i || l.Default != (l.ID == "d1") || !l.CreatedAt.Equal(created) {
+				t.Errorf("Lists[%d] = %+v", i, l)
+			}
+		}
+		if _, err := repo.GetList(ctx, "alice", "b1"); !errors.Is(err, ErrListNotFound) {
+			t.Errorf("GetList of another user = %v, want ErrListNotFound", err)
+		}
+	})
+
+	t.Run("Items", func(t *testing.T) {
+		if _, err := repo.Add(ctx, "l1", AnyVersion, &Item{Symbol: "AAPL", CreatedAt: created}); err != nil {
+			t.Fatalf("Add AAPL: %v", err)
+		}
+		if _, err := repo.Add(ctx, "l1", AnyVersion, &Item{Symbol: "MSFT", OnHand: true, CreatedAt: created.Add(time.Minute)}); err != nil {
+			t.Fatalf("Add MSFT: %v", err)
+		}
+		if _, err := repo.Add(ctx, "d1", AnyVersion, &Item{Symbol: "TSLA", CreatedAt: created}); err != nil {
+			t.Fatalf("Add TSLA: %v", err)
+		}
+		if _, err := repo.Add(ctx, "missing", AnyVersion, &Item{Symbol: "TSLA", CreatedAt: created}); !errors.Is(err, ErrListNotFound) {
+			t.Errorf("Add to missing list = %v, want ErrListNotFound", err)
+		}
+
+		// Re-adding keeps the original creation time and updates on_hand.
+		it, err := repo.Add(ctx, "l1", AnyVersion, &Item{Symbol: "AAPL", OnHand: true, CreatedAt: created.Add(time.Hour)})
+		if err != nil {
+			t.Fatalf("re-Add AAPL: %v", err)
+		}
+		if !it.OnHand || !it.CreatedAt.Equal(created) {
+			t.Errorf("re-Add AAPL = %+v, want on_hand=true created_at=%s", it, created)
+		}
+
+		items, err := repo.Items(ctx, "l1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := symbols(items); got != "AAPL,MSFT" {
+			t.Fatalf("Items = %s, want AAPL,MSFT", got)
+		}
+		if l, err := repo.GetList(ctx, "alice", "l1"); err != nil || l.ItemCount != 2 {
+			t.Errorf("GetList = %+v, %v, want 2 items", l, err)
+		}
+
+		if _, err := repo.Add(ctx, "l1", AnyVersion, &Item{Symbol: "NVDA", CreatedAt: created, UpdatedAt: created}); err != nil {
+			t.Fatalf("Add NVDA: %v", err)
+		}
+		if _, err := repo.Get(ctx, "l1", "TSLA"); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Get missing = %v, want ErrNotFound", err)
+		}
+
+		updated := created.Add(2 * time.Hour)
+		it, err = repo.Update(ctx, "l1", AnyVersion, &Item{
+			Symbol: "NVDA", Note: "earnings in May", Tags: []string{"ai", "semis"}, BuyTarget: 95.5,
+			Position: 0, CreatedAt: updated, UpdatedAt: updated,
+		})
+		if err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		if !it.CreatedAt.Equal(created) || !it.UpdatedAt.Equal(updated) || it.Position != 0 {
+			t.Errorf("Update = %+v, want created_at=%s updated_at=%s position 0", it, created, updated)
+		}
+		got, err := repo.Get(ctx, "l1", "NVDA")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Note != "earnings in May" || strings.Join(got.Tags, ",") != "ai,semis" || got.BuyTarget != 95.5 || got.SellTarget != 0 {
+			t.Errorf("Get after Update = %+v", got)
+		}
+		if _, err := repo.Update(ctx, "l1", AnyVersion, &Item{Symbol: "TSLA"}); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Update missing = %v, want ErrNotFound", err)
+		}
+		items, _ = repo.Items(ctx, "l1")
+		if symbols(items) != "NVDA,AAPL,MSFT" || items[2].Position != 2 {
+			t.Errorf("Items after move = %+v, want NVDA,AAPL,MSFT", items)
+		}
+		// Re-adding keeps the position.
+		if it, err := repo.Add(ctx, "l1", AnyVersion, &Item{Symbol: "MSFT", CreatedAt: updated}); err != nil || it.Position != 2 {
+			t.Errorf("re-Add MSFT = %+v, %v, want position 2", it, err)
+		}
+
+		added, err := repo.AddItems(ctx, "l2", AnyVersion, []*Item{
+			{Symbol: "XOM", CreatedAt: created, UpdatedAt: created},
+			{Symbol: "CVX", Tags: []string{"oil"}, CreatedAt: created, UpdatedAt: created},
+		})
+		if err != nil {
+			t.Fatalf("AddItems: %v", err)
+		}
+		if len(added) != 2 || added[1].Position != 1 || strings.Join(added[1].Tags, ",") != "oil" {
+			t.Errorf("AddItems = %+v", added)
+		}
+		if _, err := repo.AddItems(ctx, "missing", AnyVersion, []*Item{{Symbol: "XOM", CreatedAt: created}}); !errors.Is(err, ErrListNotFound) {
+			t.Errorf("AddItems to missing list = %v, want ErrListNotFound", err)
+		}
+		if items, _ := repo.Items(ctx, "l2"); symbols(items) != "XOM,CVX" {
+			t.Errorf("Items after AddItems = %s, want XOM,CVX", symbols(items))
+		}
+		if err := repo.RemoveItems(ctx, "l2", AnyVersion, []string{"XOM", "BP"}, created); !errors.Is(err, ErrNotFound) {
+			t.Errorf("RemoveItems with a missing item = %v, want ErrNotFound", err)
+		}
+		if items, _ := repo.Items(ctx, "l2"); symbols(items) != "XOM,CVX" {
+			t.Errorf("Items after failed RemoveItems = %s, want XOM,CVX", symbols(items))
+		}
+		if _, err := repo.Add(ctx, "l2", AnyVersion, &Item{Symbol: "BP", CreatedAt: created}); err != nil {
+			t.Fatalf("Add BP: %v", err)
+		}
+		if err := repo.RemoveItems(ctx, "l2", AnyVersion, []string{"XOM", "BP"}, created); err != nil {
+			t.Fatalf("RemoveItems: %v", err)
+		}
+		if items, _ := repo.Items(ctx, "l2"); symbols(items) != "CVX" || items[0].Position != 0 {
+			t.Errorf("Items after RemoveItems = %+v, want CVX at position 0", items)
+		}
+
+		if err := repo.Remove(ctx, "l1", AnyVersion, "AAPL", created); err != nil {
+			t.Fatalf("Remove: %v", err)
+		}
+		if err := repo.Remove(ctx, "l1", AnyVersion, "AAPL", created); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Remove missing = %v, want ErrNotFound", err)
+		}
+		items, _ = repo.Items(ctx, "l1")
+		if symbols(items) != "NVDA,MSFT" || items[1].Position != 1 {
+			t.Errorf("Items after remove = %+v, want NVDA,MSFT", items)
+		}
+	})
+
+	t.Run("Removed", func(t *testing.T) {
+		// AAPL was removed from position 1 of NVDA,AAPL,MSFT.
+		later := created.Add(time.Minute)
+		if err := repo.Remove(ctx, "l1", AnyVersion, "MSFT", later); err != nil {
+			t.Fatalf("Remove MSFT: %v", err)
+		}
+		removed, err := repo.Removed(ctx, "l1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if symbols(removed) != "MSFT,AAPL" || !removed[0].RemovedAt.Equal(later) || !removed[1].RemovedAt.Equal(created) {
+			t.Errorf("Removed = %+v, want MSFT,AAPL most recently removed first", removed)
+		}
+		if l, _ := repo.GetList(ctx, "alice", "l1"); l.ItemCount != 1 {
+			t.Errorf("ItemCount with removed items = %d, want 1", l.ItemCount)
+		}
+		if _, err := repo.Get(ctx, "l1", "MSFT"); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Get removed = %v, want ErrNotFound", err)
+		}
+
+		if _, err := repo.Restore(ctx, "l1", AnyVersion, "NVDA", later); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Restore listed item = %v, want ErrNotFound", err)
+		}
+		it, err := repo.Restore(ctx, "l1", AnyVersion, "AAPL", later)
+		if err != nil {
+			t.Fatalf("Restore: %v", err)
+		}
+		if it.Position != 1 || !it.RemovedAt.IsZero() || !it.CreatedAt.Equal(created) || !it.UpdatedAt.Equal(later) {
+			t.Errorf("Restore = %+v, want AAPL back at position 1", it)
+		}
+		// Re-adding a removed symbol lists it anew.
+		it, err = repo.Add(ctx, "l1", AnyVersion, &Item{Symbol: "MSFT", CreatedAt: later, UpdatedAt: later})
+		if err != nil {
+			t.Fatalf("re-Add MSFT: %v", err)
+		}
+		if it.Position != 2 || !it.CreatedAt.Equal(later) || !it.RemovedAt.IsZero() {
+			t.Errorf("re-Add removed MSFT = %+v, want a new item at position 2", it)
+		}
+		if removed, _ := repo.Removed(ctx, "l1"); len(removed) != 0 {
+			t.Errorf("Removed after restore and re-add = %s, want none", symbols(removed))
+		}
+		if items, _ := repo.Items(ctx, "l1"); symbols(items) != "NVDA,AAPL,MSFT" || items[2].Position != 2 {
+			t.Errorf("Items after restore = %+v, want NVDA,AAPL,MSFT", items)
+		}
+
+		// XOM and BP were removed from l2 at created.
+		if n, err := repo.Purge(ctx, created); err != nil || n != 0 {
+			t.Errorf("Purge before the removals = %d, %v, want 0", n, err)
+		}
+		if n, err := repo.Purge(ctx, later); err != nil || n != 2 {
+			t.Errorf("Purge = %d, %v, want 2", n, err)
+		}
+		if removed, _ := repo.Removed(ctx, "l2"); len(removed) != 0 {
+			t.Errorf("Removed after Purge = %s, want none", symbols(removed))
+		}
+		if _, err := repo.Restore(ctx, "l2", AnyVersion, "XOM", later); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Restore purged = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("Versions", func(t *testing.T) {
+		l, err := repo.GetList(ctx, "alice", "l3")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l.Version != 1 {
+			t.Fatalf("Version = %d, want 1", l.Version)
+		}
+		if _, err := repo.Add(ctx, "l3", 1, &Item{Symbol: "JPM", CreatedAt: created}); err != nil {
+			t.Fatalf("Add at version 1: %v", err)
+		}
+		if _, err := repo.Add(ctx, "l3", 1, &Item{Symbol: "BAC", CreatedAt: created}); !errors.Is(err, ErrVersionMismatch) {
+			t.Errorf("Add at an old version = %v, want ErrVersionMismatch", err)
+		}
+		if err := repo.Remove(ctx, "l3", 1, "JPM", created); !errors.Is(err, ErrVersionMismatch) {
+			t.Errorf("Remove at an old version = %v, want ErrVersionMismatch", err)
+		}
+		if _, err := repo.UpdateList(ctx, &List{ID: "l3", UserID: "alice", Name: "Banks", Position: 3, Version: 1}); !errors.Is(err, ErrVersionMismatch) {
+			t.Errorf("UpdateList at an old version = %v, want ErrVersionMismatch", err)
+		}
+		if err := repo.DeleteList(ctx, "alice", "l3", 1); !errors.Is(err, ErrVersionMismatch) {
+			t.Errorf("DeleteList at an old version = %v, want ErrVersionMismatch", err)
+		}
+		if l, _ := repo.GetList(ctx, "alice", "l3"); l.Version != 2 || l.ItemCount != 1 {
+			t.Errorf("GetList after rejected changes = %+v, want version 2 with 1 item", l)
+		}
+		// A failed change leaves the version unchanged.
+		if err := repo.Remove(ctx, "l3", 2, "BAC", created); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Remove missing = %v, want ErrNotFound", err)
+		}
+		if err := repo.Remove(ctx, "l3", 2, "JPM", created); err != nil {
+			t.Fatalf("Remove at version 2: %v", err)
+		}
+		if l, _ := repo.GetList(ctx, "alice", "l3"); l.Version != 3 {
+			t.Errorf("Version after Remove = %d, want 3", l.Version)
+		}
+	})
+
+	t.Run("UpdateList", func(t *testing.T) {
+		moved, err := repo.UpdateList(ctx, &List{ID: "l3", UserID: "alice", Name: "Financials", Position: 1, UpdatedAt: created.Add(time.Hour)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if moved.Name != "Financials" || moved.Position != 1 || !moved.UpdatedAt.Equal(created.Add(time.Hour)) || !moved.CreatedAt.Equal(created) {
+			t.Errorf("UpdateList = %+v", moved)
+		}
+		if lists, _ := repo.Lists(ctx, "alice"); names(lists) != "Default,Financials,Tech,Energy" {
+			t.Errorf("Lists after move up = %s", names(lists))
+		}
+		// Positions past the end move the list last.
+		if _, err := repo.UpdateList(ctx, &List{ID: "d1", UserID: "alice", Name: "Default", Position: 10, UpdatedAt: created}); err != nil {
+			t.Fatal(err)
+		}
+		lists, _ := repo.Lists(ctx, "alice")
+		if names(lists) != "Financials,Tech,Energy,Default" || lists[3].Position != 3 || !lists[3].Default {
+			t.Errorf("Lists after move down = %s", names(lists))
+		}
+
+		if _, err := repo.UpdateList(ctx, &List{ID: "l1", UserID: "alice", Name: "energy", Position: 1}); !errors.Is(err, ErrConflict) {
+			t.Errorf("UpdateList to a taken name = %v, want ErrConflict", err)
+		}
+		if _, err := repo.UpdateList(ctx, &List{ID: "l1", UserID: "alice", Name: "TECH", Position: 1, UpdatedAt: created}); err != nil {
+			t.Errorf("UpdateList changing case = %v", err)
+		}
+		if _, err := repo.UpdateList(ctx, &List{ID: "b1", UserID: "alice", Name: "Mine"}); !errors.Is(err, ErrListNotFound) {
+			t.Errorf("UpdateList of another user = %v, want ErrListNotFound", err)
+		}
+	})
+
+	t.Run("Shares", func(t *testing.T) {
+		before, _ := repo.GetList(ctx, "alice", "l2")
+		for _, sh := range []*Share{
+			{ListID: "l1", UserID: "bob", Role: RoleViewer, CreatedAt: created},
+			{ListID: "l2", UserID: "bob", Role: RoleViewer, CreatedAt: created},
+			{ListID: "l2", UserID: "carol", Role: RoleViewer, CreatedAt: created},
+		} {
+			if _, err := repo.PutShare(ctx, sh); err != nil {
+				t.Fatalf("PutShare %s with %s: %v", sh.ListID, sh.UserID, err)
+			}
+		}
+		// Sharing again changes the role only.
+		sh, err := repo.PutShare(ctx, &Share{ListID: "l2", UserID: "bob", Role: RoleEditor, CreatedAt: created.Add(time.Hour)})
+		if err != nil || sh.Role != RoleEditor || !sh.CreatedAt.Equal(created) {
+			t.Errorf("PutShare again = %+v, %v, want editor created at %v", sh, err, created)
+		}
+		if _, err := repo.PutShare(ctx, &Share{ListID: "missing", UserID: "bob", Role: RoleViewer, CreatedAt: created}); !errors.Is(err, ErrListNotFound) {
+			t.Errorf("PutShare of a missing list = %v, want ErrListNotFound", err)
+		}
+
+		shared, err := repo.SharedLists(ctx, "bob")
+		if err != nil || len(shared) != 2 || shared[0].ID != "l2" || shared[0].Role != RoleEditor || shared[1].ID != "l1" || shared[1].Role != RoleViewer {
+			t.Fatalf("SharedLists bob = %+v, %v, want Energy as editor and TECH as viewer", shared, err)
+		}
+		if shared[0].UserID != "alice" || shared[0].Name != "Energy" {
+			t.Errorf("SharedLists bob[0] = %+v, want Energy of alice", shared[0].List)
+		}
+		if sl, err := repo.GetSharedList(ctx, "carol", "l2"); err != nil || sl.Role != RoleViewer || sl.UserID != "alice" {
+			t.Errorf("GetSharedList carol = %+v, %v", sl, err)
+		}
+		if _, err := repo.GetSharedList(ctx, "carol", "l1"); !errors.Is(err, ErrListNotFound) {
+			t.Errorf("GetSharedList not shared = %v, want ErrListNotFound", err)
+		}
+		if _, err := repo.GetSharedList(ctx, "alice", "l1"); !errors.Is(err, ErrListNotFound) {
+			t.Errorf("GetSharedList of the owner = %v, want ErrListNotFound", err)
+		}
+
+		shares, err := repo.Shares(ctx, "l2")
+		if err != nil || len(shares) != 2 || shares[0].UserID != "bob" || shares[1].UserID != "carol" || shares[0].ListID != "l2" {
+			t.Errorf("Shares l2 = %+v, %v, want bob and carol", shares, err)
+		}
+		if err := repo.DeleteShare(ctx, "l2", "carol"); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.DeleteShare(ctx, "l2", "carol"); !errors.Is(err, ErrShareNotFound) {
+			t.Errorf("DeleteShare again = %v, want ErrShareNotFound", err)
+		}
+		if shared, _ := repo.SharedLists(ctx, "carol"); len(shared) != 0 {
+			t.Errorf("SharedLists carol after DeleteShare = %+v, want none", shared)
+		}
+		if l, _ := repo.GetList(ctx, "alice", "l2"); l.Version != before.Version {
+			t.Errorf("Version after sharing = %d, want %d", l.Version, before.Version)
+		}
+	})
+
+	t.Run("PublicToken", func(t *testing.T) {
+		l, err := repo.SetPublicToken(ctx, "l2", "token")
+		if err != nil || l.PublicToken != "token" || l.Name != "Energy" {
+			t.Fatalf("SetPublicToken = %+v, %v", l, err)
+		}
+		if l, err := repo.PublicList(ctx, "token"); err != nil || l.ID != "l2" || l.PublicToken != "token" {
+			t.Errorf("PublicList = %+v, %v, want l2", l, err)
+		}
+		if l, _ := repo.GetList(ctx, "alice", "l2"); l.PublicToken != "token" {
+			t.Errorf("GetList public token = %q", l.PublicToken)
+		}
+		if _, err := repo.PublicList(ctx, "other"); !errors.Is(err, ErrListNotFound) {
+			t.Errorf("PublicList unknown token = %v, want ErrListNotFound", err)
+		}
+		if l, err := repo.SetPublicToken(ctx, "l2", ""); err != nil || l.PublicToken != "" {
+			t.Errorf("SetPublicToken empty = %+v, %v", l, err)
+		}
+		for _, token := range []string{"token", ""} {
+			if _, err := repo.PublicList(ctx, token); !errors.Is(err, ErrListNotFound) {
+				t.Errorf("PublicList(%q) after unpublishing = %v, want ErrListNotFound", token, err)
+			}
+		}
+		if _, err := repo.SetPublicToken(ctx, "missing", "token"); !errors.Is(err, ErrListNotFound) {
+			t.Errorf("SetPublicToken of a missing list = %v, want ErrListNotFound", err)
+		}
+	})
+
+	t.Run("DeleteList", func(t *testing.T) {
+		if err := repo.DeleteList(ctx, "alice", "l1", AnyVersion); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.DeleteList(ctx, "alice", "l1", AnyVersion); !errors.Is(err, ErrListNotFound) {
+			t.Errorf("DeleteList missing = %v, want ErrListNotFound", err)
+		}
+		if err := repo.DeleteList(ctx, "alice", "b1", AnyVersion); !errors.Is(err, ErrListNotFound) {
+			t.Errorf("DeleteList of another user = %v, want ErrListNotFound", err)
+		}
+		lists, _ := repo.Lists(ctx, "alice")
+		if names(lists) != "Financials,Energy,Default" || lists[1].Position != 1 || lists[2].Position != 2 {
+			t.Errorf("Lists after delete = %+v", lists)
+		}
+		if items, err := repo.Items(ctx, "l1"); err != nil || len(items) != 0 {
+			t.Errorf("Items of deleted list = %v, %v, want none", items, err)
+		}
+		if lists, _ := repo.Lists(ctx, "bob"); names(lists) != "Tech" {
+			t.Errorf("Lists bob = %s", names(lists))
+		}
+		if shared, _ := repo.SharedLists(ctx, "bob"); len(shared) != 1 || shared[0].ID != "l2" {
+			t.Errorf("SharedLists bob after delete = %+v, want l2 only", shared)
+		}
+	})
+}
+
+func TestMemoryRepository(t *testing.T) {
+	testRepository(t, NewMemoryRepository())
+}
@@ -0,0 +1,657 @@
+package watchlist
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	watchlistGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/auth"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/eventbus"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+)
+
+// DefaultListID stands for the ID of the user's default watchlist in the
+// /watchlists routes.
+const DefaultListID = "default"
+
+// defaultListName is the name given to the default watchlist on creation.
+const defaultListName = "Default"
+
+// Service implements the generated watchlist service on top of a Repository.
+type Service struct {
+	repo    Repository
+	symbols *SymbolResolver
+	// retention is how long removed items can be restored.
+	retention time.Duration
+	// events carries the changes of the items to the event streams, under
+	// the ID of their user.
+	events *eventbus.Bus
+	now    func() time.Time
+	newID  func() string
+}
+
+var _ watchlistGen.Service = (*Service)(nil)
+
+// NewService returns the watchlist service implementation backed by repo,
+// normalizing and validating symbols with symbols. Removed items can be
+// restored for the retention window, DefaultRetention when not positive.
+// Changes of the items are published to events. Methods log through the
+// request logger carried by ctx (see logctx).
+func NewService(repo Repository, symbols *SymbolResolver, retention time.Duration, events *eventbus.Bus) *Service {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	return &Service{repo: repo, symbols: symbols, retention: retention, events: events, now: time.Now, newID: newID}
+}
+
+// List returns the items of the user's default watchlist.
+func (s *Service) List(ctx context.Context, p *watchlistGen.ListPayload) (*watchlistGen.TickerItemsResult, error) {
+	l, err := s.defaultList(ctx, callerID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return s.items(ctx, l, p.IfNoneMatch)
+}
+
+// Add stores a ticker in the user's default watchlist, normalized and
+// located by the symbol resolver.
+func (s *Service) Add(ctx context.Context, p *watchlistGen.AddPayload) (*watchlistGen.TickerItem, error) {
+	l, err := s.defaultList(ctx, callerID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return s.add(ctx, l, p.IfMatch, p.Symbol, itemChanges{
+		OnHand:     &p.OnHand,
+		Note:       p.Note,
+		Tags:       p.Tags,
+		BuyTarget:  p.BuyTarget,
+		SellTarget: p.SellTarget,
+	})
+}
+
+// Update changes the attributes of a ticker of the user's default watchlist
+// in place.
+func (s *Service) Update(ctx context.Context, p *watchlistGen.UpdatePayload) (*watchlistGen.TickerItem, error) {
+	l, err := s.defaultList(ctx, callerID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return s.update(ctx, l, p.IfMatch, p.Symbol, itemChanges{
+		OnHand:     p.OnHand,
+		Note:       p.Note,
+		Tags:       p.Tags,
+		BuyTarget:  p.BuyTarget,
+		SellTarget: p.SellTarget,
+		Position:   p.Position,
+	})
+}
+
+// Remove takes a ticker out of the user's default watchlist; it can be
+// restored until it is purged. Removing a symbol that is not in the list is
+// not an error.
+func (s *Service) Remove(ctx context.Context, p *watchlistGen.RemovePayload) error {
+	l, err := s.defaultList(ctx, callerID(ctx))
+	if err != nil {
+		return err
+	}
+	return s.remove(ctx, l, p.IfMatch, p.Symbol)
+}
+
+// ListWatchlists returns the user's watchlists, creating the default one on
+// first use.
+func (s *Service) ListWatchlists(ctx context.Context, p *watchlistGen.ListWatchlistsPayload) ([]*watchlistGen.Watchlist, error) {
+	user := callerID(ctx)
+	if _, err := s.defaultList(ctx, user); err != nil {
+		return nil, err
+	}
+	lists, err := s.repo.Lists(ctx, user)
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to list watchlists", "error", err)
+		return nil, err
+	}
+	res := make([]*watchlistGen.Watchlist, len(lists))
+	for i, l := range lists {
+		res[i] = toWatchlist(l, RoleOwner)
+	}
+	return res, nil
+}
+
+// CreateWatchlist adds an empty watchlist after the user's others. The
+// default watchlist is created first, so that it keeps the first position.
+func (s *Service) CreateWatchlist(ctx context.Context, p *watchlistGen.CreateWatchlistPayload) (*watchlistGen.Watchlist, error) {
+	user := callerID(ctx)
+	if _, err := s.defaultList(ctx, user); err != nil {
+		return nil, err
+	}
+	now := s.now()
+	l, err := s.repo.CreateList(ctx, &List{
+		ID:        s.newID(),
+		UserID:    user,
+		Name:      strings.TrimSpace(p.Name),
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if errors.Is(err, ErrConflict) {
+		return nil, watchlistGen.MakeConflict(fmt.Errorf("watchlist %q already exists", strings.TrimSpace(p.Name)))
+	}
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to create watchlist", "error", err)
+		return nil, err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist created", "watchlist", l.ID)
+	return toWatchlist(l, RoleOwner), nil
+}
+
+// GetWatchlist returns one of the user's watchlists, or one shared with
+// them.
+func (s *Service) GetWatchlist(ctx context.Context, p *watchlistGen.GetWatchlistPayload) (*watchlistGen.Watchlist, error) {
+	l, role, err := s.access(ctx, callerID(ctx), p.ID, RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	return toWatchlist(l, role), nil
+}
+
+// UpdateWatchlist renames or moves one of the user's watchlists.
+func (s *Service) UpdateWatchlist(ctx context.Context, p *watchlistGen.UpdateWatchlistPayload) (*watchlistGen.Watchlist, error) {
+	l, err := s.list(ctx, callerID(ctx), p.ID, RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if l.Version, err = ifMatch(l, p.IfMatch); err != nil {
+		return nil, err
+	}
+	if p.Name != nil {
+		l.Name = strings.TrimSpace(*p.Name)
+	}
+	if p.Position != nil {
+		l.Position = *p.Position
+	}
+	l.UpdatedAt = s.now()
+	res, err := s.repo.UpdateList(ctx, l)
+	switch {
+	case errors.Is(err, ErrListNotFound):
+		return nil, watchlistGen.MakeNotFound(fmt.Errorf("watchlist %q not found", p.ID))
+	case errors.Is(err, ErrConflict):
+		return nil, watchlistGen.MakeConflict(fmt.Errorf("watchlist %q already exists", l.Name))
+	case errors.Is(err, ErrVersionMismatch):
+		return nil, preconditionFailed(l)
+	case err != nil:
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to update watchlist", "watchlist", l.ID, "error", err)
+		return nil, err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist updated", "watchlist", res.ID, "position", res.Position)
+	return toWatchlist(res, RoleOwner), nil
+}
+
+// DeleteWatchlist removes one of the user's watchlists other than the
+// default one, with its items and shares.
+func (s *Service) DeleteWatchlist(ctx context.Context, p *watchlistGen.DeleteWatchlistPayload) error {
+	l, err := s.list(ctx, callerID(ctx), p.ID, RoleOwner)
+	if err != nil {
+		return err
+	}
+	if l.Default {
+		return watchlistGen.MakeConflict(errors.New("the default watchlist cannot be deleted"))
+	}
+	version, err := ifMatch(l, p.IfMatch)
+	if err != nil {
+		return err
+	}
+	err = s.repo.DeleteList(ctx, callerID(ctx), l.ID, version)
+	switch {
+	case errors.Is(err, ErrListNotFound):
+		return watchlistGen.MakeNotFound(fmt.Errorf("watchlist %q not found", p.ID))
+	case errors.Is(err, ErrVersionMismatch):
+		return preconditionFailed(l)
+	case err != nil:
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to delete watchlist", "watchlist", l.ID, "error", err)
+		return err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist deleted", "watchlist", l.ID)
+	return nil
+}
+
+// ListItems returns the items of one of the user's watchlists, or of one
+// shared with them.
+func (s *Service) ListItems(ctx context.Context, p *watchlistGen.ListItemsPayload) (*watchlistGen.TickerItemsResult, error) {
+	l, err := s.list(ctx, callerID(ctx), p.ID, RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	return s.items(ctx, l, p.IfNoneMatch)
+}
+
+// AddItem stores a ticker in one of the user's watchlists, or in one shared
+// with them as an editor, as Add does.
+func (s *Service) AddItem(ctx context.Context, p *watchlistGen.AddItemPayload) (*watchlistGen.TickerItem, error) {
+	l, err := s.list(ctx, callerID(ctx), p.ID, RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	return s.add(ctx, l, p.IfMatch, p.Symbol, itemChanges{
+		OnHand:     &p.OnHand,
+		Note:       p.Note,
+		Tags:       p.Tags,
+		BuyTarget:  p.BuyTarget,
+		SellTarget: p.SellTarget,
+	})
+}
+
+// UpdateItem changes the attributes of a ticker of one of the user's
+// watchlists, or of one shared with them as an editor, in place, as Update
+// does.
+func (s *Service) UpdateItem(ctx context.Context, p *watchlistGen.UpdateItemPayload) (*watchlistGen.TickerItem, error) {
+	l, err := s.list(ctx, callerID(ctx), p.ID, RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	return s.update(ctx, l, p.IfMatch, p.Symbol, itemChanges{
+		OnHand:     p.OnHand,
+		Note:       p.Note,
+		Tags:       p.Tags,
+		BuyTarget:  p.BuyTarget,
+		SellTarget: p.SellTarget,
+		Position:   p.Position,
+	})
+}
+
+// RemoveItem removes a ticker from one of the user's watchlists, or from
+// one shared with them as an editor, as Remove does.
+func (s *Service) RemoveItem(ctx context.Context, p *watchlistGen.RemoveItemPayload) error {
+	l, err := s.list(ctx, callerID(ctx), p.ID, RoleEditor)
+	if err != nil {
+		return err
+	}
+	return s.remove(ctx, l, p.IfMatch, p.Symbol)
+}
+
+// callerID returns the ID of the user the request acts for: the subject of
+// the bearer token authenticated by the JWT security of the endpoints.
+func callerID(ctx context.Context) string {
+	if c, ok := auth.ClaimsFromContext(ctx); ok {
+		return c.Subject
+	}
+	return ""
+}
+
+// defaultList returns the user's default watchlist, creating it on first
+// use.
+func (s *Service) defaultList(ctx context.Context, userID string) (*List, error) {
+	now := s.now()
+	l, err := s.repo.DefaultList(ctx, &List{
+		ID:        s.newID(),
+		UserID:    userID,
+		Name:      defaultListName,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to get default watchlist", "error", err)
+		return nil, err
+	}
+	return l, nil
+}
+
+// list returns the watchlist with the given ID or DefaultListID that the
+// user may act on as need: one of theirs, or one shared with them with a
+// role allowing it. Watchlists neither theirs nor shared with them are not
+// found; a role not allowing need is forbidden.
+func (s *Service) list(ctx context.Context, userID, id string, need Role) (*List, error) {
+	l, _, err := s.access(ctx, userID, id, need)
+	return l, err
+}
+
+// access returns the watchlist as list does, with the role of the user.
+// Ownership and shares are looked up in the repository, so that the user
+// ID of a request grants no access by itself.
+func (s *Service) access(ctx context.Context, userID, id string, need Role) (*List, Role, error) {
+	if id == DefaultListID {
+		l, err := s.defaultList(ctx, userID)
+		return l, RoleOwner, err
+	}
+	l, err := s.repo.GetList(ctx, userID, id)
+	if err == nil {
+		return l, RoleOwner, nil
+	}
+	if !errors.Is(err, ErrListNotFound) {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to get watchlist", "watchlist", id, "error", err)
+		return nil, "", err
+	}
+	sl, err := s.repo.GetSharedList(ctx, userID, id)
+	if errors.Is(err, ErrListNotFound) {
+		return nil, "", watchlistGen.MakeNotFound(fmt.Errorf("watchlist %q not found", id))
+	}
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to get shared watchlist", "watchlist", id, "error", err)
+		return nil, "", err
+	}
+	if !sl.Role.allows(need) {
+		logctx.LoggerFromContext(ctx).DebugContext(ctx, "watchlist access denied", "watchlist", id, "role", sl.Role, "need", need)
+		return nil, "", watchlistGen.MakeForbidden(fmt.Errorf("watchlist %q is shared with you as %s", id, sl.Role))
+	}
+	return sl.List, sl.Role, nil
+}
+
+// listOrDefault returns the watchlist with the given ID as list does, or
+// the user's default watchlist when id is nil.
+func (s *Service) listOrDefault(ctx context.Context, userID string, id *string, need Role) (*List, error) {
+	if id == nil {
+		return s.defaultList(ctx, userID)
+	}
+	return s.list(ctx, userID, *id, need)
+}
+
+// items returns the items of l and its ETag, or only the ETag with the
+// not_modified outcome when ifNoneMatch names it.
+func (s *Service) items(ctx context.Context, l *List, ifNoneMatch *string) (*watchlistGen.TickerItemsResult, error) {
+	res := &watchlistGen.TickerItemsResult{Etag: etag(l)}
+	if noneMatch(l, ifNoneMatch) {
+		outcome := "not_modified"
+		res.Outcome = &outcome
+		return res, nil
+	}
+	// The items are read after l, so that they are at least as recent as
+	// the ETag.
+	items, err := s.repo.Items(ctx, l.ID)
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to list watchlist", "watchlist", l.ID, "error", err)
+		return nil, err
+	}
+	res.Items = make([]*watchlistGen.TickerItem, len(items))
+	for i, it := range items {
+		res.Items[i] = toTickerItem(it)
+	}
+	return res, nil
+}
+
+// add stores a ticker in l, or applies c to the item of the same symbol,
+// when the If-Match header names the current version of l.
+func (s *Service) add(ctx context.Context, l *List, ifMatchHeader *string, symbol string, c itemChanges) (*watchlistGen.TickerItem, error) {
+	version, err := ifMatch(l, ifMatchHeader)
+	if err != nil {
+		return nil, err
+	}
+	it, existing, err := s.prepare(ctx, l, symbol, c, s.now())
+	if errors.Is(err, ErrInvalidSymbol) {
+		logctx.LoggerFromContext(ctx).DebugContext(ctx, "watchlist symbol rejected", "symbol", symbol, "error", err)
+		return nil, watchlistGen.MakeInvalidSymbol(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.repo.Add(ctx, l.ID, version, it)
+	switch {
+	case errors.Is(err, ErrListNotFound):
+		return nil, watchlistGen.MakeNotFound(fmt.Errorf("watchlist %q not found", l.ID))
+	case errors.Is(err, ErrVersionMismatch):
+		return nil, preconditionFailed(l)
+	case err != nil:
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to add watchlist item", "watchlist", l.ID, "symbol", it.Symbol, "error", err)
+		return nil, err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist item added", "watchlist", l.ID, "symbol", res.Symbol, "exchange_mic", res.ExchangeMIC)
+	if existing == nil {
+		s.publish(l, eventAdded, res.Symbol, res, res.UpdatedAt)
+	} else {
+		s.publish(l, eventUpdated, res.Symbol, res, res.UpdatedAt)
+	}
+	return toTickerItem(res), nil
+}
+
+// prepare resolves symbol and returns the item of l it refers to with c
+// applied, updated at now, and the stored item, nil when l does not have it
+// yet. The error wraps ErrInvalidSymbol when symbol is rejected.
+func (s *Service) prepare(ctx context.Context, l *List, symbol string, c itemChanges, now time.Time) (it, existing *Item, err error) {
+	listing, err := s.symbols.Resolve(ctx, symbol)
+	if errors.Is(err, ErrInvalidSymbol) {
+		return nil, nil, err
+	}
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to resolve watchlist symbol", "symbol", symbol, "error", err)
+		return nil, nil, err
+	}
+	existing, err = s.repo.Get(ctx, l.ID, listing.Symbol)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		existing = nil
+		it = &Item{Symbol: listing.Symbol, CreatedAt: now}
+	case err != nil:
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to get watchlist item", "watchlist", l.ID, "symbol", listing.Symbol, "error", err)
+		return nil, nil, err
+	default:
+		it = copyItem(existing)
+	}
+	c.apply(it)
+	it.ExchangeMIC = listing.ExchangeMIC
+	it.InstrumentID = listing.InstrumentID
+	it.UpdatedAt = now
+	return it, existing, nil
+}
+
+// update applies c to the item of l with the given symbol, when the
+// If-Match header names the current version of l.
+func (s *Service) update(ctx context.Context, l *List, ifMatchHeader *string, symbol string, c itemChanges) (*watchlistGen.TickerItem, error) {
+	version, err := ifMatch(l, ifMatchHeader)
+	if err != nil {
+		return nil, err
+	}
+	symbol = s.symbols.Key(symbol)
+	notFound := watchlistGen.MakeNotFound(fmt.Errorf("%s is not in the watchlist", symbol))
+	it, err := s.repo.Get(ctx, l.ID, symbol)
+	if errors.Is(err, ErrNotFound) {
+		return nil, notFound
+	}
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to get watchlist item", "watchlist", l.ID, "symbol", symbol, "error", err)
+		return nil, err
+	}
+	c.apply(it)
+	it.UpdatedAt = s.now()
+
+	res, err := s.repo.Update(ctx, l.ID, version, it)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, notFound
+	case errors.Is(err, ErrVersionMismatch):
+		return nil, preconditionFailed(l)
+	case err != nil:
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to update watchlist item", "watchlist", l.ID, "symbol", symbol, "error", err)
+		return nil, err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist item updated", "watchlist", l.ID, "symbol", symbol, "position", res.Position)
+	s.publish(l, eventUpdated, symbol, res, res.UpdatedAt)
+	return toTickerItem(res), nil
+}
+
+// remove deletes the item of l with the given symbol, when the If-Match
+// header names the current version of l.
+func (s *Service) remove(ctx context.Context, l *List, ifMatchHeader *string, symbol string) error {
+	version, err := ifMatch(l, ifMatchHeader)
+	if err != nil {
+		return err
+	}
+	symbol = s.symbols.Key(symbol)
+	now := s.now()
+	err = s.repo.Remove(ctx, l.ID, version, symbol, now)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		logctx.LoggerFromContext(ctx).DebugContext(ctx, "watchlist item not found", "watchlist", l.ID, "symbol", symbol)
+		return nil
+	case errors.Is(err, ErrVersionMismatch):
+		return preconditionFailed(l)
+	case err != nil:
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to remove watchlist item", "watchlist", l.ID, "symbol", symbol, "error", err)
+		return err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist item removed", "watchlist", l.ID, "symbol", symbol)
+	s.publish(l, eventRemoved, symbol, nil, now)
+	return nil
+}
+
+// Check implements health.Checker. The service is ready when its storage
+// is reachable.
+func (s *Service) Check(ctx context.Context) error {
+	if err := s.repo.Ping(ctx); err != nil {
+		return fmt.Errorf("storage unavailable: %w", err)
+	}
+	return nil
+}
+
+// itemChanges are the item attributes given by an add or update payload,
+// nil when omitted.
+type itemChanges struct {
+	OnHand     *bool
+	Note       *string
+	Tags       []string
+	BuyTarget  *float64
+	SellTarget *float64
+	Position   *int
+}
+
+// apply sets the attributes of it given by c. Tags are trimmed and
+// deduplicated regardless of case, keeping their first spelling.
+func (c itemChanges) apply(it *Item) {
+	if c.OnHand != nil {
+		it.OnHand = *c.OnHand
+	}
+	if c.Note != nil {
+		it.Note = strings.TrimSpace(*c.Note)
+	}
+	if c.Tags != nil {
+		it.Tags = make([]string, 0, len(c.Tags))
+		seen := make(map[string]bool, len(c.Tags))
+		for _, tag := range c.Tags {
+			tag = strings.TrimSpace(tag)
+			if key := strings.ToLower(tag); tag != "" && !seen[key] {
+				seen[key] = true
+				it.Tags = append(it.Tags, tag)
+			}
+		}
+	}
+	if c.BuyTarget != nil {
+		it.BuyTarget = *c.BuyTarget
+	}
+	if c.SellTarget != nil {
+		it.SellTarget = *c.SellTarget
+	}
+	if c.Position != nil {
+		it.Position = *c.Position
+	}
+}
+
+// etag returns the ETag of the current version of l. It includes the ID of
+// l, so that an ETag of one watchlist never matches another.
+func etag(l *List) string {
+	return fmt.Sprintf(`"%s-%d"`, l.ID, l.Version)
+}
+
+// ifMatch returns the version of l a change is based on, given its If-Match
+// header: the current one when the header names its ETag, AnyVersion
+// without a header or with "*". It returns a precondition_failed error when
+// the header names other ETags only.
+func ifMatch(l *List, header *string) (int64, error) {
+	if header == nil {
+		return AnyVersion, nil
+	}
+	tag := etag(l)
+	for _, t := range strings.Split(*header, ",") {
+		switch strings.TrimSpace(t) {
+		case "*":
+			return AnyVersion, nil
+		case tag:
+			return l.Version, nil
+		}
+	}
+	return 0, preconditionFailed(l)
+}
+
+// noneMatch reports whether an If-None-Match header names the ETag of the
+// current version of l, or is "*". Weak ETags match too.
+func noneMatch(l *List, header *string) bool {
+	if header == nil {
+		return false
+	}
+	tag := etag(l)
+	for _, t := range strings.Split(*header, ",") {
+		t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+		if t == "*" || t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func preconditionFailed(l *List) error {
+	return watchlistGen.MakePreconditionFailed(fmt.Errorf("watchlist %q has changed; reload it and retry", l.ID))
+}
+
+func toTickerItem(it *Item) *watchlistGen.TickerItem {
+	createdAt := it.CreatedAt.UTC().Format(time.RFC3339)
+	updatedAt := it.UpdatedAt.UTC().Format(time.RFC3339)
+	res := &watchlistGen.TickerItem{
+		Symbol:       it.Symbol,
+		OnHand:       it.OnHand,
+		CreatedAt:    &createdAt,
+		UpdatedAt:    &updatedAt,
+		ExchangeMic:  optional(it.ExchangeMIC),
+		InstrumentID: optional(it.InstrumentID),
+		Note:         optional(it.Note),
+		Tags:         it.Tags,
+		Position:     it.Position,
+	}
+	if it.BuyTarget != 0 {
+		res.BuyTarget = &it.BuyTarget
+	}
+	if it.SellTarget != 0 {
+		res.SellTarget = &it.SellTarget
+	}
+	return res
+}
+
+// toWatchlist returns l as seen by a user with the given role. Only the
+// owner sees its public link.
+func toWatchlist(l *List, role Role) *watchlistGen.Watchlist {
+	res := &watchlistGen.Watchlist{
+		ID:        l.ID,
+		Name:      l.Name,
+		Position:  l.Position,
+		Default:   l.Default,
+		ItemCount: l.ItemCount,
+		CreatedAt: l.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt: l.UpdatedAt.UTC().Format(time.RFC3339),
+		Owner:     l.UserID,
+		Role:      string(role),
+	}
+	if role == RoleOwner {
+		res.PublicToken = optional(l.PublicToken)
+	}
+	return res
+}
+
+// newID returns a random watchlist ID of 16 hexadecimal digits, the format
+// the SQLite migration gives existing lists.
+func newID() string {
+	var b [8]byte
+	rand.Read(b[:]) //nolint:errcheck // never fails since Go 1.24
+	return hex.EncodeToString(b[:])
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func value(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
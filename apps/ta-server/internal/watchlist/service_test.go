@@ -0,0 +1,763 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	watchlistGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/auth"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/eventbus"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// asUser returns a copy of ctx authenticated as user, as by JWTAuth.
+func asUser(ctx context.Context, user string) context.Context {
+	return auth.ContextWithClaims(ctx, &auth.Claims{Subject: user})
+}
+
+func errorName(err error) string {
+	var se *goa.ServiceError
+	if errors.As(err, &se) {
+		return se.Name
+	}
+	return ""
+}
+
+func TestServiceWatchlists(t *testing.T) {
+	ctx := context.Background()
+	symbols, err := NewSymbolResolver(Config{}, testListings, testMICs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(NewMemoryRepository(), symbols, DefaultRetention, eventbus.New(0))
+
+	// The /watchlist routes serve the default watchlist, created on first use.
+	if _, err := svc.Add(asUser(ctx, "alice"), &watchlistGen.AddPayload{Symbol: "AAPL"}); err != nil {
+		t.Fatal(err)
+	}
+	lists, err := svc.ListWatchlists(asUser(ctx, "alice"), &watchlistGen.ListWatchlistsPayload{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lists) != 1 || !lists[0].Default || lists[0].Name != "Default" || lists[0].ItemCount != 1 {
+		t.Fatalf("ListWatchlists = %+v, want the default watchlist", lists)
+	}
+	def := lists[0]
+
+	tech, err := svc.CreateWatchlist(asUser(ctx, "alice"), &watchlistGen.CreateWatchlistPayload{Name: " Tech "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tech.Name != "Tech" || tech.Position != 1 || tech.Default {
+		t.Errorf("CreateWatchlist = %+v", tech)
+	}
+	if _, err := svc.CreateWatchlist(asUser(ctx, "alice"), &watchlistGen.CreateWatchlistPayload{Name: "tech"}); errorName(err) != "conflict" {
+		t.Errorf("CreateWatchlist duplicate = %v, want conflict", err)
+	}
+
+	if _, err := svc.AddItem(asUser(ctx, "alice"), &watchlistGen.AddItemPayload{ID: tech.ID, Symbol: "vod.xlon"}); err != nil {
+		t.Fatal(err)
+	}
+	res, err := svc.ListItems(asUser(ctx, "alice"), &watchlistGen.ListItemsPayload{ID: tech.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := res.Items
+	if len(items) != 1 || items[0].Symbol != "VOD" {
+		t.Errorf("ListItems = %+v, want VOD", items)
+	}
+	// "default" names the default watchlist.
+	res, err = svc.ListItems(asUser(ctx, "alice"), &watchlistGen.ListItemsPayload{ID: DefaultListID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	items = res.Items
+	if len(items) != 1 || items[0].Symbol != "AAPL" {
+		t.Errorf("ListItems default = %+v, want AAPL", items)
+	}
+
+	if _, err := svc.ListItems(asUser(ctx, "bob"), &watchlistGen.ListItemsPayload{ID: tech.ID}); errorName(err) != "not_found" {
+		t.Errorf("ListItems of another user = %v, want not_found", err)
+	}
+	if err := svc.DeleteWatchlist(asUser(ctx, "alice"), &watchlistGen.DeleteWatchlistPayload{ID: def.ID}); errorName(err) != "conflict" {
+		t.Errorf("DeleteWatchlist default = %v, want conflict", err)
+	}
+
+	first := 0
+	moved, err := svc.UpdateWatchlist(asUser(ctx, "alice"), &watchlistGen.UpdateWatchlistPayload{ID: tech.ID, Position: &first})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved.Name != "Tech" || moved.Position != 0 {
+		t.Errorf("UpdateWatchlist = %+v, want Tech first", moved)
+	}
+
+	if err := svc.DeleteWatchlist(asUser(ctx, "alice"), &watchlistGen.DeleteWatchlistPayload{ID: tech.ID}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := svc.GetWatchlist(asUser(ctx, "alice"), &watchlistGen.GetWatchlistPayload{ID: DefaultListID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != def.ID || got.Position != 0 {
+		t.Errorf("GetWatchlist default = %+v, want %s at position 0", got, def.ID)
+	}
+}
+
+func TestServiceUpdateItem(t *testing.T) {
+	ctx := context.Background()
+	symbols, err := NewSymbolResolver(Config{}, testListings, testMICs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(NewMemoryRepository(), symbols, DefaultRetention, eventbus.New(0))
+	now := created
+	svc.now = func() time.Time { return now }
+
+	note, target := "long term", 180.0
+	for _, symbol := range []string{"AAPL", "TSLA"} {
+		if _, err := svc.Add(asUser(ctx, "alice"), &watchlistGen.AddPayload{Symbol: symbol, Note: &note, Tags: []string{" Tech ", "tech", "growth"}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	now = created.Add(time.Hour)
+	first := 0
+	it, err := svc.Update(asUser(ctx, "alice"), &watchlistGen.UpdatePayload{Symbol: "tsla", SellTarget: &target, Position: &first})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *it.Note != note || strings.Join(it.Tags, ",") != "Tech,growth" || *it.SellTarget != target || it.BuyTarget != nil || it.Position != 0 {
+		t.Errorf("Update = %+v", it)
+	}
+	if *it.CreatedAt != "2025-01-02T03:04:05Z" || *it.UpdatedAt != "2025-01-02T04:04:05Z" {
+		t.Errorf("Update times = %s, %s, want creation time kept", *it.CreatedAt, *it.UpdatedAt)
+	}
+
+	// Re-adding keeps the position, and empty values clear attributes.
+	empty, zero := "", 0.0
+	it, err = svc.Add(asUser(ctx, "alice"), &watchlistGen.AddPayload{Symbol: "TSLA", OnHand: true, Note: &empty, Tags: []string{}, SellTarget: &zero})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !it.OnHand || it.Note != nil || len(it.Tags) > 0 || it.SellTarget != nil || it.Position != 0 {
+		t.Errorf("re-Add = %+v", it)
+	}
+
+	res, err := svc.List(asUser(ctx, "alice"), &watchlistGen.ListPayload{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := res.Items
+	if len(items) != 2 || items[0].Symbol != "TSLA" || items[1].Symbol != "AAPL" || items[1].Position != 1 {
+		t.Errorf("List = %+v, want TSLA, AAPL", items)
+	}
+
+	if _, err := svc.Update(asUser(ctx, "alice"), &watchlistGen.UpdatePayload{Symbol: "MSFT"}); errorName(err) != "not_found" {
+		t.Errorf("Update missing = %v, want not_found", err)
+	}
+}
+
+func TestServiceImportExport(t *testing.T) {
+	ctx := context.Background()
+	symbols, err := NewSymbolResolver(Config{}, testListings, testMICs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(NewMemoryRepository(), symbols, DefaultRetention, eventbus.New(0))
+	importFile := func(p *watchlistGen.ImportPayload, data string) *watchlistGen.WatchlistImportReport {
+		t.Helper()
+		report, err := svc.Import(asUser(ctx, "alice"), p, io.NopCloser(strings.NewReader(data)))
+		if err != nil {
+			t.Fatalf("Import: %v", err)
+		}
+		return report
+	}
+
+	// Columns that are not item attributes, as "name" here, are ignored.
+	file := "Symbol,Name,Exchange_MIC,On_Hand,Tags,Buy_Target\n" +
+		"aapl,Apple,,yes,tech;core,150\n" +
+		"VOD,Vodafone,XLON,no,,\n" +
+		"TSLA,Tesla,,maybe,,\n" +
+		"XNAS:AAPL,Apple,,,,\n"
+	report := importFile(&watchlistGen.ImportPayload{}, file)
+	if report.Applied || report.Rows != 4 || len(report.Errors) != 2 ||
+		report.Errors[0].Line != 4 || report.Errors[1].Line != 5 || !strings.Contains(report.Errors[1].Error, "line 2") {
+		t.Fatalf("Import invalid = %+v %v", report, report.Errors)
+	}
+	if res, _ := svc.List(asUser(ctx, "alice"), &watchlistGen.ListPayload{}); len(res.Items) != 0 {
+		t.Fatalf("List after invalid import = %+v, want empty", res.Items)
+	}
+
+	file = strings.Join(strings.Split(file, "\n")[:3], "\n")
+	report = importFile(&watchlistGen.ImportPayload{DryRun: true}, file)
+	if report.Applied || report.Added != 2 || len(report.Errors) != 0 {
+		t.Errorf("Import dry run = %+v", report)
+	}
+	report = importFile(&watchlistGen.ImportPayload{}, file)
+	if !report.Applied || report.Added != 2 {
+		t.Errorf("Import = %+v", report)
+	}
+	report = importFile(&watchlistGen.ImportPayload{}, file)
+	if !report.Applied || report.Unchanged != 2 {
+		t.Errorf("Import again = %+v", report)
+	}
+
+	// An exported file imports into another watchlist as is.
+	for _, format := range []string{FormatCSV, FormatJSON} {
+		res, body, err := svc.Export(asUser(ctx, "alice"), &watchlistGen.ExportPayload{Format: format})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(res.ContentDisposition, `filename="Default.`+format+`"`) {
+			t.Errorf("Export %s disposition = %s", format, res.ContentDisposition)
+		}
+		data, _ := io.ReadAll(body)
+
+		l, err := svc.CreateWatchlist(asUser(ctx, "alice"), &watchlistGen.CreateWatchlistPayload{Name: "From " + format})
+		if err != nil {
+			t.Fatal(err)
+		}
+		report := importFile(&watchlistGen.ImportPayload{Watchlist: &l.ID}, string(data))
+		if !report.Applied || report.Added != 2 {
+			t.Fatalf("Import %s export = %+v %v\n%s", format, report, report.Errors, data)
+		}
+		list, err := svc.ListItems(asUser(ctx, "alice"), &watchlistGen.ListItemsPayload{ID: l.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		items := list.Items
+		if len(items) != 2 || items[0].Symbol != "AAPL" || !items[0].OnHand || strings.Join(items[0].Tags, ",") != "tech,core" ||
+			*items[0].BuyTarget != 150 || items[1].Symbol != "VOD" || *items[1].ExchangeMic != "XLON" {
+			t.Errorf("ListItems after %s import = %+v, %+v", format, items[0], items[1])
+		}
+	}
+
+	if _, err := svc.Import(asUser(ctx, "alice"), &watchlistGen.ImportPayload{}, io.NopCloser(strings.NewReader(`{"symbol": "AAPL"}`))); errorName(err) != "invalid_file" {
+		t.Errorf("Import JSON object = %v, want invalid_file", err)
+	}
+	format := FormatJSON
+	report = importFile(&watchlistGen.ImportPayload{Format: &format}, "[\n  {\"symbol\": \"AAPL\"},\n  {\"symbol\": \"MSFT\", \"on_hand\": \"yes\"}\n]")
+	if len(report.Errors) != 1 || report.Errors[0].Line != 3 {
+		t.Errorf("Import JSON = %+v %v, want an error on line 3", report, report.Errors)
+	}
+}
+
+func TestServiceBulk(t *testing.T) {
+	ctx := context.Background()
+	symbols, err := NewSymbolResolver(Config{}, testListings, testMICs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(NewMemoryRepository(), symbols, DefaultRetention, eventbus.New(0))
+	statuses := func(res *watchlistGen.WatchlistBulkResult) string {
+		s := make([]string, len(res.Results))
+		for i, r := range res.Results {
+			s[i] = r.Status
+		}
+		return strings.Join(s, ",")
+	}
+	listed := func() string {
+		res, err := svc.List(asUser(ctx, "alice"), &watchlistGen.ListPayload{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		items := res.Items
+		s := make([]string, len(items))
+		for i, it := range items {
+			s[i] = it.Symbol
+		}
+		return strings.Join(s, ",")
+	}
+	if _, err := svc.Add(asUser(ctx, "alice"), &watchlistGen.AddPayload{Symbol: "AAPL"}); err != nil {
+		t.Fatal(err)
+	}
+
+	onHand := true
+	items := []*watchlistGen.BulkAddItem{
+		{Symbol: "aapl", OnHand: &onHand},
+		{Symbol: "VOD.XLON"},
+		{Symbol: "VOD.XXXX"},
+		{Symbol: "AAPL"},
+	}
+	res, err := svc.BulkAdd(asUser(ctx, "alice"), &watchlistGen.BulkAddPayload{Mode: BulkAllOrNothing, Items: items})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statuses(res) != "skipped,skipped,invalid,invalid" || res.Succeeded != 0 || res.Failed != 2 || res.Results[3].Error == nil {
+		t.Errorf("BulkAdd all_or_nothing = %s %+v", statuses(res), res)
+	}
+	if msg := res.Results[3].Error; msg == nil || *msg != "duplicate symbol in request: AAPL" {
+		t.Errorf("BulkAdd duplicate error = %v, want duplicate symbol in request: AAPL", msg)
+	}
+	if got := listed(); got != "AAPL" {
+		t.Errorf("List after rejected BulkAdd = %s, want AAPL", got)
+	}
+
+	res, err = svc.BulkAdd(asUser(ctx, "alice"), &watchlistGen.BulkAddPayload{Mode: BulkBestEffort, Items: items})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statuses(res) != "updated,added,invalid,invalid" || res.Succeeded != 2 || res.Failed != 2 ||
+		!res.Results[0].Item.OnHand || res.Results[1].Item.Position != 1 || res.Results[2].Item != nil {
+		t.Errorf("BulkAdd best_effort = %s %+v", statuses(res), res)
+	}
+	if got := listed(); got != "AAPL,VOD" {
+		t.Errorf("List after BulkAdd = %s, want AAPL,VOD", got)
+	}
+
+	remove := []string{"vod", "TSLA"}
+	res, err = svc.BulkRemove(asUser(ctx, "alice"), &watchlistGen.BulkRemovePayload{Mode: BulkAllOrNothing, Symbols: remove})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statuses(res) != "skipped,not_found" || listed() != "AAPL,VOD" {
+		t.Errorf("BulkRemove all_or_nothing = %s, left %s", statuses(res), listed())
+	}
+	res, err = svc.BulkRemove(asUser(ctx, "alice"), &watchlistGen.BulkRemovePayload{Mode: BulkBestEffort, Symbols: remove})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statuses(res) != "removed,not_found" || res.Succeeded != 1 || listed() != "AAPL" {
+		t.Errorf("BulkRemove best_effort = %s, left %s", statuses(res), listed())
+	}
+
+	other := "missing"
+	if _, err := svc.BulkRemove(asUser(ctx, "alice"), &watchlistGen.BulkRemovePayload{Watchlist: &other, Mode: BulkBestEffort, Symbols: remove}); errorName(err) != "not_found" {
+		t.Errorf("BulkRemove from a missing watchlist = %v, want not_found", err)
+	}
+
+	res, err = svc.BulkRemove(asUser(ctx, "alice"), &watchlistGen.BulkRemovePayload{Mode: BulkBestEffort, Symbols: []string{"aapl", "AAPL"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg := res.Results[1].Error; statuses(res) != "removed,invalid" || msg == nil || *msg != "duplicate symbol in request: AAPL" {
+		t.Errorf("BulkRemove duplicate = %s %v", statuses(res), msg)
+	}
+}
+
+func TestServiceETags(t *testing.T) {
+	ctx := context.Background()
+	symbols, err := NewSymbolResolver(Config{}, testListings, testMICs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(NewMemoryRepository(), symbols, DefaultRetention, eventbus.New(0))
+
+	res, err := svc.List(asUser(ctx, "alice"), &watchlistGen.ListPayload{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v1 := res.Etag
+	if res.Outcome != nil || res.Items == nil {
+		t.Errorf("List = %+v, want the items", res)
+	}
+	for _, header := range []string{v1, `"other", W/` + v1, "*"} {
+		res, err := svc.List(asUser(ctx, "alice"), &watchlistGen.ListPayload{IfNoneMatch: &header})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Outcome == nil || *res.Outcome != "not_modified" || res.Items != nil || res.Etag != v1 {
+			t.Errorf("List If-None-Match %s = %+v, want not_modified", header, res)
+		}
+	}
+
+	if _, err := svc.Add(asUser(ctx, "alice"), &watchlistGen.AddPayload{Symbol: "AAPL", IfMatch: &v1}); err != nil {
+		t.Fatalf("Add If-Match current: %v", err)
+	}
+	res, _ = svc.List(asUser(ctx, "alice"), &watchlistGen.ListPayload{IfNoneMatch: &v1})
+	if res.Outcome != nil || len(res.Items) != 1 || res.Etag == v1 {
+		t.Errorf("List If-None-Match outdated = %+v, want the items and a new ETag", res)
+	}
+	v2 := res.Etag
+
+	// The other tab still has the first version.
+	if _, err := svc.Add(asUser(ctx, "alice"), &watchlistGen.AddPayload{Symbol: "MSFT", IfMatch: &v1}); errorName(err) != "precondition_failed" {
+		t.Errorf("Add If-Match outdated = %v, want precondition_failed", err)
+	}
+	if err := svc.Remove(asUser(ctx, "alice"), &watchlistGen.RemovePayload{Symbol: "AAPL", IfMatch: &v1}); errorName(err) != "precondition_failed" {
+		t.Errorf("Remove If-Match outdated = %v, want precondition_failed", err)
+	}
+	if _, err := svc.BulkAdd(asUser(ctx, "alice"), &watchlistGen.BulkAddPayload{Mode: BulkAllOrNothing, IfMatch: &v1,
+		Items: []*watchlistGen.BulkAddItem{{Symbol: "MSFT"}}}); errorName(err) != "precondition_failed" {
+		t.Errorf("BulkAdd If-Match outdated = %v, want precondition_failed", err)
+	}
+	star := "*"
+	if _, err := svc.Update(asUser(ctx, "alice"), &watchlistGen.UpdatePayload{Symbol: "AAPL", IfMatch: &star}); err != nil {
+		t.Errorf("Update If-Match *: %v", err)
+	}
+	if _, err := svc.Update(asUser(ctx, "alice"), &watchlistGen.UpdatePayload{Symbol: "AAPL", IfMatch: &v2}); errorName(err) != "precondition_failed" {
+		t.Errorf("Update If-Match outdated = %v, want precondition_failed", err)
+	}
+
+	// ETags name their watchlist.
+	tech, err := svc.CreateWatchlist(asUser(ctx, "alice"), &watchlistGen.CreateWatchlistPayload{Name: "Tech"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	items, err := svc.ListItems(asUser(ctx, "alice"), &watchlistGen.ListItemsPayload{ID: tech.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, _ = svc.List(asUser(ctx, "alice"), &watchlistGen.ListPayload{})
+	if _, err := svc.UpdateWatchlist(asUser(ctx, "alice"), &watchlistGen.UpdateWatchlistPayload{ID: tech.ID, IfMatch: &res.Etag}); errorName(err) != "precondition_failed" {
+		t.Errorf("UpdateWatchlist If-Match of another watchlist = %v, want precondition_failed", err)
+	}
+	if err := svc.DeleteWatchlist(asUser(ctx, "alice"), &watchlistGen.DeleteWatchlistPayload{ID: tech.ID, IfMatch: &items.Etag}); err != nil {
+		t.Errorf("DeleteWatchlist If-Match current: %v", err)
+	}
+}
+
+func TestServiceRestore(t *testing.T) {
+	ctx := context.Background()
+	symbols, err := NewSymbolResolver(Config{}, testListings, testMICs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := created
+	svc := NewService(NewMemoryRepository(), symbols, 24*time.Hour, eventbus.New(0))
+	svc.now = func() time.Time { return now }
+
+	for _, symbol := range []string{"AAPL", "MSFT", "NVDA"} {
+		if _, err := svc.Add(asUser(ctx, "alice"), &watchlistGen.AddPayload{Symbol: symbol}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := svc.Remove(asUser(ctx, "alice"), &watchlistGen.RemovePayload{Symbol: "aapl"}); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(time.Hour)
+	if err := svc.Remove(asUser(ctx, "alice"), &watchlistGen.RemovePayload{Symbol: "MSFT"}); err != nil {
+		t.Fatal(err)
+	}
+	removed, err := svc.ListRemoved(asUser(ctx, "alice"), &watchlistGen.ListRemovedPayload{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(removed) != 2 || removed[0].Symbol != "MSFT" || removed[1].Symbol != "AAPL" ||
+		removed[1].RemovedAt != "2025-01-02T03:04:05Z" || removed[1].PurgeAt != "2025-01-03T03:04:05Z" {
+		t.Fatalf("ListRemoved = %+v, want MSFT then AAPL", removed)
+	}
+
+	it, err := svc.Restore(asUser(ctx, "alice"), &watchlistGen.RestorePayload{Symbol: "aapl"})
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if it.Symbol != "AAPL" || it.Position != 0 {
+		t.Errorf("Restore = %+v, want AAPL back at position 0", it)
+	}
+	if _, err := svc.Restore(asUser(ctx, "alice"), &watchlistGen.RestorePayload{Symbol: "AAPL"}); errorName(err) != "not_found" {
+		t.Errorf("Restore listed = %v, want not_found", err)
+	}
+	res, _ := svc.List(asUser(ctx, "alice"), &watchlistGen.ListPayload{})
+	stale := res.Etag
+	now = now.Add(time.Hour)
+	if err := svc.Remove(asUser(ctx, "alice"), &watchlistGen.RemovePayload{Symbol: "NVDA"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.Restore(asUser(ctx, "alice"), &watchlistGen.RestorePayload{Symbol: "NVDA", IfMatch: &stale}); errorName(err) != "precondition_failed" {
+		t.Errorf("Restore If-Match outdated = %v, want precondition_failed", err)
+	}
+
+	// Past the retention window, removed items can no longer be restored
+	// and the next purge deletes them.
+	now = now.Add(23*time.Hour + time.Second)
+	if _, err := svc.Restore(asUser(ctx, "alice"), &watchlistGen.RestorePayload{Symbol: "MSFT"}); errorName(err) != "not_found" {
+		t.Errorf("Restore past retention = %v, want not_found", err)
+	}
+	if removed, _ := svc.ListRemoved(asUser(ctx, "alice"), &watchlistGen.ListRemovedPayload{}); len(removed) != 1 || removed[0].Symbol != "NVDA" {
+		t.Errorf("ListRemoved past retention = %+v, want NVDA", removed)
+	}
+	if n, err := svc.Purge(ctx); err != nil || n != 1 {
+		t.Errorf("Purge = %d, %v, want 1", n, err)
+	}
+}
+
+// eventStream collects the events sent to an events stream.
+type eventStream chan *watchlistGen.WatchlistEvent
+
+func (s eventStream) Send(e *watchlistGen.WatchlistEvent) error { s <- e; return nil }
+func (s eventStream) SendWithContext(ctx context.Context, e *watchlistGen.WatchlistEvent) error {
+	return s.Send(e)
+}
+func (s eventStream) Close() error { return nil }
+
+// next returns the next event of s with its type and symbol.
+func (s eventStream) next(t *testing.T) (e *watchlistGen.WatchlistEvent, typ, symbol string) {
+	t.Helper()
+	select {
+	case e = <-s:
+	case <-time.After(time.Second):
+		t.Fatal("no event")
+	}
+	if e.Data != nil {
+		symbol = e.Data.Symbol
+	}
+	return e, e.Type, symbol
+}
+
+func TestServiceEvents(t *testing.T) {
+	symbols, err := NewSymbolResolver(Config{}, testListings, testMICs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(NewMemoryRepository(), symbols, DefaultRetention, eventbus.New(0))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tech, err := svc.CreateWatchlist(asUser(ctx, "alice"), &watchlistGen.CreateWatchlistPayload{Name: "Tech"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// stream opens an event stream and returns it with the error of Events
+	// once ctx is cancelled.
+	stream := func(ctx context.Context, p *watchlistGen.EventsPayload) (eventStream, <-chan error) {
+		s, done := make(eventStream, 10), make(chan error, 1)
+		go func() { done <- svc.Events(ctx, p, s) }()
+		return s, done
+	}
+	all, done := stream(asUser(ctx, "alice"), &watchlistGen.EventsPayload{})
+	techOnly, _ := stream(asUser(ctx, "alice"), &watchlistGen.EventsPayload{Watchlist: &tech.ID})
+	bobs, _ := stream(asUser(ctx, "bob"), &watchlistGen.EventsPayload{})
+	for _, s := range []eventStream{all, techOnly, bobs} {
+		if _, typ, _ := s.next(t); typ != "ready" {
+			t.Fatalf("first event = %s, want ready", typ)
+		}
+	}
+
+	if _, err := svc.Add(asUser(ctx, "alice"), &watchlistGen.AddPayload{Symbol: "aapl"}); err != nil {
+		t.Fatal(err)
+	}
+	e, typ, symbol := all.next(t)
+	if typ != "added" || symbol != "AAPL" || e.Data.Item == nil || e.Data.Item.Symbol != "AAPL" {
+		t.Fatalf("event after Add = %+v, want AAPL added", e)
+	}
+	added := e.ID
+	if _, err := svc.Update(asUser(ctx, "alice"), &watchlistGen.UpdatePayload{Symbol: "AAPL", Note: new(string)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.Remove(asUser(ctx, "alice"), &watchlistGen.RemovePayload{Symbol: "AAPL"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.Restore(asUser(ctx, "alice"), &watchlistGen.RestorePayload{Symbol: "AAPL"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.AddItem(asUser(ctx, "alice"), &watchlistGen.AddItemPayload{ID: tech.ID, Symbol: "NVDA"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"updated AAPL", "removed AAPL", "restored AAPL", "added NVDA"} {
+		e, typ, symbol := all.next(t)
+		if typ+" "+symbol != want {
+			t.Errorf("event = %s %s, want %s", typ, symbol, want)
+		}
+		if typ == "removed" && e.Data.Item != nil {
+			t.Errorf("removed event has an item: %+v", e.Data.Item)
+		}
+	}
+	if _, typ, symbol := techOnly.next(t); typ != "added" || symbol != "NVDA" {
+		t.Errorf("event of the Tech stream = %s %s, want added NVDA", typ, symbol)
+	}
+	select {
+	case e := <-bobs:
+		t.Errorf("bob received %+v", e)
+	default:
+	}
+
+	// Resuming replays the missed events before ready.
+	resumed, _ := stream(asUser(ctx, "alice"), &watchlistGen.EventsPayload{LastEventID: &added})
+	for _, want := range []string{"updated", "removed", "restored", "added", "ready"} {
+		if _, typ, _ := resumed.next(t); typ != want {
+			t.Errorf("resumed event = %s, want %s", typ, want)
+		}
+	}
+	// New streams start with the next change.
+	fresh, _ := stream(asUser(ctx, "alice"), &watchlistGen.EventsPayload{})
+	if _, typ, _ := fresh.next(t); typ != "ready" {
+		t.Errorf("first event of a new stream = %s, want ready", typ)
+	}
+	unknown := "x-1"
+	reset, _ := stream(asUser(ctx, "alice"), &watchlistGen.EventsPayload{LastEventID: &unknown})
+	if _, typ, _ := reset.next(t); typ != "reset" {
+		t.Errorf("event resuming from an unknown ID = %s, want reset", typ)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Events = %v", err)
+	}
+}
+
+func TestServiceShares(t *testing.T) {
+	symbols, err := NewSymbolResolver(Config{}, testListings, testMICs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(NewMemoryRepository(), symbols, DefaultRetention, eventbus.New(0))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tech, err := svc.CreateWatchlist(asUser(ctx, "alice"), &watchlistGen.CreateWatchlistPayload{Name: "Tech"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tech.Owner != "alice" || tech.Role != "owner" {
+		t.Errorf("CreateWatchlist = %+v, want owned by alice", tech)
+	}
+	if _, err := svc.AddItem(asUser(ctx, "alice"), &watchlistGen.AddItemPayload{ID: tech.ID, Symbol: "AAPL"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.GetWatchlist(asUser(ctx, "bob"), &watchlistGen.GetWatchlistPayload{ID: tech.ID}); errorName(err) != "not_found" {
+		t.Errorf("GetWatchlist before sharing = %v, want not_found", err)
+	}
+
+	if _, err := svc.ShareWatchlist(asUser(ctx, "alice"), &watchlistGen.ShareWatchlistPayload{ID: tech.ID, User: "bob", Role: "viewer"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.ShareWatchlist(asUser(ctx, "alice"), &watchlistGen.ShareWatchlistPayload{ID: tech.ID, User: "alice", Role: "viewer"}); errorName(err) != "conflict" {
+		t.Errorf("ShareWatchlist with the owner = %v, want conflict", err)
+	}
+	shared, err := svc.ListSharedWatchlists(asUser(ctx, "bob"), &watchlistGen.ListSharedWatchlistsPayload{})
+	if err != nil || len(shared) != 1 || shared[0].ID != tech.ID || shared[0].Owner != "alice" || shared[0].Role != "viewer" || shared[0].ItemCount != 1 {
+		t.Fatalf("ListSharedWatchlists = %+v, %v, want Tech as viewer", shared, err)
+	}
+
+	// A viewer reads the watchlist and its changes.
+	res, err := svc.ListItems(asUser(ctx, "bob"), &watchlistGen.ListItemsPayload{ID: tech.ID})
+	if err != nil || len(res.Items) != 1 || res.Items[0].Symbol != "AAPL" {
+		t.Errorf("ListItems as viewer = %+v, %v, want AAPL", res, err)
+	}
+	events, done := make(eventStream, 10), make(chan error, 1)
+	go func() {
+		done <- svc.Events(asUser(ctx, "bob"), &watchlistGen.EventsPayload{Watchlist: &tech.ID}, events)
+	}()
+	if _, typ, _ := events.next(t); typ != "ready" {
+		t.Fatalf("first event = %s, want ready", typ)
+	}
+	if _, err := svc.AddItem(asUser(ctx, "alice"), &watchlistGen.AddItemPayload{ID: tech.ID, Symbol: "NVDA"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, typ, symbol := events.next(t); typ != "added" || symbol != "NVDA" {
+		t.Errorf("event of the shared watchlist = %s %s, want added NVDA", typ, symbol)
+	}
+	if _, err := svc.AddItem(asUser(ctx, "bob"), &watchlistGen.AddItemPayload{ID: tech.ID, Symbol: "MSFT"}); errorName(err) != "forbidden" {
+		t.Errorf("AddItem as viewer = %v, want forbidden", err)
+	}
+
+	// An editor changes the items, but not the watchlist itself.
+	if _, err := svc.ShareWatchlist(asUser(ctx, "alice"), &watchlistGen.ShareWatchlistPayload{ID: tech.ID, User: "bob", Role: "editor"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.AddItem(asUser(ctx, "bob"), &watchlistGen.AddItemPayload{ID: tech.ID, Symbol: "MSFT"}); err != nil {
+		t.Errorf("AddItem as editor = %v", err)
+	}
+	if _, err := svc.BulkRemove(asUser(ctx, "bob"), &watchlistGen.BulkRemovePayload{Watchlist: &tech.ID, Mode: BulkAllOrNothing, Symbols: []string{"NVDA"}}); err != nil {
+		t.Errorf("BulkRemove as editor = %v", err)
+	}
+	for _, want := range []string{"added MSFT", "removed NVDA"} {
+		if _, typ, symbol := events.next(t); typ+" "+symbol != want {
+			t.Errorf("event = %s %s, want %s", typ, symbol, want)
+		}
+	}
+	name := "Mine"
+	if _, err := svc.UpdateWatchlist(asUser(ctx, "bob"), &watchlistGen.UpdateWatchlistPayload{ID: tech.ID, Name: &name}); errorName(err) != "forbidden" {
+		t.Errorf("UpdateWatchlist as editor = %v, want forbidden", err)
+	}
+	if err := svc.DeleteWatchlist(asUser(ctx, "bob"), &watchlistGen.DeleteWatchlistPayload{ID: tech.ID}); errorName(err) != "forbidden" {
+		t.Errorf("DeleteWatchlist as editor = %v, want forbidden", err)
+	}
+	if _, err := svc.ShareWatchlist(asUser(ctx, "bob"), &watchlistGen.ShareWatchlistPayload{ID: tech.ID, User: "carol", Role: "editor"}); errorName(err) != "forbidden" {
+		t.Errorf("ShareWatchlist as editor = %v, want forbidden", err)
+	}
+	// The default watchlist is always the user's own.
+	if res, err := svc.ListItems(asUser(ctx, "bob"), &watchlistGen.ListItemsPayload{ID: DefaultListID}); err != nil || len(res.Items) != 0 {
+		t.Errorf("ListItems of bob's default = %+v, %v, want none", res, err)
+	}
+	if err := svc.UnshareWatchlist(asUser(ctx, "carol"), &watchlistGen.UnshareWatchlistPayload{ID: tech.ID, User: "bob"}); errorName(err) != "not_found" {
+		t.Errorf("UnshareWatchlist by a stranger = %v, want not_found", err)
+	}
+
+	shares, err := svc.ListShares(asUser(ctx, "alice"), &watchlistGen.ListSharesPayload{ID: tech.ID})
+	if err != nil || len(shares) != 1 || shares[0].UserID != "bob" || shares[0].Role != "editor" {
+		t.Errorf("ListShares = %+v, %v, want bob as editor", shares, err)
+	}
+
+	// bob leaves the watchlist; his stream ends at the next change.
+	if err := svc.UnshareWatchlist(asUser(ctx, "bob"), &watchlistGen.UnshareWatchlistPayload{ID: tech.ID, User: "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.ListItems(asUser(ctx, "bob"), &watchlistGen.ListItemsPayload{ID: tech.ID}); errorName(err) != "not_found" {
+		t.Errorf("ListItems after leaving = %v, want not_found", err)
+	}
+	if err := svc.UnshareWatchlist(asUser(ctx, "alice"), &watchlistGen.UnshareWatchlistPayload{ID: tech.ID, User: "bob"}); errorName(err) != "not_found" {
+		t.Errorf("UnshareWatchlist again = %v, want not_found", err)
+	}
+	if err := svc.RemoveItem(asUser(ctx, "alice"), &watchlistGen.RemoveItemPayload{ID: tech.ID, Symbol: "AAPL"}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Events = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("stream of an unshared watchlist still open")
+	}
+	if len(events) != 0 {
+		t.Errorf("event sent after unsharing: %+v", <-events)
+	}
+}
+
+func TestServicePublish(t *testing.T) {
+	ctx := context.Background()
+	symbols, err := NewSymbolResolver(Config{}, testListings, testMICs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(NewMemoryRepository(), symbols, DefaultRetention, eventbus.New(0))
+	tech, err := svc.CreateWatchlist(asUser(ctx, "alice"), &watchlistGen.CreateWatchlistPayload{Name: "Tech"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	note := "earnings in May"
+	if _, err := svc.AddItem(asUser(ctx, "alice"), &watchlistGen.AddItemPayload{ID: tech.ID, Symbol: "AAPL", OnHand: true, Note: &note}); err != nil {
+		t.Fatal(err)
+	}
+
+	published, err := svc.PublishWatchlist(asUser(ctx, "alice"), &watchlistGen.PublishWatchlistPayload{ID: tech.ID})
+	if err != nil || published.PublicToken == nil || len(*published.PublicToken) < 26 {
+		t.Fatalf("PublishWatchlist = %+v, %v, want a public token", published, err)
+	}
+	token := *published.PublicToken
+	if again, err := svc.PublishWatchlist(asUser(ctx, "alice"), &watchlistGen.PublishWatchlistPayload{ID: tech.ID}); err != nil || *again.PublicToken != token {
+		t.Errorf("PublishWatchlist again = %+v, %v, want the same token", again, err)
+	}
+	public, err := svc.GetPublicWatchlist(ctx, &watchlistGen.GetPublicWatchlistPayload{Token: token})
+	if err != nil || public.Name != "Tech" || len(public.Items) != 1 || public.Items[0].Symbol != "AAPL" || *public.Items[0].Note != note {
+		t.Errorf("GetPublicWatchlist = %+v, %v, want Tech with AAPL", public, err)
+	}
+
+	// Only the owner sees the token and publishes.
+	if _, err := svc.ShareWatchlist(asUser(ctx, "alice"), &watchlistGen.ShareWatchlistPayload{ID: tech.ID, User: "bob", Role: "editor"}); err != nil {
+		t.Fatal(err)
+	}
+	if l, err := svc.GetWatchlist(asUser(ctx, "bob"), &watchlistGen.GetWatchlistPayload{ID: tech.ID}); err != nil || l.PublicToken != nil {
+		t.Errorf("GetWatchlist as editor = %+v, %v, want no token", l, err)
+	}
+	if err := svc.UnpublishWatchlist(asUser(ctx, "bob"), &watchlistGen.UnpublishWatchlistPayload{ID: tech.ID}); errorName(err) != "forbidden" {
+		t.Errorf("UnpublishWatchlist as editor = %v, want forbidden", err)
+	}
+
+	if err := svc.UnpublishWatchlist(asUser(ctx, "alice"), &watchlistGen.UnpublishWatchlistPayload{ID: tech.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.GetPublicWatchlist(ctx, &watchlistGen.GetPublicWatchlistPayload{Token: token}); errorName(err) != "not_found" {
+		t.Errorf("GetPublicWatchlist after unpublishing = %v, want not_found", err)
+	}
+	if republished, err := svc.PublishWatchlist(asUser(ctx, "alice"), &watchlistGen.PublishWatchlistPayload{ID: tech.ID}); err != nil || *republished.PublicToken == token {
+		t.Errorf("PublishWatchlist after unpublishing = %+v, %v, want a new token", republished, err)
+	}
+}
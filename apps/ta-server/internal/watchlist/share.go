@@ -0,0 +1,187 @@
+package watchlist
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	watchlistGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/logctx"
+)
+
+// ListSharedWatchlists returns the watchlists of other users shared with the
+// user.
+func (s *Service) ListSharedWatchlists(ctx context.Context, p *watchlistGen.ListSharedWatchlistsPayload) ([]*watchlistGen.Watchlist, error) {
+	lists, err := s.repo.SharedLists(ctx, callerID(ctx))
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to list shared watchlists", "error", err)
+		return nil, err
+	}
+	res := make([]*watchlistGen.Watchlist, len(lists))
+	for i, sl := range lists {
+		res[i] = toWatchlist(sl.List, sl.Role)
+	}
+	return res, nil
+}
+
+// ListShares returns the users one of the user's watchlists is shared with.
+func (s *Service) ListShares(ctx context.Context, p *watchlistGen.ListSharesPayload) ([]*watchlistGen.WatchlistShare, error) {
+	l, err := s.list(ctx, callerID(ctx), p.ID, RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := s.repo.Shares(ctx, l.ID)
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to list watchlist shares", "watchlist", l.ID, "error", err)
+		return nil, err
+	}
+	res := make([]*watchlistGen.WatchlistShare, len(shares))
+	for i, sh := range shares {
+		res[i] = toWatchlistShare(sh)
+	}
+	return res, nil
+}
+
+// ShareWatchlist shares one of the user's watchlists with another user, or
+// changes the role it is shared with them with.
+func (s *Service) ShareWatchlist(ctx context.Context, p *watchlistGen.ShareWatchlistPayload) (*watchlistGen.WatchlistShare, error) {
+	l, err := s.list(ctx, callerID(ctx), p.ID, RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if p.User == l.UserID {
+		return nil, watchlistGen.MakeConflict(fmt.Errorf("watchlist %q cannot be shared with its owner", l.ID))
+	}
+	sh, err := s.repo.PutShare(ctx, &Share{ListID: l.ID, UserID: p.User, Role: Role(p.Role), CreatedAt: s.now()})
+	switch {
+	case errors.Is(err, ErrListNotFound):
+		return nil, watchlistGen.MakeNotFound(fmt.Errorf("watchlist %q not found", p.ID))
+	case err != nil:
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to share watchlist", "watchlist", l.ID, "error", err)
+		return nil, err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist shared", "watchlist", l.ID, "shared_with", sh.UserID, "role", sh.Role)
+	return toWatchlistShare(sh), nil
+}
+
+// UnshareWatchlist stops sharing one of the user's watchlists with another
+// user. Users may also remove their own share of a watchlist of another
+// user, to leave it.
+func (s *Service) UnshareWatchlist(ctx context.Context, p *watchlistGen.UnshareWatchlistPayload) error {
+	user := callerID(ctx)
+	need := RoleOwner
+	if p.User == user {
+		need = RoleViewer
+	}
+	l, err := s.list(ctx, user, p.ID, need)
+	if err != nil {
+		return err
+	}
+	err = s.repo.DeleteShare(ctx, l.ID, p.User)
+	switch {
+	case errors.Is(err, ErrShareNotFound):
+		return watchlistGen.MakeNotFound(fmt.Errorf("watchlist %q is not shared with %s", l.ID, p.User))
+	case err != nil:
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to unshare watchlist", "watchlist", l.ID, "error", err)
+		return err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist unshared", "watchlist", l.ID, "shared_with", p.User)
+	return nil
+}
+
+// PublishWatchlist gives one of the user's watchlists a public link. A
+// published watchlist keeps its link.
+func (s *Service) PublishWatchlist(ctx context.Context, p *watchlistGen.PublishWatchlistPayload) (*watchlistGen.Watchlist, error) {
+	l, err := s.list(ctx, callerID(ctx), p.ID, RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if l.PublicToken != "" {
+		return toWatchlist(l, RoleOwner), nil
+	}
+	res, err := s.setPublicToken(ctx, l, rand.Text())
+	if err != nil {
+		return nil, err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist published", "watchlist", l.ID)
+	return toWatchlist(res, RoleOwner), nil
+}
+
+// UnpublishWatchlist disables the public link of one of the user's
+// watchlists, if any.
+func (s *Service) UnpublishWatchlist(ctx context.Context, p *watchlistGen.UnpublishWatchlistPayload) error {
+	l, err := s.list(ctx, callerID(ctx), p.ID, RoleOwner)
+	if err != nil {
+		return err
+	}
+	if l.PublicToken == "" {
+		return nil
+	}
+	if _, err := s.setPublicToken(ctx, l, ""); err != nil {
+		return err
+	}
+	logctx.LoggerFromContext(ctx).InfoContext(ctx, "watchlist unpublished", "watchlist", l.ID)
+	return nil
+}
+
+// GetPublicWatchlist returns a published watchlist with its items. It is
+// served without authentication, so it discloses neither the owner nor
+// whether they hold the tickers.
+func (s *Service) GetPublicWatchlist(ctx context.Context, p *watchlistGen.GetPublicWatchlistPayload) (*watchlistGen.PublicWatchlist, error) {
+	l, err := s.repo.PublicList(ctx, p.Token)
+	if errors.Is(err, ErrListNotFound) {
+		return nil, watchlistGen.MakeNotFound(errors.New("public watchlist not found"))
+	}
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to get public watchlist", "error", err)
+		return nil, err
+	}
+	items, err := s.repo.Items(ctx, l.ID)
+	if err != nil {
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to list watchlist", "watchlist", l.ID, "error", err)
+		return nil, err
+	}
+	res := &watchlistGen.PublicWatchlist{Name: l.Name, Items: make([]*watchlistGen.PublicTickerItem, len(items))}
+	for i, it := range items {
+		res.Items[i] = toPublicTickerItem(it)
+	}
+	return res, nil
+}
+
+// setPublicToken sets the token of the public link of l, empty to disable
+// it. The token is not logged, since it grants access to the watchlist.
+func (s *Service) setPublicToken(ctx context.Context, l *List, token string) (*List, error) {
+	res, err := s.repo.SetPublicToken(ctx, l.ID, token)
+	switch {
+	case errors.Is(err, ErrListNotFound):
+		return nil, watchlistGen.MakeNotFound(fmt.Errorf("watchlist %q not found", l.ID))
+	case err != nil:
+		logctx.LoggerFromContext(ctx).ErrorContext(ctx, "failed to set watchlist public link", "watchlist", l.ID, "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func toWatchlistShare(sh *Share) *watchlistGen.WatchlistShare {
+	return &watchlistGen.WatchlistShare{
+		UserID:    sh.UserID,
+		Role:      string(sh.Role),
+		CreatedAt: sh.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func toPublicTickerItem(it *Item) *watchlistGen.PublicTickerItem {
+	t := toTickerItem(it)
+	return &watchlistGen.PublicTickerItem{
+		Symbol:       t.Symbol,
+		ExchangeMic:  t.ExchangeMic,
+		InstrumentID: t.InstrumentID,
+		Note:         t.Note,
+		Tags:         t.Tags,
+		BuyTarget:    t.BuyTarget,
+		SellTarget:   t.SellTarget,
+		Position:     t.Position,
+	}
+}
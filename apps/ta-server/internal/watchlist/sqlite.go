@@ -0,0 +1,741 @@
+package watchlist
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/sqlstore"
+)
+
+// timeLayout is the format used to store timestamps in SQLite TEXT columns.
+const timeLayout = time.RFC3339Nano
+
+// removedLayout is the format of removed_at, with a fixed number of
+// fractional digits so that it sorts and compares as text.
+const removedLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// SQLiteRepository stores watchlists in an embedded SQLite database file.
+type SQLiteRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteRepository opens (or creates) the SQLite database at path and runs
+// any pending schema migrations.
+func NewSQLiteRepository(ctx context.Context, path string) (*SQLiteRepository, error) {
+	db, err := sqlstore.Open(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if err := migrate(ctx, db); err != nil {
+		db.Close() //nolint:errcheck
+		return nil, err
+	}
+	return &SQLiteRepository{db: db}, nil
+}
+
+// listColumns selects the columns of a watchlist w scanned by scanList.
+const listColumns = `w.id, w.user_id, w.name, w.position, w.is_default, w.version, w.created_at, w.updated_at,
+	(SELECT COUNT(*) FROM watchlist_items i WHERE i.watchlist_id = w.id AND i.removed_at IS NULL), w.public_token`
+
+// Lists implements Repository.
+func (r *SQLiteRepository) Lists(ctx context.Context, userID string) ([]*List, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+listColumns+` FROM watchlists w WHERE w.user_id = ? ORDER BY w.position`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*List{}
+	for rows.Next() {
+		l, err := scanList(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, l)
+	}
+	return res, rows.Err()
+}
+
+// GetList implements Repository.
+func (r *SQLiteRepository) GetList(ctx context.Context, userID, id string) (*List, error) {
+	return r.getList(ctx, r.db, userID, id)
+}
+
+// DefaultList implements Repository.
+func (r *SQLiteRepository) DefaultList(ctx context.Context, def *List) (*List, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT `+listColumns+` FROM watchlists w WHERE w.user_id = ? AND w.is_default`, def.UserID)
+	l, err := scanList(row)
+	if !errors.Is(err, sql.ErrNoRows) {
+		return l, err
+	}
+	// Another request may create the default first; the partial unique
+	// index keeps a single one.
+	if _, err := r.db.ExecContext(ctx,
+		`INSERT INTO watchlists (id, user_id, name, position, is_default, created_at, updated_at)
+		 SELECT ?, ?, ?, COUNT(*), TRUE, ?, ? FROM watchlists WHERE user_id = ?
+		 ON CONFLICT (user_id) WHERE is_default DO NOTHING`,
+		def.ID, def.UserID, def.Name, def.CreatedAt.UTC().Format(timeLayout), def.UpdatedAt.UTC().Format(timeLayout),
+		def.UserID); err != nil {
+		if sqlstore.IsUniqueViolation(err) {
+			return nil, ErrConflict
+		}
+		return nil, err
+	}
+	row = r.db.QueryRowContext(ctx,
+		`SELECT `+listColumns+` FROM watchlists w WHERE w.user_id = ? AND w.is_default`, def.UserID)
+	return scanList(row)
+}
+
+// CreateList implements Repository.
+func (r *SQLiteRepository) CreateList(ctx context.Context, list *List) (*List, error) {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO watchlists (id, user_id, name, position, is_default, created_at, updated_at)
+		 SELECT ?, ?, ?, COUNT(*), FALSE, ?, ? FROM watchlists WHERE user_id = ?`,
+		list.ID, list.UserID, list.Name, list.CreatedAt.UTC().Format(timeLayout), list.UpdatedAt.UTC().Format(timeLayout),
+		list.UserID)
+	if sqlstore.IsUniqueViolation(err) {
+		return nil, ErrConflict
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.GetList(ctx, list.UserID, list.ID)
+}
+
+// UpdateList implements Repository.
+func (r *SQLiteRepository) UpdateList(ctx context.Context, list *List) (*List, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	cur, err := r.getList(ctx, tx, list.UserID, list.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := bumpVersion(ctx, tx, list.ID, list.Version); err != nil {
+		return nil, err
+	}
+	var count int
+	if err := tx.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM watchlists WHERE user_id = ?`, list.UserID).Scan(&count); err != nil {
+		return nil, err
+	}
+	pos := min(max(list.Position, 0), count-1)
+	switch {
+	case pos < cur.Position:
+		_, err = tx.ExecContext(ctx,
+			`UPDATE watchlists SET position = position + 1 WHERE user_id = ? AND position >= ? AND position < ?`,
+			list.UserID, pos, cur.Position)
+	case pos > cur.Position:
+		_, err = tx.ExecContext(ctx,
+			`UPDATE watchlists SET position = position - 1 WHERE user_id = ? AND position > ? AND position <= ?`,
+			list.UserID, cur.Position, pos)
+	}
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx,
+		`UPDATE watchlists SET name = ?, position = ?, updated_at = ? WHERE id = ?`,
+		list.Name, pos, list.UpdatedAt.UTC().Format(timeLayout), list.ID)
+	if sqlstore.IsUniqueViolation(err) {
+		return nil, ErrConflict
+	}
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.getList(ctx, tx, list.UserID, list.ID)
+	if err != nil {
+		return nil, err
+	}
+	return res, tx.Commit()
+}
+
+// DeleteList implements Repository. The items and shares are deleted by the
+// foreign key cascade.
+func (r *SQLiteRepository) DeleteList(ctx context.Context, userID, id string, version int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	cur, err := r.getList(ctx, tx, userID, id)
+	if err != nil {
+		return err
+	}
+	if err := bumpVersion(ctx, tx, id, version); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM watchlists WHERE id = ?`, id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE watchlists SET position = position - 1 WHERE user_id = ? AND position > ?`,
+		userID, cur.Position); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SharedLists implements Repository.
+func (r *SQLiteRepository) SharedLists(ctx context.Context, userID string) ([]*SharedList, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+listColumns+`, s.role FROM watchlists w JOIN watchlist_shares s ON s.watchlist_id = w.id
+		 WHERE s.user_id = ? ORDER BY w.name COLLATE NOCASE, w.id`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*SharedList{}
+	for rows.Next() {
+		sl, err := scanSharedList(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, sl)
+	}
+	return res, rows.Err()
+}
+
+// GetSharedList implements Repository.
+func (r *SQLiteRepository) GetSharedList(ctx context.Context, userID, id string) (*SharedList, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT `+listColumns+`, s.role FROM watchlists w JOIN watchlist_shares s ON s.watchlist_id = w.id
+		 WHERE w.id = ? AND s.user_id = ?`, id, userID)
+	sl, err := scanSharedList(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrListNotFound
+	}
+	return sl, err
+}
+
+// Shares implements Repository.
+func (r *SQLiteRepository) Shares(ctx context.Context, listID string) ([]*Share, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT watchlist_id, user_id, role, created_at FROM watchlist_shares WHERE watchlist_id = ? ORDER BY user_id`, listID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*Share{}
+	for rows.Next() {
+		sh, err := scanShare(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, sh)
+	}
+	return res, rows.Err()
+}
+
+// PutShare implements Repository.
+func (r *SQLiteRepository) PutShare(ctx context.Context, share *Share) (*Share, error) {
+	row := r.db.QueryRowContext(ctx,
+		`INSERT INTO watchlist_shares (watchlist_id, user_id, role, created_at) VALUES (?, ?, ?, ?)
+		 ON CONFLICT (watchlist_id, user_id) DO UPDATE SET role = excluded.role
+		 RETURNING watchlist_id, user_id, role, created_at`,
+		share.ListID, share.UserID, string(share.Role), share.CreatedAt.UTC().Format(timeLayout))
+	sh, err := scanShare(row)
+	if sqlstore.IsForeignKeyViolation(err) {
+		return nil, ErrListNotFound
+	}
+	return sh, err
+}
+
+// DeleteShare implements Repository.
+func (r *SQLiteRepository) DeleteShare(ctx context.Context, listID, userID string) error {
+	res, err := r.db.ExecContext(ctx,
+		`DELETE FROM watchlist_shares WHERE watchlist_id = ? AND user_id = ?`, listID, userID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrShareNotFound
+	}
+	return nil
+}
+
+// SetPublicToken implements Repository.
+func (r *SQLiteRepository) SetPublicToken(ctx context.Context, listID, token string) (*List, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE watchlists SET public_token = ? WHERE id = ?`,
+		sql.NullString{String: token, Valid: token != ""}, listID); err != nil {
+		return nil, err
+	}
+	l, err := scanList(tx.QueryRowContext(ctx, `SELECT `+listColumns+` FROM watchlists w WHERE w.id = ?`, listID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrListNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return l, tx.Commit()
+}
+
+// PublicList implements Repository.
+func (r *SQLiteRepository) PublicList(ctx context.Context, token string) (*List, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT `+listColumns+` FROM watchlists w WHERE w.public_token = ?`, token)
+	l, err := scanList(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrListNotFound
+	}
+	return l, err
+}
+
+// itemColumns selects the columns of an item scanned by scanItem.
+const itemColumns = `symbol, on_hand, note, tags, buy_target, sell_target, position, created_at, updated_at,
+	exchange_mic, instrument_id, removed_at`
+
+// Items implements Repository.
+func (r *SQLiteRepository) Items(ctx context.Context, listID string) ([]*Item, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+itemColumns+` FROM watchlist_items WHERE watchlist_id = ? AND removed_at IS NULL ORDER BY position`, listID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*Item{}
+	for rows.Next() {
+		it, err := scanItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, it)
+	}
+	return res, rows.Err()
+}
+
+// Get implements Repository.
+func (r *SQLiteRepository) Get(ctx context.Context, listID, symbol string) (*Item, error) {
+	return r.getItem(ctx, r.db, listID, symbol)
+}
+
+// Add implements Repository.
+func (r *SQLiteRepository) Add(ctx context.Context, listID string, version int64, item *Item) (*Item, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if err := bumpVersion(ctx, tx, listID, version); err != nil {
+		return nil, err
+	}
+	res, err := addItem(ctx, tx, listID, item)
+	if err != nil {
+		return nil, err
+	}
+	return res, tx.Commit()
+}
+
+// AddItems implements Repository.
+func (r *SQLiteRepository) AddItems(ctx context.Context, listID string, version int64, items []*Item) ([]*Item, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if err := bumpVersion(ctx, tx, listID, version); err != nil {
+		return nil, err
+	}
+	res := make([]*Item, len(items))
+	for i, item := range items {
+		if res[i], err = addItem(ctx, tx, listID, item); err != nil {
+			return nil, err
+		}
+	}
+	return res, tx.Commit()
+}
+
+// Update implements Repository.
+func (r *SQLiteRepository) Update(ctx context.Context, listID string, version int64, item *Item) (*Item, error) {
+	tags, err := json.Marshal(nonNil(item.Tags))
+	if err != nil {
+		return nil, err
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if err := bumpVersion(ctx, tx, listID, version); err != nil {
+		return nil, err
+	}
+	cur, err := r.getItem(ctx, tx, listID, item.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	var count int
+	if err := tx.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM watchlist_items WHERE watchlist_id = ? AND removed_at IS NULL`, listID).Scan(&count); err != nil {
+		return nil, err
+	}
+	pos := min(max(item.Position, 0), count-1)
+	switch {
+	case pos < cur.Position:
+		_, err = tx.ExecContext(ctx,
+			`UPDATE watchlist_items SET position = position + 1
+			 WHERE watchlist_id = ? AND position >= ? AND position < ? AND removed_at IS NULL`,
+			listID, pos, cur.Position)
+	case pos > cur.Position:
+		_, err = tx.ExecContext(ctx,
+			`UPDATE watchlist_items SET position = position - 1
+			 WHERE watchlist_id = ? AND position > ? AND position <= ? AND removed_at IS NULL`,
+			listID, cur.Position, pos)
+	}
+	if err != nil {
+		return nil, err
+	}
+	row := tx.QueryRowContext(ctx,
+		`UPDATE watchlist_items SET on_hand = ?, note = ?, tags = ?, buy_target = ?, sell_target = ?, position = ?,
+		   updated_at = ?, exchange_mic = ?, instrument_id = ?
+		 WHERE watchlist_id = ? AND symbol = ?
+		 RETURNING `+itemColumns,
+		item.OnHand, item.Note, string(tags), price(item.BuyTarget), price(item.SellTarget), pos,
+		item.UpdatedAt.UTC().Format(timeLayout), item.ExchangeMIC, item.InstrumentID,
+		listID, item.Symbol)
+	res, err := scanItem(row)
+	if err != nil {
+		return nil, err
+	}
+	return res, tx.Commit()
+}
+
+// Remove implements Repository.
+func (r *SQLiteRepository) Remove(ctx context.Context, listID string, version int64, symbol string, removedAt time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if err := bumpVersion(ctx, tx, listID, version); err != nil {
+		return err
+	}
+	if err := r.removeItem(ctx, tx, listID, symbol, removedAt); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// RemoveItems implements Repository.
+func (r *SQLiteRepository) RemoveItems(ctx context.Context, listID string, version int64, symbols []string, removedAt time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if err := bumpVersion(ctx, tx, listID, version); err != nil {
+		return err
+	}
+	for _, symbol := range symbols {
+		if err := r.removeItem(ctx, tx, listID, symbol, removedAt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Removed implements Repository.
+func (r *SQLiteRepository) Removed(ctx context.Context, listID string) ([]*Item, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+itemColumns+` FROM watchlist_items WHERE watchlist_id = ? AND removed_at IS NOT NULL
+		 ORDER BY removed_at DESC, symbol`, listID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := []*Item{}
+	for rows.Next() {
+		it, err := scanItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, it)
+	}
+	return res, rows.Err()
+}
+
+// Restore implements Repository.
+func (r *SQLiteRepository) Restore(ctx context.Context, listID string, version int64, symbol string, restoredAt time.Time) (*Item, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if err := bumpVersion(ctx, tx, listID, version); err != nil {
+		return nil, err
+	}
+	var pos, count int
+	err = tx.QueryRowContext(ctx,
+		`SELECT position FROM watchlist_items WHERE watchlist_id = ? AND symbol = ? AND removed_at IS NOT NULL`,
+		listID, symbol).Scan(&pos)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM watchlist_items WHERE watchlist_id = ? AND removed_at IS NULL`, listID).Scan(&count); err != nil {
+		return nil, err
+	}
+	pos = min(pos, count)
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE watchlist_items SET position = position + 1 WHERE watchlist_id = ? AND position >= ? AND removed_at IS NULL`,
+		listID, pos); err != nil {
+		return nil, err
+	}
+	row := tx.QueryRowContext(ctx,
+		`UPDATE watchlist_items SET removed_at = NULL, position = ?, updated_at = ?
+		 WHERE watchlist_id = ? AND symbol = ?
+		 RETURNING `+itemColumns,
+		pos, restoredAt.UTC().Format(timeLayout), listID, symbol)
+	res, err := scanItem(row)
+	if err != nil {
+		return nil, err
+	}
+	return res, tx.Commit()
+}
+
+// Purge implements Repository.
+func (r *SQLiteRepository) Purge(ctx context.Context, before time.Time) (int, error) {
+	res, err := r.db.ExecContext(ctx,
+		`DELETE FROM watchlist_items WHERE removed_at IS NOT NULL AND removed_at < ?`,
+		before.UTC().Format(removedLayout))
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+// Ping implements Repository.
+func (r *SQLiteRepository) Ping(ctx context.Context) error {
+	return r.db.PingContext(ctx)
+}
+
+// Close implements Repository.
+func (r *SQLiteRepository) Close() error {
+	return r.db.Close()
+}
+
+// scanner is implemented by *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// querier is implemented by *sql.DB and *sql.Tx.
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// bumpVersion increments the version of the watchlist in tx. It returns
+// ErrListNotFound when the watchlist does not exist and ErrVersionMismatch,
+// for the caller to roll back, when its version was not the given one.
+func bumpVersion(ctx context.Context, tx *sql.Tx, listID string, version int64) error {
+	var prev int64
+	err := tx.QueryRowContext(ctx,
+		`UPDATE watchlists SET version = version + 1 WHERE id = ? RETURNING version - 1`, listID).Scan(&prev)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrListNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if version != AnyVersion && version != prev {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
+// removeItem marks an item removed in tx and closes the gap in positions.
+func (r *SQLiteRepository) removeItem(ctx context.Context, tx *sql.Tx, listID, symbol string, removedAt time.Time) error {
+	cur, err := r.getItem(ctx, tx, listID, symbol)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE watchlist_items SET removed_at = ? WHERE watchlist_id = ? AND symbol = ?`,
+		removedAt.UTC().Format(removedLayout), listID, symbol); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx,
+		`UPDATE watchlist_items SET position = position - 1 WHERE watchlist_id = ? AND position > ? AND removed_at IS NULL`,
+		listID, cur.Position)
+	return err
+}
+
+func (r *SQLiteRepository) getList(ctx context.Context, q querier, userID, id string) (*List, error) {
+	row := q.QueryRowContext(ctx,
+		`SELECT `+listColumns+` FROM watchlists w WHERE w.id = ? AND w.user_id = ?`, id, userID)
+	l, err := scanList(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrListNotFound
+	}
+	return l, err
+}
+
+// scanList scans the columns of a watchlist, selected by listColumns,
+// followed by the extra ones.
+func scanList(s scanner, extra ...any) (*List, error) {
+	var (
+		l                    List
+		createdAt, updatedAt string
+		publicToken          sql.NullString
+	)
+	dest := append([]any{&l.ID, &l.UserID, &l.Name, &l.Position, &l.Default, &l.Version, &createdAt, &updatedAt,
+		&l.ItemCount, &publicToken}, extra...)
+	if err := s.Scan(dest...); err != nil {
+		return nil, err
+	}
+	l.PublicToken = publicToken.String
+	var err error
+	if l.CreatedAt, err = time.Parse(timeLayout, createdAt); err != nil {
+		return nil, fmt.Errorf("parse created_at %q: %w", createdAt, err)
+	}
+	if l.UpdatedAt, err = time.Parse(timeLayout, updatedAt); err != nil {
+		return nil, fmt.Errorf("parse updated_at %q: %w", updatedAt, err)
+	}
+	return &l, nil
+}
+
+// scanSharedList scans the columns of a watchlist followed by the role of
+// its share.
+func scanSharedList(s scanner) (*SharedList, error) {
+	var role string
+	l, err := scanList(s, &role)
+	if err != nil {
+		return nil, err
+	}
+	return &SharedList{List: l, Role: Role(role)}, nil
+}
+
+func scanShare(s scanner) (*Share, error) {
+	var (
+		sh        Share
+		role      string
+		createdAt string
+	)
+	if err := s.Scan(&sh.ListID, &sh.UserID, &role, &createdAt); err != nil {
+		return nil, err
+	}
+	sh.Role = Role(role)
+	var err error
+	if sh.CreatedAt, err = time.Parse(timeLayout, createdAt); err != nil {
+		return nil, fmt.Errorf("parse created_at %q: %w", createdAt, err)
+	}
+	return &sh, nil
+}
+
+// addItem stores item as Add does. A removed item with the same symbol is
+// replaced as if it had never been listed.
+func addItem(ctx context.Context, q querier, listID string, item *Item) (*Item, error) {
+	tags, err := json.Marshal(nonNil(item.Tags))
+	if err != nil {
+		return nil, err
+	}
+	row := q.QueryRowContext(ctx,
+		`INSERT INTO watchlist_items (watchlist_id, symbol, on_hand, note, tags, buy_target, sell_target, position,
+		   created_at, updated_at, exchange_mic, instrument_id)
+		 SELECT ?, ?, ?, ?, ?, ?, ?, COUNT(*), ?, ?, ?, ? FROM watchlist_items WHERE watchlist_id = ? AND removed_at IS NULL
+		 ON CONFLICT (watchlist_id, symbol) DO UPDATE SET
+		   on_hand = excluded.on_hand, note = excluded.note, tags = excluded.tags,
+		   buy_target = excluded.buy_target, sell_target = excluded.sell_target, updated_at = excluded.updated_at,
+		   exchange_mic = excluded.exchange_mic, instrument_id = excluded.instrument_id,
+		   position = CASE WHEN removed_at IS NULL THEN position ELSE excluded.position END,
+		   created_at = CASE WHEN removed_at IS NULL THEN created_at ELSE excluded.created_at END,
+		   removed_at = NULL
+		 RETURNING `+itemColumns,
+		listID, item.Symbol, item.OnHand, item.Note, string(tags), price(item.BuyTarget), price(item.SellTarget),
+		item.CreatedAt.UTC().Format(timeLayout), item.UpdatedAt.UTC().Format(timeLayout), item.ExchangeMIC, item.InstrumentID,
+		listID)
+	it, err := scanItem(row)
+	if sqlstore.IsForeignKeyViolation(err) {
+		return nil, ErrListNotFound
+	}
+	return it, err
+}
+
+func (r *SQLiteRepository) getItem(ctx context.Context, q querier, listID, symbol string) (*Item, error) {
+	row := q.QueryRowContext(ctx,
+		`SELECT `+itemColumns+` FROM watchlist_items WHERE watchlist_id = ? AND symbol = ? AND removed_at IS NULL`,
+		listID, symbol)
+	it, err := scanItem(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return it, err
+}
+
+func scanItem(s scanner) (*Item, error) {
+	var (
+		it                    Item
+		tags                  string
+		buyTarget, sellTarget sql.NullFloat64
+		createdAt, updatedAt  string
+		removedAt             sql.NullString
+	)
+	if err := s.Scan(&it.Symbol, &it.OnHand, &it.Note, &tags, &buyTarget, &sellTarget, &it.Position,
+		&createdAt, &updatedAt, &it.ExchangeMIC, &it.InstrumentID, &removedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(tags), &it.Tags); err != nil {
+		return nil, fmt.Errorf("parse tags %q: %w", tags, err)
+	}
+	it.BuyTarget, it.SellTarget = buyTarget.Float64, sellTarget.Float64
+	var err error
+	if it.CreatedAt, err = time.Parse(timeLayout, createdAt); err != nil {
+		return nil, fmt.Errorf("parse created_at %q: %w", createdAt, err)
+	}
+	if it.UpdatedAt, err = time.Parse(timeLayout, updatedAt); err != nil {
+		return nil, fmt.Errorf("parse updated_at %q: %w", updatedAt, err)
+	}
+	if removedAt.Valid {
+		if it.RemovedAt, err = time.Parse(timeLayout, removedAt.String); err != nil {
+			return nil, fmt.Errorf("parse removed_at %q: %w", removedAt.String, err)
+		}
+	}
+	return &it, nil
+}
+
+// price returns the column value of a target price, NULL when unset.
+func price(p float64) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: p, Valid: p != 0}
+}
+
+// nonNil returns tags, or an empty slice when nil, so that it is stored as
+// a JSON array.
+func nonNil(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
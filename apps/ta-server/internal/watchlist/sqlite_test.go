@@ -0,0 +1,141 @@
+package watchlist
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func openTestSQLite(t *testing.T, path string) *SQLiteRepository {
+	t.Helper()
+	repo, err := NewSQLiteRepository(context.Background(), path)
+	if err != nil {
+		t.Fatalf("NewSQLiteRepository: %v", err)
+	}
+	t.Cleanup(func() { repo.Close() })
+	return repo
+}
+
+func TestSQLiteRepository(t *testing.T) {
+	testRepository(t, openTestSQLite(t, filepath.Join(t.TempDir(), "watchlist.db")))
+}
+
+func TestSQLiteRepositoryPersistsAcrossRestart(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "watchlist.db")
+
+	repo, err := NewSQLiteRepository(ctx, path)
+	if err != nil {
+		t.Fatalf("NewSQLiteRepository: %v", err)
+	}
+	def, err := repo.DefaultList(ctx, &List{ID: "d1", UserID: "alice", Name: "Default", CreatedAt: created, UpdatedAt: created})
+	if err != nil {
+		t.Fatalf("DefaultList: %v", err)
+	}
+	if _, err := repo.Add(ctx, def.ID, AnyVersion, &Item{Symbol: "AAPL", CreatedAt: time.Now()}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := repo.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// Reopening runs migrations again, which must be a no-op.
+	repo = openTestSQLite(t, path)
+	items, err := repo.Items(ctx, def.ID)
+	if err != nil {
+		t.Fatalf("Items: %v", err)
+	}
+	if len(items) != 1 || items[0].Symbol != "AAPL" {
+		t.Errorf("Items after reopen = %+v, want [AAPL]", items)
+	}
+
+	var version int
+	if err := repo.db.QueryRowContext(ctx, `SELECT MAX(version) FROM schema_migrations`).Scan(&version); err != nil {
+		t.Fatalf("read schema version: %v", err)
+	}
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("loadMigrations: %v", err)
+	}
+	if want := migrations[len(migrations)-1].Version; version != want {
+		t.Errorf("schema version = %d, want %d", version, want)
+	}
+}
+
+// TestMigrations upgrades items stored by the first schema version: symbols
+// are upper-cased, merging items that differed only in case, each user's
+// items move to their default watchlist and keep their order.
+func TestMigrations(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "watchlist.db")
+
+	// Create the items as the first schema version stored them.
+	repo := openTestSQLite(t, path)
+	for _, stmt := range []string{
+		`DROP TABLE watchlist_shares`,
+		`DROP TABLE watchlist_items`,
+		`DROP TABLE watchlists`,
+		`DELETE FROM schema_migrations`,
+	} {
+		if _, err := repo.db.ExecContext(ctx, stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.db.ExecContext(ctx, migrations[0].SQL); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.db.ExecContext(ctx, `INSERT INTO schema_migrations (version) VALUES (1)`); err != nil {
+		t.Fatal(err)
+	}
+	// alice added AAPL twice in different cases, the second time on hand.
+	for _, it := range []struct {
+		user, symbol string
+		onHand       bool
+		minutes      int
+	}{{"alice", "msft", false, 0}, {"alice", "aapl", false, 1}, {"alice", "AAPL", true, 2}, {"alice", "NVDA", false, 2}, {"bob", "tsla", false, 0}} {
+		if _, err := repo.db.ExecContext(ctx,
+			`INSERT INTO watchlist_items (user_id, symbol, on_hand, created_at) VALUES (?, ?, ?, ?)`,
+			it.user, it.symbol, it.onHand, created.Add(time.Duration(it.minutes)*time.Minute).Format(timeLayout)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	repo.Close()
+
+	repo = openTestSQLite(t, path)
+	for user, want := range map[string]string{"alice": "MSFT,AAPL,NVDA", "bob": "TSLA"} {
+		lists, err := repo.Lists(ctx, user)
+		if err != nil {
+			t.Fatalf("Lists: %v", err)
+		}
+		if len(lists) != 1 || !lists[0].Default || len(lists[0].ID) != 16 || !lists[0].CreatedAt.Equal(created) || lists[0].Version != 1 {
+			t.Fatalf("Lists %s after migration = %+v, want a default watchlist", user, lists)
+		}
+		def, err := repo.DefaultList(ctx, &List{ID: "new", UserID: user, Name: "Default"})
+		if err != nil || def.ID != lists[0].ID {
+			t.Fatalf("DefaultList %s = %+v, %v, want the migrated watchlist", user, def, err)
+		}
+		items, err := repo.Items(ctx, def.ID)
+		if err != nil {
+			t.Fatalf("Items: %v", err)
+		}
+		if got := symbols(items); got != want {
+			t.Errorf("Items %s after migration = %s, want %s", user, got, want)
+		}
+		for i, it := range items {
+			if it.Position != i || !it.UpdatedAt.Equal(it.CreatedAt) || it.Tags == nil {
+				t.Errorf("Items[%d] %s after migration = %+v", i, user, it)
+			}
+		}
+		if user == "alice" {
+			// The AAPL items are merged: on hand, created with the first.
+			if aapl := items[1]; !aapl.OnHand || !aapl.CreatedAt.Equal(created.Add(time.Minute)) {
+				t.Errorf("merged AAPL after migration = %+v", aapl)
+			}
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/instrument"
+)
+
+// DefaultSymbolPattern accepts the symbols the instrument master accepts.
+const DefaultSymbolPattern = `^[A-Z0-9][A-Z0-9.\-]{0,19}$`
+
+// ErrInvalidSymbol is wrapped by the errors of SymbolResolver.Resolve.
+var ErrInvalidSymbol = errors.New("invalid symbol")
+
+// Config configures the watchlist service.
+type Config struct {
+	// SymbolPattern is the regular expression symbols must match once
+	// upper-cased and stripped of their MIC suffix, DefaultSymbolPattern
+	// when empty.
+	SymbolPattern string
+	// RequireInstrument rejects symbols that are not listed in the
+	// instrument master.
+	RequireInstrument bool
+	// Retention is how long removed items can be restored before they are
+	// purged, DefaultRetention when zero.
+	Retention time.Duration
+}
+
+// Instruments finds the listings of a symbol in the instrument master, as
+// instrument.Service does.
+type Instruments interface {
+	// Listings returns the operating MICs of the exchanges listing symbol.
+	Listings(ctx context.Context, symbol string) ([]string, error)
+}
+
+// MICResolver maps an operating or segment MIC to the operating MIC of the
+// exchange, as exchange.Service does.
+type MICResolver interface {
+	ResolveMIC(mic string) (string, bool)
+}
+
+// Listing is a normalized watchlist symbol and where it is listed.
+type Listing struct {
+	Symbol string
+	// ExchangeMIC is the operating MIC of the listing exchange, empty when
+	// neither given nor known to the instrument master.
+	ExchangeMIC string
+	// InstrumentID is set when the instrument master lists the symbol.
+	InstrumentID string
+}
+
+// SymbolResolver normalizes and validates the symbols added to watchlists.
+type SymbolResolver struct {
+	pattern           *regexp.Regexp
+	requireInstrument bool
+	instruments       Instruments
+	exchanges         MICResolver
+}
+
+// NewSymbolResolver returns a resolver validating symbols as configured by
+// cfg, against the listings of instruments and the MICs of exchanges.
+func NewSymbolResolver(cfg Config, instruments Instruments, exchanges MICResolver) (*SymbolResolver, error) {
+	expr := cfg.SymbolPattern
+	if expr == "" {
+		expr = DefaultSymbolPattern
+	}
+	pattern, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid watchlist symbol pattern: %w", err)
+	}
+	return &SymbolResolver{
+		pattern:           pattern,
+		requireInstrument: cfg.RequireInstrument,
+		instruments:       instruments,
+		exchanges:         exchanges,
+	}, nil
+}
+
+// split upper-cases s and separates the exchange MIC given either as a
+// suffix ("VOD.XLON") or as an instrument ID ("XLON:VOD"). A four-character
+// suffix that is not a known MIC is reported as unknown; shorter suffixes,
+// as in BRK.B, are part of the symbol.
+func (r *SymbolResolver) split(s string) (symbol, mic string, err error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if m, sym, ok := strings.Cut(s, ":"); ok {
+		symbol, mic = sym, m
+	} else if i := strings.LastIndexByte(s, '.'); i > 0 && len(s)-i-1 == 4 {
+		symbol, mic = s[:i], s[i+1:]
+	} else {
+		return s, "", nil
+	}
+	op, ok := r.exchanges.ResolveMIC(mic)
+	if !ok {
+		return symbol, mic, fmt.Errorf("%w: unknown exchange MIC %q", ErrInvalidSymbol, mic)
+	}
+	return symbol, op, nil
+}
+
+// Resolve normalizes and validates symbol. A symbol without a MIC takes the
+// exchange of its only listing in the instrument master; one listed on
+// several exchanges must name one. The error wraps ErrInvalidSymbol when
+// symbol is rejected.
+func (r *SymbolResolver) Resolve(ctx context.Context, symbol string) (*Listing, error) {
+	sym, mic, err := r.split(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if !r.pattern.MatchString(sym) {
+		return nil, fmt.Errorf("%w: %q does not match %s", ErrInvalidSymbol, sym, r.pattern)
+	}
+	mics, err := r.instruments.Listings(ctx, sym)
+	if err != nil {
+		return nil, fmt.Errorf("look up %s: %w", sym, err)
+	}
+
+	l := &Listing{Symbol: sym, ExchangeMIC: mic}
+	switch {
+	case mic != "" && slices.Contains(mics, mic):
+		l.InstrumentID = instrument.FormatID(mic, sym)
+	case mic == "" && len(mics) == 1:
+		l.ExchangeMIC = mics[0]
+		l.InstrumentID = instrument.FormatID(mics[0], sym)
+	case mic == "" && len(mics) > 1:
+		return nil, fmt.Errorf("%w: %s is listed on %s; add the MIC as a suffix, as in %s.%s",
+			ErrInvalidSymbol, sym, strings.Join(mics, ", "), sym, mics[0])
+	case r.requireInstrument:
+		return nil, fmt.Errorf("%w: %s is not in the instrument master", ErrInvalidSymbol, strings.TrimSuffix(sym+"."+mic, "."))
+	}
+	return l, nil
+}
+
+// Key returns the stored symbol of the watchlist item symbol refers to,
+// without validating it, so that items are removed under any spelling
+// Resolve accepts.
+func (r *SymbolResolver) Key(symbol string) string {
+	sym, _, _ := r.split(symbol)
+	return sym
+}
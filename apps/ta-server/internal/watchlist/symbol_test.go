@@ -0,0 +1,118 @@
+package watchlist
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	watchlistGen "github.com/reidlai/ta-workspace/apps/ta-server/gen/watchlist"
+	"github.com/reidlai/ta-workspace/apps/ta-server/internal/eventbus"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// listings maps symbols to the operating MICs listing them.
+type listings map[string][]string
+
+func (l listings) Listings(ctx context.Context, symbol string) ([]string, error) {
+	return l[symbol], nil
+}
+
+// mics maps operating and segment MICs to operating MICs.
+type mics map[string]string
+
+func (m mics) ResolveMIC(mic string) (string, bool) {
+	op, ok := m[strings.ToUpper(mic)]
+	return op, ok
+}
+
+var (
+	testListings = listings{"AAPL": {"XNAS"}, "VOD": {"XLON", "XNAS"}}
+	testMICs     = mics{"XNAS": "XNAS", "XLON": "XLON", "XLOM": "XLON"}
+)
+
+func TestSymbolResolver(t *testing.T) {
+	ctx := context.Background()
+	r, err := NewSymbolResolver(Config{}, testListings, testMICs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		symbol string
+		want   Listing
+	}{
+		{" aapl ", Listing{Symbol: "AAPL", ExchangeMIC: "XNAS", InstrumentID: "XNAS:AAPL"}},
+		{"vod.xlon", Listing{Symbol: "VOD", ExchangeMIC: "XLON", InstrumentID: "XLON:VOD"}},
+		{"VOD.XLOM", Listing{Symbol: "VOD", ExchangeMIC: "XLON", InstrumentID: "XLON:VOD"}}, // segment MIC
+		{"XNAS:VOD", Listing{Symbol: "VOD", ExchangeMIC: "XNAS", InstrumentID: "XNAS:VOD"}},
+		{"TSLA", Listing{Symbol: "TSLA"}}, // not in the master
+		{"TSLA.XNAS", Listing{Symbol: "TSLA", ExchangeMIC: "XNAS"}},
+		{"BRK.B", Listing{Symbol: "BRK.B"}},
+	} {
+		got, err := r.Resolve(ctx, tc.symbol)
+		if err != nil {
+			t.Errorf("Resolve(%q): %v", tc.symbol, err)
+			continue
+		}
+		if *got != tc.want {
+			t.Errorf("Resolve(%q) = %+v, want %+v", tc.symbol, *got, tc.want)
+		}
+	}
+
+	for _, symbol := range []string{"", "AAPL$", "VOD", "VOD.XXXX", "XXXX:VOD", "TOOLONGSYMBOLFORANYEXCHANGE"} {
+		if _, err := r.Resolve(ctx, symbol); !errors.Is(err, ErrInvalidSymbol) {
+			t.Errorf("Resolve(%q) = %v, want ErrInvalidSymbol", symbol, err)
+		}
+	}
+
+	strict, err := NewSymbolResolver(Config{SymbolPattern: `^[A-Z]{1,5}$`, RequireInstrument: true}, testListings, testMICs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for symbol, ok := range map[string]bool{"AAPL": true, "VOD.XNAS": true, "TSLA": false, "AAPL.XLON": false, "BRK.B": false} {
+		if _, err := strict.Resolve(ctx, symbol); (err == nil) != ok {
+			t.Errorf("strict Resolve(%q) = %v", symbol, err)
+		}
+	}
+
+	if _, err := NewSymbolResolver(Config{SymbolPattern: "("}, testListings, testMICs); err == nil {
+		t.Error("NewSymbolResolver accepted an invalid pattern")
+	}
+}
+
+func TestServiceNormalizesSymbols(t *testing.T) {
+	ctx := context.Background()
+	symbols, err := NewSymbolResolver(Config{}, testListings, testMICs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(NewMemoryRepository(), symbols, DefaultRetention, eventbus.New(0))
+
+	for _, symbol := range []string{"aapl", "AAPL", "xnas:aapl"} {
+		if _, err := svc.Add(asUser(ctx, "alice"), &watchlistGen.AddPayload{Symbol: symbol}); err != nil {
+			t.Fatalf("Add %s: %v", symbol, err)
+		}
+	}
+	res, err := svc.List(asUser(ctx, "alice"), &watchlistGen.ListPayload{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := res.Items
+	if len(items) != 1 || items[0].Symbol != "AAPL" || *items[0].ExchangeMic != "XNAS" || *items[0].InstrumentID != "XNAS:AAPL" {
+		t.Fatalf("List = %+v, want a single XNAS:AAPL", items)
+	}
+
+	_, err = svc.Add(asUser(ctx, "alice"), &watchlistGen.AddPayload{Symbol: "not a symbol"})
+	var se *goa.ServiceError
+	if !errors.As(err, &se) || se.Name != "invalid_symbol" {
+		t.Errorf("Add invalid = %v, want invalid_symbol", err)
+	}
+
+	if err := svc.Remove(asUser(ctx, "alice"), &watchlistGen.RemovePayload{Symbol: "aapl.xnas"}); err != nil {
+		t.Fatal(err)
+	}
+	if res, _ := svc.List(asUser(ctx, "alice"), &watchlistGen.ListPayload{}); len(res.Items) != 0 {
+		t.Errorf("List after Remove = %+v, want empty", res.Items)
+	}
+}